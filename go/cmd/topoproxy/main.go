@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// topoproxy is a caching read-through proxy for the global topology
+// server. Point the Vitess processes at it with
+// -topo_implementation topoproxy, so they share its upstream watches
+// and cached reads instead of each talking to the global topo server.
+package main
+
+import (
+	"context"
+	"flag"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproxy"
+
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+var (
+	cacheTTL = flag.Duration("cache_ttl", topoproxy.DefaultCacheTTL, "how long to keep watching a file after its last read, to serve the following reads from cache")
+)
+
+func init() {
+	servenv.RegisterDefaultFlags()
+}
+
+func main() {
+	servenv.ParseFlags("topoproxy")
+	servenv.Init()
+	defer servenv.Close()
+
+	if *servenv.GRPCPort == 0 {
+		log.Exitf("grpc_port must be set")
+	}
+	if *cacheTTL <= 0 {
+		log.Exitf("cache_ttl must be positive")
+	}
+
+	ts := topo.Open()
+	defer ts.Close()
+	conn, err := ts.ConnForCell(context.Background(), topo.GlobalCell)
+	if err != nil {
+		log.Exitf("cannot connect to the global topo server: %v", err)
+	}
+
+	proxy := topoproxy.NewProxy(conn, *cacheTTL)
+	servenv.OnRun(func() {
+		topoproxyservicepb.RegisterTopoProxyServer(servenv.GRPCServer, proxy)
+	})
+	servenv.OnTermSync(proxy.Close)
+	servenv.RunDefault()
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// vstreamsink streams the changes of a VStream consumer to files, webhooks
+// or Kafka. See vitess.io/vitess/go/vt/vstreamsink for the configuration.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vstreamsink"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	// Include the grpc client.
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+var (
+	configFile = flag.String("config", "", "path of the YAML configuration file")
+	server     = flag.String("server", "localhost:15991", "vtgate server to connect to")
+)
+
+func main() {
+	defer exit.RecoverAll()
+	defer logutil.Flush()
+
+	flag.Parse()
+	if len(flag.Args()) != 0 {
+		flag.Usage()
+		log.Exitf("vstreamsink doesn't take any parameter.")
+	}
+	if *configFile == "" {
+		flag.Usage()
+		log.Exitf("-config is required.")
+	}
+
+	config, err := vstreamsink.LoadConfig(*configFile)
+	if err != nil {
+		log.Exitf("Cannot load the configuration: %v", err)
+	}
+	var sinks []vstreamsink.Sink
+	for _, sinkConfig := range config.Sinks {
+		sink, err := vstreamsink.NewSink(sinkConfig)
+		if err != nil {
+			log.Exitf("Cannot create %v sink: %v", sinkConfig.Type, err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		cancel()
+	}()
+
+	conn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		log.Exitf("Cannot connect to %v: %v", *server, err)
+	}
+	defer conn.Close()
+
+	runner, err := vstreamsink.NewRunner(conn, config, sinks)
+	if err != nil {
+		log.Exitf("Invalid configuration: %v", err)
+	}
+	err = runner.Run(ctx)
+	runner.Close()
+	if err != nil && ctx.Err() == nil {
+		log.Exitf("vstreamsink failed: %v", err)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/vtadmin"
+	"vitess.io/vitess/go/vt/vtadmin/audit"
 	"vitess.io/vitess/go/vt/vtadmin/cluster"
 	"vitess.io/vitess/go/vt/vtadmin/grpcserver"
 	vtadminhttp "vitess.io/vitess/go/vt/vtadmin/http"
@@ -42,7 +43,8 @@ var (
 	clusterFileConfig    cluster.FileConfig
 	defaultClusterConfig cluster.Config
 
-	rbacConfigPath string
+	rbacConfigPath  string
+	auditConfigPath string
 
 	traceCloser io.Closer = &noopCloser{}
 
@@ -110,6 +112,16 @@ func run(cmd *cobra.Command, args []string) {
 		rbacConfig = cfg
 	}
 
+	var auditConfig *audit.Config
+	if auditConfigPath != "" {
+		cfg, err := audit.LoadConfig(auditConfigPath)
+		if err != nil {
+			fatal(err)
+		}
+
+		auditConfig = cfg
+	}
+
 	for i, cfg := range configs {
 		cluster, err := cfg.Cluster()
 		if err != nil {
@@ -124,6 +136,7 @@ func run(cmd *cobra.Command, args []string) {
 		GRPCOpts: opts,
 		HTTPOpts: httpOpts,
 		RBAC:     rbacConfig,
+		Audit:    auditConfig,
 	})
 	bootSpan.Finish()
 
@@ -164,6 +177,9 @@ func main() {
 	// rbac flags
 	rootCmd.Flags().StringVar(&rbacConfigPath, "rbac-config", "rbac.yaml", "")
 
+	// audit flags
+	rootCmd.Flags().StringVar(&auditConfigPath, "audit-config", "", "path to an audit log configuration, specifying the sinks to write audit entries of mutating operations to. see go/vt/vtadmin/audit/example/config.yaml")
+
 	// glog flags, no better way to do this
 	rootCmd.Flags().AddGoFlag(flag.Lookup("v"))
 	rootCmd.Flags().AddGoFlag(flag.Lookup("logtostderr"))
@@ -544,6 +544,9 @@ func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage
 	// We have more than the minimum retention count, so we could afford to
 	// prune some. See if any are beyond the minimum retention time.
 	// ListBackups returns them sorted by oldest first.
+	// Backups that incremental backups are based on are never pruned, since
+	// the incremental backups could no longer be restored without them.
+	dependents := mysqlctl.GetBackupDependents(ctx, backups)
 	for _, backup := range backups {
 		backupTime, err := parseBackupTime(backup.Name())
 		if err != nil {
@@ -554,6 +557,10 @@ func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage
 			log.Infof("Oldest backup taken at %v has not reached min_retention_time of %v. Nothing left to prune.", backupTime, *minRetentionTime)
 			break
 		}
+		if deps := dependents[backup.Name()]; len(deps) > 0 {
+			log.Infof("Keeping old backup %v, since incremental backups are based on it: %v", backup.Name(), strings.Join(deps, ", "))
+			continue
+		}
 		// Remove the backup.
 		log.Infof("Removing old backup %v from %v, since it's older than min_retention_time of %v", backup.Name(), backupDir, *minRetentionTime)
 		if err := backupStorage.RemoveBackup(ctx, backupDir, backup.Name()); err != nil {
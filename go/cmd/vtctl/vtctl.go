@@ -47,7 +47,7 @@ import (
 var (
 	waitTime         = flag.Duration("wait-time", 24*time.Hour, "time to wait on an action")
 	detachedMode     = flag.Bool("detach", false, "detached mode - run vtcl detached from the terminal")
-	durabilityPolicy = flag.String("durability_policy", "none", "type of durability to enforce. Default is none. Other values are dictated by registered plugins")
+	durabilityPolicy = flag.String("durability_policy", "none", "default durability policy, for the keyspaces that don't have a durability policy in their topo record. Default is none. Other values are dictated by registered plugins, or a declarative policy such as 'ackers=1;cross_cell=true'")
 )
 
 func init() {
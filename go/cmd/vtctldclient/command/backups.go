@@ -18,22 +18,37 @@ package command
 
 import (
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
-// GetBackups makes a GetBackups gRPC call to a vtctld.
-var GetBackups = &cobra.Command{
-	Use:  "GetBackups <keyspace/shard>",
-	Args: cobra.ExactArgs(1),
-	RunE: commandGetBackups,
-}
+var (
+	// GetBackups makes a GetBackups gRPC call to a vtctld.
+	GetBackups = &cobra.Command{
+		Use:  "GetBackups <keyspace/shard>",
+		Args: cobra.ExactArgs(1),
+		RunE: commandGetBackups,
+	}
+	// RestoreFromBackup makes a RestoreFromBackup gRPC call to a vtctld.
+	RestoreFromBackup = &cobra.Command{
+		Use: "RestoreFromBackup [--backup-timestamp|-t <YYYY-mm-DD.HHMMSS>] [--restore-to-time <YYYY-mm-DD.HHMMSS> | --restore-to-pos <position>] <tablet_alias>",
+		Long: "Stops mysqld on the specified tablet and restores the data from either the latest backup or closest before `backup-timestamp`. " +
+			"If `restore-to-time` or `restore-to-pos` is given, archived binary logs are then applied up to that point, and the tablet is left DRAINED.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandRestoreFromBackup,
+	}
+)
 
 var getBackupsOptions = struct {
 	Limit      uint32
@@ -77,8 +92,72 @@ func commandGetBackups(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var restoreFromBackupOptions = struct {
+	BackupTimestamp string
+	RestoreToTime   string
+	RestoreToPos    string
+}{}
+
+func commandRestoreFromBackup(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	req := &vtctldatapb.RestoreFromBackupRequest{
+		TabletAlias:  alias,
+		RestoreToPos: restoreFromBackupOptions.RestoreToPos,
+	}
+
+	if restoreFromBackupOptions.BackupTimestamp != "" {
+		backupTime, err := time.Parse(mysqlctl.BackupTimestampFormat, restoreFromBackupOptions.BackupTimestamp)
+		if err != nil {
+			return err
+		}
+
+		req.BackupTime = logutil.TimeToProto(backupTime)
+	}
+
+	if restoreFromBackupOptions.RestoreToTime != "" {
+		if restoreFromBackupOptions.RestoreToPos != "" {
+			return fmt.Errorf("--restore-to-time and --restore-to-pos are mutually exclusive")
+		}
+
+		restoreToTime, err := time.Parse(mysqlctl.BackupTimestampFormat, restoreFromBackupOptions.RestoreToTime)
+		if err != nil {
+			return err
+		}
+
+		req.RestoreToTimestamp = logutil.TimeToProto(restoreToTime)
+	}
+
+	cli.FinishedParsing(cmd)
+
+	stream, err := client.RestoreFromBackup(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		e, err := stream.Recv()
+		switch err {
+		case nil:
+			fmt.Printf("%s\n", logutil.EventString(e.Event))
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
 func init() {
 	GetBackups.Flags().Uint32VarP(&getBackupsOptions.Limit, "limit", "l", 0, "Retrieve only the most recent N backups")
 	GetBackups.Flags().BoolVarP(&getBackupsOptions.OutputJSON, "json", "j", false, "Output backup info in JSON format rather than a list of backups")
 	Root.AddCommand(GetBackups)
+
+	RestoreFromBackup.Flags().StringVarP(&restoreFromBackupOptions.BackupTimestamp, "backup-timestamp", "t", "", "Use the backup taken at or before this timestamp rather than using the latest backup.")
+	RestoreFromBackup.Flags().StringVar(&restoreFromBackupOptions.RestoreToTime, "restore-to-time", "", "Run a point-in-time recovery that ends at this timestamp, by applying archived binary logs on top of the latest backup taken before it.")
+	RestoreFromBackup.Flags().StringVar(&restoreFromBackupOptions.RestoreToPos, "restore-to-pos", "", "Run a point-in-time recovery that ends at this replication position, by applying archived binary logs on top of the latest backup taken before it.")
+	Root.AddCommand(RestoreFromBackup)
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
@@ -35,10 +36,12 @@ var (
 	sqlFileFlag        = flag.String("sql-file", "", "Identifies the file that contains the SQL commands to analyze")
 	schemaFlag         = flag.String("schema", "", "The SQL table schema")
 	schemaFileFlag     = flag.String("schema-file", "", "Identifies the file that contains the SQL table schema")
-	vschemaFlag        = flag.String("vschema", "", "Identifies the VTGate routing schema")
+	vschemaFlag        = flag.String("vschema", "", "Identifies the VTGate routing schema, either as a JSON map of keyspace name -> keyspace vschema or as a full SrvVSchema (the output of GetSrvVSchema, including routing rules)")
 	vschemaFileFlag    = flag.String("vschema-file", "", "Identifies the VTGate routing schema file")
-	ksShardMapFlag     = flag.String("ks-shard-map", "", "JSON map of keyspace name -> shard name -> ShardReference object. The inner map is the same as the output of FindAllShardsInKeyspace")
+	ksShardMapFlag     = flag.String("ks-shard-map", "", "JSON map of keyspace name -> shard name -> ShardReference object. The inner map is the same as the output of FindAllShardsInKeyspace. The inner map may also be replaced with a list of shard names, e.g. [\"-40\", \"40-c0\", \"c0-\"]")
 	ksShardMapFileFlag = flag.String("ks-shard-map-file", "", "File containing json blob of keyspace name -> shard name -> ShardReference object")
+	rowCountsFlag      = flag.String("row-counts", "", "JSON map of table name (or keyspace.table) -> total number of rows, used to estimate the rows read or written by each tablet query")
+	rowCountsFileFlag  = flag.String("row-counts-file", "", "File containing json blob of table name (or keyspace.table) -> total number of rows")
 	numShards          = flag.Int("shards", 2, "Number of shards per keyspace. Passing -ks-shard-map/-ks-shard-map-file causes this flag to be ignored.")
 	executionMode      = flag.String("execution-mode", "multi", "The execution mode to simulate -- must be set to multi, legacy-autocommit, or twopc")
 	replicationMode    = flag.String("replication-mode", "ROW", "The replication mode to simulate -- must be set to either ROW or STATEMENT")
@@ -47,6 +50,13 @@ var (
 	dbName             = flag.String("dbname", "", "Optional database target to override normal routing")
 	plannerVersionStr  = flag.String("planner-version", "V3", "Sets the query planner version to use when generating the explain output. Valid values are V3 and Gen4")
 
+	// flags for the schema change impact analysis
+	queryLogFileFlag        = flag.String("query-log-file", "", "File containing a vtgate query log, in the text or json format, or one SQL statement per line. When set, the queries are planned under both the current and the proposed schema and vschema, and the queries whose plans change, that start failing or that become scatter queries are reported")
+	proposedSchemaFlag      = flag.String("proposed-schema", "", "The proposed SQL table schema to compare against when using -query-log-file. Defaults to the current schema")
+	proposedSchemaFileFlag  = flag.String("proposed-schema-file", "", "Identifies the file that contains the proposed SQL table schema")
+	proposedVSchemaFlag     = flag.String("proposed-vschema", "", "The proposed VTGate routing schema to compare against when using -query-log-file. Defaults to the current vschema")
+	proposedVSchemaFileFlag = flag.String("proposed-vschema-file", "", "Identifies the file that contains the proposed VTGate routing schema")
+
 	// vtexplainFlags lists all the flags that should show in usage
 	vtexplainFlags = []string{
 		"output-mode",
@@ -62,6 +72,13 @@ var (
 		"vschema-file",
 		"ks-shard-map",
 		"ks-shard-map-file",
+		"row-counts",
+		"row-counts-file",
+		"query-log-file",
+		"proposed-schema",
+		"proposed-schema-file",
+		"proposed-vschema",
+		"proposed-vschema-file",
 		"dbname",
 		"queryserver-config-passthrough-dmls",
 	}
@@ -149,10 +166,13 @@ func main() {
 }
 
 func parseAndRun() error {
-	sql, err := getFileParam(*sqlFlag, *sqlFileFlag, "sql", true)
+	sql, err := getFileParam(*sqlFlag, *sqlFileFlag, "sql", *queryLogFileFlag == "")
 	if err != nil {
 		return err
 	}
+	if sql != "" && *queryLogFileFlag != "" {
+		return fmt.Errorf("action requires only one of sql or query-log-file")
+	}
 
 	schema, err := getFileParam(*schemaFlag, *schemaFileFlag, "schema", true)
 	if err != nil {
@@ -169,6 +189,18 @@ func parseAndRun() error {
 		return err
 	}
 
+	rowCountsStr, err := getFileParam(*rowCountsFlag, *rowCountsFileFlag, "row-counts", false)
+	if err != nil {
+		return err
+	}
+
+	var rowCounts map[string]int64
+	if rowCountsStr != "" {
+		if err := json.Unmarshal([]byte(rowCountsStr), &rowCounts); err != nil {
+			return fmt.Errorf("cannot parse row-counts: %v", err)
+		}
+	}
+
 	plannerVersion := querypb.ExecuteOptions_PlannerVersion(querypb.ExecuteOptions_PlannerVersion_value[*plannerVersionStr])
 	if plannerVersion != querypb.ExecuteOptions_V3 && plannerVersion != querypb.ExecuteOptions_Gen4 {
 		return fmt.Errorf("invalid value specified for planner-version of '%s' -- valid values are V3 and Gen4", *plannerVersionStr)
@@ -181,6 +213,11 @@ func parseAndRun() error {
 		NumShards:       *numShards,
 		Normalize:       *normalize,
 		Target:          *dbName,
+		TableRowCounts:  rowCounts,
+	}
+
+	if *queryLogFileFlag != "" {
+		return analyzeImpact(schema, vschema, ksShardMap, opts)
 	}
 
 	log.V(100).Infof("sql %s\n", sql)
@@ -205,3 +242,56 @@ func parseAndRun() error {
 
 	return nil
 }
+
+// analyzeImpact reports how the queries of the query log are impacted by
+// the proposed schema and vschema.
+func analyzeImpact(schema, vschema, ksShardMap string, opts *vtexplain.Options) error {
+	queryLog, err := os.ReadFile(*queryLogFileFlag)
+	if err != nil {
+		return fmt.Errorf("cannot read file %v: %v", *queryLogFileFlag, err)
+	}
+
+	proposedSchema, err := getFileParam(*proposedSchemaFlag, *proposedSchemaFileFlag, "proposed-schema", false)
+	if err != nil {
+		return err
+	}
+
+	proposedVSchema, err := getFileParam(*proposedVSchemaFlag, *proposedVSchemaFileFlag, "proposed-vschema", false)
+	if err != nil {
+		return err
+	}
+
+	if proposedSchema == "" && proposedVSchema == "" {
+		return fmt.Errorf("action requires one of proposed-schema, proposed-schema-file, proposed-vschema or proposed-vschema-file")
+	}
+	if proposedSchema == "" {
+		proposedSchema = schema
+	}
+	if proposedVSchema == "" {
+		proposedVSchema = vschema
+	}
+
+	queries, err := vtexplain.ParseQueryLog(string(queryLog))
+	if err != nil {
+		return fmt.Errorf("cannot parse query log: %v", err)
+	}
+
+	report, err := vtexplain.AnalyzeImpact(
+		queries,
+		&vtexplain.Schema{SQLSchema: schema, VSchema: vschema},
+		&vtexplain.Schema{SQLSchema: proposedSchema, VSchema: proposedVSchema},
+		ksShardMap,
+		opts,
+	)
+	if err != nil {
+		return err
+	}
+
+	if *outputMode == "text" {
+		fmt.Print(vtexplain.ImpactReportAsText(report))
+	} else {
+		fmt.Print(vtexplain.ImpactReportAsJSON(report))
+	}
+
+	return nil
+}
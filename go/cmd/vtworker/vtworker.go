@@ -45,7 +45,7 @@ var (
 	cell                   = flag.String("cell", "", "cell to pick servers from")
 	commandDisplayInterval = flag.Duration("command_display_interval", time.Second, "Interval between each status update when vtworker is executing a single command from the command line")
 	username               = flag.String("username", "", "If set, value is set as immediate caller id in the request and used by vttablet for TableACL check")
-	durabilityPolicy       = flag.String("durability_policy", "none", "type of durability to enforce. Default is none. Other values are dictated by registered plugins")
+	durabilityPolicy       = flag.String("durability_policy", "none", "default durability policy, for the keyspaces that don't have a durability policy in their topo record. Default is none. Other values are dictated by registered plugins, or a declarative policy such as 'ackers=1;cross_cell=true'")
 )
 
 func init() {
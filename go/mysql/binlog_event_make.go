@@ -279,6 +279,23 @@ func NewMariaDBGTIDEvent(f BinlogFormat, s *FakeBinlogStream, gtid MariadbGTID,
 	return NewMariadbBinlogEvent(ev)
 }
 
+// NewMySQL56GTIDEvent returns a MySQL 5.6 specific GTID event.
+func NewMySQL56GTIDEvent(f BinlogFormat, s *FakeBinlogStream, gtid Mysql56GTID) BinlogEvent {
+	data := make([]byte, 1+16+8)
+	copy(data[1:17], gtid.Server[:])
+	binary.LittleEndian.PutUint64(data[17:25], uint64(gtid.Sequence))
+
+	ev := s.Packetize(f, eGTIDEvent, 0, data)
+	return NewMysql56BinlogEvent(ev)
+}
+
+// NewMySQL56PreviousGTIDsEvent returns a MySQL 5.6 specific PreviousGTIDs
+// event, as found at the start of every binlog file.
+func NewMySQL56PreviousGTIDsEvent(f BinlogFormat, s *FakeBinlogStream, set Mysql56GTIDSet) BinlogEvent {
+	ev := s.Packetize(f, ePreviousGTIDsEvent, 0, set.SIDBlock())
+	return NewMysql56BinlogEvent(ev)
+}
+
 // NewTableMapEvent returns a TableMap event.
 // Only works with post_header_length=8.
 func NewTableMapEvent(f BinlogFormat, s *FakeBinlogStream, tableID uint64, tm *TableMap) BinlogEvent {
@@ -238,6 +238,49 @@ func TestMariadDBGTIDEVent(t *testing.T) {
 	}
 }
 
+func TestMySQL56GTIDEvents(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	s := NewFakeBinlogStream()
+
+	sid := SID{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+	event := NewMySQL56GTIDEvent(f, s, Mysql56GTID{Server: sid, Sequence: 0x123456789})
+	if !event.IsValid() {
+		t.Fatalf("NewMySQL56GTIDEvent().IsValid() is false")
+	}
+	if !event.IsGTID() {
+		t.Fatalf("NewMySQL56GTIDEvent().IsGTID() is false")
+	}
+	event, _, err := event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+	gtid, _, err := event.GTID(f)
+	if err != nil {
+		t.Fatalf("NewMySQL56GTIDEvent().GTID() returned error: %v", err)
+	}
+	want := Mysql56GTID{Server: sid, Sequence: 0x123456789}
+	if gtid != want {
+		t.Fatalf("NewMySQL56GTIDEvent().GTID() = %v, want %v", gtid, want)
+	}
+
+	set := Mysql56GTIDSet{sid: []interval{{start: 1, end: 5}}}
+	event = NewMySQL56PreviousGTIDsEvent(f, s, set)
+	if !event.IsPreviousGTIDs() {
+		t.Fatalf("NewMySQL56PreviousGTIDsEvent().IsPreviousGTIDs() is false")
+	}
+	event, _, err = event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+	pos, err := event.PreviousGTIDs(f)
+	if err != nil {
+		t.Fatalf("NewMySQL56PreviousGTIDsEvent().PreviousGTIDs() returned error: %v", err)
+	}
+	if !pos.GTIDSet.Equal(set) {
+		t.Fatalf("NewMySQL56PreviousGTIDsEvent().PreviousGTIDs() = %v, want %v", pos.GTIDSet, set)
+	}
+}
+
 func TestTableMapEvent(t *testing.T) {
 	f := NewMySQL56BinlogFormat()
 	s := NewFakeBinlogStream()
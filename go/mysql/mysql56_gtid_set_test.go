@@ -497,6 +497,18 @@ func TestMysql56GTIDSetDifference(t *testing.T) {
 	}
 }
 
+func TestMysql56GTIDSetCount(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 255}
+
+	assert.Equal(t, int64(0), Mysql56GTIDSet{}.Count())
+	set := Mysql56GTIDSet{
+		sid1: []interval{{1, 5}, {10, 20}},
+		sid2: []interval{{50, 50}},
+	}
+	assert.Equal(t, int64(17), set.Count())
+}
+
 func TestMysql56GTIDSetSIDBlock(t *testing.T) {
 	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
 	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
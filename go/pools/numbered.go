@@ -170,6 +170,26 @@ func (nu *Numbered) GetByFilter(purpose string, match func(val interface{}) bool
 	return vals
 }
 
+// GetByIdleFilter returns a list of resources that match the filter, and
+// locks them. The filter is also given how long the resource has been idle.
+// It does not return any resources that are already locked.
+func (nu *Numbered) GetByIdleFilter(purpose string, match func(val interface{}, idle time.Duration) bool) (vals []interface{}) {
+	nu.mu.Lock()
+	defer nu.mu.Unlock()
+	now := time.Now()
+	for _, nw := range nu.resources {
+		if nw.inUse || !nw.enforceTimeout {
+			continue
+		}
+		if match(nw.val, now.Sub(nw.timeUsed)) {
+			nw.inUse = true
+			nw.purpose = purpose
+			vals = append(vals, nw.val)
+		}
+	}
+	return vals
+}
+
 // GetOutdated returns a list of resources that are older than age, and locks them.
 // It does not return any resources that are already locked.
 func (nu *Numbered) GetOutdated(age time.Duration, purpose string) (vals []interface{}) {
@@ -18,6 +18,7 @@ package pools
 
 import (
 	"math/rand"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -119,6 +120,29 @@ func TestNumberedGetByFilter(t *testing.T) {
 	assert.Equal(t, want, vals)
 }
 
+func TestNumberedGetByIdleFilter(t *testing.T) {
+	p := NewNumbered()
+	p.Register(1, 1, true)
+	p.Register(2, 2, true)
+	p.Register(3, 3, false)
+	time.Sleep(10 * time.Millisecond)
+	p.Register(4, 4, true)
+
+	vals := p.GetByIdleFilter("filtered", func(v interface{}, idle time.Duration) bool {
+		return idle >= 10*time.Millisecond
+	})
+	sort.Slice(vals, func(i, j int) bool { return vals[i].(int) < vals[j].(int) })
+	want := []interface{}{1, 2}
+	assert.Equal(t, want, vals)
+
+	// Locked resources are not returned again.
+	vals = p.GetByIdleFilter("filtered", func(v interface{}, idle time.Duration) bool {
+		return true
+	})
+	want = []interface{}{4}
+	assert.Equal(t, want, vals)
+}
+
 /*
 go test --test.run=XXX --test.bench=. --test.benchtime=10s
 
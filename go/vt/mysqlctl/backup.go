@@ -42,10 +42,13 @@ import (
 // This file handles the backup and restore related code
 
 const (
-	// the three bases for files to restore
+	// the bases for files to restore
 	backupInnodbDataHomeDir     = "InnoDBData"
 	backupInnodbLogGroupHomeDir = "InnoDBLog"
 	backupData                  = "Data"
+	// backupBinlogDir is only used by incremental backups, whose binlogs
+	// are applied on restore rather than copied in place.
+	backupBinlogDir = "BinlogDir"
 
 	// backupManifestFileName is the MANIFEST file name within a backup.
 	backupManifestFileName = "MANIFEST"
@@ -107,15 +110,56 @@ func Backup(ctx context.Context, params BackupParams) error {
 		return vterrors.Wrap(err, "unable to get backup storage")
 	}
 	defer bs.Close()
+
+	be, err := GetBackupEngine()
+	if err != nil {
+		return vterrors.Wrap(err, "failed to find backup engine")
+	}
+
+	if params.Incremental {
+		if _, ok := be.(*BuiltinBackupEngine); !ok {
+			return vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "incremental backups are only supported by the %v backup engine", builtinBackupEngineName)
+		}
+		// An incremental backup is based on the latest backup, be it full
+		// or incremental.
+		bhs, err := bs.ListBackups(ctx, backupDir)
+		if err != nil {
+			return vterrors.Wrap(err, "ListBackups failed")
+		}
+		if len(bhs) == 0 {
+			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no backup in %v to take an incremental backup from", backupDir)
+		}
+		parent, err := FindBackupToRestore(ctx, RestoreParams{Logger: params.Logger, Keyspace: params.Keyspace, Shard: params.Shard}, bhs)
+		if err != nil {
+			return vterrors.Wrap(err, "can't find a backup to take an incremental backup from")
+		}
+		parentManifest, err := GetBackupManifest(ctx, parent)
+		if err != nil {
+			return err
+		}
+		params.incrementalFromBackup = parent.Name()
+		params.incrementalFromPos = parentManifest.Position
+	}
+
 	bh, err := bs.StartBackup(ctx, backupDir, name)
 	if err != nil {
 		return vterrors.Wrap(err, "StartBackup failed")
 	}
 
-	be, err := GetBackupEngine()
+	// Encrypt the files of the backup before they reach the BackupStorage,
+	// if configured to.
+	encryptedBH, envelope, err := newEncryptingBackupHandle(ctx, bh)
 	if err != nil {
-		return vterrors.Wrap(err, "failed to find backup engine")
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			params.Logger.Errorf2(abortErr, "failed to abort backup")
+		}
+		return err
+	}
+	if envelope != nil {
+		params.Logger.Infof("encrypting backup with a data key wrapped by key %v of %v", envelope.KeyID, envelope.KeyManager)
 	}
+	bh = encryptedBH
+	params.encryption = envelope
 
 	// Take the backup, and either AbortBackup or EndBackup.
 	usable, err := be.ExecuteBackup(ctx, params, bh)
@@ -142,6 +186,37 @@ func Backup(ctx context.Context, params BackupParams) error {
 	return finishErr
 }
 
+// restoreIncrementalBackups applies incremental backups, oldest first, on top
+// of the backup that was just restored at position pos. mysqld must be
+// running. It returns the manifest of the last incremental backup, with the
+// position reached by mysqld.
+func restoreIncrementalBackups(ctx context.Context, params RestoreParams, pos mysql.Position, bhs []backupstorage.BackupHandle) (*BackupManifest, error) {
+	if err := disableSuperReadOnly(params.Mysqld); err != nil {
+		return nil, err
+	}
+
+	be := &BuiltinBackupEngine{}
+	var manifest *BackupManifest
+	for _, bh := range bhs {
+		params.Logger.Infof("Restore: applying incremental backup %v", bh.Name())
+		bm, err := be.restoreIncrementalBackup(ctx, params, bh, pos)
+		if err != nil {
+			return nil, err
+		}
+		manifest = bm
+		pos = mysql.Position{GTIDSet: pos.GTIDSet.Union(bm.Position.GTIDSet)}
+	}
+
+	// The binlogs may have been applied only partially, for a point-in-time
+	// recovery, so the position is the one mysqld actually reached.
+	pos, err := params.Mysqld.PrimaryPosition()
+	if err != nil {
+		return nil, err
+	}
+	manifest.Position = pos
+	return manifest, nil
+}
+
 // ParseBackupName parses the backup name for a given dir/name, according to
 // the format generated by mysqlctl.Backup. An error is returned only if the
 // backup name does not have the expected number of parts; errors parsing the
@@ -268,6 +343,13 @@ func ShouldRestore(ctx context.Context, params RestoreParams) (bool, error) {
 func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error) {
 	startTs := time.Now()
 	// find the right backup handle: most recent one, with a MANIFEST
+	if !params.RestoreToPos.IsZero() && !params.RestoreToTimestamp.IsZero() {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot restore both to a position and to a timestamp")
+	}
+	if !params.RestoreToTimestamp.IsZero() && (params.StartTime.IsZero() || params.StartTime.After(params.RestoreToTimestamp)) {
+		// The backup to restore must have been taken before the requested time.
+		params.StartTime = params.RestoreToTimestamp
+	}
 	params.Logger.Infof("Restore: looking for a suitable backup to restore")
 	bs, err := backupstorage.GetBackupStorage()
 	if err != nil {
@@ -311,12 +393,32 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, err
 	}
 
-	re, err := GetRestoreEngine(ctx, bh)
+	// An incremental backup is restored by first restoring the full backup
+	// it is based on, and then applying the incremental backups in order.
+	chain, err := FindBackupChain(ctx, bhs, bh)
+	if err != nil {
+		return nil, err
+	}
+	if len(chain) > 1 {
+		params.Logger.Infof("Restore: backup %v is an incremental backup, restoring full backup %v first", bh.Name(), chain[0].Name())
+	}
+	// Encrypted backups are decrypted with the data key in their MANIFEST.
+	for i, b := range chain {
+		bm, err := GetBackupManifest(ctx, b)
+		if err != nil {
+			return nil, err
+		}
+		if chain[i], err = newDecryptingBackupHandle(ctx, b, bm.Encryption); err != nil {
+			return nil, err
+		}
+	}
+
+	re, err := GetRestoreEngine(ctx, chain[0])
 	if err != nil {
 		return nil, vterrors.Wrap(err, "Failed to find restore engine")
 	}
 
-	manifest, err := re.ExecuteRestore(ctx, params, bh)
+	manifest, err := re.ExecuteRestore(ctx, params, chain[0])
 	if err != nil {
 		return nil, err
 	}
@@ -379,6 +481,23 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, err
 	}
 
+	if len(chain) > 1 {
+		manifest, err = restoreIncrementalBackups(ctx, params, manifest.Position, chain[1:])
+		if err != nil {
+			return nil, vterrors.Wrap(err, "failed to restore incremental backups")
+		}
+	}
+
+	if params.IsPointInTimeRecovery() {
+		params.Logger.Infof("Restore: applying archived binlogs on top of backup at position %v", mysql.EncodePosition(manifest.Position))
+		pos, err := RestoreFromBinlogArchive(ctx, bs, params, manifest.Position)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "point-in-time recovery failed")
+		}
+		params.Logger.Infof("Restore: point-in-time recovery reached position %v", mysql.EncodePosition(pos))
+		manifest.Position = pos
+	}
+
 	if err = removeStateFile(params.Cnf); err != nil {
 		return nil, err
 	}
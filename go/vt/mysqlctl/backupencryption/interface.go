@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backupencryption implements the client-side encryption of backups.
+//
+// Backups are encrypted with envelope encryption: each backup gets its own
+// random data key, which encrypts all the files of the backup with AES-GCM.
+// The data key is itself encrypted (wrapped) by a KeyManager, and stored
+// wrapped in the MANIFEST of the backup, along with the ID of the key that
+// wrapped it.
+package backupencryption
+
+import (
+	"context"
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"io"
+)
+
+const (
+	// Algorithm is the algorithm used to encrypt the files of a backup.
+	Algorithm = "AES-256-GCM"
+
+	// dataKeySize is the size of a data key, in bytes.
+	dataKeySize = 32
+)
+
+var (
+	// KeyManagerImplementation is the name of the KeyManager wrapping the
+	// data keys of new backups. Backups are not encrypted if it is empty.
+	// Exported for test purposes.
+	KeyManagerImplementation = flag.String("backup_encryption_key_manager", "", "which implementation to use to wrap the data keys of backups, to encrypt them client-side. Backups are not encrypted if unset. Builtin implementation: keyfile")
+)
+
+// KeyManager wraps and unwraps data keys with a master key, which never
+// leaves the KeyManager. It can be a local key, or a KMS.
+type KeyManager interface {
+	// WrapKey encrypts a data key with the current master key. It returns
+	// the ID of the master key, and the wrapped data key.
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error)
+
+	// UnwrapKey decrypts a data key that was wrapped by WrapKey with the
+	// master key keyID.
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// KeyManagerMap contains the registered implementations for KeyManager.
+// A KMS plugin registers itself here in its init function.
+var KeyManagerMap = make(map[string]KeyManager)
+
+// GetKeyManager returns the registered KeyManager with the given name.
+func GetKeyManager(name string) (KeyManager, error) {
+	km, ok := KeyManagerMap[name]
+	if !ok {
+		return nil, fmt.Errorf("no registered implementation of KeyManager named %v", name)
+	}
+	return km, nil
+}
+
+// Envelope describes how the files of a backup are encrypted. It is stored
+// in the MANIFEST of the backup.
+type Envelope struct {
+	// Algorithm is the algorithm the files are encrypted with.
+	Algorithm string
+
+	// KeyManager is the name of the KeyManager that wrapped the data key.
+	KeyManager string
+
+	// KeyID is the ID of the master key that wrapped the data key, within
+	// KeyManager.
+	KeyID string
+
+	// EncryptedDataKey is the wrapped data key.
+	EncryptedDataKey []byte
+}
+
+// NewEnvelope generates a new data key, and wraps it with the KeyManager
+// set by --backup_encryption_key_manager. It returns a nil Envelope if
+// backups are not encrypted.
+func NewEnvelope(ctx context.Context) (*Envelope, []byte, error) {
+	if *KeyManagerImplementation == "" {
+		return nil, nil, nil
+	}
+	km, err := GetKeyManager(*KeyManagerImplementation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, nil, fmt.Errorf("can't generate data key: %v", err)
+	}
+	keyID, wrapped, err := km.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't wrap data key with %v: %v", *KeyManagerImplementation, err)
+	}
+	return &Envelope{
+		Algorithm:        Algorithm,
+		KeyManager:       *KeyManagerImplementation,
+		KeyID:            keyID,
+		EncryptedDataKey: wrapped,
+	}, dataKey, nil
+}
+
+// DataKey unwraps the data key of the envelope, with the KeyManager that
+// wrapped it. That KeyManager doesn't have to be the one set by
+// --backup_encryption_key_manager, but it must be registered.
+func (e *Envelope) DataKey(ctx context.Context) ([]byte, error) {
+	if e.Algorithm != Algorithm {
+		return nil, fmt.Errorf("unsupported backup encryption algorithm %v", e.Algorithm)
+	}
+	km, err := GetKeyManager(e.KeyManager)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := km.UnwrapKey(ctx, e.KeyID, e.EncryptedDataKey)
+	if err != nil {
+		return nil, fmt.Errorf("can't unwrap data key with key %v of %v: %v", e.KeyID, e.KeyManager, err)
+	}
+	if len(dataKey) != dataKeySize {
+		return nil, fmt.Errorf("invalid data key size %v", len(dataKey))
+	}
+	return dataKey, nil
+}
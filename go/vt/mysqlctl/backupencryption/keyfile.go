@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupencryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// keyFileKeySize is the size of the master key in the keyfile, in bytes.
+const keyFileKeySize = 32
+
+var (
+	keyFile = flag.String("backup_encryption_keyfile", "", "path to the file holding the hex-encoded 256-bit master key of the keyfile backup encryption key manager, e.g. generated with 'openssl rand -hex 32'")
+)
+
+// KeyFileManager is a KeyManager using a master key read from a local file,
+// set by --backup_encryption_keyfile. The ID of the key is derived from its
+// hash, so a backup can only be restored with the key that encrypted it.
+type KeyFileManager struct{}
+
+// readKey reads the master key from the keyfile, and returns it with its ID.
+// The file is read every time, so the key can be rotated without a restart.
+func (KeyFileManager) readKey() (string, cipher.AEAD, error) {
+	if *keyFile == "" {
+		return "", nil, fmt.Errorf("--backup_encryption_keyfile is not set")
+	}
+	data, err := os.ReadFile(*keyFile)
+	if err != nil {
+		return "", nil, err
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return "", nil, fmt.Errorf("can't decode key in %v: %v", *keyFile, err)
+	}
+	if len(key) != keyFileKeySize {
+		return "", nil, fmt.Errorf("key in %v is %v bytes long, expected %v", *keyFile, len(key), keyFileKeySize)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", nil, err
+	}
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8]), aead, nil
+}
+
+// WrapKey is part of the KeyManager interface.
+func (km KeyFileManager) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	keyID, aead, err := km.readKey()
+	if err != nil {
+		return "", nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", nil, err
+	}
+	return keyID, aead.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+// UnwrapKey is part of the KeyManager interface.
+func (km KeyFileManager) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	currentID, aead, err := km.readKey()
+	if err != nil {
+		return nil, err
+	}
+	if keyID != currentID {
+		return nil, fmt.Errorf("data key was wrapped with key %v, but %v holds key %v", keyID, *keyFile, currentID)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, fmt.Errorf("wrapped data key is too short")
+	}
+	nonce, sealed := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, []byte(keyID))
+}
+
+// newAEAD returns AES-GCM with the given 256-bit key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func init() {
+	KeyManagerMap["keyfile"] = KeyFileManager{}
+}
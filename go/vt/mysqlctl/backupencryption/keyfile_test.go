@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupencryption
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyFileEnvelope(t *testing.T) {
+	ctx := context.Background()
+
+	keyPath := path.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyPath, []byte(strings.Repeat("01", keyFileKeySize)+"\n"), 0600))
+	oldKeyFile := *keyFile
+	*keyFile = keyPath
+	defer func() { *keyFile = oldKeyFile }()
+
+	oldImplementation := *KeyManagerImplementation
+	defer func() { *KeyManagerImplementation = oldImplementation }()
+
+	// Backups are not encrypted by default.
+	*KeyManagerImplementation = ""
+	envelope, dataKey, err := NewEnvelope(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, envelope)
+	assert.Nil(t, dataKey)
+
+	*KeyManagerImplementation = "keyfile"
+	envelope, dataKey, err = NewEnvelope(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, envelope)
+	assert.Equal(t, Algorithm, envelope.Algorithm)
+	assert.Equal(t, "keyfile", envelope.KeyManager)
+	assert.Len(t, envelope.KeyID, 16)
+	assert.NotContains(t, string(envelope.EncryptedDataKey), string(dataKey))
+
+	// The data key can be unwrapped even if backups are no longer encrypted.
+	*KeyManagerImplementation = ""
+	got, err := envelope.DataKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, got)
+
+	// A rotated key can't unwrap data keys wrapped by the previous one.
+	require.NoError(t, os.WriteFile(keyPath, []byte(strings.Repeat("02", keyFileKeySize)), 0600))
+	_, err = envelope.DataKey(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "data key was wrapped with key "+envelope.KeyID)
+
+	require.NoError(t, os.WriteFile(keyPath, []byte("0102"), 0600))
+	_, err = envelope.DataKey(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is 2 bytes long, expected 32")
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupencryption
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// An encrypted file is made of a header, followed by chunks of at most
+// chunkSize bytes of plaintext, each sealed with AES-GCM. The header holds
+// a magic string and a random nonce, from which the nonce of each chunk is
+// derived with the index of the chunk. The name of the file and whether the
+// chunk is the last one are authenticated with each chunk, so that files
+// can't be swapped, and chunks can't be reordered, dropped or truncated.
+const (
+	streamMagic = "VTBKENC1"
+	chunkSize   = 64 * 1024
+)
+
+// ErrCorrupted is returned when an encrypted file can't be authenticated:
+// it was modified, truncated, or it is decrypted with the wrong data key.
+var ErrCorrupted = errors.New("encrypted backup file is corrupted or the data key is wrong")
+
+// chunkCipher seals and opens the chunks of an encrypted file.
+type chunkCipher struct {
+	aead  cipher.AEAD
+	nonce []byte
+	ad    []byte
+	index uint64
+}
+
+func newChunkCipher(dataKey []byte, name string, nonce []byte) (*chunkCipher, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &chunkCipher{
+		aead:  aead,
+		nonce: nonce,
+		// The last byte is set to 1 for the last chunk.
+		ad: append([]byte(name), 0),
+	}, nil
+}
+
+// next returns the nonce and the additional data of the next chunk.
+func (c *chunkCipher) next(last bool) ([]byte, []byte) {
+	nonce := make([]byte, len(c.nonce))
+	copy(nonce, c.nonce)
+	counter := binary.BigEndian.Uint64(nonce[len(nonce)-8:]) ^ c.index
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], counter)
+	c.index++
+
+	c.ad[len(c.ad)-1] = 0
+	if last {
+		c.ad[len(c.ad)-1] = 1
+	}
+	return nonce, c.ad
+}
+
+// encryptWriter encrypts what is written to it, see NewWriter.
+type encryptWriter struct {
+	w      io.Writer
+	c      *chunkCipher
+	buf    []byte
+	out    []byte
+	closed bool
+}
+
+// NewWriter returns a WriteCloser that encrypts what is written to it with
+// dataKey, and writes it to w. name is the name of the file within the
+// backup, and must be given to NewReader to decrypt it. Close must be called
+// to write the last chunk, it does not close w.
+func NewWriter(w io.Writer, dataKey []byte, name string) (io.WriteCloser, error) {
+	nonce := make([]byte, 12)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	c, err := newChunkCipher(dataKey, name, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(append([]byte(streamMagic), nonce...)); err != nil {
+		return nil, err
+	}
+	return &encryptWriter{
+		w:   w,
+		c:   c,
+		buf: make([]byte, 0, chunkSize),
+		out: make([]byte, 0, chunkSize+c.aead.Overhead()),
+	}, nil
+}
+
+// Write is part of the io.Writer interface.
+func (ew *encryptWriter) Write(p []byte) (int, error) {
+	if ew.closed {
+		return 0, fmt.Errorf("write on closed encrypted file")
+	}
+	written := 0
+	for len(p) > 0 {
+		// A full chunk is only sealed once more data comes, as we don't
+		// know before whether it is the last one.
+		if len(ew.buf) == chunkSize {
+			if err := ew.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := chunkSize - len(ew.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		ew.buf = append(ew.buf, p[:n]...)
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+// Close seals the last chunk, which may be empty.
+func (ew *encryptWriter) Close() error {
+	if ew.closed {
+		return nil
+	}
+	ew.closed = true
+	return ew.seal(true)
+}
+
+func (ew *encryptWriter) seal(last bool) error {
+	nonce, ad := ew.c.next(last)
+	ew.out = ew.c.aead.Seal(ew.out[:0], nonce, ew.buf, ad)
+	ew.buf = ew.buf[:0]
+	_, err := ew.w.Write(ew.out)
+	return err
+}
+
+// decryptReader decrypts what is read from it, see NewReader.
+type decryptReader struct {
+	r     *bufio.Reader
+	c     *chunkCipher
+	in    []byte
+	plain []byte
+	buf   []byte
+	done  bool
+}
+
+// NewReader returns a Reader that decrypts r, which was written by a Writer
+// returned by NewWriter with the same dataKey and name. Reads return
+// ErrCorrupted if r can't be authenticated.
+func NewReader(r io.Reader, dataKey []byte, name string) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(streamMagic)+12)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("can't read encrypted file header: %v", err)
+	}
+	if string(header[:len(streamMagic)]) != streamMagic {
+		return nil, fmt.Errorf("not an encrypted backup file")
+	}
+	c, err := newChunkCipher(dataKey, name, header[len(streamMagic):])
+	if err != nil {
+		return nil, err
+	}
+	return &decryptReader{
+		r:   br,
+		c:   c,
+		in:  make([]byte, chunkSize+c.aead.Overhead()),
+		buf: make([]byte, 0, chunkSize),
+	}, nil
+}
+
+// Read is part of the io.Reader interface.
+func (dr *decryptReader) Read(p []byte) (int, error) {
+	for len(dr.plain) == 0 {
+		if dr.done {
+			return 0, io.EOF
+		}
+		if err := dr.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, dr.plain)
+	dr.plain = dr.plain[n:]
+	return n, nil
+}
+
+func (dr *decryptReader) open() error {
+	n, err := io.ReadFull(dr.r, dr.in)
+	last := false
+	switch err {
+	case nil:
+		if _, err := dr.r.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	case io.EOF, io.ErrUnexpectedEOF:
+		last = true
+	default:
+		return err
+	}
+
+	nonce, ad := dr.c.next(last)
+	plain, err := dr.c.aead.Open(dr.buf[:0], nonce, dr.in[:n], ad)
+	if err != nil {
+		return ErrCorrupted
+	}
+	dr.plain = plain
+	dr.done = last
+	return nil
+}
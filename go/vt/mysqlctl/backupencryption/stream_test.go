@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupencryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encrypt(t *testing.T, dataKey []byte, name string, plaintext []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, dataKey, name)
+	require.NoError(t, err)
+	// Write in odd-sized pieces, to cross chunk boundaries.
+	for len(plaintext) > 0 {
+		n := 10000
+		if n > len(plaintext) {
+			n = len(plaintext)
+		}
+		_, err := w.Write(plaintext[:n])
+		require.NoError(t, err)
+		plaintext = plaintext[n:]
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decrypt(dataKey []byte, name string, ciphertext []byte) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(ciphertext), dataKey, name)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestStream(t *testing.T) {
+	dataKey := make([]byte, dataKeySize)
+	_, err := rand.Read(dataKey)
+	require.NoError(t, err)
+
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 12345} {
+		plaintext := make([]byte, size)
+		_, err := rand.Read(plaintext)
+		require.NoError(t, err)
+
+		ciphertext := encrypt(t, dataKey, "file", plaintext)
+		got, err := decrypt(dataKey, "file", ciphertext)
+		require.NoError(t, err, "size %v", size)
+		assert.Equal(t, plaintext, got, "size %v", size)
+	}
+
+	plaintext := bytes.Repeat([]byte("vitess"), chunkSize)
+	ciphertext := encrypt(t, dataKey, "file", plaintext)
+	assert.False(t, bytes.Contains(ciphertext, []byte("vitessvitess")))
+
+	otherKey := make([]byte, dataKeySize)
+	tampered := append([]byte{}, ciphertext...)
+	tampered[len(tampered)/2] ^= 1
+	tests := []struct {
+		name       string
+		dataKey    []byte
+		fileName   string
+		ciphertext []byte
+	}{{
+		name:       "wrong key",
+		dataKey:    otherKey,
+		fileName:   "file",
+		ciphertext: ciphertext,
+	}, {
+		name:       "wrong file name",
+		dataKey:    dataKey,
+		fileName:   "other",
+		ciphertext: ciphertext,
+	}, {
+		name:       "tampered",
+		dataKey:    dataKey,
+		fileName:   "file",
+		ciphertext: tampered,
+	}, {
+		name:       "truncated at a chunk boundary",
+		dataKey:    dataKey,
+		fileName:   "file",
+		ciphertext: ciphertext[:len(streamMagic)+12+2*(chunkSize+16)],
+	}, {
+		name:       "truncated within a chunk",
+		dataKey:    dataKey,
+		fileName:   "file",
+		ciphertext: ciphertext[:len(ciphertext)-1],
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decrypt(tt.dataKey, tt.fileName, tt.ciphertext)
+			assert.Equal(t, ErrCorrupted, err)
+		})
+	}
+}
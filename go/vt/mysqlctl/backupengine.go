@@ -29,6 +29,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupencryption"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/topo"
@@ -65,6 +66,18 @@ type BackupParams struct {
 	TabletAlias string
 	// BackupTime is the time at which the backup is being started
 	BackupTime time.Time
+	// Incremental, if true, requests a backup of only the binlogs written
+	// since the latest backup, instead of a full backup.
+	Incremental bool
+
+	// incrementalFromBackup and incrementalFromPos are the name and position
+	// of the backup an incremental backup is based on. They are set by Backup.
+	incrementalFromBackup string
+	incrementalFromPos    mysql.Position
+
+	// encryption is the envelope of the data key the backup is encrypted
+	// with, if any. It is set by Backup, and must be stored in the MANIFEST.
+	encryption *backupencryption.Envelope
 }
 
 // RestoreParams is the struct that holds all params passed to ExecuteRestore
@@ -91,6 +104,20 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToPos, if set, requests a point-in-time recovery: the latest
+	// backup at or before this position is restored, and archived binlogs
+	// are applied on top of it up to this position.
+	RestoreToPos mysql.Position
+	// RestoreToTimestamp, if set, requests a point-in-time recovery: the
+	// latest backup taken at or before this time is restored, and archived
+	// binlogs are applied on top of it up to this time.
+	RestoreToTimestamp time.Time
+}
+
+// IsPointInTimeRecovery returns true if the restore should apply archived
+// binlogs on top of the restored backup.
+func (p *RestoreParams) IsPointInTimeRecovery() bool {
+	return !p.RestoreToPos.IsZero() || !p.RestoreToTimestamp.IsZero()
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -187,6 +214,17 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// Incremental is true if the backup only contains the binlogs written
+	// since the backup named FromBackup, which was at FromPosition. Such a
+	// backup can only be restored on top of FromBackup.
+	Incremental  bool
+	FromBackup   string
+	FromPosition mysql.Position
+
+	// Encryption describes how the files of the backup are encrypted, if
+	// they are. The MANIFEST itself is never encrypted.
+	Encryption *backupencryption.Envelope
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
@@ -198,6 +236,7 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 	// if a StartTime is provided in params, then find a backup that was taken at or before that time
 	checkBackupTime := !params.StartTime.IsZero()
 	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	checkBackupPos := !params.RestoreToPos.IsZero()
 
 	for index = len(bhs) - 1; index >= 0; index-- {
 		bh = bhs[index]
@@ -216,6 +255,10 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 				continue
 			}
 		}
+		if checkBackupPos && !params.RestoreToPos.AtLeast(bm.Position) {
+			params.Logger.Infof("Restore: skipping backup %v/%v at position %v, which is after the requested position", backupDir, bh.Name(), mysql.EncodePosition(bm.Position))
+			continue
+		}
 		if !checkBackupTime || backupTime.Equal(params.StartTime) || backupTime.Before(params.StartTime) {
 			if !checkBackupTime {
 				params.Logger.Infof("Restore: found latest backup %v %v to restore", bh.Directory(), bh.Name())
@@ -229,6 +272,9 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 		if checkBackupTime {
 			params.Logger.Errorf("No valid backup found before time %v", params.StartTime.Format(BackupTimestampFormat))
 		}
+		if checkBackupPos {
+			params.Logger.Errorf("No valid backup found before position %v", mysql.EncodePosition(params.RestoreToPos))
+		}
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
 		// up empty.
@@ -238,6 +284,74 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 	return bh, nil
 }
 
+// FindBackupChain returns the backups to restore, oldest first, in order to
+// restore bh: the full backup bh is based on, followed by the incremental
+// backups leading to bh. If bh is a full backup, it is the only one returned.
+func FindBackupChain(ctx context.Context, bhs []backupstorage.BackupHandle, bh backupstorage.BackupHandle) ([]backupstorage.BackupHandle, error) {
+	byName := make(map[string]backupstorage.BackupHandle, len(bhs))
+	for _, b := range bhs {
+		byName[b.Name()] = b
+	}
+
+	chain := []backupstorage.BackupHandle{bh}
+	var child *BackupManifest
+	for {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't read MANIFEST of backup %v", bh.Name())
+		}
+		if child != nil && !bm.Position.Equal(child.FromPosition) {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v is at position %v, but incremental backup %v is based on position %v", bh.Name(), mysql.EncodePosition(bm.Position), chain[len(chain)-2].Name(), mysql.EncodePosition(child.FromPosition))
+		}
+		if !bm.Incremental {
+			break
+		}
+		parent, ok := byName[bm.FromBackup]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "incremental backup %v is based on backup %v, which does not exist", bh.Name(), bm.FromBackup)
+		}
+		if len(chain) > len(bhs) {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "incremental backup %v is part of a cycle", bh.Name())
+		}
+		chain = append(chain, parent)
+		bh = parent
+		child = bm
+	}
+
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain, nil
+}
+
+// GetBackupDependents returns, for each of the given backups which has some,
+// the names of the incremental backups which are based on it, directly or
+// through other incremental backups. A backup with dependents must not be
+// removed, or these incremental backups could no longer be restored.
+func GetBackupDependents(ctx context.Context, bhs []backupstorage.BackupHandle) map[string][]string {
+	// Backups without a readable MANIFEST are incomplete, so nothing can be
+	// based on them and they can't be based on anything either.
+	parents := make(map[string]string)
+	for _, bh := range bhs {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil || !bm.Incremental {
+			continue
+		}
+		parents[bh.Name()] = bm.FromBackup
+	}
+
+	dependents := make(map[string][]string)
+	for _, bh := range bhs {
+		name := bh.Name()
+		seen := map[string]bool{name: true}
+		for parent, ok := parents[name]; ok && !seen[parent]; parent, ok = parents[parent] {
+			seen[parent] = true
+			dependents[parent] = append(dependents[parent], name)
+		}
+	}
+	return dependents
+}
+
 func prepareToRestore(ctx context.Context, cnf *Mycnf, mysqld MysqlDaemon, logger logutil.Logger) error {
 	// shutdown mysqld if it is running
 	logger.Infof("Restore: shutdown mysqld")
@@ -0,0 +1,657 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/klauspost/pgzip"
+	"github.com/planetscale/pargzip"
+
+	"vitess.io/vitess/go/mysql"
+	vtenv "vitess.io/vitess/go/vt/env"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupencryption"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file handles the archiving of binary logs to the BackupStorage,
+// and applying them on top of a restored backup for point-in-time recovery.
+
+const (
+	// binlogArchiveDirName is the top level directory of the binlog archive
+	// within the BackupStorage. Archived binlogs for a keyspace/shard are
+	// stored under binlog_archive/<keyspace>/<shard>.
+	binlogArchiveDirName = "binlog_archive"
+
+	// binlogArchiveFileName is the name of the binlog file within an
+	// archived binlog handle.
+	binlogArchiveFileName = "binlog"
+
+	// binlogFileMagic is the header of every binary log file.
+	binlogFileMagic = "\xfebin"
+
+	// binlogEventHeaderLength is the length of a v4 binlog event header.
+	binlogEventHeaderLength = 19
+
+	// mysqlbinlogDatetimeFormat is the format of --stop-datetime.
+	mysqlbinlogDatetimeFormat = "2006-01-02 15:04:05"
+)
+
+// BinlogArchiveParams is the struct that holds all params passed to
+// ArchiveBinlogs.
+type BinlogArchiveParams struct {
+	Cnf    *Mycnf
+	Mysqld MysqlDaemon
+	Logger logutil.Logger
+	// Keyspace and Shard are used to infer the directory where archived
+	// binlogs are stored.
+	Keyspace string
+	Shard    string
+	// TabletAlias is used to name the archived binlogs, so several tablets
+	// can archive their binlogs for the same shard.
+	TabletAlias string
+}
+
+// BinlogArchiveManifest is the MANIFEST of an archived binary log.
+type BinlogArchiveManifest struct {
+	// FileName is the name of the binlog file on the tablet that archived it.
+	FileName string
+
+	// TabletAlias is the alias of the tablet that archived the binlog.
+	TabletAlias string
+
+	// PreviousPosition is the set of GTIDs executed before this binlog,
+	// as found in its PreviousGTIDs event.
+	PreviousPosition mysql.Position
+
+	// Position is the set of GTIDs executed at the end of this binlog.
+	Position mysql.Position
+
+	// FirstTimestamp and LastTimestamp are the times (in RFC 3339 format,
+	// UTC) of the first and last events in this binlog.
+	FirstTimestamp string
+	LastTimestamp  string
+
+	// SkipCompress is true if the binlog was NOT run through gzip.
+	SkipCompress bool
+
+	// Hash is the crc32 hash of the archived file.
+	Hash string
+
+	// Encryption describes how the archived binlog is encrypted, if it is.
+	Encryption *backupencryption.Envelope
+}
+
+// GetBinlogArchiveDir returns the directory where archived binlogs for the
+// given keyspace/shard are (or will be) stored.
+func GetBinlogArchiveDir(keyspace, shard string) string {
+	return fmt.Sprintf("%v/%v/%v", binlogArchiveDirName, keyspace, shard)
+}
+
+// parseBinlogArchiveName parses the name of an archived binlog, of the form
+// <date>.<time>.<tablet_alias>.<binlog file name>.
+func parseBinlogArchiveName(name string) (alias string, fileName string, err error) {
+	parts := strings.SplitN(name, ".", 4)
+	if len(parts) != 4 {
+		return "", "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot parse binlog archive name %s, expected <date>.<time>.<tablet_alias>.<file>", name)
+	}
+	return parts[2], parts[3], nil
+}
+
+// ArchiveBinlogs copies the binary logs of the local mysqld that were not
+// archived yet into the BackupStorage. The binary log that is currently being
+// written to is never archived: it will be once mysqld rotates it. It returns
+// the number of binlogs that were archived.
+func ArchiveBinlogs(ctx context.Context, bs backupstorage.BackupStorage, params BinlogArchiveParams) (int, error) {
+	qr, err := params.Mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return 0, vterrors.Wrap(err, "can't list binary logs")
+	}
+	if len(qr.Rows) < 2 {
+		// Nothing to archive but the active binlog.
+		return 0, nil
+	}
+
+	dir := GetBinlogArchiveDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return 0, vterrors.Wrap(err, "ListBackups failed")
+	}
+	archived := make(map[string]bool, len(bhs))
+	for _, bh := range bhs {
+		alias, fileName, err := parseBinlogArchiveName(bh.Name())
+		if err != nil {
+			params.Logger.Warningf("Skipping unexpected entry %v in binlog archive %v: %v", bh.Name(), dir, err)
+			continue
+		}
+		if alias == params.TabletAlias {
+			archived[fileName] = true
+		}
+	}
+
+	binlogDir := filepath.Dir(params.Cnf.BinLogPath)
+	count := 0
+	for _, row := range qr.Rows[:len(qr.Rows)-1] {
+		fileName := row[0].ToString()
+		if archived[fileName] {
+			continue
+		}
+		params.Logger.Infof("Archiving binlog %v to %v", fileName, dir)
+		if err := archiveBinlogFile(ctx, bs, params, filepath.Join(binlogDir, fileName)); err != nil {
+			return count, vterrors.Wrapf(err, "can't archive binlog %v", fileName)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// archiveBinlogFile archives a single binlog file, along with its MANIFEST.
+func archiveBinlogFile(ctx context.Context, bs backupstorage.BackupStorage, params BinlogArchiveParams, path string) (finalErr error) {
+	info, err := readBinlogFileInfo(path)
+	if err != nil {
+		return err
+	}
+
+	dir := GetBinlogArchiveDir(params.Keyspace, params.Shard)
+	name := fmt.Sprintf("%v.%v.%v", info.firstTimestamp.UTC().Format(BackupTimestampFormat), params.TabletAlias, filepath.Base(path))
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
+	defer func() {
+		if finalErr != nil {
+			if err := bh.AbortBackup(ctx); err != nil {
+				params.Logger.Errorf2(err, "failed to abort binlog archive %v", name)
+			}
+			return
+		}
+		finalErr = bh.EndBackup(ctx)
+	}()
+
+	// Archived binlogs are encrypted like backups.
+	// bh is only replaced on success, as the deferred function above still
+	// needs it to abort the archive.
+	ebh, envelope, err := newEncryptingBackupHandle(ctx, bh)
+	if err != nil {
+		return err
+	}
+	bh = ebh
+
+	hash, err := copyBinlogToArchive(ctx, bh, path)
+	if err != nil {
+		return err
+	}
+
+	manifest := &BinlogArchiveManifest{
+		FileName:         filepath.Base(path),
+		TabletAlias:      params.TabletAlias,
+		PreviousPosition: info.previousPosition,
+		Position:         info.position,
+		FirstTimestamp:   info.firstTimestamp.UTC().Format(time.RFC3339),
+		LastTimestamp:    info.lastTimestamp.UTC().Format(time.RFC3339),
+		SkipCompress:     !*backupStorageCompress,
+		Hash:             hash,
+		Encryption:       envelope,
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	wc, err := bh.AddFile(ctx, backupManifestFileName, int64(len(data)))
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to binlog archive", backupManifestFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		return vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	return wc.Close()
+}
+
+// copyBinlogToArchive copies a binlog file into the archive handle,
+// compressing it if needed. It returns the hash of the archived data.
+func copyBinlogToArchive(ctx context.Context, bh backupstorage.BackupHandle, path string) (hash string, finalErr error) {
+	source, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer source.Close()
+
+	fi, err := source.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	wc, err := bh.AddFile(ctx, binlogArchiveFileName, fi.Size())
+	if err != nil {
+		return "", vterrors.Wrapf(err, "cannot add file %v", binlogArchiveFileName)
+	}
+	defer func() {
+		if err := wc.Close(); err != nil && finalErr == nil {
+			finalErr = err
+		}
+	}()
+
+	bw := newBackupWriter(path, fi.Size(), wc)
+	var writer io.Writer = bw
+
+	var gzip *pargzip.Writer
+	if *backupStorageCompress {
+		gzip = pargzip.NewWriter(writer)
+		gzip.ChunkSize = *backupCompressBlockSize
+		gzip.Parallel = *backupCompressBlocks
+		gzip.CompressionLevel = pargzip.BestSpeed
+		writer = gzip
+	}
+
+	if _, err := io.Copy(writer, source); err != nil {
+		return "", vterrors.Wrap(err, "cannot copy data")
+	}
+	if gzip != nil {
+		if err := gzip.Close(); err != nil {
+			return "", vterrors.Wrap(err, "cannot close gzip")
+		}
+	}
+	if err := bw.Close(); err != nil {
+		return "", vterrors.Wrapf(err, "cannot flush destination: %v", binlogArchiveFileName)
+	}
+	return bw.HashString(), nil
+}
+
+// binlogFileInfo is what we learn about a binlog file by scanning its events.
+type binlogFileInfo struct {
+	previousPosition mysql.Position
+	position         mysql.Position
+	firstTimestamp   time.Time
+	lastTimestamp    time.Time
+}
+
+// readBinlogFileInfo scans a binlog file, to find its GTID range and the
+// time range of its events. Only MySQL 5.6+ binlogs with GTIDs are supported.
+func readBinlogFileInfo(path string) (*binlogFileInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+
+	magic := make([]byte, len(binlogFileMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != binlogFileMagic {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v is not a binlog file", path)
+	}
+
+	info := &binlogFileInfo{}
+	var format mysql.BinlogFormat
+	foundPreviousGTIDs := false
+	header := make([]byte, binlogEventHeaderLength)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, vterrors.Wrapf(err, "truncated event header in %v", path)
+		}
+		length := binary.LittleEndian.Uint32(header[9:13])
+		if length < binlogEventHeaderLength {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid event length %v in %v", length, path)
+		}
+		buf := make([]byte, length)
+		copy(buf, header)
+		if _, err := io.ReadFull(r, buf[binlogEventHeaderLength:]); err != nil {
+			return nil, vterrors.Wrapf(err, "truncated event in %v", path)
+		}
+
+		ev := mysql.NewMysql56BinlogEvent(buf)
+		if ts := ev.Timestamp(); ts != 0 {
+			t := time.Unix(int64(ts), 0)
+			if info.firstTimestamp.IsZero() {
+				info.firstTimestamp = t
+			}
+			if t.After(info.lastTimestamp) {
+				info.lastTimestamp = t
+			}
+		}
+
+		if ev.IsFormatDescription() {
+			if format, err = ev.Format(); err != nil {
+				return nil, vterrors.Wrapf(err, "can't parse format description in %v", path)
+			}
+			continue
+		}
+		if format.IsZero() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "event before format description in %v", path)
+		}
+		ev, _, err = ev.StripChecksum(format)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't strip checksum in %v", path)
+		}
+
+		switch {
+		case ev.IsPreviousGTIDs():
+			pos, err := ev.PreviousGTIDs(format)
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "can't parse previous GTIDs in %v", path)
+			}
+			info.previousPosition = pos
+			info.position = pos
+			foundPreviousGTIDs = true
+		case ev.IsGTID():
+			if !foundPreviousGTIDs {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "GTID event before previous GTIDs event in %v", path)
+			}
+			gtid, _, err := ev.GTID(format)
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "can't parse GTID in %v", path)
+			}
+			info.position = mysql.AppendGTID(info.position, gtid)
+		}
+	}
+	if !foundPreviousGTIDs {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "no previous GTIDs event in %v, binlog archiving requires gtid_mode=ON", path)
+	}
+	return info, nil
+}
+
+// binlogArchive is an archived binlog, with its decoded MANIFEST.
+type binlogArchive struct {
+	bh             backupstorage.BackupHandle
+	manifest       *BinlogArchiveManifest
+	firstTimestamp time.Time
+	lastTimestamp  time.Time
+}
+
+// listBinlogArchives returns all the valid archived binlogs for the given
+// keyspace/shard, oldest first.
+func listBinlogArchives(ctx context.Context, bs backupstorage.BackupStorage, keyspace, shard string, logger logutil.Logger) ([]*binlogArchive, error) {
+	dir := GetBinlogArchiveDir(keyspace, shard)
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	archives := make([]*binlogArchive, 0, len(bhs))
+	for _, bh := range bhs {
+		manifest := &BinlogArchiveManifest{}
+		if err := getBackupManifestInto(ctx, bh, manifest); err != nil {
+			logger.Warningf("Possibly incomplete binlog archive %v in directory %v on BackupStorage: %v", bh.Name(), dir, err)
+			continue
+		}
+		first, err := time.Parse(time.RFC3339, manifest.FirstTimestamp)
+		if err != nil {
+			logger.Warningf("Skipping binlog archive %v/%v with invalid time %v: %v", dir, bh.Name(), manifest.FirstTimestamp, err)
+			continue
+		}
+		last, err := time.Parse(time.RFC3339, manifest.LastTimestamp)
+		if err != nil {
+			logger.Warningf("Skipping binlog archive %v/%v with invalid time %v: %v", dir, bh.Name(), manifest.LastTimestamp, err)
+			continue
+		}
+		bh, err := newDecryptingBackupHandle(ctx, bh, manifest.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		archives = append(archives, &binlogArchive{
+			bh:             bh,
+			manifest:       manifest,
+			firstTimestamp: first,
+			lastTimestamp:  last,
+		})
+	}
+	return archives, nil
+}
+
+// selectBinlogArchives returns the chain of archived binlogs to apply on top
+// of a backup restored at pos, to reach either restoreToPos or restoreToTime.
+// Each binlog in the chain must start at or before the position reached by
+// the previous ones, and must bring something new.
+func selectBinlogArchives(archives []*binlogArchive, pos mysql.Position, restoreToPos mysql.Position, restoreToTime time.Time) ([]*binlogArchive, error) {
+	var chain []*binlogArchive
+	used := make(map[*binlogArchive]bool)
+	for {
+		if !restoreToPos.IsZero() && pos.AtLeast(restoreToPos) {
+			return chain, nil
+		}
+
+		var next *binlogArchive
+		reachedTime := false
+		for _, a := range archives {
+			if used[a] || !pos.AtLeast(a.manifest.PreviousPosition) || pos.AtLeast(a.manifest.Position) {
+				continue
+			}
+			if !restoreToTime.IsZero() && a.firstTimestamp.After(restoreToTime) {
+				// The following binlog starts after the requested time,
+				// so we already have everything we need.
+				reachedTime = true
+				continue
+			}
+			next = a
+			break
+		}
+		if next == nil {
+			if reachedTime {
+				return chain, nil
+			}
+			if !restoreToPos.IsZero() {
+				return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "binlog archive does not cover position %v, can only reach %v", mysql.EncodePosition(restoreToPos), mysql.EncodePosition(pos))
+			}
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "binlog archive does not cover time %v, can only reach %v", restoreToTime.UTC().Format(time.RFC3339), mysql.EncodePosition(pos))
+		}
+
+		chain = append(chain, next)
+		used[next] = true
+		pos = mysql.Position{GTIDSet: pos.GTIDSet.Union(next.manifest.Position.GTIDSet)}
+		if !restoreToTime.IsZero() && !next.lastTimestamp.Before(restoreToTime) {
+			return chain, nil
+		}
+	}
+}
+
+// RestoreFromBinlogArchive applies archived binlogs on top of a backup that
+// was just restored at position pos, up to params.RestoreToPos or
+// params.RestoreToTimestamp. mysqld must be running. It returns the position
+// reached by mysqld.
+func RestoreFromBinlogArchive(ctx context.Context, bs backupstorage.BackupStorage, params RestoreParams, pos mysql.Position) (mysql.Position, error) {
+	if pos.IsZero() {
+		return mysql.Position{}, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "restored backup has no position, can't apply binlogs on top of it")
+	}
+	archives, err := listBinlogArchives(ctx, bs, params.Keyspace, params.Shard, params.Logger)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	chain, err := selectBinlogArchives(archives, pos, params.RestoreToPos, params.RestoreToTimestamp)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	if len(chain) == 0 {
+		params.Logger.Infof("Restore: backup is already at the requested point in time, no binlog to apply")
+		return pos, nil
+	}
+
+	tmpDir, err := os.MkdirTemp(params.Cnf.TmpDir, "binlog_archive")
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := disableSuperReadOnly(params.Mysqld); err != nil {
+		return mysql.Position{}, err
+	}
+
+	for _, a := range chain {
+		params.Logger.Infof("Restore: applying archived binlog %v", a.bh.Name())
+		path, err := fetchArchivedBinlog(ctx, a, tmpDir)
+		if err != nil {
+			return mysql.Position{}, vterrors.Wrapf(err, "can't fetch archived binlog %v", a.bh.Name())
+		}
+		if err := params.Mysqld.ApplyBinlogFile(ctx, path, pos, params.RestoreToPos, params.RestoreToTimestamp); err != nil {
+			return mysql.Position{}, vterrors.Wrapf(err, "can't apply archived binlog %v", a.bh.Name())
+		}
+		pos = mysql.Position{GTIDSet: pos.GTIDSet.Union(a.manifest.Position.GTIDSet)}
+		if err := os.Remove(path); err != nil {
+			log.Warningf("Restore: can't remove %v: %v", path, err)
+		}
+	}
+	return params.Mysqld.PrimaryPosition()
+}
+
+// disableSuperReadOnly allows binlogs to be applied with the mysql client.
+// It is a no-op on servers that don't know about super_read_only.
+func disableSuperReadOnly(mysqld MysqlDaemon) error {
+	if err := mysqld.SetSuperReadOnly(false); err != nil {
+		if !strings.Contains(err.Error(), strconv.Itoa(mysql.ERUnknownSystemVariable)) {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetchArchivedBinlog copies an archived binlog into dir, uncompressing it if
+// needed, and returns the path of the copy.
+func fetchArchivedBinlog(ctx context.Context, a *binlogArchive, dir string) (path string, finalErr error) {
+	source, err := a.bh.ReadFile(ctx, binlogArchiveFileName)
+	if err != nil {
+		return "", vterrors.Wrap(err, "can't open source file for reading")
+	}
+	defer source.Close()
+
+	path = filepath.Join(dir, a.manifest.FileName)
+	dst, err := os.Create(path)
+	if err != nil {
+		return "", vterrors.Wrap(err, "can't open destination file for writing")
+	}
+	defer func() {
+		if err := dst.Close(); err != nil && finalErr == nil {
+			finalErr = vterrors.Wrap(err, "failed to close destination file")
+		}
+	}()
+
+	bp := newBackupReader(a.bh.Name(), source)
+	defer bp.Close()
+	var reader io.Reader = bp
+	if !a.manifest.SkipCompress {
+		gz, err := pgzip.NewReader(reader)
+		if err != nil {
+			return "", vterrors.Wrap(err, "can't open gzip decompressor")
+		}
+		defer gz.Close()
+		reader = gz
+	}
+	if _, err := io.Copy(dst, reader); err != nil {
+		return "", vterrors.Wrap(err, "failed to copy file contents")
+	}
+	if hash := bp.HashString(); hash != a.manifest.Hash {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "hash mismatch for %v, got %v expected %v", a.bh.Name(), hash, a.manifest.Hash)
+	}
+	return path, nil
+}
+
+// ApplyBinlogFile extracts the events of a binlog file with mysqlbinlog, and
+// applies them with the mysql client. The GTIDs of excludePos are skipped:
+// a binlog starts at the position of the previous one, which may be before
+// the position that was restored. If restorePos is set, only the GTIDs it
+// contains are applied. If restoreTime is set, only the events up to that
+// time (inclusive, with a one second granularity) are applied.
+func (mysqld *Mysqld) ApplyBinlogFile(ctx context.Context, binlogFile string, excludePos, restorePos mysql.Position, restoreTime time.Time) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	mysqlbinlogPath, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	mysqlPath, err := binaryPath(dir, "mysql")
+	if err != nil {
+		return err
+	}
+	env, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+
+	args := []string{binlogFile}
+	if !excludePos.IsZero() {
+		args = append(args, "--exclude-gtids="+excludePos.GTIDSet.String())
+	}
+	if !restorePos.IsZero() {
+		args = append(args, "--include-gtids="+restorePos.GTIDSet.String())
+	}
+	if !restoreTime.IsZero() {
+		// mysqlbinlog reads --stop-datetime in the local time zone, and
+		// stops at the first event at or after it.
+		stop := restoreTime.Truncate(time.Second).Add(time.Second).Local()
+		args = append(args, "--stop-datetime="+stop.Format(mysqlbinlogDatetimeFormat))
+	}
+	extract := exec.CommandContext(ctx, mysqlbinlogPath, args...)
+	extract.Env = env
+	extract.Dir = dir
+	var extractStderr bytes.Buffer
+	extract.Stderr = &extractStderr
+
+	params, err := mysqld.dbcfgs.DbaConnector().MysqlParams()
+	if err != nil {
+		return err
+	}
+	cnf, err := mysqld.defaultsExtraFile(params)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(cnf)
+	apply := exec.CommandContext(ctx, mysqlPath, "--defaults-extra-file="+cnf, "--batch")
+	apply.Env = env
+	apply.Dir = dir
+	var applyOutput bytes.Buffer
+	apply.Stdout = &applyOutput
+	apply.Stderr = &applyOutput
+
+	pipe, err := extract.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	apply.Stdin = pipe
+
+	log.Infof("ApplyBinlogFile: %v %v | %v", mysqlbinlogPath, args, mysqlPath)
+	if err := extract.Start(); err != nil {
+		return err
+	}
+	if err := apply.Run(); err != nil {
+		_ = extract.Process.Kill()
+		_ = extract.Wait()
+		return fmt.Errorf("mysql: %v, output: %v", err, applyOutput.String())
+	}
+	if err := extract.Wait(); err != nil {
+		return fmt.Errorf("mysqlbinlog: %v, output: %v", err, extractStderr.String())
+	}
+	return nil
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl_test
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupencryption"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+const testBinlogBaseTime = 1640995200 // 2022-01-01 00:00:00 UTC
+
+var testBinlogSID = mysql.SID{0x3e, 0x11, 0xfa, 0x47, 0x71, 0xca, 0x11, 0xe1, 0x9e, 0x33, 0xc8, 0x0a, 0xa9, 0x42, 0x95, 0x62} // 3e11fa47-71ca-11e1-9e33-c80aa9429562
+
+// writeTestBinlog writes a binlog file with a PreviousGTIDs event for
+// previous, then one GTID event per sequence number, the n-th one being
+// timestamped n minutes after testBinlogBaseTime.
+func writeTestBinlog(t *testing.T, dir, name string, previous int64, sequences ...int64) {
+	t.Helper()
+	f := mysql.NewMySQL56BinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+	s.Timestamp = uint32(testBinlogBaseTime + previous*60)
+
+	set := mysql.Mysql56GTIDSet{}
+	if previous > 0 {
+		set = testBinlogPosition(t, previous).GTIDSet.(mysql.Mysql56GTIDSet)
+	}
+
+	data := []byte("\xfebin")
+	data = append(data, mysql.NewFormatDescriptionEvent(f, s).Bytes()...)
+	data = append(data, mysql.NewMySQL56PreviousGTIDsEvent(f, s, set).Bytes()...)
+	for _, seq := range sequences {
+		s.Timestamp = uint32(testBinlogBaseTime + seq*60)
+		data = append(data, mysql.NewMySQL56GTIDEvent(f, s, mysql.Mysql56GTID{Server: testBinlogSID, Sequence: seq}).Bytes()...)
+		data = append(data, mysql.NewQueryEvent(f, s, mysql.Query{Database: "vt_test", SQL: "insert into t values (1)"}).Bytes()...)
+		data = append(data, mysql.NewXIDEvent(f, s).Bytes()...)
+	}
+	require.NoError(t, os.WriteFile(path.Join(dir, name), data, 0644))
+}
+
+func testBinlogPosition(t *testing.T, last int64) mysql.Position {
+	t.Helper()
+	pos, err := mysql.DecodePosition(fmt.Sprintf("MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-%d", last))
+	require.NoError(t, err)
+	return pos
+}
+
+func TestBinlogArchive(t *testing.T) {
+	ctx := context.Background()
+
+	backupRoot := t.TempDir()
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	*filebackupstorage.FileBackupStorageRoot = backupRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	bs := &filebackupstorage.FileBackupStorage{}
+
+	binlogDir := t.TempDir()
+	writeTestBinlog(t, binlogDir, "vt-bin.000001", 0, 1, 2, 3)
+	writeTestBinlog(t, binlogDir, "vt-bin.000002", 3, 4, 5, 6)
+	writeTestBinlog(t, binlogDir, "vt-bin.000003", 6, 7)
+
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(fakesqldb.New(t))
+	defer mysqld.Close()
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+			"vt-bin.000001|100",
+			"vt-bin.000002|100",
+			"vt-bin.000003|100",
+		),
+	}
+
+	cnf := &mysqlctl.Mycnf{
+		BinLogPath: path.Join(binlogDir, "vt-bin"),
+		TmpDir:     t.TempDir(),
+	}
+	archiveParams := mysqlctl.BinlogArchiveParams{
+		Cnf:         cnf,
+		Mysqld:      mysqld,
+		Logger:      logutil.NewMemoryLogger(),
+		Keyspace:    "ks",
+		Shard:       "0",
+		TabletAlias: "cell1-0000000100",
+	}
+
+	// The active binlog is never archived.
+	count, err := mysqlctl.ArchiveBinlogs(ctx, bs, archiveParams)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// Archiving again is a no-op.
+	count, err = mysqlctl.ArchiveBinlogs(ctx, bs, archiveParams)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	bhs, err := bs.ListBackups(ctx, mysqlctl.GetBinlogArchiveDir("ks", "0"))
+	require.NoError(t, err)
+	require.Len(t, bhs, 2)
+	assert.Equal(t, "2022-01-01.000000.cell1-0000000100.vt-bin.000001", bhs[0].Name())
+	assert.Equal(t, "2022-01-01.000300.cell1-0000000100.vt-bin.000002", bhs[1].Name())
+
+	rc, err := bhs[1].ReadFile(ctx, "MANIFEST")
+	require.NoError(t, err)
+	manifest := &mysqlctl.BinlogArchiveManifest{}
+	require.NoError(t, json.NewDecoder(rc).Decode(manifest))
+	rc.Close()
+	assert.Equal(t, "vt-bin.000002", manifest.FileName)
+	assert.Equal(t, "cell1-0000000100", manifest.TabletAlias)
+	assert.True(t, manifest.PreviousPosition.Equal(testBinlogPosition(t, 3)), "PreviousPosition: %v", manifest.PreviousPosition)
+	assert.True(t, manifest.Position.Equal(testBinlogPosition(t, 6)), "Position: %v", manifest.Position)
+	assert.Equal(t, "2022-01-01T00:03:00Z", manifest.FirstTimestamp)
+	assert.Equal(t, "2022-01-01T00:06:00Z", manifest.LastTimestamp)
+
+	tests := []struct {
+		name          string
+		backupPos     int64
+		restoreToPos  int64
+		restoreToTime time.Time
+		want          []string
+		// wantExclude holds the last sequence number of the GTIDs excluded
+		// from each applied binlog.
+		wantExclude []int64
+		wantErr     string
+	}{{
+		// The first binlog starts before the backup position, the GTIDs of
+		// the backup must not be applied again.
+		name:         "to position within the first binlog",
+		backupPos:    1,
+		restoreToPos: 2,
+		want:         []string{"vt-bin.000001"},
+		wantExclude:  []int64{1},
+	}, {
+		name:         "to position across binlogs",
+		backupPos:    2,
+		restoreToPos: 5,
+		want:         []string{"vt-bin.000001", "vt-bin.000002"},
+		wantExclude:  []int64{2, 3},
+	}, {
+		name:         "backup already at position",
+		backupPos:    4,
+		restoreToPos: 4,
+	}, {
+		name:         "position not archived yet",
+		backupPos:    2,
+		restoreToPos: 7,
+		wantErr:      "binlog archive does not cover position",
+	}, {
+		name:          "to time within the second binlog",
+		backupPos:     2,
+		restoreToTime: time.Unix(testBinlogBaseTime+4*60, 0),
+		want:          []string{"vt-bin.000001", "vt-bin.000002"},
+		wantExclude:   []int64{2, 3},
+	}, {
+		name:          "to time between events of the first binlog",
+		backupPos:     1,
+		restoreToTime: time.Unix(testBinlogBaseTime+2*60+30, 0),
+		want:          []string{"vt-bin.000001"},
+		wantExclude:   []int64{1},
+	}, {
+		name:          "time not archived yet",
+		backupPos:     2,
+		restoreToTime: time.Unix(testBinlogBaseTime+10*60, 0),
+		wantErr:       "binlog archive does not cover time",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mysqld.AppliedBinlogFiles = nil
+			mysqld.AppliedBinlogExcludePositions = nil
+			params := mysqlctl.RestoreParams{
+				Cnf:                cnf,
+				Mysqld:             mysqld,
+				Logger:             logutil.NewMemoryLogger(),
+				Keyspace:           "ks",
+				Shard:              "0",
+				RestoreToTimestamp: tt.restoreToTime,
+			}
+			if tt.restoreToPos != 0 {
+				params.RestoreToPos = testBinlogPosition(t, tt.restoreToPos)
+			}
+			_, err := mysqlctl.RestoreFromBinlogArchive(ctx, bs, params, testBinlogPosition(t, tt.backupPos))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, mysqld.AppliedBinlogFiles)
+
+			var wantExclude, gotExclude []string
+			for _, last := range tt.wantExclude {
+				wantExclude = append(wantExclude, mysql.EncodePosition(testBinlogPosition(t, last)))
+			}
+			for _, pos := range mysqld.AppliedBinlogExcludePositions {
+				gotExclude = append(gotExclude, mysql.EncodePosition(pos))
+			}
+			assert.Equal(t, wantExclude, gotExclude)
+		})
+	}
+}
+
+func TestEncryptedBinlogArchive(t *testing.T) {
+	ctx := context.Background()
+
+	backupRoot := t.TempDir()
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	*filebackupstorage.FileBackupStorageRoot = backupRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	bs := &filebackupstorage.FileBackupStorage{}
+
+	keyPath := path.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyPath, []byte(strings.Repeat("ab", 32)), 0600))
+	require.NoError(t, flag.Set("backup_encryption_keyfile", keyPath))
+	defer flag.Set("backup_encryption_keyfile", "")
+	*backupencryption.KeyManagerImplementation = "keyfile"
+	defer func() { *backupencryption.KeyManagerImplementation = "" }()
+
+	binlogDir := t.TempDir()
+	writeTestBinlog(t, binlogDir, "vt-bin.000001", 0, 1, 2, 3)
+	writeTestBinlog(t, binlogDir, "vt-bin.000002", 3, 4)
+
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(fakesqldb.New(t))
+	defer mysqld.Close()
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+			"vt-bin.000001|100",
+			"vt-bin.000002|100",
+		),
+	}
+	cnf := &mysqlctl.Mycnf{
+		BinLogPath: path.Join(binlogDir, "vt-bin"),
+		TmpDir:     t.TempDir(),
+	}
+
+	count, err := mysqlctl.ArchiveBinlogs(ctx, bs, mysqlctl.BinlogArchiveParams{
+		Cnf:         cnf,
+		Mysqld:      mysqld,
+		Logger:      logutil.NewMemoryLogger(),
+		Keyspace:    "ks",
+		Shard:       "0",
+		TabletAlias: "cell1-0000000100",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// The MANIFEST is in clear, and records how the binlog is encrypted.
+	bhs, err := bs.ListBackups(ctx, mysqlctl.GetBinlogArchiveDir("ks", "0"))
+	require.NoError(t, err)
+	require.Len(t, bhs, 1)
+	rc, err := bhs[0].ReadFile(ctx, "MANIFEST")
+	require.NoError(t, err)
+	manifest := &mysqlctl.BinlogArchiveManifest{}
+	require.NoError(t, json.NewDecoder(rc).Decode(manifest))
+	rc.Close()
+	require.NotNil(t, manifest.Encryption)
+	assert.Equal(t, backupencryption.Algorithm, manifest.Encryption.Algorithm)
+	assert.Equal(t, "keyfile", manifest.Encryption.KeyManager)
+	assert.NotEmpty(t, manifest.Encryption.KeyID)
+
+	// The archived binlog itself is encrypted.
+	rc, err = bhs[0].ReadFile(ctx, "binlog")
+	require.NoError(t, err)
+	_, err = gzip.NewReader(rc)
+	rc.Close()
+	assert.Error(t, err)
+
+	// It is decrypted transparently on restore, and its hash checked.
+	params := mysqlctl.RestoreParams{
+		Cnf:          cnf,
+		Mysqld:       mysqld,
+		Logger:       logutil.NewMemoryLogger(),
+		Keyspace:     "ks",
+		Shard:        "0",
+		RestoreToPos: testBinlogPosition(t, 3),
+	}
+	_, err = mysqlctl.RestoreFromBinlogArchive(ctx, bs, params, testBinlogPosition(t, 1))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vt-bin.000001"}, mysqld.AppliedBinlogFiles)
+
+	// It can't be restored once the key is gone.
+	require.NoError(t, os.WriteFile(keyPath, []byte(strings.Repeat("cd", 32)), 0600))
+	_, err = mysqlctl.RestoreFromBinlogArchive(ctx, bs, params, testBinlogPosition(t, 1))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "data key was wrapped with key "+manifest.Encryption.KeyID)
+}
+
+func TestBinlogArchiveBadKeyfile(t *testing.T) {
+	ctx := context.Background()
+
+	backupRoot := t.TempDir()
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	*filebackupstorage.FileBackupStorageRoot = backupRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	bs := &filebackupstorage.FileBackupStorage{}
+
+	require.NoError(t, flag.Set("backup_encryption_keyfile", path.Join(t.TempDir(), "missing")))
+	defer flag.Set("backup_encryption_keyfile", "")
+	*backupencryption.KeyManagerImplementation = "keyfile"
+	defer func() { *backupencryption.KeyManagerImplementation = "" }()
+
+	binlogDir := t.TempDir()
+	writeTestBinlog(t, binlogDir, "vt-bin.000001", 0, 1, 2, 3)
+	writeTestBinlog(t, binlogDir, "vt-bin.000002", 3, 4)
+
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(fakesqldb.New(t))
+	defer mysqld.Close()
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+			"vt-bin.000001|100",
+			"vt-bin.000002|100",
+		),
+	}
+
+	// The archive fails, and is aborted, instead of panicking.
+	_, err := mysqlctl.ArchiveBinlogs(ctx, bs, mysqlctl.BinlogArchiveParams{
+		Cnf: &mysqlctl.Mycnf{
+			BinLogPath: path.Join(binlogDir, "vt-bin"),
+			TmpDir:     t.TempDir(),
+		},
+		Mysqld:      mysqld,
+		Logger:      logutil.NewMemoryLogger(),
+		Keyspace:    "ks",
+		Shard:       "0",
+		TabletAlias: "cell1-0000000100",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "can't set up backup encryption")
+
+	bhs, err := bs.ListBackups(ctx, mysqlctl.GetBinlogArchiveDir("ks", "0"))
+	require.NoError(t, err)
+	assert.Empty(t, bhs)
+}
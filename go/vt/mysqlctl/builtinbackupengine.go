@@ -97,6 +97,8 @@ type FileEntry struct {
 	// - backupInnodbDataHomeDir for files that go into Mycnf.InnodbDataHomeDir
 	// - backupInnodbLogGroupHomeDir for files that go into Mycnf.InnodbLogGroupHomeDir
 	// - backupData for files that go into Mycnf.DataDir
+	// - backupBinlogDir for binlogs of an incremental backup, which are
+	//   taken from the directory of Mycnf.BinLogPath
 	Base string
 
 	// Name is the file name, relative to Base
@@ -117,6 +119,8 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 		root = cnf.InnodbLogGroupHomeDir
 	case backupData:
 		root = cnf.DataDir
+	case backupBinlogDir:
+		root = path.Dir(cnf.BinLogPath)
 	default:
 		return nil, vterrors.Errorf(vtrpc.Code_UNKNOWN, "unknown base: %v", fe.Base)
 	}
@@ -147,6 +151,10 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 
 	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
 
+	if params.Incremental {
+		return be.executeIncrementalBackup(ctx, params, bh)
+	}
+
 	// Save initial state so we can restore.
 	replicaStartRequired := false
 	sourceIsPrimary := false
@@ -282,8 +290,72 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 	return usable, backupErr
 }
 
+// executeIncrementalBackup backs up the binlogs written since the backup the
+// incremental backup is based on. mysqld keeps running: the binlogs are
+// rotated first, so that only complete binlogs are backed up.
+func (be *BuiltinBackupEngine) executeIncrementalBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
+	fromPos := params.incrementalFromPos
+	if fromPos.IsZero() {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v has no position, can't take an incremental backup from it", params.incrementalFromBackup)
+	}
+	params.Logger.Infof("taking an incremental backup from backup %v at position %v", params.incrementalFromBackup, mysql.EncodePosition(fromPos))
+
+	if err := params.Mysqld.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+		return false, vterrors.Wrap(err, "can't rotate binary logs")
+	}
+	qr, err := params.Mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return false, vterrors.Wrap(err, "can't list binary logs")
+	}
+	if len(qr.Rows) == 0 {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no binary logs, incremental backups require binary logging")
+	}
+
+	// The last binlog is the one mysqld just rotated to, it has no
+	// transaction yet. The others are backed up, starting from the first one
+	// with transactions that are not in the previous backup.
+	binlogDir := path.Dir(params.Cnf.BinLogPath)
+	var fes []FileEntry
+	var firstPos, pos mysql.Position
+	for _, row := range qr.Rows[:len(qr.Rows)-1] {
+		name := row[0].ToString()
+		info, err := readBinlogFileInfo(path.Join(binlogDir, name))
+		if err != nil {
+			return false, vterrors.Wrapf(err, "can't read binlog %v", name)
+		}
+		if len(fes) == 0 {
+			if fromPos.AtLeast(info.position) {
+				continue
+			}
+			firstPos = info.previousPosition
+		}
+		fes = append(fes, FileEntry{Base: backupBinlogDir, Name: name})
+		pos = info.position
+	}
+	if len(fes) == 0 {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no new transaction since backup %v at position %v", params.incrementalFromBackup, mysql.EncodePosition(fromPos))
+	}
+	if !fromPos.AtLeast(firstPos) {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary logs since backup %v were purged, the first available one starts at position %v", params.incrementalFromBackup, mysql.EncodePosition(firstPos))
+	}
+	if !pos.AtLeast(fromPos) {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary logs at position %v don't contain all the transactions of backup %v at position %v", mysql.EncodePosition(pos), params.incrementalFromBackup, mysql.EncodePosition(fromPos))
+	}
+	params.Logger.Infof("found %v binlogs to backup, up to position %v", len(fes), mysql.EncodePosition(pos))
+
+	err = be.backupFileEntries(ctx, params, bh, fes, BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		Position:     pos,
+		BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+		Incremental:  true,
+		FromBackup:   params.incrementalFromBackup,
+		FromPosition: fromPos,
+	})
+	return err == nil, err
+}
+
 // backupFiles finds the list of files to backup, and creates the backup.
-func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) error {
 
 	// Get the files to backup.
 	// We don't care about totalSize because we add each file separately.
@@ -293,6 +365,16 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 	params.Logger.Infof("found %v files to backup", len(fes))
 
+	return be.backupFileEntries(ctx, params, bh, fes, BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		Position:     replicationPosition,
+		BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+	})
+}
+
+// backupFileEntries backs up the given files, and then writes the MANIFEST
+// built from bm.
+func (be *BuiltinBackupEngine) backupFileEntries(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fes []FileEntry, bm BackupManifest) (finalErr error) {
 	// Backup with the provided concurrency.
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	wg := sync.WaitGroup{}
@@ -341,21 +423,18 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}()
 
 	// JSON-encode and write the MANIFEST
-	bm := &builtinBackupManifest{
+	bm.FinishedTime = time.Now().UTC().Format(time.RFC3339)
+	bm.Encryption = params.encryption
+	manifest := &builtinBackupManifest{
 		// Common base fields
-		BackupManifest: BackupManifest{
-			BackupMethod: builtinBackupEngineName,
-			Position:     replicationPosition,
-			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
-			FinishedTime: time.Now().UTC().Format(time.RFC3339),
-		},
+		BackupManifest: bm,
 
 		// Builtin-specific fields
 		FileEntries:   fes,
 		TransformHook: *backupStorageHook,
 		SkipCompress:  !*backupStorageCompress,
 	}
-	data, err := json.MarshalIndent(bm, "", "  ")
+	data, err := json.MarshalIndent(manifest, "", "  ")
 	if err != nil {
 		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
 	}
@@ -555,6 +634,9 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
 		return nil, err
 	}
+	if bm.Incremental {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v is an incremental backup, it can only be restored on top of backup %v", bh.Name(), bm.FromBackup)
+	}
 
 	// mark restore as in progress
 	if err := createStateFile(params.Cnf); err != nil {
@@ -576,6 +658,47 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 	return &bm.BackupManifest, nil
 }
 
+// restoreIncrementalBackup applies the binlogs of an incremental backup on
+// top of the backup it is based on, which must already be restored at
+// position pos. mysqld must be running.
+func (be *BuiltinBackupEngine) restoreIncrementalBackup(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, pos mysql.Position) (*BackupManifest, error) {
+	var bm builtinBackupManifest
+	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+		return nil, err
+	}
+	if !bm.Incremental {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "backup %v is not an incremental backup", bh.Name())
+	}
+
+	// The binlogs are copied to a temporary directory rather than to the
+	// binlog directory of mysqld, and are then applied with mysqlbinlog.
+	tmpDir, err := os.MkdirTemp(params.Cnf.TmpDir, "incremental_backup")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	cnf := *params.Cnf
+	cnf.BinLogPath = path.Join(tmpDir, path.Base(params.Cnf.BinLogPath))
+	tmpParams := params
+	tmpParams.Cnf = &cnf
+
+	params.Logger.Infof("Restore: copying %v binlogs of incremental backup %v", len(bm.FileEntries), bh.Name())
+	if err := be.restoreFiles(ctx, tmpParams, bh, bm); err != nil {
+		return nil, vterrors.Wrap(err, "failed to restore binlogs")
+	}
+
+	// The first binlog starts at the position of the previous binlog, which
+	// may be before pos, so the GTIDs that are already restored are skipped.
+	for _, fe := range bm.FileEntries {
+		params.Logger.Infof("Restore: applying binlog %v of incremental backup %v", fe.Name, bh.Name())
+		if err := params.Mysqld.ApplyBinlogFile(ctx, path.Join(tmpDir, fe.Name), pos, params.RestoreToPos, params.RestoreToTimestamp); err != nil {
+			return nil, vterrors.Wrapf(err, "can't apply binlog %v of incremental backup %v", fe.Name, bh.Name())
+		}
+	}
+	return &bm.BackupManifest, nil
+}
+
 // restoreFiles will copy all the files from the BackupStorage to the
 // right place.
 func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest) error {
@@ -6,6 +6,7 @@ package mysqlctl_test
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"path"
 	"testing"
@@ -15,8 +16,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
 	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
 	"vitess.io/vitess/go/vt/proto/topodata"
@@ -134,3 +137,205 @@ func TestExecuteBackup(t *testing.T) {
 	assert.Error(t, err)
 	assert.False(t, ok)
 }
+
+func TestIncrementalBackup(t *testing.T) {
+	ctx := context.Background()
+
+	backupRoot := t.TempDir()
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	*filebackupstorage.FileBackupStorageRoot = backupRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	oldImplementation := *backupstorage.BackupStorageImplementation
+	*backupstorage.BackupStorageImplementation = "file"
+	defer func() { *backupstorage.BackupStorageImplementation = oldImplementation }()
+	bs := &filebackupstorage.FileBackupStorage{}
+	backupDir := mysqlctl.GetBackupDir("ks", "0")
+
+	// The full backup the incremental backups are based on.
+	fullName := "2022-01-01.000300.cell1-0000000100"
+	bh, err := bs.StartBackup(ctx, backupDir, fullName)
+	require.NoError(t, err)
+	wc, err := bh.AddFile(ctx, "MANIFEST", 0)
+	require.NoError(t, err)
+	require.NoError(t, json.NewEncoder(wc).Encode(mysqlctl.BackupManifest{
+		BackupMethod: "builtin",
+		Position:     testBinlogPosition(t, 3),
+	}))
+	require.NoError(t, wc.Close())
+	require.NoError(t, bh.EndBackup(ctx))
+
+	binlogDir := t.TempDir()
+	writeTestBinlog(t, binlogDir, "vt-bin.000001", 0, 1, 2, 3)
+	writeTestBinlog(t, binlogDir, "vt-bin.000002", 3, 4, 5, 6)
+	writeTestBinlog(t, binlogDir, "vt-bin.000003", 6, 7)
+	writeTestBinlog(t, binlogDir, "vt-bin.000004", 7)
+
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(fakesqldb.New(t))
+	defer mysqld.Close()
+	mysqld.ExpectedExecuteSuperQueryList = []string{"FLUSH BINARY LOGS"}
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+			"vt-bin.000001|100",
+			"vt-bin.000002|100",
+			"vt-bin.000003|100",
+			"vt-bin.000004|100",
+		),
+	}
+
+	backupParams := mysqlctl.BackupParams{
+		Cnf: &mysqlctl.Mycnf{
+			BinLogPath: path.Join(binlogDir, "vt-bin"),
+			TmpDir:     t.TempDir(),
+		},
+		Mysqld:       mysqld,
+		Logger:       logutil.NewMemoryLogger(),
+		Concurrency:  1,
+		HookExtraEnv: map[string]string{},
+		Keyspace:     "ks",
+		Shard:        "0",
+		TabletAlias:  "cell1-0000000100",
+		BackupTime:   time.Unix(testBinlogBaseTime+8*60, 0),
+		Incremental:  true,
+	}
+	require.NoError(t, mysqlctl.Backup(ctx, backupParams))
+
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	require.NoError(t, err)
+	require.Len(t, bhs, 2)
+	incrementalName := "2022-01-01.000800.cell1-0000000100"
+	assert.Equal(t, incrementalName, bhs[1].Name())
+
+	// Only the binlogs with transactions after the full backup are backed
+	// up, and the active one is left out.
+	manifest, err := mysqlctl.GetBackupManifest(ctx, bhs[1])
+	require.NoError(t, err)
+	assert.True(t, manifest.Incremental)
+	assert.Equal(t, fullName, manifest.FromBackup)
+	assert.True(t, manifest.FromPosition.Equal(testBinlogPosition(t, 3)), "FromPosition: %v", manifest.FromPosition)
+	assert.True(t, manifest.Position.Equal(testBinlogPosition(t, 7)), "Position: %v", manifest.Position)
+
+	rc, err := bhs[1].ReadFile(ctx, "MANIFEST")
+	require.NoError(t, err)
+	var fileEntries struct {
+		FileEntries []mysqlctl.FileEntry
+	}
+	require.NoError(t, json.NewDecoder(rc).Decode(&fileEntries))
+	rc.Close()
+	require.Len(t, fileEntries.FileEntries, 2)
+	for i, name := range []string{"vt-bin.000002", "vt-bin.000003"} {
+		assert.Equal(t, "BinlogDir", fileEntries.FileEntries[i].Base)
+		assert.Equal(t, name, fileEntries.FileEntries[i].Name)
+	}
+
+	chain, err := mysqlctl.FindBackupChain(ctx, bhs, bhs[1])
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	assert.Equal(t, fullName, chain[0].Name())
+	assert.Equal(t, incrementalName, chain[1].Name())
+
+	assert.Equal(t, map[string][]string{fullName: {incrementalName}}, mysqlctl.GetBackupDependents(ctx, bhs))
+
+	// There is nothing left to back up since the incremental backup.
+	mysqld.ExpectedExecuteSuperQueryCurrent = 0
+	backupParams.BackupTime = time.Unix(testBinlogBaseTime+9*60, 0)
+	err = mysqlctl.Backup(ctx, backupParams)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no new transaction since backup "+incrementalName)
+}
+
+func TestRestoreIncrementalBackup(t *testing.T) {
+	ctx := context.Background()
+
+	backupRoot := t.TempDir()
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	*filebackupstorage.FileBackupStorageRoot = backupRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	oldImplementation := *backupstorage.BackupStorageImplementation
+	*backupstorage.BackupStorageImplementation = "file"
+	defer func() { *backupstorage.BackupStorageImplementation = oldImplementation }()
+	bs := &filebackupstorage.FileBackupStorage{}
+	backupDir := mysqlctl.GetBackupDir("ks", "0")
+
+	// The full backup is in the middle of the first binlog, so the first
+	// binlog of the incremental backup overlaps it.
+	bh, err := bs.StartBackup(ctx, backupDir, "2022-01-01.000200.cell1-0000000100")
+	require.NoError(t, err)
+	wc, err := bh.AddFile(ctx, "MANIFEST", 0)
+	require.NoError(t, err)
+	require.NoError(t, json.NewEncoder(wc).Encode(mysqlctl.BackupManifest{
+		BackupMethod: "builtin",
+		Position:     testBinlogPosition(t, 2),
+	}))
+	require.NoError(t, wc.Close())
+	require.NoError(t, bh.EndBackup(ctx))
+
+	tabletDir := t.TempDir()
+	cnf := &mysqlctl.Mycnf{
+		DataDir:               path.Join(tabletDir, "data"),
+		InnodbDataHomeDir:     path.Join(tabletDir, "innodb", "data"),
+		InnodbLogGroupHomeDir: path.Join(tabletDir, "innodb", "logs"),
+		BinLogPath:            path.Join(tabletDir, "bin-logs", "vt-bin"),
+		RelayLogPath:          path.Join(tabletDir, "relay-logs", "vt-relay-bin"),
+		RelayLogIndexPath:     path.Join(tabletDir, "relay-logs", "vt-relay-bin.index"),
+		RelayLogInfoPath:      path.Join(tabletDir, "relay-logs", "relay-log.info"),
+		TmpDir:                t.TempDir(),
+	}
+	for _, dir := range []string{cnf.DataDir, path.Dir(cnf.BinLogPath)} {
+		require.NoError(t, os.MkdirAll(dir, 0755))
+	}
+	binlogDir := path.Dir(cnf.BinLogPath)
+	writeTestBinlog(t, binlogDir, "vt-bin.000001", 0, 1, 2, 3)
+	writeTestBinlog(t, binlogDir, "vt-bin.000002", 3, 4, 5)
+	writeTestBinlog(t, binlogDir, "vt-bin.000003", 5)
+
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQueryPattern(".*", &sqltypes.Result{})
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(db)
+	defer mysqld.Close()
+	mysqld.ExpectedExecuteSuperQueryList = []string{"FLUSH BINARY LOGS"}
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+			"vt-bin.000001|100",
+			"vt-bin.000002|100",
+			"vt-bin.000003|100",
+		),
+	}
+
+	require.NoError(t, mysqlctl.Backup(ctx, mysqlctl.BackupParams{
+		Cnf:          cnf,
+		Mysqld:       mysqld,
+		Logger:       logutil.NewMemoryLogger(),
+		Concurrency:  1,
+		HookExtraEnv: map[string]string{},
+		Keyspace:     "ks",
+		Shard:        "0",
+		TabletAlias:  "cell1-0000000100",
+		BackupTime:   time.Unix(testBinlogBaseTime+6*60, 0),
+		Incremental:  true,
+	}))
+
+	mysqld.CurrentPrimaryPosition = testBinlogPosition(t, 5)
+	manifest, err := mysqlctl.Restore(ctx, mysqlctl.RestoreParams{
+		Cnf:           cnf,
+		Mysqld:        mysqld,
+		Logger:        logutil.NewMemoryLogger(),
+		Concurrency:   1,
+		HookExtraEnv:  map[string]string{},
+		LocalMetadata: map[string]string{},
+		DbName:        "vt_test",
+		Keyspace:      "ks",
+		Shard:         "0",
+	})
+	require.NoError(t, err)
+	assert.True(t, manifest.Position.Equal(testBinlogPosition(t, 5)), "Position: %v", manifest.Position)
+
+	// Both binlogs are applied without the GTIDs of the full backup.
+	assert.Equal(t, []string{"vt-bin.000001", "vt-bin.000002"}, mysqld.AppliedBinlogFiles)
+	require.Len(t, mysqld.AppliedBinlogExcludePositions, 2)
+	for _, pos := range mysqld.AppliedBinlogExcludePositions {
+		assert.True(t, pos.Equal(testBinlogPosition(t, 2)), "excluded position: %v", pos)
+	}
+}
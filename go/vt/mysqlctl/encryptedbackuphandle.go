@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"io"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupencryption"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// encryptedBackupHandle is a BackupHandle that encrypts the files added to
+// the backup, and decrypts the files read from it, with the data key of the
+// backup. The MANIFEST is neither encrypted nor decrypted, as it holds the
+// wrapped data key.
+type encryptedBackupHandle struct {
+	backupstorage.BackupHandle
+	dataKey []byte
+}
+
+// newEncryptingBackupHandle returns a BackupHandle that encrypts the files
+// added to bh with a new data key, along with the envelope of that key, to
+// be stored in the MANIFEST. If backups are not encrypted, bh is returned
+// unchanged with a nil envelope.
+func newEncryptingBackupHandle(ctx context.Context, bh backupstorage.BackupHandle) (backupstorage.BackupHandle, *backupencryption.Envelope, error) {
+	envelope, dataKey, err := backupencryption.NewEnvelope(ctx)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "can't set up backup encryption")
+	}
+	if envelope == nil {
+		return bh, nil, nil
+	}
+	return &encryptedBackupHandle{BackupHandle: bh, dataKey: dataKey}, envelope, nil
+}
+
+// newDecryptingBackupHandle returns a BackupHandle that decrypts the files
+// read from bh with the data key in envelope. If envelope is nil, the backup
+// is not encrypted and bh is returned unchanged.
+func newDecryptingBackupHandle(ctx context.Context, bh backupstorage.BackupHandle, envelope *backupencryption.Envelope) (backupstorage.BackupHandle, error) {
+	if envelope == nil {
+		return bh, nil
+	}
+	dataKey, err := envelope.DataKey(ctx)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't decrypt backup %v", bh.Name())
+	}
+	return &encryptedBackupHandle{BackupHandle: bh, dataKey: dataKey}, nil
+}
+
+// encryptedFile closes both the encrypting writer and the underlying file.
+type encryptedFile struct {
+	io.WriteCloser
+	file io.WriteCloser
+}
+
+func (ef *encryptedFile) Close() error {
+	if err := ef.WriteCloser.Close(); err != nil {
+		ef.file.Close()
+		return err
+	}
+	return ef.file.Close()
+}
+
+// AddFile is part of the BackupHandle interface.
+func (bh *encryptedBackupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	wc, err := bh.BackupHandle.AddFile(ctx, filename, filesize)
+	if err != nil || filename == backupManifestFileName {
+		return wc, err
+	}
+	w, err := backupencryption.NewWriter(wc, bh.dataKey, filename)
+	if err != nil {
+		wc.Close()
+		return nil, vterrors.Wrapf(err, "can't encrypt %v", filename)
+	}
+	return &encryptedFile{WriteCloser: w, file: wc}, nil
+}
+
+// ReadFile is part of the BackupHandle interface.
+func (bh *encryptedBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	rc, err := bh.BackupHandle.ReadFile(ctx, filename)
+	if err != nil || filename == backupManifestFileName {
+		return rc, err
+	}
+	r, err := backupencryption.NewReader(rc, bh.dataKey, filename)
+	if err != nil {
+		rc.Close()
+		return nil, vterrors.Wrapf(err, "can't decrypt %v", filename)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{r, rc}, nil
+}
@@ -18,6 +18,7 @@ package fakemysqldaemon
 
 import (
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"sync"
@@ -126,6 +127,17 @@ type FakeMysqlDaemon struct {
 	// PromoteError is used by Promote
 	PromoteError error
 
+	// AppliedBinlogFiles records the base names of the files passed to
+	// ApplyBinlogFile, in order.
+	AppliedBinlogFiles []string
+
+	// AppliedBinlogExcludePositions records the excludePos passed to
+	// ApplyBinlogFile, in order.
+	AppliedBinlogExcludePositions []mysql.Position
+
+	// ApplyBinlogFileError is used by ApplyBinlogFile
+	ApplyBinlogFileError error
+
 	// SchemaFunc provides the return value for GetSchema.
 	// If not defined, the "Schema" field will be used instead, see below.
 	SchemaFunc func() (*tabletmanagerdatapb.SchemaDefinition, error)
@@ -489,6 +501,16 @@ func (fmd *FakeMysqlDaemon) DisableBinlogPlayback() error {
 	return nil
 }
 
+// ApplyBinlogFile is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogFile(ctx context.Context, binlogFile string, excludePos, restorePos mysql.Position, restoreTime time.Time) error {
+	if fmd.ApplyBinlogFileError != nil {
+		return fmd.ApplyBinlogFileError
+	}
+	fmd.AppliedBinlogFiles = append(fmd.AppliedBinlogFiles, filepath.Base(binlogFile))
+	fmd.AppliedBinlogExcludePositions = append(fmd.AppliedBinlogExcludePositions, excludePos)
+	return nil
+}
+
 // Close is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) Close() {
 	if fmd.appPool != nil {
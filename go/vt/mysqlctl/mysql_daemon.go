@@ -18,6 +18,7 @@ package mysqlctl
 
 import (
 	"context"
+	"time"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
@@ -97,6 +98,11 @@ type MysqlDaemon interface {
 	// DisableBinlogPlayback disable playback of binlog events
 	DisableBinlogPlayback() error
 
+	// ApplyBinlogFile applies the events of a binlog file, up to the given
+	// position or time if set. The GTIDs of excludePos, which are already
+	// applied, are skipped. It is used for point-in-time recovery.
+	ApplyBinlogFile(ctx context.Context, binlogFile string, excludePos, restorePos mysql.Position, restoreTime time.Time) error
+
 	// Close will close this instance of Mysqld. It will wait for all dba
 	// queries to be finished.
 	Close()
@@ -17,9 +17,42 @@ limitations under the License.
 package inst
 
 import (
+	"context"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	"vitess.io/vitess/go/vt/orchestrator/external/golib/log"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 )
 
+// keyspaceDurabilityCache caches the durability policies of the keyspaces,
+// so that the analysis doesn't read the topo for every instance.
+var keyspaceDurabilityCache = cache.New(10*time.Second, time.Minute)
+
+// GetDurabilityPolicy returns the durability policy of the keyspace of the
+// tablet, as stored in the topo. It returns nil, which means the default
+// durability policy, if the keyspace can't be read.
+func GetDurabilityPolicy(tablet *topodatapb.Tablet) reparentutil.Durabler {
+	if TopoServ == nil || tablet == nil {
+		return nil
+	}
+	if durability, found := keyspaceDurabilityCache.Get(tablet.Keyspace); found {
+		return durability.(reparentutil.Durabler)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	durability, err := reparentutil.GetKeyspaceDurability(ctx, TopoServ, tablet.Keyspace)
+	if err != nil {
+		log.Errorf("can't read durability policy of keyspace %v, using the default policy: %v", tablet.Keyspace, err)
+		return nil
+	}
+	keyspaceDurabilityCache.Set(tablet.Keyspace, durability, cache.DefaultExpiration)
+	return durability
+}
+
 // IsReplicaSemiSync returns the replica semi-sync setting for the instance.
 func IsReplicaSemiSync(primaryKey, replicaKey InstanceKey) bool {
 	primary, err := ReadTablet(primaryKey)
@@ -30,7 +63,7 @@ func IsReplicaSemiSync(primaryKey, replicaKey InstanceKey) bool {
 	if err != nil {
 		return false
 	}
-	return reparentutil.IsReplicaSemiSync(primary, replica)
+	return reparentutil.IsReplicaSemiSync(GetDurabilityPolicy(primary), primary, replica)
 }
 
 // SemiSyncAckers returns the primary semi-sync setting for the instance.
@@ -40,5 +73,5 @@ func SemiSyncAckers(instanceKey InstanceKey) int {
 	if err != nil {
 		return 0
 	}
-	return reparentutil.SemiSyncAckers(primary)
+	return reparentutil.SemiSyncAckers(GetDurabilityPolicy(primary), primary)
 }
@@ -45,7 +45,7 @@ var file_queryservice_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x1a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x10, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x32, 0x8e, 0x10, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x07, 0x45,
+	0x6f, 0x32, 0xf0, 0x10, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x07, 0x45,
 	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x45,
 	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
 	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
@@ -174,10 +174,16 @@ var file_queryservice_proto_rawDesc = []byte{
 	0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x69, 0x6e,
 	0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x30, 0x01, 0x42, 0x2b, 0x5a, 0x29, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f,
-	0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x30, 0x01, 0x12, 0x60, 0x0a, 0x11, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x12, 0x24, 0x2e, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69,
+	0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_queryservice_proto_goTypes = []interface{}{
@@ -207,32 +213,34 @@ var file_queryservice_proto_goTypes = []interface{}{
 	(*binlogdata.VStreamRequest)(nil),               // 23: binlogdata.VStreamRequest
 	(*binlogdata.VStreamRowsRequest)(nil),           // 24: binlogdata.VStreamRowsRequest
 	(*binlogdata.VStreamResultsRequest)(nil),        // 25: binlogdata.VStreamResultsRequest
-	(*query.ExecuteResponse)(nil),                   // 26: query.ExecuteResponse
-	(*query.StreamExecuteResponse)(nil),             // 27: query.StreamExecuteResponse
-	(*query.BeginResponse)(nil),                     // 28: query.BeginResponse
-	(*query.CommitResponse)(nil),                    // 29: query.CommitResponse
-	(*query.RollbackResponse)(nil),                  // 30: query.RollbackResponse
-	(*query.PrepareResponse)(nil),                   // 31: query.PrepareResponse
-	(*query.CommitPreparedResponse)(nil),            // 32: query.CommitPreparedResponse
-	(*query.RollbackPreparedResponse)(nil),          // 33: query.RollbackPreparedResponse
-	(*query.CreateTransactionResponse)(nil),         // 34: query.CreateTransactionResponse
-	(*query.StartCommitResponse)(nil),               // 35: query.StartCommitResponse
-	(*query.SetRollbackResponse)(nil),               // 36: query.SetRollbackResponse
-	(*query.ConcludeTransactionResponse)(nil),       // 37: query.ConcludeTransactionResponse
-	(*query.ReadTransactionResponse)(nil),           // 38: query.ReadTransactionResponse
-	(*query.BeginExecuteResponse)(nil),              // 39: query.BeginExecuteResponse
-	(*query.BeginStreamExecuteResponse)(nil),        // 40: query.BeginStreamExecuteResponse
-	(*query.MessageStreamResponse)(nil),             // 41: query.MessageStreamResponse
-	(*query.MessageAckResponse)(nil),                // 42: query.MessageAckResponse
-	(*query.ReserveExecuteResponse)(nil),            // 43: query.ReserveExecuteResponse
-	(*query.ReserveBeginExecuteResponse)(nil),       // 44: query.ReserveBeginExecuteResponse
-	(*query.ReserveStreamExecuteResponse)(nil),      // 45: query.ReserveStreamExecuteResponse
-	(*query.ReserveBeginStreamExecuteResponse)(nil), // 46: query.ReserveBeginStreamExecuteResponse
-	(*query.ReleaseResponse)(nil),                   // 47: query.ReleaseResponse
-	(*query.StreamHealthResponse)(nil),              // 48: query.StreamHealthResponse
-	(*binlogdata.VStreamResponse)(nil),              // 49: binlogdata.VStreamResponse
-	(*binlogdata.VStreamRowsResponse)(nil),          // 50: binlogdata.VStreamRowsResponse
-	(*binlogdata.VStreamResultsResponse)(nil),       // 51: binlogdata.VStreamResultsResponse
+	(*binlogdata.VStreamPositionAtRequest)(nil),     // 26: binlogdata.VStreamPositionAtRequest
+	(*query.ExecuteResponse)(nil),                   // 27: query.ExecuteResponse
+	(*query.StreamExecuteResponse)(nil),             // 28: query.StreamExecuteResponse
+	(*query.BeginResponse)(nil),                     // 29: query.BeginResponse
+	(*query.CommitResponse)(nil),                    // 30: query.CommitResponse
+	(*query.RollbackResponse)(nil),                  // 31: query.RollbackResponse
+	(*query.PrepareResponse)(nil),                   // 32: query.PrepareResponse
+	(*query.CommitPreparedResponse)(nil),            // 33: query.CommitPreparedResponse
+	(*query.RollbackPreparedResponse)(nil),          // 34: query.RollbackPreparedResponse
+	(*query.CreateTransactionResponse)(nil),         // 35: query.CreateTransactionResponse
+	(*query.StartCommitResponse)(nil),               // 36: query.StartCommitResponse
+	(*query.SetRollbackResponse)(nil),               // 37: query.SetRollbackResponse
+	(*query.ConcludeTransactionResponse)(nil),       // 38: query.ConcludeTransactionResponse
+	(*query.ReadTransactionResponse)(nil),           // 39: query.ReadTransactionResponse
+	(*query.BeginExecuteResponse)(nil),              // 40: query.BeginExecuteResponse
+	(*query.BeginStreamExecuteResponse)(nil),        // 41: query.BeginStreamExecuteResponse
+	(*query.MessageStreamResponse)(nil),             // 42: query.MessageStreamResponse
+	(*query.MessageAckResponse)(nil),                // 43: query.MessageAckResponse
+	(*query.ReserveExecuteResponse)(nil),            // 44: query.ReserveExecuteResponse
+	(*query.ReserveBeginExecuteResponse)(nil),       // 45: query.ReserveBeginExecuteResponse
+	(*query.ReserveStreamExecuteResponse)(nil),      // 46: query.ReserveStreamExecuteResponse
+	(*query.ReserveBeginStreamExecuteResponse)(nil), // 47: query.ReserveBeginStreamExecuteResponse
+	(*query.ReleaseResponse)(nil),                   // 48: query.ReleaseResponse
+	(*query.StreamHealthResponse)(nil),              // 49: query.StreamHealthResponse
+	(*binlogdata.VStreamResponse)(nil),              // 50: binlogdata.VStreamResponse
+	(*binlogdata.VStreamRowsResponse)(nil),          // 51: binlogdata.VStreamRowsResponse
+	(*binlogdata.VStreamResultsResponse)(nil),       // 52: binlogdata.VStreamResultsResponse
+	(*binlogdata.VStreamPositionAtResponse)(nil),    // 53: binlogdata.VStreamPositionAtResponse
 }
 var file_queryservice_proto_depIdxs = []int32{
 	0,  // 0: queryservice.Query.Execute:input_type -> query.ExecuteRequest
@@ -261,34 +269,36 @@ var file_queryservice_proto_depIdxs = []int32{
 	23, // 23: queryservice.Query.VStream:input_type -> binlogdata.VStreamRequest
 	24, // 24: queryservice.Query.VStreamRows:input_type -> binlogdata.VStreamRowsRequest
 	25, // 25: queryservice.Query.VStreamResults:input_type -> binlogdata.VStreamResultsRequest
-	26, // 26: queryservice.Query.Execute:output_type -> query.ExecuteResponse
-	27, // 27: queryservice.Query.StreamExecute:output_type -> query.StreamExecuteResponse
-	28, // 28: queryservice.Query.Begin:output_type -> query.BeginResponse
-	29, // 29: queryservice.Query.Commit:output_type -> query.CommitResponse
-	30, // 30: queryservice.Query.Rollback:output_type -> query.RollbackResponse
-	31, // 31: queryservice.Query.Prepare:output_type -> query.PrepareResponse
-	32, // 32: queryservice.Query.CommitPrepared:output_type -> query.CommitPreparedResponse
-	33, // 33: queryservice.Query.RollbackPrepared:output_type -> query.RollbackPreparedResponse
-	34, // 34: queryservice.Query.CreateTransaction:output_type -> query.CreateTransactionResponse
-	35, // 35: queryservice.Query.StartCommit:output_type -> query.StartCommitResponse
-	36, // 36: queryservice.Query.SetRollback:output_type -> query.SetRollbackResponse
-	37, // 37: queryservice.Query.ConcludeTransaction:output_type -> query.ConcludeTransactionResponse
-	38, // 38: queryservice.Query.ReadTransaction:output_type -> query.ReadTransactionResponse
-	39, // 39: queryservice.Query.BeginExecute:output_type -> query.BeginExecuteResponse
-	40, // 40: queryservice.Query.BeginStreamExecute:output_type -> query.BeginStreamExecuteResponse
-	41, // 41: queryservice.Query.MessageStream:output_type -> query.MessageStreamResponse
-	42, // 42: queryservice.Query.MessageAck:output_type -> query.MessageAckResponse
-	43, // 43: queryservice.Query.ReserveExecute:output_type -> query.ReserveExecuteResponse
-	44, // 44: queryservice.Query.ReserveBeginExecute:output_type -> query.ReserveBeginExecuteResponse
-	45, // 45: queryservice.Query.ReserveStreamExecute:output_type -> query.ReserveStreamExecuteResponse
-	46, // 46: queryservice.Query.ReserveBeginStreamExecute:output_type -> query.ReserveBeginStreamExecuteResponse
-	47, // 47: queryservice.Query.Release:output_type -> query.ReleaseResponse
-	48, // 48: queryservice.Query.StreamHealth:output_type -> query.StreamHealthResponse
-	49, // 49: queryservice.Query.VStream:output_type -> binlogdata.VStreamResponse
-	50, // 50: queryservice.Query.VStreamRows:output_type -> binlogdata.VStreamRowsResponse
-	51, // 51: queryservice.Query.VStreamResults:output_type -> binlogdata.VStreamResultsResponse
-	26, // [26:52] is the sub-list for method output_type
-	0,  // [0:26] is the sub-list for method input_type
+	26, // 26: queryservice.Query.VStreamPositionAt:input_type -> binlogdata.VStreamPositionAtRequest
+	27, // 27: queryservice.Query.Execute:output_type -> query.ExecuteResponse
+	28, // 28: queryservice.Query.StreamExecute:output_type -> query.StreamExecuteResponse
+	29, // 29: queryservice.Query.Begin:output_type -> query.BeginResponse
+	30, // 30: queryservice.Query.Commit:output_type -> query.CommitResponse
+	31, // 31: queryservice.Query.Rollback:output_type -> query.RollbackResponse
+	32, // 32: queryservice.Query.Prepare:output_type -> query.PrepareResponse
+	33, // 33: queryservice.Query.CommitPrepared:output_type -> query.CommitPreparedResponse
+	34, // 34: queryservice.Query.RollbackPrepared:output_type -> query.RollbackPreparedResponse
+	35, // 35: queryservice.Query.CreateTransaction:output_type -> query.CreateTransactionResponse
+	36, // 36: queryservice.Query.StartCommit:output_type -> query.StartCommitResponse
+	37, // 37: queryservice.Query.SetRollback:output_type -> query.SetRollbackResponse
+	38, // 38: queryservice.Query.ConcludeTransaction:output_type -> query.ConcludeTransactionResponse
+	39, // 39: queryservice.Query.ReadTransaction:output_type -> query.ReadTransactionResponse
+	40, // 40: queryservice.Query.BeginExecute:output_type -> query.BeginExecuteResponse
+	41, // 41: queryservice.Query.BeginStreamExecute:output_type -> query.BeginStreamExecuteResponse
+	42, // 42: queryservice.Query.MessageStream:output_type -> query.MessageStreamResponse
+	43, // 43: queryservice.Query.MessageAck:output_type -> query.MessageAckResponse
+	44, // 44: queryservice.Query.ReserveExecute:output_type -> query.ReserveExecuteResponse
+	45, // 45: queryservice.Query.ReserveBeginExecute:output_type -> query.ReserveBeginExecuteResponse
+	46, // 46: queryservice.Query.ReserveStreamExecute:output_type -> query.ReserveStreamExecuteResponse
+	47, // 47: queryservice.Query.ReserveBeginStreamExecute:output_type -> query.ReserveBeginStreamExecuteResponse
+	48, // 48: queryservice.Query.Release:output_type -> query.ReleaseResponse
+	49, // 49: queryservice.Query.StreamHealth:output_type -> query.StreamHealthResponse
+	50, // 50: queryservice.Query.VStream:output_type -> binlogdata.VStreamResponse
+	51, // 51: queryservice.Query.VStreamRows:output_type -> binlogdata.VStreamRowsResponse
+	52, // 52: queryservice.Query.VStreamResults:output_type -> binlogdata.VStreamResultsResponse
+	53, // 53: queryservice.Query.VStreamPositionAt:output_type -> binlogdata.VStreamPositionAtResponse
+	27, // [27:54] is the sub-list for method output_type
+	0,  // [0:27] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
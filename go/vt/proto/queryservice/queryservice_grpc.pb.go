@@ -77,6 +77,9 @@ type QueryClient interface {
 	VStreamRows(ctx context.Context, in *binlogdata.VStreamRowsRequest, opts ...grpc.CallOption) (Query_VStreamRowsClient, error)
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(ctx context.Context, in *binlogdata.VStreamResultsRequest, opts ...grpc.CallOption) (Query_VStreamResultsClient, error)
+	// VStreamPositionAt returns the position of the binlogs at a time, to
+	// start a VStream from.
+	VStreamPositionAt(ctx context.Context, in *binlogdata.VStreamPositionAtRequest, opts ...grpc.CallOption) (*binlogdata.VStreamPositionAtResponse, error)
 }
 
 type queryClient struct {
@@ -528,6 +531,15 @@ func (x *queryVStreamResultsClient) Recv() (*binlogdata.VStreamResultsResponse,
 	return m, nil
 }
 
+func (c *queryClient) VStreamPositionAt(ctx context.Context, in *binlogdata.VStreamPositionAtRequest, opts ...grpc.CallOption) (*binlogdata.VStreamPositionAtResponse, error) {
+	out := new(binlogdata.VStreamPositionAtResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/VStreamPositionAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -589,6 +601,9 @@ type QueryServer interface {
 	VStreamRows(*binlogdata.VStreamRowsRequest, Query_VStreamRowsServer) error
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(*binlogdata.VStreamResultsRequest, Query_VStreamResultsServer) error
+	// VStreamPositionAt returns the position of the binlogs at a time, to
+	// start a VStream from.
+	VStreamPositionAt(context.Context, *binlogdata.VStreamPositionAtRequest) (*binlogdata.VStreamPositionAtResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -674,6 +689,9 @@ func (UnimplementedQueryServer) VStreamRows(*binlogdata.VStreamRowsRequest, Quer
 func (UnimplementedQueryServer) VStreamResults(*binlogdata.VStreamResultsRequest, Query_VStreamResultsServer) error {
 	return status.Errorf(codes.Unimplemented, "method VStreamResults not implemented")
 }
+func (UnimplementedQueryServer) VStreamPositionAt(context.Context, *binlogdata.VStreamPositionAtRequest) (*binlogdata.VStreamPositionAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VStreamPositionAt not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -1182,6 +1200,24 @@ func (x *queryVStreamResultsServer) Send(m *binlogdata.VStreamResultsResponse) e
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_VStreamPositionAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(binlogdata.VStreamPositionAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VStreamPositionAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/VStreamPositionAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VStreamPositionAt(ctx, req.(*binlogdata.VStreamPositionAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1257,6 +1293,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Release",
 			Handler:    _Query_Release_Handler,
 		},
+		{
+			MethodName: "VStreamPositionAt",
+			Handler:    _Query_VStreamPositionAt_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
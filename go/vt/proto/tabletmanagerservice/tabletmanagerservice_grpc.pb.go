@@ -44,6 +44,11 @@ type TabletManagerClient interface {
 	ExecuteFetchAsDba(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsDbaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error)
 	ExecuteFetchAsAllPrivs(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAllPrivsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAllPrivsResponse, error)
 	ExecuteFetchAsApp(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAppRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error)
+	// ListQueries returns the queries running on the tablet
+	ListQueries(ctx context.Context, in *tabletmanagerdata.ListQueriesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ListQueriesResponse, error)
+	// KillQueries kills the queries running on the tablet that match a caller,
+	// a table or a fingerprint
+	KillQueries(ctx context.Context, in *tabletmanagerdata.KillQueriesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.KillQueriesResponse, error)
 	// ReplicationStatus returns the current replication status.
 	ReplicationStatus(ctx context.Context, in *tabletmanagerdata.ReplicationStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReplicationStatusResponse, error)
 	// Deprecated, use PrimaryStatus instead
@@ -289,6 +294,24 @@ func (c *tabletManagerClient) ExecuteFetchAsApp(ctx context.Context, in *tabletm
 	return out, nil
 }
 
+func (c *tabletManagerClient) ListQueries(ctx context.Context, in *tabletmanagerdata.ListQueriesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ListQueriesResponse, error) {
+	out := new(tabletmanagerdata.ListQueriesResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ListQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) KillQueries(ctx context.Context, in *tabletmanagerdata.KillQueriesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.KillQueriesResponse, error) {
+	out := new(tabletmanagerdata.KillQueriesResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/KillQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) ReplicationStatus(ctx context.Context, in *tabletmanagerdata.ReplicationStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReplicationStatusResponse, error) {
 	out := new(tabletmanagerdata.ReplicationStatusResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ReplicationStatus", in, out, opts...)
@@ -643,6 +666,11 @@ type TabletManagerServer interface {
 	ExecuteFetchAsDba(context.Context, *tabletmanagerdata.ExecuteFetchAsDbaRequest) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error)
 	ExecuteFetchAsAllPrivs(context.Context, *tabletmanagerdata.ExecuteFetchAsAllPrivsRequest) (*tabletmanagerdata.ExecuteFetchAsAllPrivsResponse, error)
 	ExecuteFetchAsApp(context.Context, *tabletmanagerdata.ExecuteFetchAsAppRequest) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error)
+	// ListQueries returns the queries running on the tablet
+	ListQueries(context.Context, *tabletmanagerdata.ListQueriesRequest) (*tabletmanagerdata.ListQueriesResponse, error)
+	// KillQueries kills the queries running on the tablet that match a caller,
+	// a table or a fingerprint
+	KillQueries(context.Context, *tabletmanagerdata.KillQueriesRequest) (*tabletmanagerdata.KillQueriesResponse, error)
 	// ReplicationStatus returns the current replication status.
 	ReplicationStatus(context.Context, *tabletmanagerdata.ReplicationStatusRequest) (*tabletmanagerdata.ReplicationStatusResponse, error)
 	// Deprecated, use PrimaryStatus instead
@@ -771,6 +799,12 @@ func (UnimplementedTabletManagerServer) ExecuteFetchAsAllPrivs(context.Context,
 func (UnimplementedTabletManagerServer) ExecuteFetchAsApp(context.Context, *tabletmanagerdata.ExecuteFetchAsAppRequest) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteFetchAsApp not implemented")
 }
+func (UnimplementedTabletManagerServer) ListQueries(context.Context, *tabletmanagerdata.ListQueriesRequest) (*tabletmanagerdata.ListQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueries not implemented")
+}
+func (UnimplementedTabletManagerServer) KillQueries(context.Context, *tabletmanagerdata.KillQueriesRequest) (*tabletmanagerdata.KillQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillQueries not implemented")
+}
 func (UnimplementedTabletManagerServer) ReplicationStatus(context.Context, *tabletmanagerdata.ReplicationStatusRequest) (*tabletmanagerdata.ReplicationStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplicationStatus not implemented")
 }
@@ -1219,6 +1253,42 @@ func _TabletManager_ExecuteFetchAsApp_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_ListQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ListQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ListQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ListQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ListQueries(ctx, req.(*tabletmanagerdata.ListQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_KillQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.KillQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).KillQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/KillQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).KillQueries(ctx, req.(*tabletmanagerdata.KillQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_ReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.ReplicationStatusRequest)
 	if err := dec(in); err != nil {
@@ -1866,6 +1936,14 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteFetchAsApp",
 			Handler:    _TabletManager_ExecuteFetchAsApp_Handler,
 		},
+		{
+			MethodName: "ListQueries",
+			Handler:    _TabletManager_ListQueries_Handler,
+		},
+		{
+			MethodName: "KillQueries",
+			Handler:    _TabletManager_KillQueries_Handler,
+		},
 		{
 			MethodName: "ReplicationStatus",
 			Handler:    _TabletManager_ReplicationStatus_Handler,
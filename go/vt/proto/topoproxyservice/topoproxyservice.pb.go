@@ -0,0 +1,175 @@
+//
+//Copyright 2022 The Vitess Authors.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// gRPC RPC interface for the topo proxy, that serves the topo.Conn API
+// of the global cell to many clients through one upstream connection.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.4
+// source: topoproxyservice.proto
+
+package topoproxyservice
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	topoproxydata "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_topoproxyservice_proto protoreflect.FileDescriptor
+
+var file_topoproxyservice_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x13, 0x74, 0x6f, 0x70, 0x6f,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32,
+	0x89, 0x06, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x6f, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x45, 0x0a,
+	0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c,
+	0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74,
+	0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x03, 0x47,
+	0x65, 0x74, 0x12, 0x19, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1a, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x12, 0x1d, 0x2e, 0x74,
+	0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x6f,
+	0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x43, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x74, 0x6f, 0x70, 0x6f,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x11, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x27, 0x2e, 0x74, 0x6f,
+	0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x57, 0x61, 0x69, 0x74,
+	0x46, 0x6f, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x12, 0x69, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x76,
+	0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2f,
+	0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x6f, 0x70, 0x6f,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_topoproxyservice_proto_goTypes = []interface{}{
+	(*topoproxydata.CreateRequest)(nil),              // 0: topoproxydata.CreateRequest
+	(*topoproxydata.UpdateRequest)(nil),              // 1: topoproxydata.UpdateRequest
+	(*topoproxydata.GetRequest)(nil),                 // 2: topoproxydata.GetRequest
+	(*topoproxydata.ListRequest)(nil),                // 3: topoproxydata.ListRequest
+	(*topoproxydata.DeleteRequest)(nil),              // 4: topoproxydata.DeleteRequest
+	(*topoproxydata.ListDirRequest)(nil),             // 5: topoproxydata.ListDirRequest
+	(*topoproxydata.WatchRequest)(nil),               // 6: topoproxydata.WatchRequest
+	(*topoproxydata.LockRequest)(nil),                // 7: topoproxydata.LockRequest
+	(*topoproxydata.WaitForLeadershipRequest)(nil),   // 8: topoproxydata.WaitForLeadershipRequest
+	(*topoproxydata.GetCurrentLeaderIDRequest)(nil),  // 9: topoproxydata.GetCurrentLeaderIDRequest
+	(*topoproxydata.CreateResponse)(nil),             // 10: topoproxydata.CreateResponse
+	(*topoproxydata.UpdateResponse)(nil),             // 11: topoproxydata.UpdateResponse
+	(*topoproxydata.GetResponse)(nil),                // 12: topoproxydata.GetResponse
+	(*topoproxydata.ListResponse)(nil),               // 13: topoproxydata.ListResponse
+	(*topoproxydata.DeleteResponse)(nil),             // 14: topoproxydata.DeleteResponse
+	(*topoproxydata.ListDirResponse)(nil),            // 15: topoproxydata.ListDirResponse
+	(*topoproxydata.WatchResponse)(nil),              // 16: topoproxydata.WatchResponse
+	(*topoproxydata.LockResponse)(nil),               // 17: topoproxydata.LockResponse
+	(*topoproxydata.WaitForLeadershipResponse)(nil),  // 18: topoproxydata.WaitForLeadershipResponse
+	(*topoproxydata.GetCurrentLeaderIDResponse)(nil), // 19: topoproxydata.GetCurrentLeaderIDResponse
+}
+var file_topoproxyservice_proto_depIdxs = []int32{
+	0,  // 0: topoproxyservice.TopoProxy.Create:input_type -> topoproxydata.CreateRequest
+	1,  // 1: topoproxyservice.TopoProxy.Update:input_type -> topoproxydata.UpdateRequest
+	2,  // 2: topoproxyservice.TopoProxy.Get:input_type -> topoproxydata.GetRequest
+	3,  // 3: topoproxyservice.TopoProxy.List:input_type -> topoproxydata.ListRequest
+	4,  // 4: topoproxyservice.TopoProxy.Delete:input_type -> topoproxydata.DeleteRequest
+	5,  // 5: topoproxyservice.TopoProxy.ListDir:input_type -> topoproxydata.ListDirRequest
+	6,  // 6: topoproxyservice.TopoProxy.Watch:input_type -> topoproxydata.WatchRequest
+	7,  // 7: topoproxyservice.TopoProxy.Lock:input_type -> topoproxydata.LockRequest
+	8,  // 8: topoproxyservice.TopoProxy.WaitForLeadership:input_type -> topoproxydata.WaitForLeadershipRequest
+	9,  // 9: topoproxyservice.TopoProxy.GetCurrentLeaderID:input_type -> topoproxydata.GetCurrentLeaderIDRequest
+	10, // 10: topoproxyservice.TopoProxy.Create:output_type -> topoproxydata.CreateResponse
+	11, // 11: topoproxyservice.TopoProxy.Update:output_type -> topoproxydata.UpdateResponse
+	12, // 12: topoproxyservice.TopoProxy.Get:output_type -> topoproxydata.GetResponse
+	13, // 13: topoproxyservice.TopoProxy.List:output_type -> topoproxydata.ListResponse
+	14, // 14: topoproxyservice.TopoProxy.Delete:output_type -> topoproxydata.DeleteResponse
+	15, // 15: topoproxyservice.TopoProxy.ListDir:output_type -> topoproxydata.ListDirResponse
+	16, // 16: topoproxyservice.TopoProxy.Watch:output_type -> topoproxydata.WatchResponse
+	17, // 17: topoproxyservice.TopoProxy.Lock:output_type -> topoproxydata.LockResponse
+	18, // 18: topoproxyservice.TopoProxy.WaitForLeadership:output_type -> topoproxydata.WaitForLeadershipResponse
+	19, // 19: topoproxyservice.TopoProxy.GetCurrentLeaderID:output_type -> topoproxydata.GetCurrentLeaderIDResponse
+	10, // [10:20] is the sub-list for method output_type
+	0,  // [0:10] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_topoproxyservice_proto_init() }
+func file_topoproxyservice_proto_init() {
+	if File_topoproxyservice_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_topoproxyservice_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_topoproxyservice_proto_goTypes,
+		DependencyIndexes: file_topoproxyservice_proto_depIdxs,
+	}.Build()
+	File_topoproxyservice_proto = out.File
+	file_topoproxyservice_proto_rawDesc = nil
+	file_topoproxyservice_proto_goTypes = nil
+	file_topoproxyservice_proto_depIdxs = nil
+}
@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package topoproxyservice
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	topoproxydata "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TopoProxyClient is the client API for TopoProxy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TopoProxyClient interface {
+	Create(ctx context.Context, in *topoproxydata.CreateRequest, opts ...grpc.CallOption) (*topoproxydata.CreateResponse, error)
+	Update(ctx context.Context, in *topoproxydata.UpdateRequest, opts ...grpc.CallOption) (*topoproxydata.UpdateResponse, error)
+	Get(ctx context.Context, in *topoproxydata.GetRequest, opts ...grpc.CallOption) (*topoproxydata.GetResponse, error)
+	List(ctx context.Context, in *topoproxydata.ListRequest, opts ...grpc.CallOption) (*topoproxydata.ListResponse, error)
+	Delete(ctx context.Context, in *topoproxydata.DeleteRequest, opts ...grpc.CallOption) (*topoproxydata.DeleteResponse, error)
+	ListDir(ctx context.Context, in *topoproxydata.ListDirRequest, opts ...grpc.CallOption) (*topoproxydata.ListDirResponse, error)
+	// Watch streams the current value of a file, then its changes. The
+	// stream ends with a NOT_FOUND error when the file is deleted.
+	Watch(ctx context.Context, in *topoproxydata.WatchRequest, opts ...grpc.CallOption) (TopoProxy_WatchClient, error)
+	// Lock takes a lock on a directory, and holds it for the duration of
+	// the stream.
+	Lock(ctx context.Context, opts ...grpc.CallOption) (TopoProxy_LockClient, error)
+	// WaitForLeadership runs for leadership, and keeps it for the duration
+	// of the stream.
+	WaitForLeadership(ctx context.Context, in *topoproxydata.WaitForLeadershipRequest, opts ...grpc.CallOption) (TopoProxy_WaitForLeadershipClient, error)
+	GetCurrentLeaderID(ctx context.Context, in *topoproxydata.GetCurrentLeaderIDRequest, opts ...grpc.CallOption) (*topoproxydata.GetCurrentLeaderIDResponse, error)
+}
+
+type topoProxyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTopoProxyClient(cc grpc.ClientConnInterface) TopoProxyClient {
+	return &topoProxyClient{cc}
+}
+
+func (c *topoProxyClient) Create(ctx context.Context, in *topoproxydata.CreateRequest, opts ...grpc.CallOption) (*topoproxydata.CreateResponse, error) {
+	out := new(topoproxydata.CreateResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Update(ctx context.Context, in *topoproxydata.UpdateRequest, opts ...grpc.CallOption) (*topoproxydata.UpdateResponse, error) {
+	out := new(topoproxydata.UpdateResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Get(ctx context.Context, in *topoproxydata.GetRequest, opts ...grpc.CallOption) (*topoproxydata.GetResponse, error) {
+	out := new(topoproxydata.GetResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) List(ctx context.Context, in *topoproxydata.ListRequest, opts ...grpc.CallOption) (*topoproxydata.ListResponse, error) {
+	out := new(topoproxydata.ListResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Delete(ctx context.Context, in *topoproxydata.DeleteRequest, opts ...grpc.CallOption) (*topoproxydata.DeleteResponse, error) {
+	out := new(topoproxydata.DeleteResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) ListDir(ctx context.Context, in *topoproxydata.ListDirRequest, opts ...grpc.CallOption) (*topoproxydata.ListDirResponse, error) {
+	out := new(topoproxydata.ListDirResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/ListDir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Watch(ctx context.Context, in *topoproxydata.WatchRequest, opts ...grpc.CallOption) (TopoProxy_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TopoProxy_ServiceDesc.Streams[0], "/topoproxyservice.TopoProxy/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &topoProxyWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TopoProxy_WatchClient interface {
+	Recv() (*topoproxydata.WatchResponse, error)
+	grpc.ClientStream
+}
+
+type topoProxyWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *topoProxyWatchClient) Recv() (*topoproxydata.WatchResponse, error) {
+	m := new(topoproxydata.WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *topoProxyClient) Lock(ctx context.Context, opts ...grpc.CallOption) (TopoProxy_LockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TopoProxy_ServiceDesc.Streams[1], "/topoproxyservice.TopoProxy/Lock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &topoProxyLockClient{stream}
+	return x, nil
+}
+
+type TopoProxy_LockClient interface {
+	Send(*topoproxydata.LockRequest) error
+	Recv() (*topoproxydata.LockResponse, error)
+	grpc.ClientStream
+}
+
+type topoProxyLockClient struct {
+	grpc.ClientStream
+}
+
+func (x *topoProxyLockClient) Send(m *topoproxydata.LockRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *topoProxyLockClient) Recv() (*topoproxydata.LockResponse, error) {
+	m := new(topoproxydata.LockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *topoProxyClient) WaitForLeadership(ctx context.Context, in *topoproxydata.WaitForLeadershipRequest, opts ...grpc.CallOption) (TopoProxy_WaitForLeadershipClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TopoProxy_ServiceDesc.Streams[2], "/topoproxyservice.TopoProxy/WaitForLeadership", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &topoProxyWaitForLeadershipClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TopoProxy_WaitForLeadershipClient interface {
+	Recv() (*topoproxydata.WaitForLeadershipResponse, error)
+	grpc.ClientStream
+}
+
+type topoProxyWaitForLeadershipClient struct {
+	grpc.ClientStream
+}
+
+func (x *topoProxyWaitForLeadershipClient) Recv() (*topoproxydata.WaitForLeadershipResponse, error) {
+	m := new(topoproxydata.WaitForLeadershipResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *topoProxyClient) GetCurrentLeaderID(ctx context.Context, in *topoproxydata.GetCurrentLeaderIDRequest, opts ...grpc.CallOption) (*topoproxydata.GetCurrentLeaderIDResponse, error) {
+	out := new(topoproxydata.GetCurrentLeaderIDResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/GetCurrentLeaderID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TopoProxyServer is the server API for TopoProxy service.
+// All implementations must embed UnimplementedTopoProxyServer
+// for forward compatibility
+type TopoProxyServer interface {
+	Create(context.Context, *topoproxydata.CreateRequest) (*topoproxydata.CreateResponse, error)
+	Update(context.Context, *topoproxydata.UpdateRequest) (*topoproxydata.UpdateResponse, error)
+	Get(context.Context, *topoproxydata.GetRequest) (*topoproxydata.GetResponse, error)
+	List(context.Context, *topoproxydata.ListRequest) (*topoproxydata.ListResponse, error)
+	Delete(context.Context, *topoproxydata.DeleteRequest) (*topoproxydata.DeleteResponse, error)
+	ListDir(context.Context, *topoproxydata.ListDirRequest) (*topoproxydata.ListDirResponse, error)
+	// Watch streams the current value of a file, then its changes. The
+	// stream ends with a NOT_FOUND error when the file is deleted.
+	Watch(*topoproxydata.WatchRequest, TopoProxy_WatchServer) error
+	// Lock takes a lock on a directory, and holds it for the duration of
+	// the stream.
+	Lock(TopoProxy_LockServer) error
+	// WaitForLeadership runs for leadership, and keeps it for the duration
+	// of the stream.
+	WaitForLeadership(*topoproxydata.WaitForLeadershipRequest, TopoProxy_WaitForLeadershipServer) error
+	GetCurrentLeaderID(context.Context, *topoproxydata.GetCurrentLeaderIDRequest) (*topoproxydata.GetCurrentLeaderIDResponse, error)
+	mustEmbedUnimplementedTopoProxyServer()
+}
+
+// UnimplementedTopoProxyServer must be embedded to have forward compatible implementations.
+type UnimplementedTopoProxyServer struct {
+}
+
+func (UnimplementedTopoProxyServer) Create(context.Context, *topoproxydata.CreateRequest) (*topoproxydata.CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTopoProxyServer) Update(context.Context, *topoproxydata.UpdateRequest) (*topoproxydata.UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTopoProxyServer) Get(context.Context, *topoproxydata.GetRequest) (*topoproxydata.GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTopoProxyServer) List(context.Context, *topoproxydata.ListRequest) (*topoproxydata.ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTopoProxyServer) Delete(context.Context, *topoproxydata.DeleteRequest) (*topoproxydata.DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTopoProxyServer) ListDir(context.Context, *topoproxydata.ListDirRequest) (*topoproxydata.ListDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDir not implemented")
+}
+func (UnimplementedTopoProxyServer) Watch(*topoproxydata.WatchRequest, TopoProxy_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedTopoProxyServer) Lock(TopoProxy_LockServer) error {
+	return status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedTopoProxyServer) WaitForLeadership(*topoproxydata.WaitForLeadershipRequest, TopoProxy_WaitForLeadershipServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForLeadership not implemented")
+}
+func (UnimplementedTopoProxyServer) GetCurrentLeaderID(context.Context, *topoproxydata.GetCurrentLeaderIDRequest) (*topoproxydata.GetCurrentLeaderIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentLeaderID not implemented")
+}
+func (UnimplementedTopoProxyServer) mustEmbedUnimplementedTopoProxyServer() {}
+
+// UnsafeTopoProxyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TopoProxyServer will
+// result in compilation errors.
+type UnsafeTopoProxyServer interface {
+	mustEmbedUnimplementedTopoProxyServer()
+}
+
+func RegisterTopoProxyServer(s grpc.ServiceRegistrar, srv TopoProxyServer) {
+	s.RegisterService(&TopoProxy_ServiceDesc, srv)
+}
+
+func _TopoProxy_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Create(ctx, req.(*topoproxydata.CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Update(ctx, req.(*topoproxydata.UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Get(ctx, req.(*topoproxydata.GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).List(ctx, req.(*topoproxydata.ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Delete(ctx, req.(*topoproxydata.DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_ListDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.ListDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).ListDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/ListDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).ListDir(ctx, req.(*topoproxydata.ListDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(topoproxydata.WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TopoProxyServer).Watch(m, &topoProxyWatchServer{stream})
+}
+
+type TopoProxy_WatchServer interface {
+	Send(*topoproxydata.WatchResponse) error
+	grpc.ServerStream
+}
+
+type topoProxyWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *topoProxyWatchServer) Send(m *topoproxydata.WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TopoProxy_Lock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TopoProxyServer).Lock(&topoProxyLockServer{stream})
+}
+
+type TopoProxy_LockServer interface {
+	Send(*topoproxydata.LockResponse) error
+	Recv() (*topoproxydata.LockRequest, error)
+	grpc.ServerStream
+}
+
+type topoProxyLockServer struct {
+	grpc.ServerStream
+}
+
+func (x *topoProxyLockServer) Send(m *topoproxydata.LockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *topoProxyLockServer) Recv() (*topoproxydata.LockRequest, error) {
+	m := new(topoproxydata.LockRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TopoProxy_WaitForLeadership_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(topoproxydata.WaitForLeadershipRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TopoProxyServer).WaitForLeadership(m, &topoProxyWaitForLeadershipServer{stream})
+}
+
+type TopoProxy_WaitForLeadershipServer interface {
+	Send(*topoproxydata.WaitForLeadershipResponse) error
+	grpc.ServerStream
+}
+
+type topoProxyWaitForLeadershipServer struct {
+	grpc.ServerStream
+}
+
+func (x *topoProxyWaitForLeadershipServer) Send(m *topoproxydata.WaitForLeadershipResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TopoProxy_GetCurrentLeaderID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.GetCurrentLeaderIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).GetCurrentLeaderID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/GetCurrentLeaderID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).GetCurrentLeaderID(ctx, req.(*topoproxydata.GetCurrentLeaderIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TopoProxy_ServiceDesc is the grpc.ServiceDesc for TopoProxy service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TopoProxy_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "topoproxyservice.TopoProxy",
+	HandlerType: (*TopoProxyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TopoProxy_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TopoProxy_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TopoProxy_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TopoProxy_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TopoProxy_Delete_Handler,
+		},
+		{
+			MethodName: "ListDir",
+			Handler:    _TopoProxy_ListDir_Handler,
+		},
+		{
+			MethodName: "GetCurrentLeaderID",
+			Handler:    _TopoProxy_GetCurrentLeaderID_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _TopoProxy_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Lock",
+			Handler:       _TopoProxy_Lock_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WaitForLeadership",
+			Handler:       _TopoProxy_WaitForLeadership_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "topoproxyservice.proto",
+}
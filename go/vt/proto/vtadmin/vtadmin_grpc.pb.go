@@ -19,10 +19,28 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VTAdminClient interface {
+	// ApplySchema applies a schema change to a keyspace, submitting it as an
+	// online schema migration when an online DDL strategy is used.
+	ApplySchema(ctx context.Context, in *ApplySchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplySchemaResponse, error)
+	// CancelSchemaMigration cancels a pending or running online schema
+	// migration on all shards of a keyspace.
+	CancelSchemaMigration(ctx context.Context, in *CancelSchemaMigrationRequest, opts ...grpc.CallOption) (*CancelSchemaMigrationResponse, error)
+	// CancelWorkflow cancels a MoveTables or Reshard workflow that has not
+	// switched traffic, removing its streams and the data it copied.
+	CancelWorkflow(ctx context.Context, in *CancelWorkflowRequest, opts ...grpc.CallOption) (*CancelWorkflowResponse, error)
+	// CompleteSchemaMigration completes an online schema migration that was
+	// submitted with postponed completion.
+	CompleteSchemaMigration(ctx context.Context, in *CompleteSchemaMigrationRequest, opts ...grpc.CallOption) (*CompleteSchemaMigrationResponse, error)
+	// CompleteWorkflow completes a MoveTables or Reshard workflow whose
+	// traffic has been switched, cleaning up the source data and the streams.
+	CompleteWorkflow(ctx context.Context, in *CompleteWorkflowRequest, opts ...grpc.CallOption) (*CompleteWorkflowResponse, error)
 	// CreateKeyspace creates a new keyspace in the given cluster.
 	CreateKeyspace(ctx context.Context, in *CreateKeyspaceRequest, opts ...grpc.CallOption) (*CreateKeyspaceResponse, error)
 	// CreateShard creates a new shard in the given cluster and keyspace.
 	CreateShard(ctx context.Context, in *CreateShardRequest, opts ...grpc.CallOption) (*vtctldata.CreateShardResponse, error)
+	// CreateWorkflow creates and starts a MoveTables or Reshard workflow in
+	// the given cluster.
+	CreateWorkflow(ctx context.Context, in *CreateWorkflowRequest, opts ...grpc.CallOption) (*CreateWorkflowResponse, error)
 	// DeleteKeyspace deletes a keyspace in the given cluster.
 	DeleteKeyspace(ctx context.Context, in *DeleteKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.DeleteKeyspaceResponse, error)
 	// DeleteShard deletes one or more shards in the given cluster and keyspace.
@@ -36,6 +54,9 @@ type VTAdminClient interface {
 	// An error occurs if either no table exists across any of the clusters with
 	// the specified table name, or if multiple tables exist with that name.
 	FindSchema(ctx context.Context, in *FindSchemaRequest, opts ...grpc.CallOption) (*Schema, error)
+	// GetAuditEntries returns the most recent audit entries of mutating
+	// operations performed through the VTAdmin API.
+	GetAuditEntries(ctx context.Context, in *GetAuditEntriesRequest, opts ...grpc.CallOption) (*GetAuditEntriesResponse, error)
 	// GetBackups returns backups grouped by cluster.
 	GetBackups(ctx context.Context, in *GetBackupsRequest, opts ...grpc.CallOption) (*GetBackupsResponse, error)
 	// GetClusters returns all configured clusters.
@@ -49,6 +70,9 @@ type VTAdminClient interface {
 	// GetSchema returns the schema for the specified (cluster, keyspace, table)
 	// tuple.
 	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*Schema, error)
+	// GetSchemaMigrations returns the online schema migrations across all
+	// shards of one or more keyspaces.
+	GetSchemaMigrations(ctx context.Context, in *GetSchemaMigrationsRequest, opts ...grpc.CallOption) (*GetSchemaMigrationsResponse, error)
 	// GetSchemas returns all schemas across the specified clusters.
 	GetSchemas(ctx context.Context, in *GetSchemasRequest, opts ...grpc.CallOption) (*GetSchemasResponse, error)
 	// GetShardReplicationPositions returns shard replication positions grouped
@@ -81,6 +105,12 @@ type VTAdminClient interface {
 	RefreshState(ctx context.Context, in *RefreshStateRequest, opts ...grpc.CallOption) (*RefreshStateResponse, error)
 	// ReparentTablet
 	ReparentTablet(ctx context.Context, in *ReparentTabletRequest, opts ...grpc.CallOption) (*ReparentTabletResponse, error)
+	// RetrySchemaMigration retries a failed or cancelled online schema
+	// migration.
+	RetrySchemaMigration(ctx context.Context, in *RetrySchemaMigrationRequest, opts ...grpc.CallOption) (*RetrySchemaMigrationResponse, error)
+	// RevertSchemaMigration submits a migration that reverts a completed
+	// online schema migration.
+	RevertSchemaMigration(ctx context.Context, in *RevertSchemaMigrationRequest, opts ...grpc.CallOption) (*RevertSchemaMigrationResponse, error)
 	// RunHealthCheck runs a health check on the tablet
 	RunHealthCheck(ctx context.Context, in *RunHealthCheckRequest, opts ...grpc.CallOption) (*RunHealthCheckResponse, error)
 	// SetReadOnly will set the tablet to read only mode
@@ -91,6 +121,9 @@ type VTAdminClient interface {
 	StartReplication(ctx context.Context, in *StartReplicationRequest, opts ...grpc.CallOption) (*StartReplicationResponse, error)
 	// StopReplication will run th underlying database command to stop replication on a tablet
 	StopReplication(ctx context.Context, in *StopReplicationRequest, opts ...grpc.CallOption) (*StopReplicationResponse, error)
+	// SwitchWorkflowTraffic switches read and/or write traffic of a
+	// MoveTables or Reshard workflow to its target, or back to its source.
+	SwitchWorkflowTraffic(ctx context.Context, in *SwitchWorkflowTrafficRequest, opts ...grpc.CallOption) (*SwitchWorkflowTrafficResponse, error)
 	// VTExplain provides information on how Vitess plans to execute a particular query.
 	VTExplain(ctx context.Context, in *VTExplainRequest, opts ...grpc.CallOption) (*VTExplainResponse, error)
 }
@@ -103,6 +136,51 @@ func NewVTAdminClient(cc grpc.ClientConnInterface) VTAdminClient {
 	return &vTAdminClient{cc}
 }
 
+func (c *vTAdminClient) ApplySchema(ctx context.Context, in *ApplySchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplySchemaResponse, error) {
+	out := new(vtctldata.ApplySchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/ApplySchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vTAdminClient) CancelSchemaMigration(ctx context.Context, in *CancelSchemaMigrationRequest, opts ...grpc.CallOption) (*CancelSchemaMigrationResponse, error) {
+	out := new(CancelSchemaMigrationResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CancelSchemaMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vTAdminClient) CancelWorkflow(ctx context.Context, in *CancelWorkflowRequest, opts ...grpc.CallOption) (*CancelWorkflowResponse, error) {
+	out := new(CancelWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CancelWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vTAdminClient) CompleteSchemaMigration(ctx context.Context, in *CompleteSchemaMigrationRequest, opts ...grpc.CallOption) (*CompleteSchemaMigrationResponse, error) {
+	out := new(CompleteSchemaMigrationResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CompleteSchemaMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vTAdminClient) CompleteWorkflow(ctx context.Context, in *CompleteWorkflowRequest, opts ...grpc.CallOption) (*CompleteWorkflowResponse, error) {
+	out := new(CompleteWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CompleteWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) CreateKeyspace(ctx context.Context, in *CreateKeyspaceRequest, opts ...grpc.CallOption) (*CreateKeyspaceResponse, error) {
 	out := new(CreateKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CreateKeyspace", in, out, opts...)
@@ -121,6 +199,15 @@ func (c *vTAdminClient) CreateShard(ctx context.Context, in *CreateShardRequest,
 	return out, nil
 }
 
+func (c *vTAdminClient) CreateWorkflow(ctx context.Context, in *CreateWorkflowRequest, opts ...grpc.CallOption) (*CreateWorkflowResponse, error) {
+	out := new(CreateWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CreateWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) DeleteKeyspace(ctx context.Context, in *DeleteKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.DeleteKeyspaceResponse, error) {
 	out := new(vtctldata.DeleteKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/DeleteKeyspace", in, out, opts...)
@@ -157,6 +244,15 @@ func (c *vTAdminClient) FindSchema(ctx context.Context, in *FindSchemaRequest, o
 	return out, nil
 }
 
+func (c *vTAdminClient) GetAuditEntries(ctx context.Context, in *GetAuditEntriesRequest, opts ...grpc.CallOption) (*GetAuditEntriesResponse, error) {
+	out := new(GetAuditEntriesResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/GetAuditEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) GetBackups(ctx context.Context, in *GetBackupsRequest, opts ...grpc.CallOption) (*GetBackupsResponse, error) {
 	out := new(GetBackupsResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/GetBackups", in, out, opts...)
@@ -211,6 +307,15 @@ func (c *vTAdminClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opt
 	return out, nil
 }
 
+func (c *vTAdminClient) GetSchemaMigrations(ctx context.Context, in *GetSchemaMigrationsRequest, opts ...grpc.CallOption) (*GetSchemaMigrationsResponse, error) {
+	out := new(GetSchemaMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/GetSchemaMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) GetSchemas(ctx context.Context, in *GetSchemasRequest, opts ...grpc.CallOption) (*GetSchemasResponse, error) {
 	out := new(GetSchemasResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/GetSchemas", in, out, opts...)
@@ -337,6 +442,24 @@ func (c *vTAdminClient) ReparentTablet(ctx context.Context, in *ReparentTabletRe
 	return out, nil
 }
 
+func (c *vTAdminClient) RetrySchemaMigration(ctx context.Context, in *RetrySchemaMigrationRequest, opts ...grpc.CallOption) (*RetrySchemaMigrationResponse, error) {
+	out := new(RetrySchemaMigrationResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/RetrySchemaMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vTAdminClient) RevertSchemaMigration(ctx context.Context, in *RevertSchemaMigrationRequest, opts ...grpc.CallOption) (*RevertSchemaMigrationResponse, error) {
+	out := new(RevertSchemaMigrationResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/RevertSchemaMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) RunHealthCheck(ctx context.Context, in *RunHealthCheckRequest, opts ...grpc.CallOption) (*RunHealthCheckResponse, error) {
 	out := new(RunHealthCheckResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/RunHealthCheck", in, out, opts...)
@@ -382,6 +505,15 @@ func (c *vTAdminClient) StopReplication(ctx context.Context, in *StopReplication
 	return out, nil
 }
 
+func (c *vTAdminClient) SwitchWorkflowTraffic(ctx context.Context, in *SwitchWorkflowTrafficRequest, opts ...grpc.CallOption) (*SwitchWorkflowTrafficResponse, error) {
+	out := new(SwitchWorkflowTrafficResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/SwitchWorkflowTraffic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) VTExplain(ctx context.Context, in *VTExplainRequest, opts ...grpc.CallOption) (*VTExplainResponse, error) {
 	out := new(VTExplainResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/VTExplain", in, out, opts...)
@@ -395,10 +527,28 @@ func (c *vTAdminClient) VTExplain(ctx context.Context, in *VTExplainRequest, opt
 // All implementations must embed UnimplementedVTAdminServer
 // for forward compatibility
 type VTAdminServer interface {
+	// ApplySchema applies a schema change to a keyspace, submitting it as an
+	// online schema migration when an online DDL strategy is used.
+	ApplySchema(context.Context, *ApplySchemaRequest) (*vtctldata.ApplySchemaResponse, error)
+	// CancelSchemaMigration cancels a pending or running online schema
+	// migration on all shards of a keyspace.
+	CancelSchemaMigration(context.Context, *CancelSchemaMigrationRequest) (*CancelSchemaMigrationResponse, error)
+	// CancelWorkflow cancels a MoveTables or Reshard workflow that has not
+	// switched traffic, removing its streams and the data it copied.
+	CancelWorkflow(context.Context, *CancelWorkflowRequest) (*CancelWorkflowResponse, error)
+	// CompleteSchemaMigration completes an online schema migration that was
+	// submitted with postponed completion.
+	CompleteSchemaMigration(context.Context, *CompleteSchemaMigrationRequest) (*CompleteSchemaMigrationResponse, error)
+	// CompleteWorkflow completes a MoveTables or Reshard workflow whose
+	// traffic has been switched, cleaning up the source data and the streams.
+	CompleteWorkflow(context.Context, *CompleteWorkflowRequest) (*CompleteWorkflowResponse, error)
 	// CreateKeyspace creates a new keyspace in the given cluster.
 	CreateKeyspace(context.Context, *CreateKeyspaceRequest) (*CreateKeyspaceResponse, error)
 	// CreateShard creates a new shard in the given cluster and keyspace.
 	CreateShard(context.Context, *CreateShardRequest) (*vtctldata.CreateShardResponse, error)
+	// CreateWorkflow creates and starts a MoveTables or Reshard workflow in
+	// the given cluster.
+	CreateWorkflow(context.Context, *CreateWorkflowRequest) (*CreateWorkflowResponse, error)
 	// DeleteKeyspace deletes a keyspace in the given cluster.
 	DeleteKeyspace(context.Context, *DeleteKeyspaceRequest) (*vtctldata.DeleteKeyspaceResponse, error)
 	// DeleteShard deletes one or more shards in the given cluster and keyspace.
@@ -412,6 +562,9 @@ type VTAdminServer interface {
 	// An error occurs if either no table exists across any of the clusters with
 	// the specified table name, or if multiple tables exist with that name.
 	FindSchema(context.Context, *FindSchemaRequest) (*Schema, error)
+	// GetAuditEntries returns the most recent audit entries of mutating
+	// operations performed through the VTAdmin API.
+	GetAuditEntries(context.Context, *GetAuditEntriesRequest) (*GetAuditEntriesResponse, error)
 	// GetBackups returns backups grouped by cluster.
 	GetBackups(context.Context, *GetBackupsRequest) (*GetBackupsResponse, error)
 	// GetClusters returns all configured clusters.
@@ -425,6 +578,9 @@ type VTAdminServer interface {
 	// GetSchema returns the schema for the specified (cluster, keyspace, table)
 	// tuple.
 	GetSchema(context.Context, *GetSchemaRequest) (*Schema, error)
+	// GetSchemaMigrations returns the online schema migrations across all
+	// shards of one or more keyspaces.
+	GetSchemaMigrations(context.Context, *GetSchemaMigrationsRequest) (*GetSchemaMigrationsResponse, error)
 	// GetSchemas returns all schemas across the specified clusters.
 	GetSchemas(context.Context, *GetSchemasRequest) (*GetSchemasResponse, error)
 	// GetShardReplicationPositions returns shard replication positions grouped
@@ -457,6 +613,12 @@ type VTAdminServer interface {
 	RefreshState(context.Context, *RefreshStateRequest) (*RefreshStateResponse, error)
 	// ReparentTablet
 	ReparentTablet(context.Context, *ReparentTabletRequest) (*ReparentTabletResponse, error)
+	// RetrySchemaMigration retries a failed or cancelled online schema
+	// migration.
+	RetrySchemaMigration(context.Context, *RetrySchemaMigrationRequest) (*RetrySchemaMigrationResponse, error)
+	// RevertSchemaMigration submits a migration that reverts a completed
+	// online schema migration.
+	RevertSchemaMigration(context.Context, *RevertSchemaMigrationRequest) (*RevertSchemaMigrationResponse, error)
 	// RunHealthCheck runs a health check on the tablet
 	RunHealthCheck(context.Context, *RunHealthCheckRequest) (*RunHealthCheckResponse, error)
 	// SetReadOnly will set the tablet to read only mode
@@ -467,6 +629,9 @@ type VTAdminServer interface {
 	StartReplication(context.Context, *StartReplicationRequest) (*StartReplicationResponse, error)
 	// StopReplication will run th underlying database command to stop replication on a tablet
 	StopReplication(context.Context, *StopReplicationRequest) (*StopReplicationResponse, error)
+	// SwitchWorkflowTraffic switches read and/or write traffic of a
+	// MoveTables or Reshard workflow to its target, or back to its source.
+	SwitchWorkflowTraffic(context.Context, *SwitchWorkflowTrafficRequest) (*SwitchWorkflowTrafficResponse, error)
 	// VTExplain provides information on how Vitess plans to execute a particular query.
 	VTExplain(context.Context, *VTExplainRequest) (*VTExplainResponse, error)
 	mustEmbedUnimplementedVTAdminServer()
@@ -476,12 +641,30 @@ type VTAdminServer interface {
 type UnimplementedVTAdminServer struct {
 }
 
+func (UnimplementedVTAdminServer) ApplySchema(context.Context, *ApplySchemaRequest) (*vtctldata.ApplySchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySchema not implemented")
+}
+func (UnimplementedVTAdminServer) CancelSchemaMigration(context.Context, *CancelSchemaMigrationRequest) (*CancelSchemaMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSchemaMigration not implemented")
+}
+func (UnimplementedVTAdminServer) CancelWorkflow(context.Context, *CancelWorkflowRequest) (*CancelWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWorkflow not implemented")
+}
+func (UnimplementedVTAdminServer) CompleteSchemaMigration(context.Context, *CompleteSchemaMigrationRequest) (*CompleteSchemaMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteSchemaMigration not implemented")
+}
+func (UnimplementedVTAdminServer) CompleteWorkflow(context.Context, *CompleteWorkflowRequest) (*CompleteWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteWorkflow not implemented")
+}
 func (UnimplementedVTAdminServer) CreateKeyspace(context.Context, *CreateKeyspaceRequest) (*CreateKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateKeyspace not implemented")
 }
 func (UnimplementedVTAdminServer) CreateShard(context.Context, *CreateShardRequest) (*vtctldata.CreateShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateShard not implemented")
 }
+func (UnimplementedVTAdminServer) CreateWorkflow(context.Context, *CreateWorkflowRequest) (*CreateWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkflow not implemented")
+}
 func (UnimplementedVTAdminServer) DeleteKeyspace(context.Context, *DeleteKeyspaceRequest) (*vtctldata.DeleteKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeyspace not implemented")
 }
@@ -494,6 +677,9 @@ func (UnimplementedVTAdminServer) DeleteTablet(context.Context, *DeleteTabletReq
 func (UnimplementedVTAdminServer) FindSchema(context.Context, *FindSchemaRequest) (*Schema, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindSchema not implemented")
 }
+func (UnimplementedVTAdminServer) GetAuditEntries(context.Context, *GetAuditEntriesRequest) (*GetAuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEntries not implemented")
+}
 func (UnimplementedVTAdminServer) GetBackups(context.Context, *GetBackupsRequest) (*GetBackupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBackups not implemented")
 }
@@ -512,6 +698,9 @@ func (UnimplementedVTAdminServer) GetKeyspaces(context.Context, *GetKeyspacesReq
 func (UnimplementedVTAdminServer) GetSchema(context.Context, *GetSchemaRequest) (*Schema, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
 }
+func (UnimplementedVTAdminServer) GetSchemaMigrations(context.Context, *GetSchemaMigrationsRequest) (*GetSchemaMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaMigrations not implemented")
+}
 func (UnimplementedVTAdminServer) GetSchemas(context.Context, *GetSchemasRequest) (*GetSchemasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchemas not implemented")
 }
@@ -554,6 +743,12 @@ func (UnimplementedVTAdminServer) RefreshState(context.Context, *RefreshStateReq
 func (UnimplementedVTAdminServer) ReparentTablet(context.Context, *ReparentTabletRequest) (*ReparentTabletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReparentTablet not implemented")
 }
+func (UnimplementedVTAdminServer) RetrySchemaMigration(context.Context, *RetrySchemaMigrationRequest) (*RetrySchemaMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrySchemaMigration not implemented")
+}
+func (UnimplementedVTAdminServer) RevertSchemaMigration(context.Context, *RevertSchemaMigrationRequest) (*RevertSchemaMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertSchemaMigration not implemented")
+}
 func (UnimplementedVTAdminServer) RunHealthCheck(context.Context, *RunHealthCheckRequest) (*RunHealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunHealthCheck not implemented")
 }
@@ -569,6 +764,9 @@ func (UnimplementedVTAdminServer) StartReplication(context.Context, *StartReplic
 func (UnimplementedVTAdminServer) StopReplication(context.Context, *StopReplicationRequest) (*StopReplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopReplication not implemented")
 }
+func (UnimplementedVTAdminServer) SwitchWorkflowTraffic(context.Context, *SwitchWorkflowTrafficRequest) (*SwitchWorkflowTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchWorkflowTraffic not implemented")
+}
 func (UnimplementedVTAdminServer) VTExplain(context.Context, *VTExplainRequest) (*VTExplainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VTExplain not implemented")
 }
@@ -585,6 +783,96 @@ func RegisterVTAdminServer(s grpc.ServiceRegistrar, srv VTAdminServer) {
 	s.RegisterService(&VTAdmin_ServiceDesc, srv)
 }
 
+func _VTAdmin_ApplySchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).ApplySchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/ApplySchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).ApplySchema(ctx, req.(*ApplySchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VTAdmin_CancelSchemaMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSchemaMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).CancelSchemaMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/CancelSchemaMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).CancelSchemaMigration(ctx, req.(*CancelSchemaMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VTAdmin_CancelWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).CancelWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/CancelWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).CancelWorkflow(ctx, req.(*CancelWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VTAdmin_CompleteSchemaMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteSchemaMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).CompleteSchemaMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/CompleteSchemaMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).CompleteSchemaMigration(ctx, req.(*CompleteSchemaMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VTAdmin_CompleteWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).CompleteWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/CompleteWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).CompleteWorkflow(ctx, req.(*CompleteWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -621,6 +909,24 @@ func _VTAdmin_CreateShard_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VTAdmin_CreateWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).CreateWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/CreateWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).CreateWorkflow(ctx, req.(*CreateWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_DeleteKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -693,6 +999,24 @@ func _VTAdmin_FindSchema_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VTAdmin_GetAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).GetAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/GetAuditEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).GetAuditEntries(ctx, req.(*GetAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_GetBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBackupsRequest)
 	if err := dec(in); err != nil {
@@ -801,6 +1125,24 @@ func _VTAdmin_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VTAdmin_GetSchemaMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).GetSchemaMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/GetSchemaMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).GetSchemaMigrations(ctx, req.(*GetSchemaMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_GetSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSchemasRequest)
 	if err := dec(in); err != nil {
@@ -1053,6 +1395,42 @@ func _VTAdmin_ReparentTablet_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VTAdmin_RetrySchemaMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrySchemaMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).RetrySchemaMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/RetrySchemaMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).RetrySchemaMigration(ctx, req.(*RetrySchemaMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VTAdmin_RevertSchemaMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertSchemaMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).RevertSchemaMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/RevertSchemaMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).RevertSchemaMigration(ctx, req.(*RevertSchemaMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_RunHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RunHealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -1143,6 +1521,24 @@ func _VTAdmin_StopReplication_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VTAdmin_SwitchWorkflowTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchWorkflowTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).SwitchWorkflowTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/SwitchWorkflowTraffic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).SwitchWorkflowTraffic(ctx, req.(*SwitchWorkflowTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_VTExplain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VTExplainRequest)
 	if err := dec(in); err != nil {
@@ -1168,6 +1564,26 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "vtadmin.VTAdmin",
 	HandlerType: (*VTAdminServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApplySchema",
+			Handler:    _VTAdmin_ApplySchema_Handler,
+		},
+		{
+			MethodName: "CancelSchemaMigration",
+			Handler:    _VTAdmin_CancelSchemaMigration_Handler,
+		},
+		{
+			MethodName: "CancelWorkflow",
+			Handler:    _VTAdmin_CancelWorkflow_Handler,
+		},
+		{
+			MethodName: "CompleteSchemaMigration",
+			Handler:    _VTAdmin_CompleteSchemaMigration_Handler,
+		},
+		{
+			MethodName: "CompleteWorkflow",
+			Handler:    _VTAdmin_CompleteWorkflow_Handler,
+		},
 		{
 			MethodName: "CreateKeyspace",
 			Handler:    _VTAdmin_CreateKeyspace_Handler,
@@ -1176,6 +1592,10 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateShard",
 			Handler:    _VTAdmin_CreateShard_Handler,
 		},
+		{
+			MethodName: "CreateWorkflow",
+			Handler:    _VTAdmin_CreateWorkflow_Handler,
+		},
 		{
 			MethodName: "DeleteKeyspace",
 			Handler:    _VTAdmin_DeleteKeyspace_Handler,
@@ -1192,6 +1612,10 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindSchema",
 			Handler:    _VTAdmin_FindSchema_Handler,
 		},
+		{
+			MethodName: "GetAuditEntries",
+			Handler:    _VTAdmin_GetAuditEntries_Handler,
+		},
 		{
 			MethodName: "GetBackups",
 			Handler:    _VTAdmin_GetBackups_Handler,
@@ -1216,6 +1640,10 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSchema",
 			Handler:    _VTAdmin_GetSchema_Handler,
 		},
+		{
+			MethodName: "GetSchemaMigrations",
+			Handler:    _VTAdmin_GetSchemaMigrations_Handler,
+		},
 		{
 			MethodName: "GetSchemas",
 			Handler:    _VTAdmin_GetSchemas_Handler,
@@ -1272,6 +1700,14 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReparentTablet",
 			Handler:    _VTAdmin_ReparentTablet_Handler,
 		},
+		{
+			MethodName: "RetrySchemaMigration",
+			Handler:    _VTAdmin_RetrySchemaMigration_Handler,
+		},
+		{
+			MethodName: "RevertSchemaMigration",
+			Handler:    _VTAdmin_RevertSchemaMigration_Handler,
+		},
 		{
 			MethodName: "RunHealthCheck",
 			Handler:    _VTAdmin_RunHealthCheck_Handler,
@@ -1292,6 +1728,10 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopReplication",
 			Handler:    _VTAdmin_StopReplication_Handler,
 		},
+		{
+			MethodName: "SwitchWorkflowTraffic",
+			Handler:    _VTAdmin_SwitchWorkflowTraffic_Handler,
+		},
 		{
 			MethodName: "VTExplain",
 			Handler:    _VTAdmin_VTExplain_Handler,
@@ -40,6 +40,8 @@ type VitessClient interface {
 	ResolveTransaction(ctx context.Context, in *vtgate.ResolveTransactionRequest, opts ...grpc.CallOption) (*vtgate.ResolveTransactionResponse, error)
 	// VStream streams binlog events from the requested sources.
 	VStream(ctx context.Context, in *vtgate.VStreamRequest, opts ...grpc.CallOption) (Vitess_VStreamClient, error)
+	// VStreamAck stores the position acknowledged by a VStream consumer.
+	VStreamAck(ctx context.Context, in *vtgate.VStreamAckRequest, opts ...grpc.CallOption) (*vtgate.VStreamAckResponse, error)
 	// Prepare is used by the MySQL server plugin as part of supporting prepared statements.
 	Prepare(ctx context.Context, in *vtgate.PrepareRequest, opts ...grpc.CallOption) (*vtgate.PrepareResponse, error)
 	// CloseSession closes the session, rolling back any implicit transactions.
@@ -147,6 +149,15 @@ func (x *vitessVStreamClient) Recv() (*vtgate.VStreamResponse, error) {
 	return m, nil
 }
 
+func (c *vitessClient) VStreamAck(ctx context.Context, in *vtgate.VStreamAckRequest, opts ...grpc.CallOption) (*vtgate.VStreamAckResponse, error) {
+	out := new(vtgate.VStreamAckResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/VStreamAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vitessClient) Prepare(ctx context.Context, in *vtgate.PrepareRequest, opts ...grpc.CallOption) (*vtgate.PrepareResponse, error) {
 	out := new(vtgate.PrepareResponse)
 	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/Prepare", in, out, opts...)
@@ -190,6 +201,8 @@ type VitessServer interface {
 	ResolveTransaction(context.Context, *vtgate.ResolveTransactionRequest) (*vtgate.ResolveTransactionResponse, error)
 	// VStream streams binlog events from the requested sources.
 	VStream(*vtgate.VStreamRequest, Vitess_VStreamServer) error
+	// VStreamAck stores the position acknowledged by a VStream consumer.
+	VStreamAck(context.Context, *vtgate.VStreamAckRequest) (*vtgate.VStreamAckResponse, error)
 	// Prepare is used by the MySQL server plugin as part of supporting prepared statements.
 	Prepare(context.Context, *vtgate.PrepareRequest) (*vtgate.PrepareResponse, error)
 	// CloseSession closes the session, rolling back any implicit transactions.
@@ -218,6 +231,9 @@ func (UnimplementedVitessServer) ResolveTransaction(context.Context, *vtgate.Res
 func (UnimplementedVitessServer) VStream(*vtgate.VStreamRequest, Vitess_VStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method VStream not implemented")
 }
+func (UnimplementedVitessServer) VStreamAck(context.Context, *vtgate.VStreamAckRequest) (*vtgate.VStreamAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VStreamAck not implemented")
+}
 func (UnimplementedVitessServer) Prepare(context.Context, *vtgate.PrepareRequest) (*vtgate.PrepareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prepare not implemented")
 }
@@ -333,6 +349,24 @@ func (x *vitessVStreamServer) Send(m *vtgate.VStreamResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Vitess_VStreamAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.VStreamAckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).VStreamAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/VStreamAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).VStreamAck(ctx, req.(*vtgate.VStreamAckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vitess_Prepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtgate.PrepareRequest)
 	if err := dec(in); err != nil {
@@ -388,6 +422,10 @@ var Vitess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveTransaction",
 			Handler:    _Vitess_ResolveTransaction_Handler,
 		},
+		{
+			MethodName: "VStreamAck",
+			Handler:    _Vitess_VStreamAck_Handler,
+		},
 		{
 			MethodName: "Prepare",
 			Handler:    _Vitess_Prepare_Handler,
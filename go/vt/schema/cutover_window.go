@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultCutOverWindowDuration is the length of a cut-over window when none is specified
+	DefaultCutOverWindowDuration = time.Hour
+	// MaxCutOverWindowDuration is the longest allowed cut-over window
+	MaxCutOverWindowDuration = 7 * 24 * time.Hour
+	// maxCutOverWindowLookahead limits the search for the next window start
+	maxCutOverWindowLookahead = 366 * 24 * time.Hour
+)
+
+// cronField is a single field in a cron expression, represented as the set of matching values
+type cronField map[int]bool
+
+// cronFieldBounds lists the valid value range of each of the five cron fields, in order:
+// minute, hour, day of month, month, day of week
+var cronFieldBounds = [5][2]int{
+	{0, 59},
+	{0, 23},
+	{1, 31},
+	{1, 12},
+	{0, 6},
+}
+
+// parseCronField parses a single cron field such as "*", "5", "1-5", "*/15", "0-30/10" or "1,3,5"
+func parseCronField(field string, min, max int) (cronField, error) {
+	result := cronField{}
+	for _, part := range strings.Split(field, ",") {
+		rangeExpr, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			rangeExpr = part[:i]
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s <= 0 {
+				return nil, fmt.Errorf("invalid step in cron field '%s'", field)
+			}
+			step = s
+		}
+		from, to := min, max
+		switch {
+		case rangeExpr == "*":
+		case strings.Contains(rangeExpr, "-"):
+			bounds := strings.SplitN(rangeExpr, "-", 2)
+			var err error
+			if from, err = strconv.Atoi(bounds[0]); err != nil {
+				return nil, fmt.Errorf("invalid range in cron field '%s'", field)
+			}
+			if to, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, fmt.Errorf("invalid range in cron field '%s'", field)
+			}
+		default:
+			v, err := strconv.Atoi(rangeExpr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value in cron field '%s'", field)
+			}
+			from, to = v, v
+			if step > 1 {
+				// "5/10" means "starting at 5, every 10"
+				to = max
+			}
+		}
+		if from < min || to > max || from > to {
+			return nil, fmt.Errorf("cron field '%s' out of range [%d-%d]", field, min, max)
+		}
+		for v := from; v <= to; v += step {
+			result[v] = true
+		}
+	}
+	return result, nil
+}
+
+// CutOverWindow describes recurring time windows in which a migration is allowed to cut-over.
+// Windows begin at the times matched by a cron-like expression and last for a given duration.
+// All times are evaluated in UTC.
+type CutOverWindow struct {
+	Expression string
+	Duration   time.Duration
+
+	minutes     cronField
+	hours       cronField
+	daysOfMonth cronField
+	months      cronField
+	daysOfWeek  cronField
+
+	// as with cron, when both day of month and day of week are restricted, either may match
+	restrictedDays bool
+}
+
+// ParseCutOverWindow parses a 5-field cron expression ("minute hour day-of-month month day-of-week")
+// denoting the start times of windows, each lasting the given duration.
+func ParseCutOverWindow(expression string, duration time.Duration) (*CutOverWindow, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFieldBounds) {
+		return nil, fmt.Errorf("invalid cut-over window '%s': expected %d fields, found %d", expression, len(cronFieldBounds), len(fields))
+	}
+	if duration <= 0 || duration > MaxCutOverWindowDuration {
+		return nil, fmt.Errorf("invalid cut-over window duration: %v", duration)
+	}
+	parsed := make([]cronField, len(fields))
+	for i, field := range fields {
+		f, err := parseCronField(field, cronFieldBounds[i][0], cronFieldBounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cut-over window '%s': %v", expression, err)
+		}
+		parsed[i] = f
+	}
+	return &CutOverWindow{
+		Expression:  expression,
+		Duration:    duration,
+		minutes:     parsed[0],
+		hours:       parsed[1],
+		daysOfMonth: parsed[2],
+		months:      parsed[3],
+		daysOfWeek:  parsed[4],
+
+		restrictedDays: fields[2] != "*" && fields[4] != "*",
+	}, nil
+}
+
+// matches returns true when the given time, truncated to the minute, is a window start
+func (w *CutOverWindow) matches(t time.Time) bool {
+	if !w.minutes[t.Minute()] || !w.hours[t.Hour()] || !w.months[int(t.Month())] {
+		return false
+	}
+	if w.restrictedDays {
+		return w.daysOfMonth[t.Day()] || w.daysOfWeek[int(t.Weekday())]
+	}
+	return w.daysOfMonth[t.Day()] && w.daysOfWeek[int(t.Weekday())]
+}
+
+// Contains returns true when the given time falls within any window
+func (w *CutOverWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	start := t.Truncate(time.Minute)
+	for candidate := start; t.Sub(candidate) < w.Duration; candidate = candidate.Add(-time.Minute) {
+		if w.matches(candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextStart returns the start time of the next window strictly after the given time. The
+// second return value is false if no window begins within the next year.
+func (w *CutOverWindow) NextStart(t time.Time) (time.Time, bool) {
+	t = t.UTC()
+	candidate := t.Truncate(time.Minute).Add(time.Minute)
+	for limit := t.Add(maxCutOverWindowLookahead); candidate.Before(limit); candidate = candidate.Add(time.Minute) {
+		if !w.months[int(candidate.Month())] {
+			// fast forward to next month
+			candidate = time.Date(candidate.Year(), candidate.Month()+1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Minute)
+			continue
+		}
+		if !w.hours[candidate.Hour()] {
+			// fast forward to next hour
+			candidate = candidate.Truncate(time.Hour).Add(time.Hour - time.Minute)
+			continue
+		}
+		if w.matches(candidate) {
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}
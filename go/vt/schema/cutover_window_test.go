@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCutOverWindow(t *testing.T) {
+	tt := []struct {
+		expression string
+		duration   time.Duration
+		isError    bool
+	}{
+		{expression: "* * * * *", duration: time.Hour},
+		{expression: "0 2 * * *", duration: time.Hour},
+		{expression: "*/15 1-5 * * 1,3,5", duration: 10 * time.Minute},
+		{expression: "5/10 * 1 1-6 *", duration: time.Minute},
+		{expression: "0 2 * *", duration: time.Hour, isError: true},
+		{expression: "0 2 * * * *", duration: time.Hour, isError: true},
+		{expression: "60 2 * * *", duration: time.Hour, isError: true},
+		{expression: "0 24 * * *", duration: time.Hour, isError: true},
+		{expression: "0 2 0 * *", duration: time.Hour, isError: true},
+		{expression: "0 2 * 13 *", duration: time.Hour, isError: true},
+		{expression: "0 2 * * 7", duration: time.Hour, isError: true},
+		{expression: "0 5-2 * * *", duration: time.Hour, isError: true},
+		{expression: "*/0 * * * *", duration: time.Hour, isError: true},
+		{expression: "a * * * *", duration: time.Hour, isError: true},
+		{expression: "0 2 * * *", duration: 0, isError: true},
+		{expression: "0 2 * * *", duration: 30 * 24 * time.Hour, isError: true},
+	}
+	for _, ts := range tt {
+		t.Run(ts.expression, func(t *testing.T) {
+			_, err := ParseCutOverWindow(ts.expression, ts.duration)
+			if ts.isError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCutOverWindowContains(t *testing.T) {
+	// 2022-03-02 is a Wednesday
+	at := func(hour, minute int) time.Time {
+		return time.Date(2022, 3, 2, hour, minute, 30, 0, time.UTC)
+	}
+	tt := []struct {
+		expression string
+		duration   time.Duration
+		t          time.Time
+		contains   bool
+	}{
+		{expression: "* * * * *", duration: time.Minute, t: at(13, 17), contains: true},
+		{expression: "0 2 * * *", duration: time.Hour, t: at(2, 0), contains: true},
+		{expression: "0 2 * * *", duration: time.Hour, t: at(2, 59), contains: true},
+		{expression: "0 2 * * *", duration: time.Hour, t: at(3, 0), contains: false},
+		{expression: "0 2 * * *", duration: time.Hour, t: at(1, 59), contains: false},
+		{expression: "30 23 * * *", duration: 3 * time.Hour, t: at(1, 15), contains: true},
+		{expression: "0 2 * * 3", duration: time.Hour, t: at(2, 30), contains: true},
+		{expression: "0 2 * * 1,5", duration: time.Hour, t: at(2, 30), contains: false},
+		{expression: "0 2 2 * 1", duration: time.Hour, t: at(2, 30), contains: true},
+		{expression: "0 2 3 * 1", duration: time.Hour, t: at(2, 30), contains: false},
+		{expression: "*/20 * * * *", duration: 5 * time.Minute, t: at(10, 44), contains: true},
+		{expression: "*/20 * * * *", duration: 5 * time.Minute, t: at(10, 46), contains: false},
+	}
+	for _, ts := range tt {
+		t.Run(ts.expression, func(t *testing.T) {
+			w, err := ParseCutOverWindow(ts.expression, ts.duration)
+			require.NoError(t, err)
+			assert.Equal(t, ts.contains, w.Contains(ts.t))
+		})
+	}
+}
+
+func TestCutOverWindowNextStart(t *testing.T) {
+	now := time.Date(2022, 3, 2, 13, 17, 30, 0, time.UTC)
+	tt := []struct {
+		expression string
+		next       time.Time
+	}{
+		{expression: "* * * * *", next: time.Date(2022, 3, 2, 13, 18, 0, 0, time.UTC)},
+		{expression: "0 2 * * *", next: time.Date(2022, 3, 3, 2, 0, 0, 0, time.UTC)},
+		{expression: "17 13 * * *", next: time.Date(2022, 3, 3, 13, 17, 0, 0, time.UTC)},
+		{expression: "0 0 1 1 *", next: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{expression: "30 4 * * 0", next: time.Date(2022, 3, 6, 4, 30, 0, 0, time.UTC)},
+		{expression: "0 0 29 2 *", next: time.Time{}},
+	}
+	for _, ts := range tt {
+		t.Run(ts.expression, func(t *testing.T) {
+			w, err := ParseCutOverWindow(ts.expression, time.Hour)
+			require.NoError(t, err)
+			next, found := w.NextStart(now)
+			assert.Equal(t, !ts.next.IsZero(), found)
+			assert.Equal(t, ts.next, next)
+		})
+	}
+}
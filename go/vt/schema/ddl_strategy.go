@@ -19,6 +19,9 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/shlex"
 )
@@ -28,14 +31,20 @@ var (
 )
 
 const (
-	declarativeFlag        = "declarative"
-	skipTopoFlag           = "skip-topo"
-	singletonFlag          = "singleton"
-	singletonContextFlag   = "singleton-context"
-	allowZeroInDateFlag    = "allow-zero-in-date"
-	postponeCompletionFlag = "postpone-completion"
-	allowConcurrentFlag    = "allow-concurrent"
-	vreplicationTestSuite  = "vreplication-test-suite"
+	declarativeFlag           = "declarative"
+	skipTopoFlag              = "skip-topo"
+	singletonFlag             = "singleton"
+	singletonContextFlag      = "singleton-context"
+	allowZeroInDateFlag       = "allow-zero-in-date"
+	postponeCompletionFlag    = "postpone-completion"
+	allowConcurrentFlag       = "allow-concurrent"
+	vreplicationTestSuite     = "vreplication-test-suite"
+	cutOverWindowFlag         = "cut-over-window"
+	cutOverWindowDurationFlag = "cut-over-window-duration"
+	windowRestrictsCopyFlag   = "window-restricts-copy"
+	cutOverAttemptsFlag       = "cut-over-attempts"
+	cutOverLockTimeoutFlag    = "cut-over-lock-timeout"
+	atomicCutOverFlag         = "atomic-cut-over"
 )
 
 // DDLStrategy suggests how an ALTER TABLE should run (e.g. "direct", "online", "gh-ost" or "pt-osc")
@@ -95,6 +104,22 @@ func ParseDDLStrategy(strategyVariable string) (*DDLStrategySetting, error) {
 	default:
 		return nil, fmt.Errorf("Unknown online DDL strategy: '%v'", strategy)
 	}
+	if setting.IsAtomicCutOver() {
+		switch setting.Strategy {
+		case DDLStrategyVitess, DDLStrategyOnline:
+		default:
+			return nil, fmt.Errorf("--%s is only supported by '%s' strategy", atomicCutOverFlag, DDLStrategyVitess)
+		}
+	}
+	if _, err := setting.CutOverWindow(); err != nil {
+		return nil, err
+	}
+	if _, err := setting.CutOverAttempts(); err != nil {
+		return nil, err
+	}
+	if _, err := setting.CutOverLockTimeout(); err != nil {
+		return nil, err
+	}
 	return setting, nil
 }
 
@@ -109,6 +134,24 @@ func isFlag(s string, name string) bool {
 	return false
 }
 
+// isValueFlag returns true when the given string is a CLI flag of the given name, with an assigned value,
+// e.g. "--name=value"
+func isValueFlag(s string, name string) bool {
+	return strings.HasPrefix(s, fmt.Sprintf("-%s=", name)) || strings.HasPrefix(s, fmt.Sprintf("--%s=", name))
+}
+
+// flagValue returns the value assigned to a named flag in the Options, e.g. "--name=value"
+func (setting *DDLStrategySetting) flagValue(name string) (value string, found bool) {
+	opts, _ := shlex.Split(setting.Options)
+	for _, opt := range opts {
+		if isValueFlag(opt, name) {
+			value = opt[strings.Index(opt, "=")+1:]
+			found = true
+		}
+	}
+	return value, found
+}
+
 // hasFlag returns true when Options include named flag
 func (setting *DDLStrategySetting) hasFlag(name string) bool {
 	opts, _ := shlex.Split(setting.Options)
@@ -155,6 +198,61 @@ func (setting *DDLStrategySetting) IsVreplicationTestSuite() bool {
 	return setting.hasFlag(vreplicationTestSuite)
 }
 
+// IsWindowRestrictsCopy checks if strategy options include -window-restricts-copy
+func (setting *DDLStrategySetting) IsWindowRestrictsCopy() bool {
+	return setting.hasFlag(windowRestrictsCopyFlag)
+}
+
+// IsAtomicCutOver checks if strategy options include -atomic-cut-over
+func (setting *DDLStrategySetting) IsAtomicCutOver() bool {
+	return setting.hasFlag(atomicCutOverFlag)
+}
+
+// CutOverWindow returns the cut-over window indicated by --cut-over-window and --cut-over-window-duration,
+// or nil when the migration may cut-over at any time
+func (setting *DDLStrategySetting) CutOverWindow() (*CutOverWindow, error) {
+	expression, found := setting.flagValue(cutOverWindowFlag)
+	if !found {
+		return nil, nil
+	}
+	duration := DefaultCutOverWindowDuration
+	if value, found := setting.flagValue(cutOverWindowDurationFlag); found {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for --%s: %v", cutOverWindowDurationFlag, err)
+		}
+		duration = d
+	}
+	return ParseCutOverWindow(expression, duration)
+}
+
+// CutOverAttempts returns the value of --cut-over-attempts: the number of failed cut-over attempts after which
+// the migration is failed. Zero means unlimited attempts.
+func (setting *DDLStrategySetting) CutOverAttempts() (int64, error) {
+	value, found := setting.flagValue(cutOverAttemptsFlag)
+	if !found {
+		return 0, nil
+	}
+	attempts, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || attempts < 0 {
+		return 0, fmt.Errorf("invalid value for --%s: '%s'", cutOverAttemptsFlag, value)
+	}
+	return attempts, nil
+}
+
+// CutOverLockTimeout returns the value of --cut-over-lock-timeout, or zero if not specified
+func (setting *DDLStrategySetting) CutOverLockTimeout() (time.Duration, error) {
+	value, found := setting.flagValue(cutOverLockTimeoutFlag)
+	if !found {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid value for --%s: '%s'", cutOverLockTimeoutFlag, value)
+	}
+	return timeout, nil
+}
+
 // RuntimeOptions returns the options used as runtime flags for given strategy, removing any internal hint options
 func (setting *DDLStrategySetting) RuntimeOptions() []string {
 	opts, _ := shlex.Split(setting.Options)
@@ -169,6 +267,12 @@ func (setting *DDLStrategySetting) RuntimeOptions() []string {
 		case isFlag(opt, postponeCompletionFlag):
 		case isFlag(opt, allowConcurrentFlag):
 		case isFlag(opt, vreplicationTestSuite):
+		case isValueFlag(opt, cutOverWindowFlag):
+		case isValueFlag(opt, cutOverWindowDurationFlag):
+		case isFlag(opt, windowRestrictsCopyFlag):
+		case isValueFlag(opt, cutOverAttemptsFlag):
+		case isValueFlag(opt, cutOverLockTimeoutFlag):
+		case isFlag(opt, atomicCutOverFlag):
 		default:
 			validOpts = append(validOpts, opt)
 		}
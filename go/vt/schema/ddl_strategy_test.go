@@ -19,6 +19,7 @@ package schema
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -39,15 +40,20 @@ func TestIsDirect(t *testing.T) {
 
 func TestParseDDLStrategy(t *testing.T) {
 	tt := []struct {
-		strategyVariable     string
-		strategy             DDLStrategy
-		options              string
-		isDeclarative        bool
-		isSingleton          bool
-		isPostponeCompletion bool
-		isAllowConcurrent    bool
-		runtimeOptions       string
-		err                  error
+		strategyVariable      string
+		strategy              DDLStrategy
+		options               string
+		isDeclarative         bool
+		isSingleton           bool
+		isPostponeCompletion  bool
+		isAllowConcurrent     bool
+		isWindowRestrictsCopy bool
+		isAtomicCutOver       bool
+		cutOverWindow         string
+		cutOverAttempts       int64
+		cutOverLockTimeout    time.Duration
+		runtimeOptions        string
+		err                   error
 	}{
 		{
 			strategyVariable: "direct",
@@ -121,6 +127,30 @@ func TestParseDDLStrategy(t *testing.T) {
 			runtimeOptions:    "",
 			isAllowConcurrent: true,
 		},
+		{
+			strategyVariable:      "vitess --cut-over-window='0 2 * * *' --cut-over-window-duration=2h --window-restricts-copy",
+			strategy:              DDLStrategyVitess,
+			options:               "--cut-over-window='0 2 * * *' --cut-over-window-duration=2h --window-restricts-copy",
+			runtimeOptions:        "",
+			cutOverWindow:         "0 2 * * *",
+			isWindowRestrictsCopy: true,
+		},
+		{
+			strategyVariable:   "online --cut-over-attempts=3 --cut-over-lock-timeout=10s -allow-concurrent",
+			strategy:           DDLStrategyOnline,
+			options:            "--cut-over-attempts=3 --cut-over-lock-timeout=10s -allow-concurrent",
+			runtimeOptions:     "",
+			isAllowConcurrent:  true,
+			cutOverAttempts:    3,
+			cutOverLockTimeout: 10 * time.Second,
+		},
+		{
+			strategyVariable: "vitess --atomic-cut-over",
+			strategy:         DDLStrategyVitess,
+			options:          "--atomic-cut-over",
+			runtimeOptions:   "",
+			isAtomicCutOver:  true,
+		},
 	}
 	for _, ts := range tt {
 		setting, err := ParseDDLStrategy(ts.strategyVariable)
@@ -131,6 +161,22 @@ func TestParseDDLStrategy(t *testing.T) {
 		assert.Equal(t, ts.isSingleton, setting.IsSingleton())
 		assert.Equal(t, ts.isPostponeCompletion, setting.IsPostponeCompletion())
 		assert.Equal(t, ts.isAllowConcurrent, setting.IsAllowConcurrent())
+		assert.Equal(t, ts.isWindowRestrictsCopy, setting.IsWindowRestrictsCopy())
+		assert.Equal(t, ts.isAtomicCutOver, setting.IsAtomicCutOver())
+
+		window, err := setting.CutOverWindow()
+		assert.NoError(t, err)
+		if ts.cutOverWindow == "" {
+			assert.Nil(t, window)
+		} else {
+			assert.Equal(t, ts.cutOverWindow, window.Expression)
+		}
+		cutOverAttempts, err := setting.CutOverAttempts()
+		assert.NoError(t, err)
+		assert.Equal(t, ts.cutOverAttempts, cutOverAttempts)
+		cutOverLockTimeout, err := setting.CutOverLockTimeout()
+		assert.NoError(t, err)
+		assert.Equal(t, ts.cutOverLockTimeout, cutOverLockTimeout)
 
 		runtimeOptions := strings.Join(setting.RuntimeOptions(), " ")
 		assert.Equal(t, ts.runtimeOptions, runtimeOptions)
@@ -139,4 +185,24 @@ func TestParseDDLStrategy(t *testing.T) {
 		_, err := ParseDDLStrategy("other")
 		assert.Error(t, err)
 	}
+	{
+		_, err := ParseDDLStrategy("vitess --cut-over-window='0 2 * *'")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("vitess --cut-over-window='0 2 * * *' --cut-over-window-duration=forever")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("gh-ost --atomic-cut-over")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("vitess --cut-over-attempts=-1")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("vitess --cut-over-lock-timeout=0s")
+		assert.Error(t, err)
+	}
 }
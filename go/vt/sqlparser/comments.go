@@ -43,6 +43,11 @@ const (
 	DirectiveAllowHashJoin = "ALLOW_HASH_JOIN"
 	// DirectiveQueryPlanner lets the user specify per query which planner should be used
 	DirectiveQueryPlanner = "PLANNER"
+	// DirectivePriority specifies the priority of the query in the vttablet connection pools
+	DirectivePriority = "PRIORITY"
+
+	// MaxPriorityValue is the lowest priority a query can have, 0 being the highest
+	MaxPriorityValue = 100
 )
 
 func isNonSpace(r rune) bool {
@@ -412,3 +417,31 @@ func AllowScatterDirective(stmt Statement) bool {
 	}
 	return directives.IsSet(DirectiveAllowScatter)
 }
+
+// GetPriorityFromStatement returns the priority set by the PRIORITY directive
+// of the statement, or an empty string if it is not set. The priority must be
+// an integer between 0 and MaxPriorityValue.
+func GetPriorityFromStatement(stmt Statement) (string, error) {
+	var directives CommentDirectives
+	switch stmt := stmt.(type) {
+	case *Select:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Insert:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Update:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Delete:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	default:
+		return "", nil
+	}
+	priority := directives.GetString(DirectivePriority, "")
+	if priority == "" {
+		return "", nil
+	}
+	intPriority, err := strconv.Atoi(priority)
+	if err != nil || intPriority < 0 || intPriority > MaxPriorityValue {
+		return "", fmt.Errorf("invalid priority value: %s, must be an integer between 0 and %d", priority, MaxPriorityValue)
+	}
+	return priority, nil
+}
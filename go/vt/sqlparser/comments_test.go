@@ -479,3 +479,35 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPriorityFromStatement(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+		err      string
+	}{
+		{query: "select * from users", expected: ""},
+		{query: "select /*vt+ PRIORITY=0 */ * from users", expected: "0"},
+		{query: "insert /*vt+ PRIORITY=10 */ into users(id) values (1)", expected: "10"},
+		{query: "update /*vt+ PRIORITY=100 */ users set name=1", expected: "100"},
+		{query: "delete /*vt+ PRIORITY=50 */ from users", expected: "50"},
+		{query: "show /*vt+ PRIORITY=50 */ create table users", expected: ""},
+		{query: "select /*vt+ PRIORITY=101 */ * from users", err: "invalid priority value: 101, must be an integer between 0 and 100"},
+		{query: "select /*vt+ PRIORITY=-1 */ * from users", err: "invalid priority value: -1, must be an integer between 0 and 100"},
+		{query: "select /*vt+ PRIORITY=high */ * from users", err: "invalid priority value: high, must be an integer between 0 and 100"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			assert.NoError(t, err)
+			got, err := GetPriorityFromStatement(stmt)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dualtopo
+
+import (
+	"context"
+	"sync"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// NewLeaderParticipation is part of the topo.Conn interface.
+func (s *Server) NewLeaderParticipation(name, id string) (topo.LeaderParticipation, error) {
+	return &dualLeaderParticipation{
+		s:    s,
+		name: name,
+		id:   id,
+	}, nil
+}
+
+// dualLeaderParticipation implements topo.LeaderParticipation.
+//
+// Like locks, leadership is acquired on the old backend first, then on
+// the new one. We are the leader while we hold both.
+type dualLeaderParticipation struct {
+	s    *Server
+	name string
+	id   string
+
+	// mu protects the following fields.
+	mu      sync.Mutex
+	stopped bool
+
+	// started has the backend participations that may be running,
+	// so Stop can stop them. Participations that never started
+	// cannot be stopped.
+	started []topo.LeaderParticipation
+
+	// cancel cancels the context returned by WaitForLeadership.
+	cancel context.CancelFunc
+}
+
+// WaitForLeadership is part of the topo.LeaderParticipation interface.
+func (mp *dualLeaderParticipation) WaitForLeadership() (context.Context, error) {
+	var ctxs []context.Context
+	var attempt []topo.LeaderParticipation
+	for _, conn := range []topo.Conn{mp.s.old, mp.s.new} {
+		p, err := conn.NewLeaderParticipation(mp.name, mp.id)
+		if err != nil {
+			mp.stopAttempt(attempt)
+			return nil, err
+		}
+
+		mp.mu.Lock()
+		if mp.stopped {
+			mp.mu.Unlock()
+			mp.stopAttempt(attempt)
+			return nil, topo.NewError(topo.Interrupted, "Leadership")
+		}
+		mp.started = append(mp.started, p)
+		mp.mu.Unlock()
+		attempt = append(attempt, p)
+
+		ctx, err := p.WaitForLeadership()
+		if err != nil {
+			mp.stopAttempt(attempt)
+			return nil, err
+		}
+		ctxs = append(ctxs, ctx)
+	}
+
+	// The leadership is lost as soon as one of the backends loses it,
+	// or when Stop is called.
+	ctx, cancel := context.WithCancel(context.Background())
+	mp.mu.Lock()
+	mp.cancel = cancel
+	mp.mu.Unlock()
+	for _, c := range ctxs {
+		go func(c context.Context) {
+			select {
+			case <-c.Done():
+				cancel()
+			case <-ctx.Done():
+			}
+		}(c)
+	}
+	return ctx, nil
+}
+
+// stopAttempt stops the participations of a failed attempt, unless Stop
+// already did.
+func (mp *dualLeaderParticipation) stopAttempt(attempt []topo.LeaderParticipation) {
+	var toStop []topo.LeaderParticipation
+	mp.mu.Lock()
+	for _, p := range attempt {
+		for i, started := range mp.started {
+			if started == p {
+				mp.started = append(mp.started[:i], mp.started[i+1:]...)
+				toStop = append(toStop, p)
+				break
+			}
+		}
+	}
+	mp.mu.Unlock()
+	for _, p := range toStop {
+		p.Stop()
+	}
+}
+
+// Stop is part of the topo.LeaderParticipation interface.
+func (mp *dualLeaderParticipation) Stop() {
+	mp.mu.Lock()
+	mp.stopped = true
+	started := mp.started
+	mp.started = nil
+	cancel := mp.cancel
+	mp.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	// Stop the new backend first, in case we are waiting on it.
+	for i := len(started) - 1; i >= 0; i-- {
+		started[i].Stop()
+	}
+}
+
+// GetCurrentLeaderID is part of the topo.LeaderParticipation interface.
+// The leader holds the old backend leadership first, so we ask it.
+func (mp *dualLeaderParticipation) GetCurrentLeaderID(ctx context.Context) (string, error) {
+	p, err := mp.s.old.NewLeaderParticipation(mp.name, mp.id)
+	if err != nil {
+		return "", err
+	}
+	return p.GetCurrentLeaderID(ctx)
+}
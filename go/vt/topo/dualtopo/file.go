@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dualtopo
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// Create is part of the topo.Conn interface.
+func (s *Server) Create(ctx context.Context, filePath string, contents []byte) (topo.Version, error) {
+	version, err := s.primary.Create(ctx, filePath, contents)
+	if err != nil {
+		return nil, err
+	}
+	s.mirrorCreate(ctx, filePath, contents)
+	return version, nil
+}
+
+// Update is part of the topo.Conn interface.
+func (s *Server) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	// A conditional update is also conditional on the secondary, against
+	// the version it has before the primary is updated. See mirrorUpdate.
+	var secondary secondaryVersion
+	if version != nil {
+		secondary = s.readSecondaryVersion(ctx, filePath)
+	}
+	newVersion, err := s.primary.Update(ctx, filePath, contents, version)
+	if err != nil {
+		return nil, err
+	}
+	s.mirrorUpdate(ctx, filePath, contents, secondary)
+	return newVersion, nil
+}
+
+// Get is part of the topo.Conn interface.
+func (s *Server) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	contents, version, err := s.primary.Get(ctx, filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.maybeCheckRead(filePath, contents, version)
+	return contents, version, nil
+}
+
+// List is part of the topo.Conn interface.
+func (s *Server) List(ctx context.Context, filePathPrefix string) ([]topo.KVInfo, error) {
+	return s.primary.List(ctx, filePathPrefix)
+}
+
+// Delete is part of the topo.Conn interface.
+func (s *Server) Delete(ctx context.Context, filePath string, version topo.Version) error {
+	var secondary secondaryVersion
+	if version != nil {
+		secondary = s.readSecondaryVersion(ctx, filePath)
+	}
+	if err := s.primary.Delete(ctx, filePath, version); err != nil {
+		return err
+	}
+	s.mirrorDelete(ctx, filePath, secondary)
+	return nil
+}
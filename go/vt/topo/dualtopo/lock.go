@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dualtopo
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// dualLockDescriptor implements topo.LockDescriptor. It holds the lock
+// on both backends.
+type dualLockDescriptor struct {
+	// lds has the old backend lock first.
+	lds []topo.LockDescriptor
+}
+
+// Lock is part of the topo.Conn interface. The old backend is locked
+// first, so two processes locking in any mode cannot deadlock.
+func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockDescriptor, error) {
+	oldLD, err := s.old.Lock(ctx, dirPath, contents)
+	if err != nil {
+		return nil, err
+	}
+	newLD, err := s.new.Lock(ctx, dirPath, contents)
+	if err != nil {
+		// Use a fresh context, ctx may be the reason we failed.
+		unlockCtx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+		defer cancel()
+		if uerr := oldLD.Unlock(unlockCtx); uerr != nil {
+			divergenceLogger.Warningf("dual topo: cannot release the old backend lock on %v in cell %v: %v", dirPath, s.cell, uerr)
+		}
+		return nil, err
+	}
+	return &dualLockDescriptor{
+		lds: []topo.LockDescriptor{oldLD, newLD},
+	}, nil
+}
+
+// Check is part of the topo.LockDescriptor interface.
+func (ld *dualLockDescriptor) Check(ctx context.Context) error {
+	for _, l := range ld.lds {
+		if err := l.Check(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unlock is part of the topo.LockDescriptor interface. It releases the
+// new backend lock first, and returns the first error.
+func (ld *dualLockDescriptor) Unlock(ctx context.Context) error {
+	var firstErr error
+	for i := len(ld.lds) - 1; i >= 0; i-- {
+		if err := ld.lds[i].Unlock(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
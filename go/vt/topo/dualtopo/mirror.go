@@ -0,0 +1,290 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dualtopo
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"math/rand"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	readCheckRate = flag.Float64("topo_dual_read_check_rate", 0, "fraction of the dual topo reads that are compared with the secondary backend, in the background, to detect divergences")
+	repair        = flag.Bool("topo_dual_repair", false, "copy the primary value to the secondary backend when a dual topo read check or write finds a divergence")
+
+	mirrorErrors = stats.NewCountersWithSingleLabel("TopoDualMirrorErrors", "Dual topo writes that could not be mirrored to the secondary backend", "Operation")
+	divergences  = stats.NewCountersWithSingleLabel("TopoDualDivergences", "Differences found between the dual topo backends", "Cause")
+	readChecks   = stats.NewCountersWithSingleLabel("TopoDualReadChecks", "Dual topo reads compared with the secondary backend", "Result")
+	writeRepairs = stats.NewCountersWithSingleLabel("TopoDualWriteRepairs", "Dual topo files whose secondary backend conflicted with a write, compared and repaired in the background", "Result")
+
+	divergenceLogger = logutil.NewThrottledLogger("TopoDualDivergence", 10*time.Second)
+)
+
+const (
+	// checkTimeout bounds a background read check or repair.
+	checkTimeout = 30 * time.Second
+
+	// repairQueueSize is the number of files waiting to be repaired
+	// after a write conflicted on the secondary. Further conflicts are
+	// not repaired until the queue drains.
+	repairQueueSize = 100
+)
+
+// secondaryVersion is the version of a file in the secondary backend,
+// read before a conditional write to the primary.
+type secondaryVersion struct {
+	// conditional is set when the write to the secondary is
+	// conditional on version, i.e. when the write to the primary is
+	// conditional and the secondary version could be read.
+	conditional bool
+
+	// version is nil when the file doesn't exist in the secondary.
+	version topo.Version
+}
+
+// readSecondaryVersion reads the current version of a file in the
+// secondary backend. If it can't be read, the write to the secondary is
+// unconditional: it is then likely to fail, and be counted as a mirror
+// error.
+func (s *Server) readSecondaryVersion(ctx context.Context, filePath string) secondaryVersion {
+	_, version, err := s.secondary.Get(ctx, filePath)
+	switch {
+	case err == nil:
+		return secondaryVersion{conditional: true, version: version}
+	case topo.IsErrType(err, topo.NoNode):
+		return secondaryVersion{conditional: true}
+	default:
+		return secondaryVersion{}
+	}
+}
+
+// mirrorCreate mirrors a Create to the secondary backend. If the file
+// already exists there, it was created concurrently by a process in the
+// other mode: see mirrorUpdate.
+func (s *Server) mirrorCreate(ctx context.Context, filePath string, contents []byte) {
+	_, err := s.secondary.Create(ctx, filePath, contents)
+	switch {
+	case err == nil:
+	case topo.IsErrType(err, topo.NodeExists):
+		s.reportConflict("CreateConflict", filePath)
+	default:
+		s.reportMirrorError("Create", filePath, err)
+	}
+}
+
+// mirrorUpdate mirrors an Update to the secondary backend.
+//
+// Processes in different modes, during the rolling flip, write to the
+// backends in opposite orders. If both updated a file concurrently and
+// unconditionally mirrored their update, each one could overwrite the
+// other on its secondary. A conditional update is thus mirrored against
+// the secondary version read before the primary was updated, and does
+// not overwrite a concurrent update. The primary was already updated,
+// so the write still succeeds: the conflict is reported, and the
+// secondary is repaired from the primary in the background.
+func (s *Server) mirrorUpdate(ctx context.Context, filePath string, contents []byte, secondary secondaryVersion) {
+	var err error
+	switch {
+	case !secondary.conditional:
+		_, err = s.secondary.Update(ctx, filePath, contents, nil)
+	case secondary.version == nil:
+		s.reportDivergence("UpdateMissing", filePath)
+		_, err = s.secondary.Create(ctx, filePath, contents)
+	default:
+		_, err = s.secondary.Update(ctx, filePath, contents, secondary.version)
+	}
+	switch {
+	case err == nil:
+	case secondary.conditional && (topo.IsErrType(err, topo.BadVersion) || topo.IsErrType(err, topo.NodeExists) || topo.IsErrType(err, topo.NoNode)):
+		// The file was updated, created or deleted since we read its version.
+		s.reportConflict("UpdateConflict", filePath)
+	default:
+		s.reportMirrorError("Update", filePath, err)
+	}
+}
+
+// mirrorDelete mirrors a Delete to the secondary backend. Like updates,
+// conditional deletes are conditional on the secondary too.
+func (s *Server) mirrorDelete(ctx context.Context, filePath string, secondary secondaryVersion) {
+	if secondary.conditional && secondary.version == nil {
+		s.reportDivergence("DeleteMissing", filePath)
+		return
+	}
+	err := s.secondary.Delete(ctx, filePath, secondary.version)
+	switch {
+	case err == nil:
+	case topo.IsErrType(err, topo.NoNode):
+		s.reportDivergence("DeleteMissing", filePath)
+	case topo.IsErrType(err, topo.BadVersion):
+		s.reportConflict("DeleteConflict", filePath)
+	default:
+		s.reportMirrorError("Delete", filePath, err)
+	}
+}
+
+func (s *Server) reportMirrorError(operation, filePath string, err error) {
+	mirrorErrors.Add(operation, 1)
+	divergenceLogger.Warningf("dual topo: %v of %v in cell %v was not mirrored: %v", operation, filePath, s.cell, err)
+}
+
+func (s *Server) reportDivergence(cause, filePath string) {
+	divergences.Add(cause, 1)
+	divergenceLogger.Warningf("dual topo: %v in cell %v differs between the backends (%v)", filePath, s.cell, cause)
+}
+
+// reportConflict reports a write which was applied to the primary, but
+// conflicted with a concurrent write on the secondary, and queues the
+// file to be repaired if asked to.
+func (s *Server) reportConflict(cause, filePath string) {
+	s.reportDivergence(cause, filePath)
+	if !*repair {
+		return
+	}
+	select {
+	case s.repairs <- filePath:
+	default:
+		writeRepairs.Add("Dropped", 1)
+	}
+}
+
+// repairWrites repairs the files queued by reportConflict, until the
+// server is closed.
+func (s *Server) repairWrites() {
+	for {
+		select {
+		case <-s.done:
+			return
+		case filePath := <-s.repairs:
+			ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+			s.repairWrite(ctx, filePath)
+			cancel()
+		}
+	}
+}
+
+// repairWrite copies the primary value of a file to the secondary
+// backend, or deletes it from the secondary if it doesn't exist in the
+// primary.
+func (s *Server) repairWrite(ctx context.Context, filePath string) {
+	contents, version, err := s.primary.Get(ctx, filePath)
+	switch {
+	case err == nil:
+	case topo.IsErrType(err, topo.NoNode):
+		contents, version = nil, nil
+	default:
+		writeRepairs.Add("Error", 1)
+		return
+	}
+	secondaryContents, secondaryVersion, err := s.secondary.Get(ctx, filePath)
+	switch {
+	case err == nil:
+		if version != nil && bytes.Equal(contents, secondaryContents) {
+			writeRepairs.Add("Match", 1)
+			return
+		}
+	case topo.IsErrType(err, topo.NoNode):
+		if version == nil {
+			writeRepairs.Add("Match", 1)
+			return
+		}
+		secondaryVersion = nil
+	default:
+		writeRepairs.Add("Error", 1)
+		return
+	}
+	if version == nil {
+		err = s.secondary.Delete(ctx, filePath, secondaryVersion)
+	} else {
+		err = s.repairSecondary(ctx, filePath, contents, version, secondaryVersion)
+	}
+	if err != nil {
+		divergenceLogger.Warningf("dual topo: cannot repair %v in cell %v: %v", filePath, s.cell, err)
+		writeRepairs.Add("Error", 1)
+		return
+	}
+	writeRepairs.Add("Repaired", 1)
+}
+
+// maybeCheckRead compares, for a fraction of the reads, the value read
+// from the primary with the secondary one.
+func (s *Server) maybeCheckRead(filePath string, contents []byte, version topo.Version) {
+	if *readCheckRate <= 0 || rand.Float64() >= *readCheckRate {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+		defer cancel()
+		s.checkRead(ctx, filePath, contents, version)
+	}()
+}
+
+// checkRead compares a value read from the primary with the secondary
+// one, and repairs the secondary if asked to.
+func (s *Server) checkRead(ctx context.Context, filePath string, contents []byte, version topo.Version) {
+	secondaryContents, secondaryVersion, err := s.secondary.Get(ctx, filePath)
+	switch {
+	case err == nil:
+		if bytes.Equal(contents, secondaryContents) {
+			readChecks.Add("Match", 1)
+			return
+		}
+	case topo.IsErrType(err, topo.NoNode):
+		secondaryVersion = nil
+	default:
+		readChecks.Add("Error", 1)
+		return
+	}
+	readChecks.Add("Divergent", 1)
+	s.reportDivergence("ReadCheck", filePath)
+	if !*repair {
+		return
+	}
+
+	if err := s.repairSecondary(ctx, filePath, contents, version, secondaryVersion); err != nil {
+		divergenceLogger.Warningf("dual topo: cannot repair %v in cell %v: %v", filePath, s.cell, err)
+		return
+	}
+	readChecks.Add("Repaired", 1)
+}
+
+// repairSecondary copies contents, read from the primary at version, to
+// the secondary backend, which has secondaryVersion (nil if the file is
+// missing there).
+func (s *Server) repairSecondary(ctx context.Context, filePath string, contents []byte, version, secondaryVersion topo.Version) error {
+	// The file may have been updated since we read it, in which case
+	// the secondary may already have a newer value. Only repair if the
+	// primary still has the value we compared.
+	_, currentVersion, err := s.primary.Get(ctx, filePath)
+	if err != nil {
+		return err
+	}
+	if currentVersion.String() != version.String() {
+		return topo.NewError(topo.BadVersion, filePath)
+	}
+	if secondaryVersion == nil {
+		_, err = s.secondary.Create(ctx, filePath, contents)
+	} else {
+		_, err = s.secondary.Update(ctx, filePath, contents, secondaryVersion)
+	}
+	return err
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package dualtopo implements topo.Server on top of two other
+implementations, to migrate the topology from one backend to another
+(for instance from ZooKeeper to etcd) without a maintenance window.
+
+The implementation is registered as "dual". The server address lists
+the two backends, old first, as <implementation>:<address> separated
+by a '|'. The root is shared by both backends, or is also split with a
+'|'. For instance:
+
+	-topo_implementation dual
+	-topo_global_server_address 'zk2:zk1:2181,zk2:2181|etcd2:http://etcd1:2379'
+	-topo_global_root /vitess/global
+
+The cells use the same syntax in their CellInfo.
+
+-topo_dual_mode selects how the two backends are used:
+  - "old": reads come from the old backend. Writes go to the old
+    backend, and are mirrored to the new one.
+  - "new": reads come from the new backend. Writes go to the new
+    backend, and are mirrored to the old one.
+  - "new_only": only the new backend is used, the old one can be shut
+    down. This is the last step before using the new implementation
+    directly.
+
+The backend reads come from is the primary. Writes are applied to the
+primary first, its versions are returned to the callers, and its errors
+are the ones returned. They are then mirrored to the other backend.
+Conditional writes are mirrored against the version the other backend
+had before the primary write, so that processes in different modes
+cannot overwrite each other's updates on their secondary during the
+flip. Once the primary is written, the call succeeds: a mirror which
+conflicts with a concurrent write is counted in TopoDualDivergences,
+and with -topo_dual_repair, the primary value is copied to the other
+backend in the background (TopoDualWriteRepairs). Other mirror
+failures are logged and counted in TopoDualMirrorErrors.
+
+Locks and leader elections are taken on both backends, always old first,
+so processes in different modes, or using only one of the backends,
+exclude each other.
+
+A migration is:
+ 1. Copy the data with topo2topo, and point the CellInfo objects of
+    both backends at the two cell backends.
+ 2. Restart all the processes with -topo_dual_mode old.
+ 3. Check the divergences (TopoDualDivergences, or topo2topo -compare),
+    optionally with -topo_dual_read_check_rate.
+ 4. Restart all the processes with -topo_dual_mode new. This is the flip.
+ 5. Restart all the processes with -topo_dual_mode new_only.
+ 6. Restart all the processes with the new implementation, and
+    decommission the old backend.
+*/
+package dualtopo
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+const (
+	// ModeOld reads from the old backend, and mirrors the writes to
+	// the new backend.
+	ModeOld = "old"
+
+	// ModeNew reads from the new backend, and mirrors the writes to
+	// the old backend.
+	ModeNew = "new"
+
+	// ModeNewOnly only uses the new backend.
+	ModeNewOnly = "new_only"
+)
+
+var (
+	mode = flag.String("topo_dual_mode", ModeOld, "how the dual topo implementation uses its backends: old (read from old, write to both), new (read from new, write to both) or new_only")
+)
+
+// Factory is the dual topo.Factory implementation.
+type Factory struct{}
+
+// HasGlobalReadOnlyCell is part of the topo.Factory interface.
+func (f Factory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the topo.Factory interface.
+func (f Factory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	return NewServer(*mode, cell, serverAddr, root)
+}
+
+// backend is one of the two backends of a Server.
+type backend struct {
+	implementation string
+	serverAddr     string
+	root           string
+}
+
+// parseBackends parses the server address and root of a Server.
+func parseBackends(serverAddr, root string) ([2]backend, error) {
+	var backends [2]backend
+	addrs := strings.Split(serverAddr, "|")
+	if len(addrs) != 2 {
+		return backends, fmt.Errorf("dual topo server address must be <implementation>:<address>|<implementation>:<address>, got %q", serverAddr)
+	}
+	roots := strings.Split(root, "|")
+	switch len(roots) {
+	case 1:
+		roots = append(roots, root)
+	case 2:
+	default:
+		return backends, fmt.Errorf("dual topo root must be <root> or <root>|<root>, got %q", root)
+	}
+	for i, addr := range addrs {
+		parts := strings.SplitN(addr, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return backends, fmt.Errorf("dual topo backend must be <implementation>:<address>, got %q", addr)
+		}
+		if parts[0] == "dual" {
+			return backends, fmt.Errorf("dual topo backend cannot be dual")
+		}
+		backends[i] = backend{
+			implementation: parts[0],
+			serverAddr:     parts[1],
+			root:           roots[i],
+		}
+	}
+	return backends, nil
+}
+
+// open returns a connection to the backend for cell.
+func (b backend) open(cell string) (topo.Conn, error) {
+	factory, err := topo.GetFactory(b.implementation)
+	if err != nil {
+		return nil, err
+	}
+	return factory.Create(cell, b.serverAddr, b.root)
+}
+
+// Server is the implementation of topo.Conn that uses two backends.
+type Server struct {
+	cell string
+
+	// old and new are the two backends.
+	old topo.Conn
+	new topo.Conn
+
+	// primary is the backend we read from, secondary the one the
+	// writes are mirrored to.
+	primary   topo.Conn
+	secondary topo.Conn
+
+	// repairs has the files to repair after a write conflicted on the
+	// secondary. done is closed by Close, to stop repairing them.
+	repairs chan string
+	done    chan struct{}
+}
+
+// NewServer returns a topo.Conn for cell, using the backends in
+// serverAddr and root. In ModeNewOnly, it returns the connection to the
+// new backend.
+func NewServer(mode, cell, serverAddr, root string) (topo.Conn, error) {
+	backends, err := parseBackends(serverAddr, root)
+	if err != nil {
+		return nil, err
+	}
+	if mode == ModeNewOnly {
+		return backends[1].open(cell)
+	}
+	if mode != ModeOld && mode != ModeNew {
+		return nil, fmt.Errorf("invalid dual topo mode %q", mode)
+	}
+
+	oldConn, err := backends[0].open(cell)
+	if err != nil {
+		return nil, err
+	}
+	newConn, err := backends[1].open(cell)
+	if err != nil {
+		oldConn.Close()
+		return nil, err
+	}
+	s := &Server{
+		cell:      cell,
+		old:       oldConn,
+		new:       newConn,
+		primary:   oldConn,
+		secondary: newConn,
+		repairs:   make(chan string, repairQueueSize),
+		done:      make(chan struct{}),
+	}
+	if mode == ModeNew {
+		s.primary, s.secondary = newConn, oldConn
+	}
+	go s.repairWrites()
+	return s, nil
+}
+
+// Close implements topo.Conn.Close.
+func (s *Server) Close() {
+	close(s.done)
+	s.old.Close()
+	s.new.Close()
+}
+
+func init() {
+	topo.RegisterFactory("dual", Factory{})
+}
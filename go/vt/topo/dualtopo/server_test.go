@@ -0,0 +1,410 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dualtopo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/test"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// testFactory serves one of the backends of the tests, from the
+// memorytopo factory of the current test server.
+type testFactory struct {
+	f *memorytopo.Factory
+}
+
+func (tf *testFactory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+func (tf *testFactory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	return tf.f.Create(cell, serverAddr, root)
+}
+
+var (
+	oldBackend = &testFactory{}
+	newBackend = &testFactory{}
+)
+
+const testAddr = "dualtest_old:|dualtest_new:"
+
+func init() {
+	topo.RegisterFactory("dualtest_old", oldBackend)
+	topo.RegisterFactory("dualtest_new", newBackend)
+}
+
+// newTestServer returns a dual topo.Server in the given mode, and the
+// memorytopo servers of its two backends.
+func newTestServer(t *testing.T, testMode string) (ts, oldTS, newTS *topo.Server) {
+	ctx := context.Background()
+	oldTS, oldBackend.f = memorytopo.NewServerAndFactory(test.LocalCellName)
+	newTS, newBackend.f = memorytopo.NewServerAndFactory(test.LocalCellName)
+	for _, backendTS := range []*topo.Server{oldTS, newTS} {
+		if err := backendTS.UpdateCellInfoFields(ctx, test.LocalCellName, func(ci *topodatapb.CellInfo) error {
+			ci.ServerAddress = testAddr
+			return nil
+		}); err != nil {
+			t.Fatalf("UpdateCellInfoFields() failed: %v", err)
+		}
+	}
+
+	*mode = testMode
+	ts, err := topo.OpenServer("dual", testAddr, "")
+	if err != nil {
+		t.Fatalf("OpenServer() failed: %v", err)
+	}
+	return ts, oldTS, newTS
+}
+
+func TestDualTopo(t *testing.T) {
+	for _, m := range []string{ModeOld, ModeNew} {
+		t.Run(m, func(t *testing.T) {
+			test.TopoServerTestSuite(t, func() *topo.Server {
+				ts, _, _ := newTestServer(t, m)
+				return ts
+			})
+		})
+	}
+}
+
+func TestParseBackends(t *testing.T) {
+	backends, err := parseBackends("zk2:zk1:2181,zk2:2181|etcd2:http://etcd1:2379", "/vitess/global")
+	if err != nil {
+		t.Fatalf("parseBackends() failed: %v", err)
+	}
+	want := [2]backend{
+		{implementation: "zk2", serverAddr: "zk1:2181,zk2:2181", root: "/vitess/global"},
+		{implementation: "etcd2", serverAddr: "http://etcd1:2379", root: "/vitess/global"},
+	}
+	if backends != want {
+		t.Errorf("parseBackends() = %v, want %v", backends, want)
+	}
+
+	backends, err = parseBackends("zk2:zk1:2181|etcd2:etcd1:2379", "/zk/global|/etcd/global")
+	if err != nil || backends[0].root != "/zk/global" || backends[1].root != "/etcd/global" {
+		t.Errorf("parseBackends() with two roots = %v, %v", backends, err)
+	}
+
+	for _, addr := range []string{"zk2:zk1:2181", "zk2:zk1|etcd2:e|consul:c", "zk1:2181|:etcd1", "dual:a|etcd2:b"} {
+		if _, err := parseBackends(addr, "/root"); err == nil {
+			t.Errorf("parseBackends(%q) succeeded, want an error", addr)
+		}
+	}
+}
+
+func TestDualTopoMirror(t *testing.T) {
+	ctx := context.Background()
+	ts, oldTS, newTS := newTestServer(t, ModeNew)
+	defer ts.Close()
+	conn, err := NewServer(ModeNew, topo.GlobalCell, testAddr, "")
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+	defer conn.Close()
+	s := conn.(*Server)
+	oldConn, err := oldTS.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell() failed: %v", err)
+	}
+	newConn, err := newTS.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell() failed: %v", err)
+	}
+	checkContents := func(c topo.Conn, want string) {
+		t.Helper()
+		contents, _, err := c.Get(ctx, "myfile")
+		if err != nil || string(contents) != want {
+			t.Fatalf("Get() = %q, %v, want %q", contents, err, want)
+		}
+	}
+
+	// Writes go to both backends, versions are the primary ones.
+	version, err := conn.Create(ctx, "myfile", []byte("a"))
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	checkContents(oldConn, "a")
+	checkContents(newConn, "a")
+	if _, newVersion, _ := newConn.Get(ctx, "myfile"); newVersion.String() != version.String() {
+		t.Errorf("Create() returned version %v, want the new backend one %v", version, newVersion)
+	}
+	if _, err := conn.Update(ctx, "myfile", []byte("b"), version); err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+	checkContents(oldConn, "b")
+	checkContents(newConn, "b")
+
+	// A divergence is found by a read check, and repaired.
+	if _, err := oldConn.Update(ctx, "myfile", []byte("diverged"), nil); err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+	contents, version, err := s.Get(ctx, "myfile")
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+	divergent := readChecks.Counts()["Divergent"]
+	*repair = true
+	defer func() { *repair = false }()
+	s.checkRead(ctx, "myfile", contents, version)
+	if got := readChecks.Counts()["Divergent"]; got != divergent+1 {
+		t.Errorf("got %v divergent read checks, want %v", got, divergent+1)
+	}
+	checkContents(oldConn, "b")
+
+	// Locks are held on both backends.
+	if _, err := conn.Create(ctx, "mydir/myfile", []byte("a")); err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	ld, err := conn.Lock(ctx, "mydir", "dual")
+	if err != nil {
+		t.Fatalf("Lock() failed: %v", err)
+	}
+	for _, c := range []topo.Conn{oldConn, newConn} {
+		lockCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		if _, err := c.Lock(lockCtx, "mydir", "other"); err == nil {
+			t.Errorf("Lock() on a backend succeeded while the dual lock is held")
+		}
+		cancel()
+	}
+	if err := ld.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock() failed: %v", err)
+	}
+
+	// Deletes are mirrored.
+	if err := conn.Delete(ctx, "myfile", nil); err != nil {
+		t.Fatalf("Delete() failed: %v", err)
+	}
+	for _, c := range []topo.Conn{oldConn, newConn} {
+		if _, _, err := c.Get(ctx, "myfile"); !topo.IsErrType(err, topo.NoNode) {
+			t.Errorf("Get() after Delete() returned %v, want NoNode", err)
+		}
+	}
+}
+
+func TestDualTopoNewOnly(t *testing.T) {
+	ctx := context.Background()
+	ts, oldTS, newTS := newTestServer(t, ModeNewOnly)
+	defer ts.Close()
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace() failed: %v", err)
+	}
+	if _, err := newTS.GetKeyspace(ctx, "ks"); err != nil {
+		t.Errorf("GetKeyspace() on the new backend failed: %v", err)
+	}
+	if _, err := oldTS.GetKeyspace(ctx, "ks"); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("GetKeyspace() on the old backend returned %v, want NoNode", err)
+	}
+}
+
+// hookConn is a backend connection that runs a hook before its next
+// update.
+type hookConn struct {
+	topo.Conn
+	beforeUpdate func()
+}
+
+func (c *hookConn) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	if hook := c.beforeUpdate; hook != nil {
+		c.beforeUpdate = nil
+		hook()
+	}
+	return c.Conn.Update(ctx, filePath, contents, version)
+}
+
+func TestDualTopoFlipConflict(t *testing.T) {
+	ctx := context.Background()
+	ts, oldTS, newTS := newTestServer(t, ModeOld)
+	defer ts.Close()
+	oldConn, err := oldTS.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell() failed: %v", err)
+	}
+	newConn, err := newTS.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell() failed: %v", err)
+	}
+	checkContents := func(c topo.Conn, want string) {
+		t.Helper()
+		contents, _, err := c.Get(ctx, "myfile")
+		if err != nil || string(contents) != want {
+			t.Fatalf("Get() = %q, %v, want %q", contents, err, want)
+		}
+	}
+
+	// During the flip, a process still in the old mode and a process
+	// already in the new mode update the same file. The update of the
+	// old mode process is mirrored after the new mode process is done.
+	hooked := &hookConn{Conn: newConn}
+	oldMode := &Server{
+		cell:      topo.GlobalCell,
+		old:       oldConn,
+		new:       hooked,
+		primary:   oldConn,
+		secondary: hooked,
+	}
+	newMode, err := NewServer(ModeNew, topo.GlobalCell, testAddr, "")
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+	defer newMode.Close()
+
+	oldModeVersion, err := oldMode.Create(ctx, "myfile", []byte("a"))
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	_, newModeVersion, err := newMode.Get(ctx, "myfile")
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+	var newModeErr error
+	hooked.beforeUpdate = func() {
+		_, newModeErr = newMode.Update(ctx, "myfile", []byte("new mode"), newModeVersion)
+	}
+
+	// Both updates succeed, as they were applied to their primary. The
+	// update of the old mode process is not mirrored over the other one,
+	// so the two backends still agree.
+	conflicts := divergences.Counts()["UpdateConflict"]
+	if _, err := oldMode.Update(ctx, "myfile", []byte("old mode"), oldModeVersion); err != nil {
+		t.Fatalf("Update() in the old mode failed: %v", err)
+	}
+	if newModeErr != nil {
+		t.Fatalf("Update() in the new mode failed: %v", newModeErr)
+	}
+	if got := divergences.Counts()["UpdateConflict"]; got != conflicts+1 {
+		t.Errorf("got %v update conflicts, want %v", got, conflicts+1)
+	}
+	checkContents(oldConn, "new mode")
+	checkContents(newConn, "new mode")
+
+	// A later update is applied to both backends.
+	_, version, err := oldMode.Get(ctx, "myfile")
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+	if _, err := oldMode.Update(ctx, "myfile", []byte("old mode"), version); err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+	checkContents(oldConn, "old mode")
+	checkContents(newConn, "old mode")
+}
+
+// connFactory is a topo.Factory which serves an existing connection.
+type connFactory struct {
+	conn topo.Conn
+}
+
+func (f connFactory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+func (f connFactory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	return f.conn, nil
+}
+
+func TestDualTopoUpdateShardFieldsConflict(t *testing.T) {
+	ctx := context.Background()
+	_, oldTS, newTS := newTestServer(t, ModeOld)
+	oldConn, err := oldTS.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell() failed: %v", err)
+	}
+	newConn, err := newTS.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell() failed: %v", err)
+	}
+	hooked := &hookConn{Conn: newConn}
+	s := &Server{
+		cell:      topo.GlobalCell,
+		old:       oldConn,
+		new:       hooked,
+		primary:   oldConn,
+		secondary: hooked,
+		repairs:   make(chan string, 1),
+	}
+	ts, err := topo.NewWithFactory(connFactory{conn: s}, "", "")
+	if err != nil {
+		t.Fatalf("NewWithFactory() failed: %v", err)
+	}
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace() failed: %v", err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatalf("CreateShard() failed: %v", err)
+	}
+	lockCtx, unlock, err := ts.LockKeyspace(ctx, "ks", "UpdateShardFields")
+	if err != nil {
+		t.Fatalf("LockKeyspace() failed: %v", err)
+	}
+	defer unlock(&err)
+
+	// The shard is updated concurrently on the secondary, by a process in
+	// the other mode, before the update is mirrored.
+	const shardPath = "keyspaces/ks/shards/0/Shard"
+	hooked.beforeUpdate = func() {
+		contents, _, err := newConn.Get(ctx, shardPath)
+		if err != nil {
+			t.Fatalf("Get() failed: %v", err)
+		}
+		if _, err := newConn.Update(ctx, shardPath, contents, nil); err != nil {
+			t.Fatalf("Update() failed: %v", err)
+		}
+	}
+
+	// The update is applied once, and succeeds.
+	*repair = true
+	defer func() { *repair = false }()
+	calls := 0
+	si, err := ts.UpdateShardFields(lockCtx, "ks", "0", func(si *topo.ShardInfo) error {
+		calls++
+		return si.UpdateSourceDeniedTables(lockCtx, topodatapb.TabletType_PRIMARY, nil, false, []string{"t1"})
+	})
+	if err != nil {
+		t.Fatalf("UpdateShardFields() failed: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("UpdateShardFields() ran its update %v times, want 1", calls)
+	}
+	if tc := si.GetTabletControl(topodatapb.TabletType_PRIMARY); tc == nil || len(tc.DeniedTables) != 1 {
+		t.Errorf("UpdateShardFields() returned tablet control %v, want t1 denied", tc)
+	}
+
+	// The secondary is repaired from the primary.
+	var filePath string
+	select {
+	case filePath = <-s.repairs:
+	default:
+		t.Fatalf("no repair was queued")
+	}
+	s.repairWrite(ctx, filePath)
+	for _, backendTS := range []*topo.Server{oldTS, newTS} {
+		si, err := backendTS.GetShard(ctx, "ks", "0")
+		if err != nil {
+			t.Fatalf("GetShard() failed: %v", err)
+		}
+		if tc := si.GetTabletControl(topodatapb.TabletType_PRIMARY); tc == nil || len(tc.DeniedTables) != 1 || tc.DeniedTables[0] != "t1" {
+			t.Errorf("backend shard has tablet control %v, want t1 denied", tc)
+		}
+	}
+}
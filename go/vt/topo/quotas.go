@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
+)
+
+// This file contains the utility methods to manage the Quotas of a
+// keyspace. They are stored in the global cell, and watched by all the
+// tablets of the keyspace.
+
+func quotasFileName(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, QuotasFile)
+}
+
+// WatchQuotasData is returned / streamed by WatchQuotas.
+// The WatchQuotas API guarantees exactly one of Value or Err will be set.
+type WatchQuotasData struct {
+	Value *quotapb.Quotas
+	Err   error
+}
+
+// ValidateQuotas checks the quotas are well formed: names are set and
+// unique, and limits are not negative.
+func ValidateQuotas(quotas *quotapb.Quotas) error {
+	names := make(map[string]bool)
+	for i, q := range quotas.GetQuotas() {
+		if q.Name == "" {
+			return fmt.Errorf("quota #%d has no name", i)
+		}
+		if names[q.Name] {
+			return fmt.Errorf("duplicate quota name: %v", q.Name)
+		}
+		names[q.Name] = true
+		if q.WindowSeconds < 0 || q.MaxQps < 0 || q.MaxConcurrentQueries < 0 || q.MaxRowsReturned < 0 || q.MaxResponseSeconds < 0 || q.MaxRowsRead < 0 || q.MaxCpuSeconds < 0 || q.MaxWaitMs < 0 {
+			return fmt.Errorf("quota %v has a negative value", q.Name)
+		}
+	}
+	return nil
+}
+
+// SaveQuotas validates and saves the quotas of a keyspace.
+// If there are no quotas, the file is removed.
+func (ts *Server) SaveQuotas(ctx context.Context, keyspace string, quotas *quotapb.Quotas) error {
+	if err := ValidateQuotas(quotas); err != nil {
+		return err
+	}
+
+	nodePath := quotasFileName(keyspace)
+	if len(quotas.GetQuotas()) == 0 {
+		if err := ts.globalCell.Delete(ctx, nodePath, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+
+	data, err := proto.Marshal(quotas)
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Update(ctx, nodePath, data, nil)
+	return err
+}
+
+// GetQuotas returns the quotas of a keyspace, which are empty if none
+// were saved.
+func (ts *Server) GetQuotas(ctx context.Context, keyspace string) (*quotapb.Quotas, error) {
+	quotas := &quotapb.Quotas{}
+	data, _, err := ts.globalCell.Get(ctx, quotasFileName(keyspace))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return quotas, nil
+		}
+		return nil, err
+	}
+	if err := proto.Unmarshal(data, quotas); err != nil {
+		return nil, vterrors.Wrapf(err, "bad quotas data: %q", data)
+	}
+	return quotas, nil
+}
+
+// WatchQuotas will set a watch on the Quotas of a keyspace.
+// It has the same contract as Conn.Watch, but it also unpacks the
+// contents into a Quotas object.
+func (ts *Server) WatchQuotas(ctx context.Context, keyspace string) (*WatchQuotasData, <-chan *WatchQuotasData, CancelFunc) {
+	current, wdChannel, cancel := ts.globalCell.Watch(ctx, quotasFileName(keyspace))
+	if current.Err != nil {
+		return &WatchQuotasData{Err: current.Err}, nil, nil
+	}
+	value := &quotapb.Quotas{}
+	if err := proto.Unmarshal(current.Contents, value); err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchQuotasData{Err: vterrors.Wrapf(err, "error unpacking initial Quotas object")}, nil, nil
+	}
+
+	changes := make(chan *WatchQuotasData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying Watch() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchQuotasData{Err: wd.Err}
+				return
+			}
+
+			value := &quotapb.Quotas{}
+			if err := proto.Unmarshal(wd.Contents, value); err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchQuotasData{Err: vterrors.Wrapf(err, "error unpacking Quotas object")}
+				return
+			}
+			changes <- &WatchQuotasData{Value: value}
+		}
+	}()
+
+	return &WatchQuotasData{Value: value}, changes, cancel
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+// This file contains the wire types shared by the topo client and the
+// raft nodes. Everything is exchanged as JSON over HTTP POST requests.
+
+// Client API endpoints, served by every node. Only the leader answers
+// them, the other nodes reply with codeNotLeader and the address of the
+// leader if they know it.
+const (
+	writePath     = "/rafttopo/v1/write"
+	getPath       = "/rafttopo/v1/get"
+	rangePath     = "/rafttopo/v1/range"
+	watchPath     = "/rafttopo/v1/watch"
+	keepAlivePath = "/rafttopo/v1/keepalive"
+)
+
+// Raft endpoints, used between the members of the group.
+const (
+	requestVotePath     = "/rafttopo/raft/vote"
+	appendEntriesPath   = "/rafttopo/raft/append"
+	installSnapshotPath = "/rafttopo/raft/snapshot"
+)
+
+// Operations of a command. A command is what is stored in the
+// replicated log, and applied to the key/value store of every node.
+const (
+	opCreate = "create"
+	opUpdate = "update"
+	opDelete = "delete"
+	opGrant  = "grant"
+	opRevoke = "revoke"
+)
+
+// Result codes returned in a response.
+const (
+	codeNoNode      = "NoNode"
+	codeNodeExists  = "NodeExists"
+	codeBadVersion  = "BadVersion"
+	codeNoLease     = "NoLease"
+	codeNotLeader   = "NotLeader"
+	codeUnavailable = "Unavailable"
+)
+
+// request is the body of every client API call. Commands sent to
+// writePath are replicated as-is.
+type request struct {
+	Op    string `json:"op,omitempty"`
+	Key   string `json:"key,omitempty"`
+	Value []byte `json:"value,omitempty"`
+
+	// Version is the expected version of the key for conditional
+	// updates and deletes (0 means unconditional), or the last
+	// version the caller has seen for watches.
+	Version uint64 `json:"version,omitempty"`
+
+	// Lease is the lease to attach the key to, or the lease to
+	// revoke or keep alive.
+	Lease int64 `json:"lease,omitempty"`
+
+	// TTL is the time to live of a new lease, in seconds.
+	TTL int64 `json:"ttl,omitempty"`
+}
+
+// keyValue is a single entry of the key/value store.
+type keyValue struct {
+	Key     string `json:"key"`
+	Value   []byte `json:"value,omitempty"`
+	Version uint64 `json:"version"`
+	Lease   int64  `json:"lease,omitempty"`
+}
+
+// response is the body returned by every client API call.
+type response struct {
+	// Code is empty on success.
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+
+	// Leader is the address of the current leader, set with
+	// codeNotLeader when known.
+	Leader string `json:"leader,omitempty"`
+
+	// Version is the new version of a key after a write.
+	Version uint64 `json:"version,omitempty"`
+
+	// Lease is the ID of a newly granted lease.
+	Lease int64 `json:"lease,omitempty"`
+
+	// KVs is the result of get, range and watch calls. get and
+	// watch return at most one entry, and none if the key doesn't
+	// exist.
+	KVs []keyValue `json:"kvs,omitempty"`
+}
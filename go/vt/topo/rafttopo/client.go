@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// retryDelay is how long the client waits after it tried all nodes
+	// without finding the leader.
+	retryDelay = 50 * time.Millisecond
+
+	// unavailableTimeout is how long the client keeps retrying when
+	// the context has no deadline and no leader can be reached.
+	unavailableTimeout = 30 * time.Second
+)
+
+// codeError is an error returned by a node in a response.
+type codeError struct {
+	code    string
+	message string
+}
+
+// Error is part of the error interface.
+func (e *codeError) Error() string {
+	if e.message == "" {
+		return e.code
+	}
+	return fmt.Sprintf("%v: %v", e.code, e.message)
+}
+
+// client sends requests to the leader of a raft topo server group. Only
+// the leader serves requests, so the client remembers which node it is,
+// and follows the redirections of the other nodes.
+type client struct {
+	addrs     []string
+	transport *transport
+
+	mu sync.Mutex
+	// leader is the address of the last known leader, it may not be
+	// in addrs.
+	leader string
+	// next is the index in addrs of the next node to try when the
+	// leader is not known.
+	next int
+}
+
+func newClient(addrs []string) *client {
+	return &client{
+		addrs:     addrs,
+		transport: newTransport(),
+	}
+}
+
+// target returns the address to send the next request to.
+func (c *client) target() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.leader != "" {
+		return c.leader
+	}
+	return c.addrs[c.next]
+}
+
+// setLeader remembers the leader address.
+func (c *client) setLeader(addr string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.leader = addr
+}
+
+// rotate forgets the leader if it failed, and moves on to the next
+// node. It returns true when all nodes were tried.
+func (c *client) rotate(failed string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.leader == failed && c.leader != "" {
+		c.leader = ""
+		return false
+	}
+	c.next = (c.next + 1) % len(c.addrs)
+	return c.next == 0
+}
+
+// call sends a request to the leader, retrying on other nodes until
+// one answers as the leader or the context expires. Errors returned by
+// the leader are returned as *codeError.
+func (c *client) call(ctx context.Context, path string, req *request) (*response, error) {
+	deadline, hasDeadline := ctx.Deadline()
+	if !hasDeadline {
+		deadline = time.Now().Add(unavailableTimeout)
+	}
+	for {
+		addr := c.target()
+		resp := &response{}
+		err := c.transport.call(ctx, addr, path, req, resp)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err == nil && resp.Code != codeNotLeader {
+			c.setLeader(addr)
+			if resp.Code != "" {
+				return resp, &codeError{code: resp.Code, message: resp.Message}
+			}
+			return resp, nil
+		}
+		if err == nil && resp.Leader != "" && resp.Leader != addr {
+			// Follow the redirection right away.
+			c.setLeader(resp.Leader)
+			continue
+		}
+		if err == nil {
+			err = &codeError{code: codeNotLeader, message: fmt.Sprintf("node %v does not know the leader", addr)}
+		}
+
+		if c.rotate(addr) {
+			if time.Now().After(deadline) {
+				return nil, &codeError{code: codeUnavailable, message: fmt.Sprintf("no raft topo server leader reachable, last error: %v", err)}
+			}
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(retryDelay):
+			}
+		}
+	}
+}
+
+// close releases the connections kept open.
+func (c *client) close() {
+	c.transport.closeIdleConnections()
+}
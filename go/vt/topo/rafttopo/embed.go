@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"flag"
+	"net"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+)
+
+// RegisterEmbeddedNodeFlags registers the flags to run a raft topo
+// server node inside the current process, and the hooks that start and
+// stop it with the process. It is used by vtctld, so a few vtctld
+// instances can serve the topology without a separate service.
+func RegisterEmbeddedNodeFlags() {
+	nodeID := flag.String("topo_raft_node_id", "", "if set, run an embedded raft topo server node with this ID. It must be one of the IDs in -topo_raft_peers.")
+	peers := flag.String("topo_raft_peers", "", "comma-separated list of id=host:port for all the members of the embedded raft topo server group, including this one. The node listens on its own address.")
+	dataDir := flag.String("topo_raft_data_dir", "", "directory where the embedded raft topo server node persists its log and snapshots")
+	electionTimeout := flag.Duration("topo_raft_election_timeout", time.Second, "minimum time a raft topo server node waits without hearing from the leader before starting an election")
+	heartbeatInterval := flag.Duration("topo_raft_heartbeat_interval", 100*time.Millisecond, "how often the raft topo server leader contacts the other nodes")
+	snapshotThreshold := flag.Uint64("topo_raft_snapshot_threshold", 10000, "number of raft log entries after which a raft topo server node compacts its log into a snapshot")
+
+	var node *Node
+	servenv.OnInit(func() {
+		if *nodeID == "" {
+			return
+		}
+		peerMap, err := ParsePeers(*peers)
+		if err != nil {
+			log.Exitf("invalid -topo_raft_peers: %v", err)
+		}
+		node, err = NewNode(Config{
+			ID:                *nodeID,
+			Peers:             peerMap,
+			DataDir:           *dataDir,
+			ElectionTimeout:   *electionTimeout,
+			HeartbeatInterval: *heartbeatInterval,
+			SnapshotThreshold: *snapshotThreshold,
+		})
+		if err != nil {
+			log.Exitf("cannot create raft topo server node: %v", err)
+		}
+		lis, err := net.Listen("tcp", peerMap[*nodeID])
+		if err != nil {
+			log.Exitf("cannot listen on raft topo server node address: %v", err)
+		}
+		node.Serve(lis)
+	})
+	servenv.OnClose(func() {
+		if node != nil {
+			node.Stop()
+		}
+	})
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// convertError converts a client error into a topo error.
+func convertError(err error, nodePath string) error {
+	if err == nil {
+		return nil
+	}
+
+	switch err {
+	case context.Canceled:
+		return topo.NewError(topo.Interrupted, nodePath)
+	case context.DeadlineExceeded:
+		return topo.NewError(topo.Timeout, nodePath)
+	}
+
+	if ce, ok := err.(*codeError); ok {
+		switch ce.code {
+		case codeNoNode:
+			return topo.NewError(topo.NoNode, nodePath)
+		case codeNodeExists:
+			return topo.NewError(topo.NodeExists, nodePath)
+		case codeBadVersion:
+			return topo.NewError(topo.BadVersion, nodePath)
+		case codeUnavailable:
+			// The leader changed while processing the request,
+			// or no leader could be reached. Like for other
+			// implementations, report it as a timeout.
+			return topo.NewError(topo.Timeout, nodePath)
+		}
+	}
+	return err
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"context"
+	"path"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// Create is part of the topo.Conn interface.
+func (s *Server) Create(ctx context.Context, filePath string, contents []byte) (topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+
+	resp, err := s.cli.call(ctx, writePath, &request{
+		Op:    opCreate,
+		Key:   nodePath,
+		Value: contents,
+	})
+	if err != nil {
+		return nil, convertError(err, nodePath)
+	}
+	return RaftVersion(resp.Version), nil
+}
+
+// Update is part of the topo.Conn interface.
+func (s *Server) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+
+	req := &request{
+		Op:    opUpdate,
+		Key:   nodePath,
+		Value: contents,
+	}
+	if version != nil {
+		// The update only succeeds if the file is at this version.
+		req.Version = uint64(version.(RaftVersion))
+	}
+	resp, err := s.cli.call(ctx, writePath, req)
+	if err != nil {
+		return nil, convertError(err, nodePath)
+	}
+	return RaftVersion(resp.Version), nil
+}
+
+// Get is part of the topo.Conn interface.
+func (s *Server) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+
+	resp, err := s.cli.call(ctx, getPath, &request{Key: nodePath})
+	if err != nil {
+		return nil, nil, convertError(err, nodePath)
+	}
+	if len(resp.KVs) != 1 {
+		return nil, nil, topo.NewError(topo.NoNode, nodePath)
+	}
+	return resp.KVs[0].Value, RaftVersion(resp.KVs[0].Version), nil
+}
+
+// List is part of the topo.Conn interface.
+func (s *Server) List(ctx context.Context, filePathPrefix string) ([]topo.KVInfo, error) {
+	nodePathPrefix := path.Join(s.root, filePathPrefix)
+
+	resp, err := s.cli.call(ctx, rangePath, &request{Key: nodePathPrefix})
+	if err != nil {
+		return []topo.KVInfo{}, convertError(err, nodePathPrefix)
+	}
+	if len(resp.KVs) == 0 {
+		return []topo.KVInfo{}, topo.NewError(topo.NoNode, nodePathPrefix)
+	}
+	results := make([]topo.KVInfo, len(resp.KVs))
+	for n, kv := range resp.KVs {
+		results[n].Key = []byte(kv.Key)
+		results[n].Value = kv.Value
+		results[n].Version = RaftVersion(kv.Version)
+	}
+	return results, nil
+}
+
+// Delete is part of the topo.Conn interface.
+func (s *Server) Delete(ctx context.Context, filePath string, version topo.Version) error {
+	nodePath := path.Join(s.root, filePath)
+
+	req := &request{
+		Op:  opDelete,
+		Key: nodePath,
+	}
+	if version != nil {
+		// The delete only succeeds if the file is at this version.
+		req.Version = uint64(version.(RaftVersion))
+	}
+	_, err := s.cli.call(ctx, writePath, req)
+	return convertError(err, nodePath)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	leaseTTL = flag.Int("topo_raft_lease_ttl", 30, "Lease TTL in seconds for locks and leader election. The client keeps the lease alive while it holds the lock.")
+)
+
+// raftLockDescriptor implements topo.LockDescriptor.
+type raftLockDescriptor struct {
+	s       *Server
+	leaseID int64
+
+	// stop is closed to stop the lease keep alive.
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// Lock is part of the topo.Conn interface.
+func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockDescriptor, error) {
+	// We list the directory first to make sure it exists.
+	if _, err := s.ListDir(ctx, dirPath, false /*full*/); err != nil {
+		return nil, convertError(err, dirPath)
+	}
+
+	return s.lock(ctx, dirPath, contents)
+}
+
+// lock is used by both Lock() and leader election.
+func (s *Server) lock(ctx context.Context, nodePath, contents string) (topo.LockDescriptor, error) {
+	nodePath = path.Join(s.root, nodePath, locksPath)
+
+	// Get a lease, and keep it alive.
+	resp, err := s.cli.call(ctx, writePath, &request{
+		Op:  opGrant,
+		TTL: int64(*leaseTTL),
+	})
+	if err != nil {
+		return nil, convertError(err, nodePath)
+	}
+	ld := &raftLockDescriptor{
+		s:       s,
+		leaseID: resp.Lease,
+		stop:    make(chan struct{}),
+	}
+	go ld.keepAlive()
+
+	// Create an ephemeral node in the locks directory. Use the
+	// lease ID as the file name, so it's guaranteed unique.
+	key := fmt.Sprintf("%v/%v", nodePath, ld.leaseID)
+	resp, err = s.cli.call(ctx, writePath, &request{
+		Op:    opCreate,
+		Key:   key,
+		Value: []byte(contents),
+		Lease: ld.leaseID,
+	})
+	if err != nil {
+		ld.revokeInBackground(key)
+		return nil, convertError(err, nodePath)
+	}
+
+	// Wait until all older nodes in the locks directory are gone.
+	for {
+		done, err := s.waitOnOlder(ctx, nodePath, resp.Version)
+		if err != nil {
+			// We had an error waiting on the last node.
+			// Revoke our lease, this will delete the file.
+			ld.revokeInBackground(key)
+			return nil, err
+		}
+		if done {
+			// No more older nodes, we're it!
+			return ld, nil
+		}
+	}
+}
+
+// waitOnOlder waits on the newest file of the provided directory
+// that is older than the provided version. It returns true only if
+// there are no more older files.
+func (s *Server) waitOnOlder(ctx context.Context, nodePath string, version uint64) (bool, error) {
+	resp, err := s.cli.call(ctx, rangePath, &request{Key: nodePath + "/"})
+	if err != nil {
+		return false, convertError(err, nodePath)
+	}
+	var blocking *keyValue
+	for i, kv := range resp.KVs {
+		if kv.Version < version && (blocking == nil || kv.Version > blocking.Version) {
+			blocking = &resp.KVs[i]
+		}
+	}
+	if blocking == nil {
+		// No older file, we're done waiting.
+		return true, nil
+	}
+
+	// Wait for the blocking file to go away. Lock files are never
+	// updated, so any change means it was deleted.
+	if _, err := s.cli.call(ctx, watchPath, &request{Key: blocking.Key, Version: blocking.Version}); err != nil {
+		return false, convertError(err, nodePath)
+	}
+	return false, nil
+}
+
+// keepAlive keeps the lease alive until the lock is released, or the
+// lease is lost.
+func (ld *raftLockDescriptor) keepAlive() {
+	interval := time.Duration(*leaseTTL) * time.Second / 3
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ld.stop:
+			return
+		case <-t.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		_, err := ld.s.cli.call(ctx, keepAlivePath, &request{Lease: ld.leaseID})
+		cancel()
+		if ce, ok := err.(*codeError); ok && ce.code == codeNoLease {
+			log.Warningf("rafttopo: lease %v was lost", ld.leaseID)
+			return
+		}
+	}
+}
+
+// revokeInBackground revokes the lease after a failure, so we don't
+// leave the lock file behind for the whole TTL.
+func (ld *raftLockDescriptor) revokeInBackground(key string) {
+	ld.stopOnce.Do(func() { close(ld.stop) })
+	go func() {
+		if _, err := ld.s.cli.call(context.Background(), writePath, &request{Op: opRevoke, Lease: ld.leaseID}); err != nil {
+			log.Warningf("Revoke(%d) failed, may have left %v behind: %v", ld.leaseID, key, err)
+		}
+	}()
+}
+
+// Check is part of the topo.LockDescriptor interface.
+// We send a keep alive to make sure the lease is still active and well.
+func (ld *raftLockDescriptor) Check(ctx context.Context) error {
+	if _, err := ld.s.cli.call(ctx, keepAlivePath, &request{Lease: ld.leaseID}); err != nil {
+		return convertError(err, "lease")
+	}
+	return nil
+}
+
+// Unlock is part of the topo.LockDescriptor interface.
+func (ld *raftLockDescriptor) Unlock(ctx context.Context) error {
+	ld.stopOnce.Do(func() { close(ld.stop) })
+	if _, err := ld.s.cli.call(ctx, writePath, &request{Op: opRevoke, Lease: ld.leaseID}); err != nil {
+		return convertError(err, "lease")
+	}
+	return nil
+}
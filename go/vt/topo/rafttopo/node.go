@@ -0,0 +1,316 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// watchPollTimeout is how long a watch request waits for a change before
+// returning the current value. The client then issues a new request.
+var watchPollTimeout = 30 * time.Second
+
+// Config is the configuration of a Node.
+type Config struct {
+	// ID is the unique name of this node in the group.
+	ID string
+
+	// Peers maps the ID of every member of the group, including this
+	// node, to its host:port address. All members must use the same
+	// list.
+	Peers map[string]string
+
+	// DataDir is the directory where the node persists its log and
+	// snapshots.
+	DataDir string
+
+	// ElectionTimeout is the minimum time a follower waits without
+	// hearing from the leader before it starts an election. The actual
+	// timeout is randomized between this and twice this value.
+	ElectionTimeout time.Duration
+
+	// HeartbeatInterval is how often the leader contacts the followers
+	// when there is nothing to replicate. It should be much lower than
+	// ElectionTimeout.
+	HeartbeatInterval time.Duration
+
+	// SnapshotThreshold is the number of log entries after which the
+	// log is compacted into a snapshot.
+	SnapshotThreshold uint64
+}
+
+// ParsePeers parses a comma-separated list of id=host:port members.
+func ParsePeers(s string) (map[string]string, error) {
+	peers := make(map[string]string)
+	for _, p := range strings.Split(s, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		parts := strings.SplitN(p, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid raft peer %q: expected id=host:port", p)
+		}
+		if _, ok := peers[parts[0]]; ok {
+			return nil, fmt.Errorf("duplicate raft peer id %q", parts[0])
+		}
+		peers[parts[0]] = parts[1]
+	}
+	if len(peers) == 0 {
+		return nil, fmt.Errorf("no raft peers specified")
+	}
+	return peers, nil
+}
+
+// Node is a member of a raft topo server group. It replicates the topo
+// data with the other members, and serves the client API used by the
+// "raft" topo implementation.
+type Node struct {
+	config  Config
+	store   *store
+	storage *storage
+	raft    *raft
+
+	server   *http.Server
+	stopOnce sync.Once
+}
+
+// NewNode creates a node, and loads its persisted state. Call Serve to
+// start it.
+func NewNode(config Config) (*Node, error) {
+	if config.ID == "" {
+		return nil, fmt.Errorf("raft node ID must be set")
+	}
+	if _, ok := config.Peers[config.ID]; !ok {
+		return nil, fmt.Errorf("raft node ID %v is not in the peer list", config.ID)
+	}
+	if config.DataDir == "" {
+		return nil, fmt.Errorf("raft node data directory must be set")
+	}
+	if config.ElectionTimeout <= 0 {
+		config.ElectionTimeout = time.Second
+	}
+	if config.HeartbeatInterval <= 0 {
+		config.HeartbeatInterval = config.ElectionTimeout / 10
+	}
+	if config.HeartbeatInterval >= config.ElectionTimeout {
+		return nil, fmt.Errorf("raft heartbeat interval %v must be lower than the election timeout %v", config.HeartbeatInterval, config.ElectionTimeout)
+	}
+	if config.SnapshotThreshold == 0 {
+		config.SnapshotThreshold = 10000
+	}
+
+	st, hs, snap, entries, err := openStorage(config.DataDir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open raft storage in %v: %v", config.DataDir, err)
+	}
+	s := newStore()
+	r, err := newRaft(config, st, hs, snap, entries, s)
+	if err != nil {
+		st.close()
+		return nil, fmt.Errorf("cannot load raft state from %v: %v", config.DataDir, err)
+	}
+	return &Node{
+		config:  config,
+		store:   s,
+		storage: st,
+		raft:    r,
+	}, nil
+}
+
+// Serve starts the node, serving both the raft and the client API on
+// the listener. It returns immediately.
+func (n *Node) Serve(lis net.Listener) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(writePath, n.handleClient(n.write))
+	mux.HandleFunc(getPath, n.handleClient(n.get))
+	mux.HandleFunc(rangePath, n.handleClient(n.rangePrefix))
+	mux.HandleFunc(watchPath, n.handleClient(n.watch))
+	mux.HandleFunc(keepAlivePath, n.handleClient(n.keepAlive))
+	mux.HandleFunc(requestVotePath, func(w http.ResponseWriter, r *http.Request) {
+		req := &requestVoteRequest{}
+		if decodeJSON(w, r, req) {
+			writeJSON(w, n.raft.handleRequestVote(req))
+		}
+	})
+	mux.HandleFunc(appendEntriesPath, func(w http.ResponseWriter, r *http.Request) {
+		req := &appendEntriesRequest{}
+		if decodeJSON(w, r, req) {
+			writeJSON(w, n.raft.handleAppendEntries(req))
+		}
+	})
+	mux.HandleFunc(installSnapshotPath, func(w http.ResponseWriter, r *http.Request) {
+		req := &installSnapshotRequest{}
+		if decodeJSON(w, r, req) {
+			writeJSON(w, n.raft.handleInstallSnapshot(req))
+		}
+	})
+	n.server = &http.Server{Handler: mux}
+
+	log.Infof("rafttopo: starting node %v on %v with peers %v", n.config.ID, lis.Addr(), n.config.Peers)
+	n.raft.start()
+	go func() {
+		if err := n.server.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Errorf("rafttopo: node %v stopped serving: %v", n.config.ID, err)
+		}
+	}()
+}
+
+// Stop stops the node. Pending client requests fail.
+func (n *Node) Stop() {
+	n.stopOnce.Do(func() {
+		if n.server != nil {
+			n.server.Close()
+		}
+		n.raft.stop()
+		n.raft.transport.closeIdleConnections()
+		n.storage.close()
+	})
+}
+
+// IsLeader returns true if this node is the leader of the group.
+func (n *Node) IsLeader() bool {
+	return n.raft.isLeader()
+}
+
+// handleClient returns the HTTP handler for a client API call.
+func (n *Node) handleClient(f func(ctx context.Context, req *request) (*response, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := &request{}
+		if !decodeJSON(w, r, req) {
+			return
+		}
+		resp, err := f(r.Context(), req)
+		switch {
+		case err == errNotLeader:
+			resp = &response{Code: codeNotLeader, Leader: n.raft.leaderAddress()}
+		case err != nil:
+			resp = &response{Code: codeUnavailable, Message: err.Error()}
+		}
+		writeJSON(w, resp)
+	}
+}
+
+// write replicates a command.
+func (n *Node) write(ctx context.Context, req *request) (*response, error) {
+	switch req.Op {
+	case opCreate, opUpdate, opDelete, opRevoke:
+	case opGrant:
+		if req.TTL <= 0 {
+			return nil, fmt.Errorf("invalid lease TTL %v", req.TTL)
+		}
+	default:
+		return nil, fmt.Errorf("unknown operation %q", req.Op)
+	}
+	return n.raft.propose(ctx, encodeRequest(req))
+}
+
+// get returns a single key.
+func (n *Node) get(ctx context.Context, req *request) (*response, error) {
+	if err := n.raft.readBarrier(ctx); err != nil {
+		return nil, err
+	}
+	resp := &response{}
+	if kv, _ := n.store.get(req.Key); kv != nil {
+		resp.KVs = []keyValue{*kv}
+	}
+	return resp, nil
+}
+
+// rangePrefix returns all the keys starting with req.Key.
+func (n *Node) rangePrefix(ctx context.Context, req *request) (*response, error) {
+	if err := n.raft.readBarrier(ctx); err != nil {
+		return nil, err
+	}
+	return &response{KVs: n.store.rangePrefix(req.Key)}, nil
+}
+
+// watch waits until the version of a key is different from req.Version,
+// or the key is deleted, and returns its current value. It returns
+// after watchPollTimeout even if nothing changed.
+func (n *Node) watch(ctx context.Context, req *request) (*response, error) {
+	timeout := time.NewTimer(watchPollTimeout)
+	defer timeout.Stop()
+
+	// Check periodically that we are still the leader, so the client
+	// doesn't wait on a deposed one.
+	recheck := time.NewTicker(n.config.ElectionTimeout)
+	defer recheck.Stop()
+
+	for {
+		if err := n.raft.readBarrier(ctx); err != nil {
+			return nil, err
+		}
+		kv, changed := n.store.get(req.Key)
+		if kv == nil {
+			return &response{}, nil
+		}
+		if kv.Version != req.Version {
+			return &response{KVs: []keyValue{*kv}}, nil
+		}
+
+		select {
+		case <-changed:
+		case <-recheck.C:
+		case <-timeout.C:
+			return &response{KVs: []keyValue{*kv}}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// keepAlive extends the TTL of a lease.
+func (n *Node) keepAlive(ctx context.Context, req *request) (*response, error) {
+	if err := n.raft.readBarrier(ctx); err != nil {
+		return nil, err
+	}
+	if !n.store.keepAlive(req.Lease, time.Now()) {
+		return &response{Code: codeNoLease}, nil
+	}
+	return &response{}, nil
+}
+
+// decodeJSON decodes the request body. On error, it writes the HTTP
+// error and returns false.
+func decodeJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return false
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		http.Error(w, fmt.Sprintf("cannot decode request: %v", err), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Warningf("rafttopo: cannot write response: %v", err)
+	}
+}
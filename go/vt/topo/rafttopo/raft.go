@@ -0,0 +1,935 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+)
+
+// errNotLeader is returned by the raft node when an operation needs
+// the leader, and this node is not it.
+var errNotLeader = errors.New("not the raft leader")
+
+// errLeadershipLost is returned for a proposal when the node stopped
+// being the leader before the proposal was applied. The proposal may
+// or may not be committed later.
+var errLeadershipLost = errors.New("raft leadership lost while waiting for the proposal to be applied")
+
+// errStopped is returned when the node is shutting down.
+var errStopped = errors.New("raft node stopped")
+
+// snapshotLogger throttles the errors sending snapshots, which are
+// retried as long as the follower is down.
+var snapshotLogger = logutil.NewThrottledLogger("RaftSnapshot", 10*time.Second)
+
+// maxEntriesPerAppend is the maximum number of entries sent in one
+// AppendEntries RPC.
+const maxEntriesPerAppend = 256
+
+type nodeState int
+
+const (
+	stateFollower nodeState = iota
+	stateCandidate
+	stateLeader
+)
+
+func (s nodeState) String() string {
+	switch s {
+	case stateFollower:
+		return "follower"
+	case stateCandidate:
+		return "candidate"
+	case stateLeader:
+		return "leader"
+	}
+	return fmt.Sprintf("nodeState(%d)", int(s))
+}
+
+// entry is a record of the replicated log. Data is a JSON encoded
+// request, or empty for the no-op entry a new leader appends.
+type entry struct {
+	Term  uint64 `json:"term"`
+	Index uint64 `json:"index"`
+	Data  []byte `json:"data,omitempty"`
+}
+
+// requestVoteRequest is the RequestVote RPC request.
+type requestVoteRequest struct {
+	Term         uint64 `json:"term"`
+	CandidateID  string `json:"candidate_id"`
+	LastLogIndex uint64 `json:"last_log_index"`
+	LastLogTerm  uint64 `json:"last_log_term"`
+}
+
+// requestVoteResponse is the RequestVote RPC response.
+type requestVoteResponse struct {
+	Term        uint64 `json:"term"`
+	VoteGranted bool   `json:"vote_granted"`
+}
+
+// appendEntriesRequest is the AppendEntries RPC request.
+type appendEntriesRequest struct {
+	Term         uint64  `json:"term"`
+	LeaderID     string  `json:"leader_id"`
+	PrevLogIndex uint64  `json:"prev_log_index"`
+	PrevLogTerm  uint64  `json:"prev_log_term"`
+	Entries      []entry `json:"entries,omitempty"`
+	LeaderCommit uint64  `json:"leader_commit"`
+}
+
+// appendEntriesResponse is the AppendEntries RPC response. On failure,
+// ConflictIndex is the index the leader should retry from.
+type appendEntriesResponse struct {
+	Term          uint64 `json:"term"`
+	Success       bool   `json:"success"`
+	ConflictIndex uint64 `json:"conflict_index,omitempty"`
+}
+
+// installSnapshotRequest is the InstallSnapshot RPC request. Snapshots
+// are small enough for the deployments this is meant for that they are
+// sent in one piece.
+type installSnapshotRequest struct {
+	Term     uint64    `json:"term"`
+	LeaderID string    `json:"leader_id"`
+	Snapshot *snapshot `json:"snapshot"`
+}
+
+// installSnapshotResponse is the InstallSnapshot RPC response.
+type installSnapshotResponse struct {
+	Term uint64 `json:"term"`
+}
+
+// raft implements the raft consensus algorithm for a static group of
+// nodes, replicating a log of commands applied to a store.
+type raft struct {
+	id        string
+	peers     map[string]string
+	config    Config
+	storage   *storage
+	store     *store
+	transport *transport
+
+	mu sync.Mutex
+	// applyCond is signaled when commitIndex or lastApplied move, when
+	// the node steps down, or on stop.
+	applyCond *sync.Cond
+
+	state    nodeState
+	term     uint64
+	votedFor string
+	leaderID string
+
+	// The log is snap followed by entries, entries[i] has index
+	// snap.Index+1+i.
+	snap    snapshot
+	entries []entry
+
+	commitIndex uint64
+	lastApplied uint64
+	// applying is true while the applier works outside of r.mu.
+	applying bool
+
+	// electionDeadline is when a follower starts an election if it
+	// hasn't heard from a leader.
+	electionDeadline time.Time
+	// lastLeaderContact is the last time a follower heard from the
+	// leader. Vote requests received shortly after are ignored, so a
+	// node that was partitioned away cannot disrupt the group.
+	lastLeaderContact time.Time
+
+	// Leader state.
+	termStartIndex uint64
+	nextIndex      map[string]uint64
+	matchIndex     map[string]uint64
+	lastAck        map[string]time.Time
+	replicate      map[string]chan struct{}
+	waiters        map[uint64]chan *response
+
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// newRaft creates a raft node from the content of its storage. It does
+// not start it.
+func newRaft(config Config, st *storage, hs hardState, snap *snapshot, entries []entry, s *store) (*raft, error) {
+	r := &raft{
+		id:        config.ID,
+		peers:     config.Peers,
+		config:    config,
+		storage:   st,
+		store:     s,
+		transport: newTransport(),
+		state:     stateFollower,
+		term:      hs.Term,
+		votedFor:  hs.VotedFor,
+		entries:   entries,
+		waiters:   make(map[uint64]chan *response),
+	}
+	r.applyCond = sync.NewCond(&r.mu)
+	if snap != nil {
+		if err := s.restore(snap.Data); err != nil {
+			return nil, err
+		}
+		r.snap = *snap
+		r.commitIndex = snap.Index
+		r.lastApplied = snap.Index
+	}
+	if len(entries) > 0 && entries[0].Index != r.snap.Index+1 {
+		return nil, fmt.Errorf("log starts at index %v but snapshot ends at index %v", entries[0].Index, r.snap.Index)
+	}
+	return r, nil
+}
+
+// start starts the background routines.
+func (r *raft) start() {
+	r.mu.Lock()
+	r.resetElectionDeadline()
+	r.mu.Unlock()
+
+	r.wg.Add(2)
+	go r.ticker()
+	go r.applier()
+}
+
+// stop stops the background routines and fails pending proposals.
+func (r *raft) stop() {
+	r.mu.Lock()
+	r.stopped = true
+	r.state = stateFollower
+	r.leaderID = ""
+	r.stopReplicators()
+	r.failWaiters(errStopped)
+	r.applyCond.Broadcast()
+	r.mu.Unlock()
+
+	r.wg.Wait()
+}
+
+// lastIndex returns the index of the last log entry. r.mu must be held.
+func (r *raft) lastIndex() uint64 {
+	return r.snap.Index + uint64(len(r.entries))
+}
+
+// lastTerm returns the term of the last log entry. r.mu must be held.
+func (r *raft) lastTerm() uint64 {
+	if len(r.entries) == 0 {
+		return r.snap.Term
+	}
+	return r.entries[len(r.entries)-1].Term
+}
+
+// termAt returns the term of the entry at the provided index, and
+// false if the index is not in the log or compacted in the snapshot.
+// r.mu must be held.
+func (r *raft) termAt(index uint64) (uint64, bool) {
+	if index == r.snap.Index {
+		return r.snap.Term, true
+	}
+	if index < r.snap.Index || index > r.lastIndex() {
+		return 0, false
+	}
+	return r.entries[index-r.snap.Index-1].Term, true
+}
+
+// quorum returns the number of nodes that make a majority.
+func (r *raft) quorum() int {
+	return len(r.peers)/2 + 1
+}
+
+// resetElectionDeadline picks a new random election deadline.
+// r.mu must be held.
+func (r *raft) resetElectionDeadline() {
+	timeout := r.config.ElectionTimeout + time.Duration(rand.Int63n(int64(r.config.ElectionTimeout)))
+	r.electionDeadline = time.Now().Add(timeout)
+}
+
+// persistState saves the hard state. r.mu must be held.
+func (r *raft) persistState() {
+	if err := r.storage.saveState(hardState{Term: r.term, VotedFor: r.votedFor}); err != nil {
+		// We cannot safely go on without our vote persisted.
+		log.Exitf("rafttopo: cannot persist raft state: %v", err)
+	}
+}
+
+// becomeFollower steps down to follower in the provided term.
+// r.mu must be held.
+func (r *raft) becomeFollower(term uint64, leaderID string) {
+	wasLeader := r.state == stateLeader
+	if term > r.term {
+		r.term = term
+		r.votedFor = ""
+		r.persistState()
+	}
+	r.state = stateFollower
+	if r.leaderID != leaderID && leaderID != "" {
+		log.Infof("rafttopo: node %v following leader %v in term %v", r.id, leaderID, r.term)
+	}
+	r.leaderID = leaderID
+	if wasLeader {
+		log.Infof("rafttopo: node %v stepping down in term %v", r.id, r.term)
+		r.stopReplicators()
+		r.failWaiters(errLeadershipLost)
+		// Wake up the read barriers.
+		r.applyCond.Broadcast()
+	}
+}
+
+// ticker drives elections, and lease expiration on the leader.
+func (r *raft) ticker() {
+	defer r.wg.Done()
+
+	t := time.NewTicker(r.config.HeartbeatInterval / 2)
+	defer t.Stop()
+	for range t.C {
+		r.mu.Lock()
+		if r.stopped {
+			r.mu.Unlock()
+			return
+		}
+		switch r.state {
+		case stateLeader:
+			if !r.hasQuorumContact() {
+				// We can't reach a majority, someone else
+				// may be elected on the other side.
+				log.Warningf("rafttopo: node %v lost contact with a majority of the group", r.id)
+				r.becomeFollower(r.term, "")
+				r.resetElectionDeadline()
+				r.mu.Unlock()
+				continue
+			}
+			r.mu.Unlock()
+			r.expireLeases()
+		default:
+			if time.Now().After(r.electionDeadline) {
+				r.startElection()
+			}
+			r.mu.Unlock()
+		}
+	}
+}
+
+// expireLeases revokes the leases that were not kept alive.
+func (r *raft) expireLeases() {
+	for _, id := range r.store.expiredLeases(time.Now(), r.config.ElectionTimeout) {
+		id := id
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), r.config.ElectionTimeout)
+			defer cancel()
+			data := encodeRequest(&request{Op: opRevoke, Lease: id})
+			if _, err := r.propose(ctx, data); err != nil {
+				log.Warningf("rafttopo: cannot revoke expired lease %v: %v", id, err)
+			}
+		}()
+	}
+}
+
+// hasQuorumContact returns true if a majority of the group, including
+// this leader, acknowledged one of its requests within the election
+// timeout. r.mu must be held.
+func (r *raft) hasQuorumContact() bool {
+	count := 1
+	now := time.Now()
+	for id := range r.peers {
+		if id == r.id {
+			continue
+		}
+		if now.Sub(r.lastAck[id]) < r.config.ElectionTimeout {
+			count++
+		}
+	}
+	return count >= r.quorum()
+}
+
+// startElection starts a new term and asks for votes. r.mu must be held.
+func (r *raft) startElection() {
+	r.state = stateCandidate
+	r.term++
+	r.votedFor = r.id
+	r.leaderID = ""
+	r.persistState()
+	r.resetElectionDeadline()
+	log.Infof("rafttopo: node %v starting election for term %v", r.id, r.term)
+
+	req := &requestVoteRequest{
+		Term:         r.term,
+		CandidateID:  r.id,
+		LastLogIndex: r.lastIndex(),
+		LastLogTerm:  r.lastTerm(),
+	}
+	votes := 1
+	if votes >= r.quorum() {
+		r.becomeLeader()
+		return
+	}
+	for id, addr := range r.peers {
+		if id == r.id {
+			continue
+		}
+		go func(addr string) {
+			ctx, cancel := context.WithTimeout(context.Background(), r.config.ElectionTimeout)
+			defer cancel()
+			resp := &requestVoteResponse{}
+			if err := r.transport.call(ctx, addr, requestVotePath, req, resp); err != nil {
+				return
+			}
+
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			if resp.Term > r.term {
+				r.becomeFollower(resp.Term, "")
+				return
+			}
+			if r.state != stateCandidate || r.term != req.Term || !resp.VoteGranted {
+				return
+			}
+			votes++
+			if votes >= r.quorum() {
+				r.becomeLeader()
+			}
+		}(addr)
+	}
+}
+
+// becomeLeader switches to leader and starts replicating.
+// r.mu must be held.
+func (r *raft) becomeLeader() {
+	log.Infof("rafttopo: node %v is the leader for term %v", r.id, r.term)
+	r.state = stateLeader
+	r.leaderID = r.id
+	r.nextIndex = make(map[string]uint64)
+	r.matchIndex = make(map[string]uint64)
+	r.lastAck = make(map[string]time.Time)
+	r.replicate = make(map[string]chan struct{})
+
+	// Append a no-op entry, committing it commits everything before
+	// it, and tells us when we can serve reads.
+	r.appendLocal(nil)
+	r.termStartIndex = r.lastIndex()
+
+	now := time.Now()
+	r.store.refreshLeases(now)
+	for id, addr := range r.peers {
+		if id == r.id {
+			continue
+		}
+		r.nextIndex[id] = r.lastIndex()
+		r.lastAck[id] = now
+		c := make(chan struct{}, 1)
+		r.replicate[id] = c
+		r.wg.Add(1)
+		go r.replicator(id, addr, r.term, c)
+	}
+	r.advanceCommitIndex()
+}
+
+// stopReplicators stops the leader replication routines.
+// r.mu must be held.
+func (r *raft) stopReplicators() {
+	for id, c := range r.replicate {
+		close(c)
+		delete(r.replicate, id)
+	}
+}
+
+// failWaiters fails all pending proposals. r.mu must be held.
+func (r *raft) failWaiters(err error) {
+	for index, c := range r.waiters {
+		c <- &response{Code: codeUnavailable, Message: err.Error()}
+		delete(r.waiters, index)
+	}
+}
+
+// appendLocal appends a new entry to the leader log, and returns its
+// index. r.mu must be held.
+func (r *raft) appendLocal(data []byte) uint64 {
+	e := entry{
+		Term:  r.term,
+		Index: r.lastIndex() + 1,
+		Data:  data,
+	}
+	if err := r.storage.append([]entry{e}); err != nil {
+		log.Exitf("rafttopo: cannot append to raft log: %v", err)
+	}
+	r.entries = append(r.entries, e)
+	for _, c := range r.replicate {
+		select {
+		case c <- struct{}{}:
+		default:
+		}
+	}
+	return e.Index
+}
+
+// propose replicates a command, and returns the result of applying it.
+func (r *raft) propose(ctx context.Context, data []byte) (*response, error) {
+	r.mu.Lock()
+	if r.state != stateLeader {
+		r.mu.Unlock()
+		return nil, errNotLeader
+	}
+	index := r.appendLocal(data)
+	c := make(chan *response, 1)
+	r.waiters[index] = c
+	r.advanceCommitIndex()
+	r.mu.Unlock()
+
+	select {
+	case resp := <-c:
+		return resp, nil
+	case <-ctx.Done():
+		r.mu.Lock()
+		delete(r.waiters, index)
+		r.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// readBarrier waits until it is safe for the leader to serve reads
+// from its store: the store must include everything committed before
+// the call, and we must still be the leader.
+func (r *raft) readBarrier(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var done chan struct{}
+	for {
+		if r.state != stateLeader || !r.hasQuorumContact() {
+			return errNotLeader
+		}
+		if r.commitIndex >= r.termStartIndex && r.lastApplied >= r.commitIndex {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if done == nil {
+			done = make(chan struct{})
+			defer close(done)
+			go r.wakeOnDone(ctx, done)
+		}
+		r.applyCond.Wait()
+	}
+}
+
+// wakeOnDone wakes up the waiters of applyCond when ctx is done, unless
+// done is closed first.
+func (r *raft) wakeOnDone(ctx context.Context, done chan struct{}) {
+	select {
+	case <-ctx.Done():
+		r.mu.Lock()
+		r.applyCond.Broadcast()
+		r.mu.Unlock()
+	case <-done:
+	}
+}
+
+// isLeader returns true if this node is the leader.
+func (r *raft) isLeader() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.state == stateLeader
+}
+
+// leaderAddress returns the address of the current leader, if known.
+func (r *raft) leaderAddress() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.peers[r.leaderID]
+}
+
+// advanceCommitIndex moves commitIndex to the highest index of the
+// current term stored on a majority. r.mu must be held.
+func (r *raft) advanceCommitIndex() {
+	matches := []uint64{r.lastIndex()}
+	for id := range r.peers {
+		if id != r.id {
+			matches = append(matches, r.matchIndex[id])
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i] > matches[j] })
+	n := matches[r.quorum()-1]
+	if n <= r.commitIndex {
+		return
+	}
+	// Only entries from the current term are committed by
+	// counting replicas.
+	if term, ok := r.termAt(n); !ok || term != r.term {
+		return
+	}
+	r.commitIndex = n
+	r.applyCond.Broadcast()
+	for _, c := range r.replicate {
+		select {
+		case c <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// replicator sends the log to a follower, until the term changes.
+func (r *raft) replicator(id, addr string, term uint64, trigger chan struct{}) {
+	defer r.wg.Done()
+
+	heartbeat := time.NewTicker(r.config.HeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		r.sendToFollower(id, addr, term)
+
+		select {
+		case _, ok := <-trigger:
+			if !ok {
+				return
+			}
+		case <-heartbeat.C:
+		}
+	}
+}
+
+// sendToFollower sends one AppendEntries or InstallSnapshot RPC to
+// a follower, and processes the response.
+func (r *raft) sendToFollower(id, addr string, term uint64) {
+	r.mu.Lock()
+	if r.state != stateLeader || r.term != term {
+		r.mu.Unlock()
+		return
+	}
+	next := r.nextIndex[id]
+	prevIndex := next - 1
+	prevTerm, ok := r.termAt(prevIndex)
+	if !ok {
+		// The follower is too far behind, the entries it needs
+		// were compacted.
+		snap := r.snap
+		req := &installSnapshotRequest{
+			Term:     term,
+			LeaderID: r.id,
+			Snapshot: &snap,
+		}
+		r.mu.Unlock()
+		r.sendSnapshot(id, addr, req)
+		return
+	}
+	req := &appendEntriesRequest{
+		Term:         term,
+		LeaderID:     r.id,
+		PrevLogIndex: prevIndex,
+		PrevLogTerm:  prevTerm,
+		LeaderCommit: r.commitIndex,
+	}
+	if last := r.lastIndex(); last >= next {
+		if last-next >= maxEntriesPerAppend {
+			last = next + maxEntriesPerAppend - 1
+		}
+		req.Entries = append([]entry(nil), r.entries[next-r.snap.Index-1:last-r.snap.Index]...)
+	}
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.ElectionTimeout)
+	defer cancel()
+	resp := &appendEntriesResponse{}
+	if err := r.transport.call(ctx, addr, appendEntriesPath, req, resp); err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if resp.Term > r.term {
+		r.becomeFollower(resp.Term, "")
+		r.resetElectionDeadline()
+		return
+	}
+	if r.state != stateLeader || r.term != term {
+		return
+	}
+	r.lastAck[id] = time.Now()
+	if !resp.Success {
+		if resp.ConflictIndex > 0 && resp.ConflictIndex < r.nextIndex[id] {
+			r.nextIndex[id] = resp.ConflictIndex
+		} else if r.nextIndex[id] > 1 {
+			r.nextIndex[id]--
+		}
+		r.trigger(id)
+		return
+	}
+	match := req.PrevLogIndex + uint64(len(req.Entries))
+	if match > r.matchIndex[id] {
+		r.matchIndex[id] = match
+	}
+	r.nextIndex[id] = match + 1
+	r.advanceCommitIndex()
+	if r.nextIndex[id] <= r.lastIndex() {
+		r.trigger(id)
+	}
+}
+
+// sendSnapshot sends our snapshot to a follower.
+func (r *raft) sendSnapshot(id, addr string, req *installSnapshotRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*r.config.ElectionTimeout)
+	defer cancel()
+	resp := &installSnapshotResponse{}
+	if err := r.transport.call(ctx, addr, installSnapshotPath, req, resp); err != nil {
+		snapshotLogger.Warningf("rafttopo: cannot send snapshot to %v: %v", id, err)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if resp.Term > r.term {
+		r.becomeFollower(resp.Term, "")
+		r.resetElectionDeadline()
+		return
+	}
+	if r.state != stateLeader || r.term != req.Term {
+		return
+	}
+	r.lastAck[id] = time.Now()
+	if req.Snapshot.Index > r.matchIndex[id] {
+		r.matchIndex[id] = req.Snapshot.Index
+	}
+	r.nextIndex[id] = req.Snapshot.Index + 1
+	r.advanceCommitIndex()
+	r.trigger(id)
+}
+
+// trigger wakes up the replicator of a follower. r.mu must be held.
+func (r *raft) trigger(id string) {
+	if c, ok := r.replicate[id]; ok {
+		select {
+		case c <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// handleRequestVote processes a RequestVote RPC.
+func (r *raft) handleRequestVote(req *requestVoteRequest) *requestVoteResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.state != stateLeader && r.leaderID != "" && time.Since(r.lastLeaderContact) < r.config.ElectionTimeout {
+		// We have a live leader, ignore the request.
+		return &requestVoteResponse{Term: r.term}
+	}
+	if req.Term > r.term {
+		r.becomeFollower(req.Term, "")
+	}
+	resp := &requestVoteResponse{Term: r.term}
+	if req.Term < r.term {
+		return resp
+	}
+	upToDate := req.LastLogTerm > r.lastTerm() || (req.LastLogTerm == r.lastTerm() && req.LastLogIndex >= r.lastIndex())
+	if (r.votedFor == "" || r.votedFor == req.CandidateID) && upToDate {
+		r.votedFor = req.CandidateID
+		r.persistState()
+		r.resetElectionDeadline()
+		resp.VoteGranted = true
+	}
+	return resp
+}
+
+// handleAppendEntries processes an AppendEntries RPC.
+func (r *raft) handleAppendEntries(req *appendEntriesRequest) *appendEntriesResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if req.Term < r.term {
+		return &appendEntriesResponse{Term: r.term}
+	}
+	r.becomeFollower(req.Term, req.LeaderID)
+	r.lastLeaderContact = time.Now()
+	r.resetElectionDeadline()
+	resp := &appendEntriesResponse{Term: r.term}
+
+	if req.PrevLogIndex > r.lastIndex() {
+		resp.ConflictIndex = r.lastIndex() + 1
+		return resp
+	}
+	if req.PrevLogIndex >= r.snap.Index {
+		if term, _ := r.termAt(req.PrevLogIndex); term != req.PrevLogTerm {
+			// Skip back over the whole conflicting term.
+			index := req.PrevLogIndex
+			for index > r.snap.Index+1 {
+				if t, _ := r.termAt(index - 1); t != term {
+					break
+				}
+				index--
+			}
+			resp.ConflictIndex = index
+			return resp
+		}
+	}
+
+	// Append the entries we don't have, truncating our log at the
+	// first conflict.
+	var toAppend []entry
+	for i, e := range req.Entries {
+		if e.Index <= r.snap.Index {
+			continue
+		}
+		if term, ok := r.termAt(e.Index); ok {
+			if term == e.Term {
+				continue
+			}
+			r.entries = r.entries[:e.Index-r.snap.Index-1]
+			if err := r.storage.rewrite(r.entries); err != nil {
+				log.Exitf("rafttopo: cannot truncate raft log: %v", err)
+			}
+		}
+		toAppend = req.Entries[i:]
+		break
+	}
+	if len(toAppend) > 0 {
+		if err := r.storage.append(toAppend); err != nil {
+			log.Exitf("rafttopo: cannot append to raft log: %v", err)
+		}
+		r.entries = append(r.entries, toAppend...)
+	}
+
+	lastNew := req.PrevLogIndex + uint64(len(req.Entries))
+	if req.LeaderCommit > r.commitIndex {
+		commit := req.LeaderCommit
+		if lastNew < commit {
+			commit = lastNew
+		}
+		if commit > r.commitIndex {
+			r.commitIndex = commit
+			r.applyCond.Broadcast()
+		}
+	}
+	resp.Success = true
+	return resp
+}
+
+// handleInstallSnapshot processes an InstallSnapshot RPC.
+func (r *raft) handleInstallSnapshot(req *installSnapshotRequest) *installSnapshotResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if req.Term < r.term {
+		return &installSnapshotResponse{Term: r.term}
+	}
+	r.becomeFollower(req.Term, req.LeaderID)
+	r.lastLeaderContact = time.Now()
+	r.resetElectionDeadline()
+	resp := &installSnapshotResponse{Term: r.term}
+
+	snap := req.Snapshot
+	if snap == nil || snap.Index <= r.commitIndex {
+		// We already have all of it.
+		return resp
+	}
+
+	// Keep the entries that follow the snapshot if our log matches
+	// it, drop everything otherwise.
+	var entries []entry
+	if term, ok := r.termAt(snap.Index); ok && term == snap.Term {
+		entries = append(entries, r.entries[snap.Index-r.snap.Index:]...)
+	}
+
+	// Wait for the applier to be idle before replacing the store.
+	for r.applying {
+		r.applyCond.Wait()
+	}
+	if err := r.store.restore(snap.Data); err != nil {
+		log.Errorf("rafttopo: cannot restore snapshot from leader %v: %v", req.LeaderID, err)
+		return resp
+	}
+	if err := r.storage.saveSnapshot(snap, entries); err != nil {
+		log.Exitf("rafttopo: cannot save snapshot: %v", err)
+	}
+	log.Infof("rafttopo: node %v installed snapshot at index %v from leader %v", r.id, snap.Index, req.LeaderID)
+	r.snap = *snap
+	r.entries = entries
+	r.commitIndex = snap.Index
+	r.lastApplied = snap.Index
+	return resp
+}
+
+// applier applies committed entries to the store, and answers the
+// pending proposals.
+func (r *raft) applier() {
+	defer r.wg.Done()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for {
+		for !r.stopped && r.lastApplied >= r.commitIndex {
+			r.applyCond.Wait()
+		}
+		if r.stopped {
+			return
+		}
+
+		first := r.lastApplied + 1
+		entries := append([]entry(nil), r.entries[first-r.snap.Index-1:r.commitIndex-r.snap.Index]...)
+		r.applying = true
+		r.mu.Unlock()
+
+		results := make([]*response, len(entries))
+		for i, e := range entries {
+			results[i] = r.store.apply(e.Index, e.Data)
+		}
+
+		r.mu.Lock()
+		r.applying = false
+		for i, e := range entries {
+			if c, ok := r.waiters[e.Index]; ok {
+				c <- results[i]
+				delete(r.waiters, e.Index)
+			}
+		}
+		r.lastApplied = entries[len(entries)-1].Index
+		r.applyCond.Broadcast()
+		r.maybeSnapshot()
+	}
+}
+
+// maybeSnapshot compacts the log if it grew past the threshold. It is
+// called by the applier, r.mu must be held.
+func (r *raft) maybeSnapshot() {
+	if r.lastApplied-r.snap.Index < r.config.SnapshotThreshold {
+		return
+	}
+	data, err := r.store.snapshot()
+	if err != nil {
+		log.Errorf("rafttopo: cannot snapshot store: %v", err)
+		return
+	}
+	term, _ := r.termAt(r.lastApplied)
+	snap := snapshot{
+		Index: r.lastApplied,
+		Term:  term,
+		Data:  data,
+	}
+	entries := append([]entry(nil), r.entries[r.lastApplied-r.snap.Index:]...)
+	if err := r.storage.saveSnapshot(&snap, entries); err != nil {
+		log.Errorf("rafttopo: cannot save snapshot: %v", err)
+		return
+	}
+	r.snap = snap
+	r.entries = entries
+}
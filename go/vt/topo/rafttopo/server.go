@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package rafttopo implements topo.Server with an embedded raft group as
+the backend.
+
+A handful of processes (usually the vtctld instances of a cell) run a
+Node each. The nodes elect a leader, and replicate the topo data in an
+in-memory key/value store persisted to a local data directory. This
+avoids running a separate consensus service for small deployments.
+
+The topo implementation is registered as "raft". Its server address is
+a comma-separated list of node addresses. Only the leader serves
+requests, the client follows the redirections of the other nodes.
+
+We follow these conventions within this package:
+  - The version of a file is the index of the log entry that last
+    modified it, like an etcd ModRevision.
+  - Locks and leader elections use ephemeral files attached to leases,
+    as in the etcd2 implementation. The leases are kept alive by the
+    client, and revoked by the leader when they expire.
+  - Call convertError(err) on any errors returned by the client.
+    Functions defined in this package can be assumed to have already
+    converted errors as necessary.
+*/
+package rafttopo
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// Factory is the raft topo.Factory implementation.
+type Factory struct{}
+
+// HasGlobalReadOnlyCell is part of the topo.Factory interface.
+func (f Factory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the topo.Factory interface.
+func (f Factory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	return NewServer(serverAddr, root)
+}
+
+// Server is the implementation of topo.Server for a raft topo server
+// group.
+type Server struct {
+	// cli is the client to the group.
+	cli *client
+
+	// root is the root path for this client.
+	root string
+}
+
+// Close implements topo.Server.Close.
+// It will nil out the global and cells fields, so any attempt to
+// re-use this server will panic.
+func (s *Server) Close() {
+	s.cli.close()
+	s.cli = nil
+}
+
+// NewServer returns a new rafttopo.Server. serverAddr is a
+// comma-separated list of node addresses.
+func NewServer(serverAddr, root string) (*Server, error) {
+	var addrs []string
+	for _, addr := range strings.Split(serverAddr, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no raft topo server address in %q", serverAddr)
+	}
+	return &Server{
+		cli:  newClient(addrs),
+		root: root,
+	}, nil
+}
+
+func init() {
+	topo.RegisterFactory("raft", Factory{})
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/test"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// testCluster is a raft group running in-process.
+type testCluster struct {
+	t       *testing.T
+	configs map[string]Config
+	nodes   map[string]*Node
+}
+
+// startCluster starts a group of nodes on local ports.
+func startCluster(t *testing.T, count int, snapshotThreshold uint64) *testCluster {
+	peers := make(map[string]string)
+	listeners := make(map[string]net.Listener)
+	for i := 0; i < count; i++ {
+		id := fmt.Sprintf("node%v", i)
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("Listen() failed: %v", err)
+		}
+		peers[id] = lis.Addr().String()
+		listeners[id] = lis
+	}
+
+	c := &testCluster{
+		t:       t,
+		configs: make(map[string]Config),
+		nodes:   make(map[string]*Node),
+	}
+	for id, lis := range listeners {
+		c.configs[id] = Config{
+			ID:                id,
+			Peers:             peers,
+			DataDir:           path.Join(t.TempDir(), id),
+			ElectionTimeout:   300 * time.Millisecond,
+			HeartbeatInterval: 30 * time.Millisecond,
+			SnapshotThreshold: snapshotThreshold,
+		}
+		c.startNode(id, lis)
+	}
+	return c
+}
+
+// startNode starts a node, on the provided listener or on its address.
+func (c *testCluster) startNode(id string, lis net.Listener) {
+	config := c.configs[id]
+	if lis == nil {
+		var err error
+		if lis, err = net.Listen("tcp", config.Peers[id]); err != nil {
+			c.t.Fatalf("Listen(%v) failed: %v", config.Peers[id], err)
+		}
+	}
+	n, err := NewNode(config)
+	if err != nil {
+		c.t.Fatalf("NewNode(%v) failed: %v", id, err)
+	}
+	n.Serve(lis)
+	c.nodes[id] = n
+}
+
+// stopNode stops a node.
+func (c *testCluster) stopNode(id string) {
+	c.nodes[id].Stop()
+	delete(c.nodes, id)
+}
+
+// stop stops all the nodes.
+func (c *testCluster) stop() {
+	for id := range c.nodes {
+		c.stopNode(id)
+	}
+}
+
+// serverAddr returns the address list for the topo client.
+func (c *testCluster) serverAddr() string {
+	var addrs []string
+	for _, config := range c.configs {
+		for _, addr := range config.Peers {
+			addrs = append(addrs, addr)
+		}
+		break
+	}
+	return strings.Join(addrs, ",")
+}
+
+// waitForLeader waits until one of the running nodes is the leader,
+// and returns its ID.
+func (c *testCluster) waitForLeader() string {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		for id, n := range c.nodes {
+			if n.IsLeader() {
+				return id
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.t.Fatalf("no leader elected")
+	return ""
+}
+
+func TestRaftTopo(t *testing.T) {
+	c := startCluster(t, 3, 0)
+	defer c.stop()
+	serverAddr := c.serverAddr()
+
+	testIndex := 0
+	newServer := func() *topo.Server {
+		// Each test will use its own sub-directories.
+		testRoot := fmt.Sprintf("/test-%v", testIndex)
+		testIndex++
+
+		// Create the server on the new root.
+		ts, err := topo.OpenServer("raft", serverAddr, path.Join(testRoot, topo.GlobalCell))
+		if err != nil {
+			t.Fatalf("OpenServer() failed: %v", err)
+		}
+
+		// Create the CellInfo.
+		if err := ts.CreateCellInfo(context.Background(), test.LocalCellName, &topodatapb.CellInfo{
+			ServerAddress: serverAddr,
+			Root:          path.Join(testRoot, test.LocalCellName),
+		}); err != nil {
+			t.Fatalf("CreateCellInfo() failed: %v", err)
+		}
+
+		return ts
+	}
+
+	// Run the TopoServerTestSuite tests.
+	test.TopoServerTestSuite(t, func() *topo.Server {
+		return newServer()
+	})
+
+	// Run raft-specific tests.
+	ts := newServer()
+	testKeyspaceLock(t, ts)
+	ts.Close()
+
+	s, err := NewServer(serverAddr, "/test-lease")
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+	testLeaseExpiration(t, s)
+	s.Close()
+}
+
+// testKeyspaceLock tests the lease keep alive of locks.
+func testKeyspaceLock(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	keyspacePath := path.Join(topo.KeyspacesPath, "test_keyspace")
+	if err := ts.CreateKeyspace(ctx, "test_keyspace", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace: %v", err)
+	}
+
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell failed: %v", err)
+	}
+
+	// Short TTL, make sure it doesn't expire.
+	defer func(ttl int) { *leaseTTL = ttl }(*leaseTTL)
+	*leaseTTL = 1
+	lockDescriptor, err := conn.Lock(ctx, keyspacePath, "short ttl")
+	if err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	time.Sleep(2500 * time.Millisecond)
+	if err := lockDescriptor.Check(ctx); err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	if err := lockDescriptor.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock failed: %v", err)
+	}
+}
+
+// testLeaseExpiration makes sure ephemeral files go away when their
+// lease is not kept alive.
+func testLeaseExpiration(t *testing.T, s *Server) {
+	ctx := context.Background()
+	resp, err := s.cli.call(ctx, writePath, &request{Op: opGrant, TTL: 1})
+	if err != nil {
+		t.Fatalf("grant failed: %v", err)
+	}
+	key := path.Join(s.root, "ephemeral")
+	if _, err := s.cli.call(ctx, writePath, &request{Op: opCreate, Key: key, Lease: resp.Lease}); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if _, _, err := s.Get(ctx, "ephemeral"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		_, _, err := s.Get(ctx, "ephemeral")
+		if topo.IsErrType(err, topo.NoNode) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("ephemeral file still there after its lease expired")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if _, err := s.cli.call(ctx, keepAlivePath, &request{Lease: resp.Lease}); err == nil {
+		t.Fatalf("keep alive of an expired lease worked")
+	}
+}
+
+// TestRaftTopoFailover stops and restarts nodes, and checks the data
+// is preserved.
+func TestRaftTopoFailover(t *testing.T) {
+	// Use a small snapshot threshold, so the restarted node has to
+	// catch up from a snapshot.
+	c := startCluster(t, 3, 10)
+	defer c.stop()
+
+	s, err := NewServer(c.serverAddr(), "/failover")
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+	defer s.Close()
+
+	ctx := context.Background()
+	write := func(first, last int) {
+		for i := first; i < last; i++ {
+			if _, err := s.Create(ctx, fmt.Sprintf("file%v", i), []byte(fmt.Sprintf("value%v", i))); err != nil {
+				t.Fatalf("Create(file%v) failed: %v", i, err)
+			}
+		}
+	}
+	check := func(count int) {
+		entries, err := s.ListDir(ctx, "/", false /*full*/)
+		if err != nil {
+			t.Fatalf("ListDir failed: %v", err)
+		}
+		if len(entries) != count {
+			t.Fatalf("ListDir returned %v entries, expected %v", len(entries), count)
+		}
+		for i := 0; i < count; i++ {
+			contents, _, err := s.Get(ctx, fmt.Sprintf("file%v", i))
+			if err != nil {
+				t.Fatalf("Get(file%v) failed: %v", i, err)
+			}
+			if got, want := string(contents), fmt.Sprintf("value%v", i); got != want {
+				t.Fatalf("Get(file%v) returned %v, expected %v", i, got, want)
+			}
+		}
+	}
+
+	write(0, 20)
+
+	// Stop the leader, a new one takes over.
+	leader := c.waitForLeader()
+	c.stopNode(leader)
+	write(20, 40)
+	check(40)
+
+	// Restart the old leader, and stop another node: the restarted
+	// node has to be part of the majority.
+	c.startNode(leader, nil)
+	time.Sleep(time.Second)
+	for id := range c.nodes {
+		if id != leader {
+			c.stopNode(id)
+			break
+		}
+	}
+	write(40, 60)
+	check(60)
+
+	// Stopping one more node leaves no majority, requests time out
+	// once the leader notices.
+	for id := range c.nodes {
+		c.stopNode(id)
+		break
+	}
+	time.Sleep(time.Second)
+	shortCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	if _, _, err := s.Get(shortCtx, "file0"); !topo.IsErrType(err, topo.Timeout) {
+		t.Fatalf("Get without a majority returned %v, expected a timeout", err)
+	}
+}
+
+// TestRaftTopoSingleNode checks a group of one node elects itself.
+func TestRaftTopoSingleNode(t *testing.T) {
+	c := startCluster(t, 1, 0)
+	defer c.stop()
+
+	s, err := NewServer(c.serverAddr(), "/single")
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+	defer s.Close()
+
+	ctx := context.Background()
+	version, err := s.Create(ctx, "file", []byte("value"))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	contents, getVersion, err := s.Get(ctx, "file")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if string(contents) != "value" || getVersion != version {
+		t.Fatalf("Get returned %q at version %v, expected %q at version %v", contents, getVersion, "value", version)
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// Files in the data directory of a node.
+const (
+	stateFile    = "state.json"
+	snapshotFile = "snapshot.json"
+	logFile      = "log.json"
+)
+
+// hardState is the raft state that has to be persisted before
+// answering any RPC.
+type hardState struct {
+	Term     uint64 `json:"term"`
+	VotedFor string `json:"voted_for,omitempty"`
+}
+
+// snapshot is a compacted prefix of the log.
+type snapshot struct {
+	Index uint64 `json:"index"`
+	Term  uint64 `json:"term"`
+	Data  []byte `json:"data"`
+}
+
+// storage persists the state of a raft node in a directory:
+//   - the hard state and the last snapshot are rewritten atomically.
+//   - the log is a stream of JSON entries, appended to and synced for
+//     every write, and rewritten when it is truncated or compacted.
+type storage struct {
+	dir string
+	log *os.File
+}
+
+// openStorage opens the storage in the provided directory, creating it
+// if necessary, and returns what it contains. snap is nil if no
+// snapshot was taken yet.
+func openStorage(dir string) (s *storage, hs hardState, snap *snapshot, entries []entry, err error) {
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return nil, hs, nil, nil, err
+	}
+	if err = readJSONFile(path.Join(dir, stateFile), &hs); err != nil && !os.IsNotExist(err) {
+		return nil, hs, nil, nil, err
+	}
+	snap = &snapshot{}
+	if err = readJSONFile(path.Join(dir, snapshotFile), snap); err != nil {
+		if !os.IsNotExist(err) {
+			return nil, hs, nil, nil, err
+		}
+		snap = nil
+	}
+
+	f, err := os.OpenFile(path.Join(dir, logFile), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, hs, nil, nil, err
+	}
+	dec := json.NewDecoder(bufio.NewReader(f))
+	var good int64
+	for {
+		e := entry{}
+		if err := dec.Decode(&e); err != nil {
+			if err != io.EOF {
+				// The last write was probably interrupted, the
+				// entry was never acknowledged, drop it.
+				log.Warningf("rafttopo: truncating log %v at offset %v after decoding error: %v", f.Name(), good, err)
+				if err := f.Truncate(good); err != nil {
+					f.Close()
+					return nil, hs, nil, nil, err
+				}
+			}
+			break
+		}
+		good = dec.InputOffset()
+		if snap != nil && e.Index <= snap.Index {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if _, err := f.Seek(good, io.SeekStart); err != nil {
+		f.Close()
+		return nil, hs, nil, nil, err
+	}
+	return &storage{dir: dir, log: f}, hs, snap, entries, nil
+}
+
+// saveState persists the hard state.
+func (s *storage) saveState(hs hardState) error {
+	return writeJSONFile(path.Join(s.dir, stateFile), hs)
+}
+
+// saveSnapshot persists a snapshot, then rewrites the log with the
+// entries that follow it.
+func (s *storage) saveSnapshot(snap *snapshot, entries []entry) error {
+	if err := writeJSONFile(path.Join(s.dir, snapshotFile), snap); err != nil {
+		return err
+	}
+	return s.rewrite(entries)
+}
+
+// append adds entries at the end of the log.
+func (s *storage) append(entries []entry) error {
+	w := bufio.NewWriter(s.log)
+	enc := json.NewEncoder(w)
+	for i := range entries {
+		if err := enc.Encode(&entries[i]); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return s.log.Sync()
+}
+
+// rewrite replaces the log with the provided entries.
+func (s *storage) rewrite(entries []entry) error {
+	name := path.Join(s.dir, logFile)
+	f, err := os.OpenFile(name+".tmp", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	old := s.log
+	s.log = f
+	if err := s.append(entries); err != nil {
+		s.log = old
+		f.Close()
+		return err
+	}
+	if err := os.Rename(name+".tmp", name); err != nil {
+		s.log = old
+		f.Close()
+		return err
+	}
+	old.Close()
+	return nil
+}
+
+// close closes the log file.
+func (s *storage) close() {
+	if err := s.log.Close(); err != nil {
+		log.Warningf("rafttopo: closing log file failed: %v", err)
+	}
+}
+
+func readJSONFile(name string, v interface{}) error {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// writeJSONFile atomically replaces a file with the JSON encoding of v.
+func writeJSONFile(name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(name+".tmp", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
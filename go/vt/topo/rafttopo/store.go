@@ -0,0 +1,325 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// lease is a replicated lease. All keys attached to a lease are
+// deleted when it is revoked.
+type lease struct {
+	ID  int64 `json:"id"`
+	TTL int64 `json:"ttl"`
+
+	// keys is the set of keys attached to the lease. It is
+	// rebuilt from the keys when a snapshot is restored.
+	keys map[string]bool
+}
+
+// storeSnapshot is the serialized form of a store.
+type storeSnapshot struct {
+	Index  uint64      `json:"index"`
+	KVs    []*keyValue `json:"kvs"`
+	Leases []*lease    `json:"leases"`
+}
+
+// store is the key/value state machine replicated by raft. Its content
+// is only modified by applying committed log entries, in order, so it
+// is identical on all nodes. Lease expiration times are the exception:
+// they are only tracked in memory, and only acted upon by the leader.
+type store struct {
+	mu sync.Mutex
+
+	// index is the index of the last applied log entry.
+	index  uint64
+	kvs    map[string]*keyValue
+	leases map[int64]*lease
+
+	// expiry is the local expiration time of each lease.
+	expiry map[int64]time.Time
+
+	// changed is closed and replaced every time the store is
+	// modified, to wake up watchers.
+	changed chan struct{}
+}
+
+func newStore() *store {
+	return &store{
+		kvs:     make(map[string]*keyValue),
+		leases:  make(map[int64]*lease),
+		expiry:  make(map[int64]time.Time),
+		changed: make(chan struct{}),
+	}
+}
+
+// apply applies a command committed at the provided log index, and
+// returns its result. An empty command is a no-op.
+func (s *store) apply(index uint64, data []byte) *response {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.index = index
+	if len(data) == 0 {
+		return &response{}
+	}
+	req := &request{}
+	if err := json.Unmarshal(data, req); err != nil {
+		return &response{Code: codeUnavailable, Message: fmt.Sprintf("cannot decode command at index %v: %v", index, err)}
+	}
+
+	var resp *response
+	switch req.Op {
+	case opCreate:
+		resp = s.create(index, req)
+	case opUpdate:
+		resp = s.update(index, req)
+	case opDelete:
+		resp = s.delete(req)
+	case opGrant:
+		resp = s.grant(index, req)
+	case opRevoke:
+		resp = s.revoke(req)
+	default:
+		return &response{Code: codeUnavailable, Message: fmt.Sprintf("unknown operation %q at index %v", req.Op, index)}
+	}
+	if resp.Code == "" {
+		close(s.changed)
+		s.changed = make(chan struct{})
+	}
+	return resp
+}
+
+func (s *store) create(index uint64, req *request) *response {
+	if _, ok := s.kvs[req.Key]; ok {
+		return &response{Code: codeNodeExists}
+	}
+	if req.Lease != 0 {
+		if _, ok := s.leases[req.Lease]; !ok {
+			return &response{Code: codeNoLease}
+		}
+	}
+	s.put(index, req)
+	return &response{Version: index}
+}
+
+func (s *store) update(index uint64, req *request) *response {
+	if req.Version != 0 {
+		kv, ok := s.kvs[req.Key]
+		if !ok {
+			return &response{Code: codeNoNode}
+		}
+		if kv.Version != req.Version {
+			return &response{Code: codeBadVersion}
+		}
+	}
+	if req.Lease != 0 {
+		if _, ok := s.leases[req.Lease]; !ok {
+			return &response{Code: codeNoLease}
+		}
+	}
+	s.put(index, req)
+	return &response{Version: index}
+}
+
+func (s *store) put(index uint64, req *request) {
+	if kv, ok := s.kvs[req.Key]; ok {
+		s.detach(kv)
+	}
+	s.kvs[req.Key] = &keyValue{
+		Key:     req.Key,
+		Value:   req.Value,
+		Version: index,
+		Lease:   req.Lease,
+	}
+	if req.Lease != 0 {
+		s.leases[req.Lease].keys[req.Key] = true
+	}
+}
+
+func (s *store) delete(req *request) *response {
+	kv, ok := s.kvs[req.Key]
+	if !ok {
+		return &response{Code: codeNoNode}
+	}
+	if req.Version != 0 && kv.Version != req.Version {
+		return &response{Code: codeBadVersion}
+	}
+	s.detach(kv)
+	delete(s.kvs, req.Key)
+	return &response{}
+}
+
+// detach removes a key from the lease it is attached to, if any.
+func (s *store) detach(kv *keyValue) {
+	if l, ok := s.leases[kv.Lease]; ok {
+		delete(l.keys, kv.Key)
+	}
+}
+
+func (s *store) grant(index uint64, req *request) *response {
+	// The index of the log entry is unique, use it as the ID.
+	id := int64(index)
+	s.leases[id] = &lease{
+		ID:   id,
+		TTL:  req.TTL,
+		keys: make(map[string]bool),
+	}
+	s.expiry[id] = time.Now().Add(time.Duration(req.TTL) * time.Second)
+	return &response{Lease: id}
+}
+
+func (s *store) revoke(req *request) *response {
+	l, ok := s.leases[req.Lease]
+	if !ok {
+		return &response{Code: codeNoLease}
+	}
+	for key := range l.keys {
+		delete(s.kvs, key)
+	}
+	delete(s.leases, req.Lease)
+	delete(s.expiry, req.Lease)
+	return &response{}
+}
+
+// get returns a copy of a key, or nil if it doesn't exist. It also
+// returns the channel that will be closed on the next change.
+func (s *store) get(key string) (*keyValue, <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kv, ok := s.kvs[key]
+	if !ok {
+		return nil, s.changed
+	}
+	c := *kv
+	return &c, s.changed
+}
+
+// rangePrefix returns copies of all the keys that start with the
+// provided prefix, sorted by key.
+func (s *store) rangePrefix(prefix string) []keyValue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []keyValue
+	for key, kv := range s.kvs {
+		if strings.HasPrefix(key, prefix) {
+			result = append(result, *kv)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
+
+// keepAlive extends the local expiration time of a lease. It returns
+// false if the lease doesn't exist.
+func (s *store) keepAlive(id int64, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.leases[id]
+	if !ok {
+		return false
+	}
+	s.expiry[id] = now.Add(time.Duration(l.TTL) * time.Second)
+	return true
+}
+
+// refreshLeases gives all leases their full TTL. It is called when a
+// node becomes the leader, as it doesn't know when the last keep alive
+// was received by the previous leader.
+func (s *store) refreshLeases(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, l := range s.leases {
+		s.expiry[id] = now.Add(time.Duration(l.TTL) * time.Second)
+	}
+}
+
+// expiredLeases returns the leases that expired. They are given a
+// grace period of the provided duration, so the caller doesn't
+// revoke them again while the revocation is being replicated.
+func (s *store) expiredLeases(now time.Time, grace time.Duration) []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []int64
+	for id, t := range s.expiry {
+		if now.After(t) {
+			result = append(result, id)
+			s.expiry[id] = now.Add(grace)
+		}
+	}
+	return result
+}
+
+// snapshot serializes the store.
+func (s *store) snapshot() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap := &storeSnapshot{
+		Index: s.index,
+	}
+	for _, kv := range s.kvs {
+		snap.KVs = append(snap.KVs, kv)
+	}
+	for _, l := range s.leases {
+		snap.Leases = append(snap.Leases, l)
+	}
+	return json.Marshal(snap)
+}
+
+// restore replaces the content of the store with a snapshot.
+func (s *store) restore(data []byte) error {
+	snap := &storeSnapshot{}
+	if err := json.Unmarshal(data, snap); err != nil {
+		return fmt.Errorf("cannot decode snapshot: %v", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.index = snap.Index
+	s.kvs = make(map[string]*keyValue, len(snap.KVs))
+	s.leases = make(map[int64]*lease, len(snap.Leases))
+	s.expiry = make(map[int64]time.Time, len(snap.Leases))
+	for _, l := range snap.Leases {
+		l.keys = make(map[string]bool)
+		s.leases[l.ID] = l
+		s.expiry[l.ID] = now.Add(time.Duration(l.TTL) * time.Second)
+	}
+	for _, kv := range snap.KVs {
+		s.kvs[kv.Key] = kv
+		if l, ok := s.leases[kv.Lease]; ok {
+			l.keys[kv.Key] = true
+		}
+	}
+	close(s.changed)
+	s.changed = make(chan struct{})
+	return nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rafttopo
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// Watch is part of the topo.Conn interface.
+func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	nodePath := path.Join(s.root, filePath)
+
+	// Get the initial version of the file
+	initial, err := s.cli.call(ctx, getPath, &request{Key: nodePath})
+	if err != nil {
+		// Generic error.
+		return &topo.WatchData{Err: convertError(err, nodePath)}, nil, nil
+	}
+	if len(initial.KVs) != 1 {
+		// Node doesn't exist.
+		return &topo.WatchData{Err: topo.NewError(topo.NoNode, nodePath)}, nil, nil
+	}
+	wd := &topo.WatchData{
+		Contents: initial.KVs[0].Value,
+		Version:  RaftVersion(initial.KVs[0].Version),
+	}
+
+	// Create a context that will be canceled by the returned cancel
+	// function. It cancels the pending watch request.
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+
+	// Create the notifications channel, send updates to it.
+	notifications := make(chan *topo.WatchData, 10)
+	go func() {
+		defer close(notifications)
+
+		currVersion := initial.KVs[0].Version
+		var watchRetries int
+		for {
+			// Each request waits for the file to change from the
+			// version we have.
+			resp, err := s.cli.call(watchCtx, watchPath, &request{Key: nodePath, Version: currVersion})
+			if watchCtx.Err() != nil {
+				// This includes context cancellation errors.
+				notifications <- &topo.WatchData{
+					Err: convertError(watchCtx.Err(), nodePath),
+				}
+				return
+			}
+			if err != nil {
+				// No leader available, or it changed. Retry
+				// with the version we know.
+				watchRetries++
+				delay := time.Second
+				if watchRetries > 10 {
+					log.Warningf("watch %v failed %v times, currVersion: %v, last error: %v", nodePath, watchRetries, currVersion, err)
+				} else {
+					delay = time.Duration(watchRetries) * retryDelay
+				}
+				select {
+				case <-watchCtx.Done():
+				case <-time.After(delay):
+				}
+				continue
+			}
+			watchRetries = 0
+
+			if len(resp.KVs) == 0 {
+				// Node is gone, send a final notice.
+				notifications <- &topo.WatchData{
+					Err: topo.NewError(topo.NoNode, nodePath),
+				}
+				return
+			}
+			kv := resp.KVs[0]
+			if kv.Version == currVersion {
+				// The request timed out without a change.
+				continue
+			}
+			currVersion = kv.Version
+			notifications <- &topo.WatchData{
+				Contents: kv.Value,
+				Version:  RaftVersion(kv.Version),
+			}
+		}
+	}()
+
+	return wd, notifications, topo.CancelFunc(watchCancel)
+}
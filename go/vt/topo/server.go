@@ -77,6 +77,8 @@ const (
 	SrvKeyspaceFile      = "SrvKeyspace"
 	RoutingRulesFile     = "RoutingRules"
 	ExternalClustersFile = "ExternalClusters"
+	QuotasFile           = "Quotas"
+	VStreamConsumerFile  = "VStreamConsumer"
 )
 
 // Path for all object types.
@@ -88,6 +90,8 @@ const (
 	TabletsPath      = "tablets"
 	MetadataPath     = "metadata"
 
+	VStreamConsumersPath = "vstream_consumers"
+
 	ExternalClusterMySQL  = "mysql"
 	ExternalClusterVitess = "vitess"
 )
@@ -179,6 +183,16 @@ func RegisterFactory(name string, factory Factory) {
 	factories[name] = factory
 }
 
+// GetFactory returns the Factory registered for an implementation.
+// It is used by implementations that delegate some cells to another one.
+func GetFactory(name string) (Factory, error) {
+	factory, ok := factories[name]
+	if !ok {
+		return nil, NewError(NoImplementation, name)
+	}
+	return factory, nil
+}
+
 // NewWithFactory creates a new Server based on the given Factory.
 // It also opens the global cell connection.
 func NewWithFactory(factory Factory, serverAddress, root string) (*Server, error) {
@@ -0,0 +1,365 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+// sharedWatch is an upstream watch on one path. It is shared by all the
+// client watches on that path, and its latest value serves Get calls.
+type sharedWatch struct {
+	path string
+
+	// ready is closed once the upstream watch returned its initial
+	// value. If it failed, err is set and the watch is not used.
+	ready chan struct{}
+	err   error
+
+	// The following fields are protected by Proxy.mu.
+
+	// cancel stops the upstream watch. It is set once ready.
+	cancel topo.CancelFunc
+
+	// current is the latest value. It is nil once the upstream watch
+	// has ended.
+	current *topo.WatchData
+
+	// stale is set by writes through the proxy, until the watch has
+	// caught up with staleVersion. Get calls read from upstream while
+	// it is set, so clients see their own writes.
+	stale        bool
+	staleVersion string
+
+	// subscribers are the client watches.
+	subscribers map[*subscriber]bool
+
+	// lastUsed is the last time a client used this watch.
+	lastUsed time.Time
+}
+
+// subscriber is a client watch. It only keeps the latest value it has
+// not sent yet, so a slow client skips intermediate versions instead of
+// blocking the other ones.
+type subscriber struct {
+	// notify has room for one signal, so posting never blocks.
+	notify chan struct{}
+
+	// pending is the value to send next, protected by Proxy.mu.
+	pending *topo.WatchData
+}
+
+// post sets the next value for the subscriber. Proxy.mu must be held.
+func (s *subscriber) post(wd *topo.WatchData) {
+	s.pending = wd
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// acquireWatch returns the upstream watch for filePath, starting it if
+// needed. started is true if this call started it.
+func (p *Proxy) acquireWatch(ctx context.Context, filePath string) (w *sharedWatch, started bool, err error) {
+	p.mu.Lock()
+	w, ok := p.watches[filePath]
+	if !ok {
+		w = &sharedWatch{
+			path:        filePath,
+			ready:       make(chan struct{}),
+			subscribers: make(map[*subscriber]bool),
+			lastUsed:    time.Now(),
+		}
+		p.watches[filePath] = w
+		go p.runWatch(w)
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-w.ready:
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+	if w.err != nil {
+		return nil, false, w.err
+	}
+	return w, !ok, nil
+}
+
+// runWatch starts the upstream watch for w, and relays its changes
+// until it ends.
+func (p *Proxy) runWatch(w *sharedWatch) {
+	current, changes, cancel := p.conn.Watch(context.Background(), w.path)
+	p.mu.Lock()
+	if current.Err != nil {
+		w.err = current.Err
+		if p.watches[w.path] == w {
+			delete(p.watches, w.path)
+		}
+		p.mu.Unlock()
+		close(w.ready)
+		return
+	}
+	if p.versionType == nil {
+		p.versionType = reflect.TypeOf(current.Version)
+	}
+	w.current = current
+	w.cancel = cancel
+	removed := p.watches[w.path] != w
+	p.mu.Unlock()
+	close(w.ready)
+	if removed {
+		// Close was called, or a write dropped the watch while
+		// it was starting.
+		cancel()
+	}
+
+	upstreamWatches.Add(1)
+	defer upstreamWatches.Add(-1)
+	for wd := range changes {
+		p.mu.Lock()
+		if wd.Err != nil {
+			if p.watches[w.path] == w {
+				delete(p.watches, w.path)
+			}
+			w.current = nil
+		} else {
+			w.current = wd
+			if w.stale && wd.Version.String() == w.staleVersion {
+				w.stale = false
+			}
+		}
+		for s := range w.subscribers {
+			s.post(wd)
+		}
+		p.mu.Unlock()
+	}
+}
+
+// get returns the contents of filePath, from the upstream watch on it
+// when it is up to date.
+func (p *Proxy) get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	w, started, err := p.acquireWatch(ctx, filePath)
+	switch {
+	case err == nil:
+	case topo.IsErrType(err, topo.NoNode) || ctx.Err() != nil:
+		// Missing files are not cached, the watch was a
+		// regular read.
+		return nil, nil, err
+	default:
+		// Some implementations cannot watch all the paths they
+		// can read.
+		cacheCounts.Add("Uncached", 1)
+		return p.conn.Get(ctx, filePath)
+	}
+
+	p.mu.Lock()
+	current := w.current
+	stale := w.stale
+	w.lastUsed = time.Now()
+	p.mu.Unlock()
+	if current != nil && !stale {
+		if started {
+			cacheCounts.Add("Miss", 1)
+		} else {
+			cacheCounts.Add("Hit", 1)
+		}
+		return current.Contents, current.Version, nil
+	}
+
+	// The watch has ended, or not caught up with a write yet.
+	cacheCounts.Add("Stale", 1)
+	contents, version, err := p.conn.Get(ctx, filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	p.mu.Lock()
+	if w.current != nil && w.current.Version.String() == version.String() {
+		w.stale = false
+	}
+	p.mu.Unlock()
+	return contents, version, nil
+}
+
+// invalidate is called after a write through the proxy. version is the
+// written version, nil for a delete.
+func (p *Proxy) invalidate(filePath string, version topo.Version) {
+	p.mu.Lock()
+	// Requests after the write must not share a call started before.
+	p.calls = make(map[string]*sharedCall)
+
+	w, ok := p.watches[filePath]
+	if !ok {
+		p.mu.Unlock()
+		return
+	}
+	if len(w.subscribers) == 0 {
+		// Nobody watches the file, drop the watch. The next Get
+		// will start a new one from a fresh read.
+		delete(p.watches, filePath)
+		cancel := w.cancel
+		p.mu.Unlock()
+		if cancel != nil {
+			cancel()
+		}
+		return
+	}
+	w.stale = true
+	w.staleVersion = versionString(version)
+	p.mu.Unlock()
+}
+
+// Watch is part of the TopoProxyServer interface.
+func (p *Proxy) Watch(req *topoproxydatapb.WatchRequest, stream topoproxyservicepb.TopoProxy_WatchServer) error {
+	ctx := stream.Context()
+	s := &subscriber{
+		notify: make(chan struct{}, 1),
+	}
+	var w *sharedWatch
+	for w == nil {
+		var err error
+		w, _, err = p.acquireWatch(ctx, req.Path)
+		if err != nil {
+			return toGRPC(err)
+		}
+		p.mu.Lock()
+		if w.current == nil {
+			// The watch ended right after it started,
+			// start a new one.
+			w = nil
+		} else {
+			w.subscribers[s] = true
+			s.post(w.current)
+		}
+		p.mu.Unlock()
+	}
+	watchSubscribers.Add(1)
+	defer func() {
+		p.mu.Lock()
+		delete(w.subscribers, s)
+		w.lastUsed = time.Now()
+		p.mu.Unlock()
+		watchSubscribers.Add(-1)
+	}()
+
+	for {
+		select {
+		case <-s.notify:
+		case <-ctx.Done():
+			return nil
+		}
+		p.mu.Lock()
+		wd := s.pending
+		s.pending = nil
+		p.mu.Unlock()
+		if wd == nil {
+			continue
+		}
+		if wd.Err != nil {
+			return toGRPC(wd.Err)
+		}
+		if err := stream.Send(&topoproxydatapb.WatchResponse{
+			Contents: wd.Contents,
+			Version:  wd.Version.String(),
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// evictLoop periodically stops the upstream watches that have not been
+// used for cacheTTL.
+func (p *Proxy) evictLoop() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(p.cacheTTL / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.closed:
+			return
+		case now := <-ticker.C:
+			p.evict(now)
+		}
+	}
+}
+
+// evict stops the unused upstream watches.
+func (p *Proxy) evict(now time.Time) {
+	var cancels []topo.CancelFunc
+	p.mu.Lock()
+	for path, w := range p.watches {
+		if w.cancel != nil && len(w.subscribers) == 0 && now.Sub(w.lastUsed) > p.cacheTTL {
+			delete(p.watches, path)
+			cancels = append(cancels, w.cancel)
+		}
+	}
+	p.mu.Unlock()
+	for _, cancel := range cancels {
+		cancel()
+	}
+}
+
+// sharedCall is an upstream List or ListDir call, shared by the
+// identical requests made while it is in flight.
+type sharedCall struct {
+	done   chan struct{}
+	result interface{}
+	err    error
+}
+
+// coalesce runs fn, or waits for the in-flight call with the same method
+// and key. fn runs with its own context, so a canceled request does not
+// fail the other ones.
+func (p *Proxy) coalesce(ctx context.Context, method, key string, fn func(context.Context) (interface{}, error)) (interface{}, error) {
+	key = method + ":" + key
+	p.mu.Lock()
+	c, ok := p.calls[key]
+	if ok {
+		coalescedCalls.Add(method, 1)
+	} else {
+		c = &sharedCall{
+			done: make(chan struct{}),
+		}
+		p.calls[key] = c
+		go func() {
+			callCtx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+			defer cancel()
+			c.result, c.err = fn(callCtx)
+			p.mu.Lock()
+			if p.calls[key] == c {
+				delete(p.calls, key)
+			}
+			p.mu.Unlock()
+			close(c.done)
+		}()
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.result, c.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
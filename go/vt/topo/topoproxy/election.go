@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// NewLeaderParticipation is part of the topo.Conn interface.
+func (c *Conn) NewLeaderParticipation(name, id string) (topo.LeaderParticipation, error) {
+	return &proxyLeaderParticipation{
+		c:    c,
+		name: name,
+		id:   id,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}, nil
+}
+
+// proxyLeaderParticipation implements topo.LeaderParticipation.
+//
+// The proxy runs the election upstream on our behalf, and keeps the
+// leadership for as long as the WaitForLeadership stream is open.
+type proxyLeaderParticipation struct {
+	// c is our parent Conn.
+	c *Conn
+
+	// name is the name of this LeaderParticipation
+	name string
+
+	// id is the process's current id.
+	id string
+
+	// stop is a channel closed when Stop is called.
+	stop chan struct{}
+
+	// done is a channel closed when we're done processing the Stop
+	done chan struct{}
+}
+
+// WaitForLeadership is part of the topo.LeaderParticipation interface.
+func (mp *proxyLeaderParticipation) WaitForLeadership() (context.Context, error) {
+	// If Stop was already called, mp.done is closed, so we are interrupted.
+	select {
+	case <-mp.done:
+		return nil, topo.NewError(topo.Interrupted, "Leadership")
+	default:
+	}
+
+	// The stream context is canceled when Stop is called, which
+	// releases the leadership.
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-mp.stop
+		cancel()
+		close(mp.done)
+	}()
+
+	stream, err := mp.c.client.WaitForLeadership(ctx, &topoproxydatapb.WaitForLeadershipRequest{
+		Name: mp.name,
+		Id:   mp.id,
+	})
+	if err != nil {
+		return nil, convertError(err, mp.name)
+	}
+	if _, err := stream.Recv(); err != nil {
+		if ctx.Err() != nil {
+			return nil, topo.NewError(topo.Interrupted, "Leadership")
+		}
+		return nil, convertError(err, mp.name)
+	}
+
+	// We are the leader until the stream ends.
+	go func() {
+		_, _ = stream.Recv()
+		cancel()
+	}()
+	return ctx, nil
+}
+
+// Stop is part of the topo.LeaderParticipation interface
+func (mp *proxyLeaderParticipation) Stop() {
+	close(mp.stop)
+	<-mp.done
+}
+
+// GetCurrentLeaderID is part of the topo.LeaderParticipation interface
+func (mp *proxyLeaderParticipation) GetCurrentLeaderID(ctx context.Context) (string, error) {
+	resp, err := mp.c.client.GetCurrentLeaderID(ctx, &topoproxydatapb.GetCurrentLeaderIDRequest{
+		Name: mp.name,
+	})
+	if err != nil {
+		return "", convertError(err, mp.name)
+	}
+	return resp.LeaderId, nil
+}
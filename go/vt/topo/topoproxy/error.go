@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// topoCodes maps the topo error codes that go over the wire to RPC codes.
+var topoCodes = map[topo.ErrorCode]vtrpcpb.Code{
+	topo.NodeExists:   vtrpcpb.Code_ALREADY_EXISTS,
+	topo.NoNode:       vtrpcpb.Code_NOT_FOUND,
+	topo.NodeNotEmpty: vtrpcpb.Code_FAILED_PRECONDITION,
+	topo.Timeout:      vtrpcpb.Code_DEADLINE_EXCEEDED,
+	topo.Interrupted:  vtrpcpb.Code_CANCELED,
+	topo.BadVersion:   vtrpcpb.Code_ABORTED,
+}
+
+// toGRPC converts an upstream topo error to a gRPC error, keeping the
+// topo error code.
+func toGRPC(err error) error {
+	if err == nil {
+		return nil
+	}
+	for code, rpcCode := range topoCodes {
+		if topo.IsErrType(err, code) {
+			return vterrors.ToGRPC(vterrors.New(rpcCode, err.Error()))
+		}
+	}
+	return vterrors.ToGRPC(err)
+}
+
+// convertError converts a gRPC error returned by the proxy to a topo
+// error for nodePath, when it has a topo error code.
+func convertError(err error, nodePath string) error {
+	if err == nil {
+		return nil
+	}
+	err = vterrors.FromGRPC(err)
+	code := vterrors.Code(err)
+	for topoCode, rpcCode := range topoCodes {
+		if code == rpcCode {
+			return topo.NewError(topoCode, nodePath)
+		}
+	}
+	return err
+}
+
+// contextError returns the topo error for a done context.
+func contextError(ctx context.Context, nodePath string) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return topo.NewError(topo.Timeout, nodePath)
+	}
+	return topo.NewError(topo.Interrupted, nodePath)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// versionString returns the wire representation of a version.
+func versionString(version topo.Version) string {
+	if version == nil {
+		return ""
+	}
+	return version.String()
+}
+
+// Create is part of the topo.Conn interface.
+func (c *Conn) Create(ctx context.Context, filePath string, contents []byte) (topo.Version, error) {
+	resp, err := c.client.Create(ctx, &topoproxydatapb.CreateRequest{
+		Path:     filePath,
+		Contents: contents,
+	})
+	if err != nil {
+		return nil, convertError(err, filePath)
+	}
+	return ProxyVersion(resp.Version), nil
+}
+
+// Update is part of the topo.Conn interface.
+func (c *Conn) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	resp, err := c.client.Update(ctx, &topoproxydatapb.UpdateRequest{
+		Path:     filePath,
+		Contents: contents,
+		Version:  versionString(version),
+	})
+	if err != nil {
+		return nil, convertError(err, filePath)
+	}
+	return ProxyVersion(resp.Version), nil
+}
+
+// Get is part of the topo.Conn interface.
+func (c *Conn) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	resp, err := c.client.Get(ctx, &topoproxydatapb.GetRequest{
+		Path: filePath,
+	})
+	if err != nil {
+		return nil, nil, convertError(err, filePath)
+	}
+	return resp.Contents, ProxyVersion(resp.Version), nil
+}
+
+// List is part of the topo.Conn interface.
+func (c *Conn) List(ctx context.Context, filePathPrefix string) ([]topo.KVInfo, error) {
+	resp, err := c.client.List(ctx, &topoproxydatapb.ListRequest{
+		PathPrefix: filePathPrefix,
+	})
+	if err != nil {
+		return nil, convertError(err, filePathPrefix)
+	}
+	results := make([]topo.KVInfo, len(resp.Kvs))
+	for i, kv := range resp.Kvs {
+		results[i] = topo.KVInfo{
+			Key:     kv.Key,
+			Value:   kv.Value,
+			Version: ProxyVersion(kv.Version),
+		}
+	}
+	return results, nil
+}
+
+// Delete is part of the topo.Conn interface.
+func (c *Conn) Delete(ctx context.Context, filePath string, version topo.Version) error {
+	_, err := c.client.Delete(ctx, &topoproxydatapb.DeleteRequest{
+		Path:    filePath,
+		Version: versionString(version),
+	})
+	return convertError(err, filePath)
+}
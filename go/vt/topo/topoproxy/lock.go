@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+// proxyLockDescriptor implements topo.LockDescriptor. The proxy holds
+// the upstream lock for as long as the stream is open.
+type proxyLockDescriptor struct {
+	dirPath string
+
+	// cancel aborts the stream, which releases the lock.
+	cancel context.CancelFunc
+
+	// mu protects the stream, which only has one request in flight.
+	mu       sync.Mutex
+	stream   topoproxyservicepb.TopoProxy_LockClient
+	unlocked bool
+}
+
+// Lock is part of the topo.Conn interface.
+func (c *Conn) Lock(ctx context.Context, dirPath, contents string) (topo.LockDescriptor, error) {
+	// The stream outlives ctx, which is only used to acquire the lock.
+	lockCtx, lockCancel := context.WithCancel(context.Background())
+	stream, err := c.client.Lock(lockCtx)
+	if err != nil {
+		lockCancel()
+		return nil, convertError(err, dirPath)
+	}
+	ld := &proxyLockDescriptor{
+		dirPath: dirPath,
+		cancel:  lockCancel,
+		stream:  stream,
+	}
+	if err := ld.call(ctx, &topoproxydatapb.LockRequest{
+		DirPath:  dirPath,
+		Contents: contents,
+	}); err != nil {
+		lockCancel()
+		return nil, err
+	}
+	return ld, nil
+}
+
+// call sends one request on the stream and waits for its response.
+// If ctx is done first, the stream is aborted and the lock released.
+func (ld *proxyLockDescriptor) call(ctx context.Context, req *topoproxydatapb.LockRequest) error {
+	stop := cancelOnDone(ctx, ld.cancel)
+	defer stop()
+	if err := ld.stream.Send(req); err != nil {
+		// The actual error is returned by Recv.
+		if _, rerr := ld.stream.Recv(); rerr != nil {
+			err = rerr
+		}
+		return ld.convertError(ctx, err)
+	}
+	if _, err := ld.stream.Recv(); err != nil {
+		return ld.convertError(ctx, err)
+	}
+	return nil
+}
+
+func (ld *proxyLockDescriptor) convertError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return contextError(ctx, ld.dirPath)
+	}
+	return convertError(err, ld.dirPath)
+}
+
+// Check is part of the topo.LockDescriptor interface.
+func (ld *proxyLockDescriptor) Check(ctx context.Context) error {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	if ld.unlocked {
+		return fmt.Errorf("lock on %v was released", ld.dirPath)
+	}
+	return ld.call(ctx, &topoproxydatapb.LockRequest{
+		Check: true,
+	})
+}
+
+// Unlock is part of the topo.LockDescriptor interface.
+func (ld *proxyLockDescriptor) Unlock(ctx context.Context) error {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	if ld.unlocked {
+		return fmt.Errorf("lock on %v was already released", ld.dirPath)
+	}
+	ld.unlocked = true
+	defer ld.cancel()
+	return ld.call(ctx, &topoproxydatapb.LockRequest{
+		Unlock: true,
+	})
+}
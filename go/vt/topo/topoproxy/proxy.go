@@ -0,0 +1,282 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// DefaultCacheTTL is the default time an unused upstream watch is kept.
+const DefaultCacheTTL = 5 * time.Minute
+
+var (
+	cacheCounts      = stats.NewCountersWithSingleLabel("TopoProxyCache", "Topo proxy Get calls by cache result", "Result")
+	coalescedCalls   = stats.NewCountersWithSingleLabel("TopoProxyCoalescedCalls", "Topo proxy calls served by an identical in-flight upstream call", "Method")
+	upstreamWatches  = stats.NewGauge("TopoProxyUpstreamWatches", "Number of upstream watches held by the topo proxy")
+	watchSubscribers = stats.NewGauge("TopoProxyWatchSubscribers", "Number of client watches served by the topo proxy")
+)
+
+// Proxy implements the TopoProxy gRPC service on top of a connection
+// to the upstream global topo server.
+type Proxy struct {
+	topoproxyservicepb.UnimplementedTopoProxyServer
+
+	// conn is the upstream connection.
+	conn topo.Conn
+
+	// cacheTTL is how long an upstream watch is kept after its last
+	// use.
+	cacheTTL time.Duration
+
+	// closed is closed by Close.
+	closed chan struct{}
+	wg     sync.WaitGroup
+
+	// mu protects the following fields, and the mutable fields of the
+	// sharedWatch and subscriber objects.
+	mu sync.Mutex
+
+	// watches has the upstream watches, by path.
+	watches map[string]*sharedWatch
+
+	// calls has the in-flight upstream List and ListDir calls, by key.
+	calls map[string]*sharedCall
+
+	// versionType is the type of the upstream versions. It is learned
+	// from the first version returned by the upstream server, and used
+	// to parse the versions sent by the clients.
+	versionType reflect.Type
+}
+
+// NewProxy returns a Proxy for the upstream connection conn. Cached
+// values unused for cacheTTL are evicted.
+func NewProxy(conn topo.Conn, cacheTTL time.Duration) *Proxy {
+	p := &Proxy{
+		conn:     conn,
+		cacheTTL: cacheTTL,
+		closed:   make(chan struct{}),
+		watches:  make(map[string]*sharedWatch),
+		calls:    make(map[string]*sharedCall),
+	}
+	p.wg.Add(1)
+	go p.evictLoop()
+	return p
+}
+
+// Close stops the upstream watches, which ends the client watches.
+// It does not close the upstream connection.
+func (p *Proxy) Close() {
+	close(p.closed)
+	p.wg.Wait()
+
+	p.mu.Lock()
+	var cancels []topo.CancelFunc
+	for path, w := range p.watches {
+		delete(p.watches, path)
+		if w.cancel != nil {
+			cancels = append(cancels, w.cancel)
+		}
+	}
+	p.mu.Unlock()
+	for _, cancel := range cancels {
+		cancel()
+	}
+}
+
+// Create is part of the TopoProxyServer interface.
+func (p *Proxy) Create(ctx context.Context, req *topoproxydatapb.CreateRequest) (*topoproxydatapb.CreateResponse, error) {
+	version, err := p.conn.Create(ctx, req.Path, req.Contents)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	p.learnVersion(version)
+	p.invalidate(req.Path, version)
+	return &topoproxydatapb.CreateResponse{
+		Version: version.String(),
+	}, nil
+}
+
+// Update is part of the TopoProxyServer interface.
+func (p *Proxy) Update(ctx context.Context, req *topoproxydatapb.UpdateRequest) (*topoproxydatapb.UpdateResponse, error) {
+	version, err := p.parseVersion(ctx, req.Path, req.Version)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	newVersion, err := p.conn.Update(ctx, req.Path, req.Contents, version)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	p.learnVersion(newVersion)
+	p.invalidate(req.Path, newVersion)
+	return &topoproxydatapb.UpdateResponse{
+		Version: newVersion.String(),
+	}, nil
+}
+
+// Get is part of the TopoProxyServer interface.
+func (p *Proxy) Get(ctx context.Context, req *topoproxydatapb.GetRequest) (*topoproxydatapb.GetResponse, error) {
+	contents, version, err := p.get(ctx, req.Path)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return &topoproxydatapb.GetResponse{
+		Contents: contents,
+		Version:  version.String(),
+	}, nil
+}
+
+// List is part of the TopoProxyServer interface.
+func (p *Proxy) List(ctx context.Context, req *topoproxydatapb.ListRequest) (*topoproxydatapb.ListResponse, error) {
+	result, err := p.coalesce(ctx, "List", req.PathPrefix, func(ctx context.Context) (interface{}, error) {
+		kvs, err := p.conn.List(ctx, req.PathPrefix)
+		if err != nil {
+			return nil, err
+		}
+		resp := &topoproxydatapb.ListResponse{
+			Kvs: make([]*topoproxydatapb.KeyValue, len(kvs)),
+		}
+		for i, kv := range kvs {
+			p.learnVersion(kv.Version)
+			resp.Kvs[i] = &topoproxydatapb.KeyValue{
+				Key:     kv.Key,
+				Value:   kv.Value,
+				Version: kv.Version.String(),
+			}
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return result.(*topoproxydatapb.ListResponse), nil
+}
+
+// Delete is part of the TopoProxyServer interface.
+func (p *Proxy) Delete(ctx context.Context, req *topoproxydatapb.DeleteRequest) (*topoproxydatapb.DeleteResponse, error) {
+	version, err := p.parseVersion(ctx, req.Path, req.Version)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	if err := p.conn.Delete(ctx, req.Path, version); err != nil {
+		return nil, toGRPC(err)
+	}
+	p.invalidate(req.Path, nil)
+	return &topoproxydatapb.DeleteResponse{}, nil
+}
+
+// ListDir is part of the TopoProxyServer interface.
+func (p *Proxy) ListDir(ctx context.Context, req *topoproxydatapb.ListDirRequest) (*topoproxydatapb.ListDirResponse, error) {
+	key := fmt.Sprintf("%v:%v", req.Full, req.DirPath)
+	result, err := p.coalesce(ctx, "ListDir", key, func(ctx context.Context) (interface{}, error) {
+		entries, err := p.conn.ListDir(ctx, req.DirPath, req.Full)
+		if err != nil {
+			return nil, err
+		}
+		resp := &topoproxydatapb.ListDirResponse{
+			Entries: make([]*topoproxydatapb.DirEntry, len(entries)),
+		}
+		for i, e := range entries {
+			resp.Entries[i] = &topoproxydatapb.DirEntry{
+				Name:      e.Name,
+				Ephemeral: e.Ephemeral,
+			}
+			if e.Type == topo.TypeFile {
+				resp.Entries[i].Type = topoproxydatapb.DirEntry_FILE
+			}
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return result.(*topoproxydatapb.ListDirResponse), nil
+}
+
+// learnVersion records the type of the upstream versions.
+func (p *Proxy) learnVersion(version topo.Version) {
+	if version == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.versionType == nil {
+		p.versionType = reflect.TypeOf(version)
+	}
+}
+
+// parseVersion converts a version sent by a client to an upstream
+// version. If we have not seen an upstream version yet, we read
+// filePath to learn their type.
+func (p *Proxy) parseVersion(ctx context.Context, filePath, s string) (topo.Version, error) {
+	if s == "" {
+		return nil, nil
+	}
+	p.mu.Lock()
+	t := p.versionType
+	p.mu.Unlock()
+	if t == nil {
+		_, version, err := p.conn.Get(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		p.learnVersion(version)
+		t = reflect.TypeOf(version)
+	}
+	return parseVersion(t, s)
+}
+
+// parseVersion parses s as a version of type t. All the topo
+// implementations use a basic type for their versions, printed with %v.
+func parseVersion(t reflect.Type, s string) (topo.Version, error) {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, t.Bits())
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid version %q: %v", s, err)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, t.Bits())
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid version %q: %v", s, err)
+		}
+		v.SetUint(n)
+	case reflect.String:
+		v.SetString(s)
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported upstream version type %v", t)
+	}
+	version, ok := v.Interface().(topo.Version)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported upstream version type %v", t)
+	}
+	return version, nil
+}
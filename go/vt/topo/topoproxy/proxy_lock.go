@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"context"
+	"sync"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Lock is part of the TopoProxyServer interface. The first request
+// takes the upstream lock, which is held until an unlock request or
+// the end of the stream.
+func (p *Proxy) Lock(stream topoproxyservicepb.TopoProxy_LockServer) error {
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if req.DirPath == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the first lock request must have a dir_path")
+	}
+	ld, err := p.conn.Lock(ctx, req.DirPath, req.Contents)
+	if err != nil {
+		return toGRPC(err)
+	}
+	unlocked := false
+	defer func() {
+		if unlocked {
+			return
+		}
+		// The client went away, release the lock.
+		unlockCtx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+		defer cancel()
+		if err := ld.Unlock(unlockCtx); err != nil {
+			log.Warningf("failed to release lock on %v: %v", req.DirPath, err)
+		}
+	}()
+	if err := stream.Send(&topoproxydatapb.LockResponse{}); err != nil {
+		return err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		switch {
+		case req.Unlock:
+			unlocked = true
+			if err := ld.Unlock(ctx); err != nil {
+				return toGRPC(err)
+			}
+			return stream.Send(&topoproxydatapb.LockResponse{})
+		case req.Check:
+			if err := ld.Check(ctx); err != nil {
+				return toGRPC(err)
+			}
+		default:
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "lock request must be a check or an unlock")
+		}
+		if err := stream.Send(&topoproxydatapb.LockResponse{}); err != nil {
+			return err
+		}
+	}
+}
+
+// WaitForLeadership is part of the TopoProxyServer interface. It sends
+// one response once the client is the leader, and keeps the leadership
+// until the end of the stream. The stream ends with an error if the
+// leadership is lost.
+func (p *Proxy) WaitForLeadership(req *topoproxydatapb.WaitForLeadershipRequest, stream topoproxyservicepb.TopoProxy_WaitForLeadershipServer) error {
+	ctx := stream.Context()
+	mp, err := p.conn.NewLeaderParticipation(req.Name, req.Id)
+	if err != nil {
+		return toGRPC(err)
+	}
+
+	// WaitForLeadership has no context, so we stop the participation
+	// if the client goes away while it waits.
+	var once sync.Once
+	stop := func() {
+		once.Do(mp.Stop)
+	}
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	leaderCtx, err := mp.WaitForLeadership()
+	if err != nil {
+		return toGRPC(err)
+	}
+	if err := stream.Send(&topoproxydatapb.WaitForLeadershipResponse{}); err != nil {
+		return err
+	}
+	select {
+	case <-leaderCtx.Done():
+		return toGRPC(topo.NewError(topo.Interrupted, req.Name))
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// GetCurrentLeaderID is part of the TopoProxyServer interface.
+func (p *Proxy) GetCurrentLeaderID(ctx context.Context, req *topoproxydatapb.GetCurrentLeaderIDRequest) (*topoproxydatapb.GetCurrentLeaderIDResponse, error) {
+	// We do not participate in the election, so the participation is
+	// not stopped.
+	mp, err := p.conn.NewLeaderParticipation(req.Name, "")
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	id, err := mp.GetCurrentLeaderID(ctx)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return &topoproxydatapb.GetCurrentLeaderIDResponse{
+		LeaderId: id,
+	}, nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package topoproxy implements a caching read-through proxy for the global
+topology server, and the topo.Conn that talks to it.
+
+Large deployments run thousands of vttablets and vtgates that all watch
+and read the global cell. The proxy is a standalone process (see
+go/cmd/topoproxy) that holds a single upstream connection to the global
+topo server, and serves the topo.Conn API over gRPC:
+  - Watches from all clients on the same path are multiplexed on one
+    upstream watch.
+  - Get results are served from the values of those watches. A write
+    through the proxy marks the cached value stale until the watch has
+    caught up with the written version.
+  - Identical concurrent List and ListDir calls share one upstream call.
+  - Locks and leader elections are held upstream for as long as the
+    client stream is open.
+
+The client side is registered as the "topoproxy" topo implementation.
+Set -topo_implementation topoproxy and -topo_global_server_address to
+the proxy address to use it. The global root is configured on the
+proxy, -topo_global_root is ignored. The proxy only serves the global
+cell: connections to the other cells use the implementation given by
+-topo_proxy_cell_implementation, with the address and root stored in
+their CellInfo.
+
+Versions are exchanged as the string representation of the upstream
+versions, the proxy converts them back before calling the upstream
+server.
+*/
+package topoproxy
+
+import (
+	"flag"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+var (
+	cellImplementation = flag.String("topo_proxy_cell_implementation", "etcd2", "the topology implementation to use for the cells other than the global cell, when using the topoproxy implementation")
+
+	cert = flag.String("topo_proxy_client_grpc_cert", "", "the cert to use to connect to the topo proxy")
+	key  = flag.String("topo_proxy_client_grpc_key", "", "the key to use to connect to the topo proxy")
+	ca   = flag.String("topo_proxy_client_grpc_ca", "", "the server ca to use to validate the topo proxy when connecting")
+	crl  = flag.String("topo_proxy_client_grpc_crl", "", "the server crl to use to validate the topo proxy certificate when connecting")
+	name = flag.String("topo_proxy_client_grpc_server_name", "", "the server name to use to validate the topo proxy certificate")
+)
+
+// Factory is the topoproxy topo.Factory implementation.
+type Factory struct{}
+
+// HasGlobalReadOnlyCell is part of the topo.Factory interface.
+func (f Factory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the topo.Factory interface.
+func (f Factory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	if cell != topo.GlobalCell {
+		if *cellImplementation == "topoproxy" {
+			return nil, fmt.Errorf("topo_proxy_cell_implementation cannot be topoproxy")
+		}
+		factory, err := topo.GetFactory(*cellImplementation)
+		if err != nil {
+			return nil, err
+		}
+		return factory.Create(cell, serverAddr, root)
+	}
+	return NewConn(serverAddr)
+}
+
+// Conn is the topo.Conn implementation that talks to a topo proxy.
+type Conn struct {
+	cc     *grpc.ClientConn
+	client topoproxyservicepb.TopoProxyClient
+}
+
+// NewConn returns a Conn connected to the topo proxy at addr.
+func NewConn(addr string) (*Conn, error) {
+	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *crl, *name)
+	if err != nil {
+		return nil, err
+	}
+	cc, err := grpcclient.Dial(addr, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{
+		cc:     cc,
+		client: topoproxyservicepb.NewTopoProxyClient(cc),
+	}, nil
+}
+
+// Close is part of the topo.Conn interface.
+func (c *Conn) Close() {
+	c.cc.Close()
+}
+
+func init() {
+	topo.RegisterFactory("topoproxy", Factory{})
+}
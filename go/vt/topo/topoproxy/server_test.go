@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/test"
+
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+// cellFactory serves the non-global cells of the tests, from the
+// memorytopo factory of the current test server.
+type cellFactory struct {
+	f *memorytopo.Factory
+}
+
+func (cf *cellFactory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+func (cf *cellFactory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	return cf.f.Create(cell, serverAddr, root)
+}
+
+var testCells = &cellFactory{}
+
+func init() {
+	topo.RegisterFactory("topoproxytest", testCells)
+}
+
+// countingConn counts the upstream Get and Watch calls.
+type countingConn struct {
+	topo.Conn
+	gets    int32
+	watches int32
+}
+
+func (c *countingConn) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	atomic.AddInt32(&c.gets, 1)
+	return c.Conn.Get(ctx, filePath)
+}
+
+func (c *countingConn) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	atomic.AddInt32(&c.watches, 1)
+	return c.Conn.Watch(ctx, filePath)
+}
+
+// startProxy starts a proxy in front of a new memorytopo, and returns
+// a topo.Server that uses it.
+func startProxy(t *testing.T, cacheTTL time.Duration) (*topo.Server, *Proxy, *countingConn) {
+	_, f := memorytopo.NewServerAndFactory(test.LocalCellName)
+	testCells.f = f
+	*cellImplementation = "topoproxytest"
+
+	upstream, err := f.Create(topo.GlobalCell, "", "")
+	if err != nil {
+		t.Fatalf("Create(global) failed: %v", err)
+	}
+	conn := &countingConn{Conn: upstream}
+	proxy := NewProxy(conn, cacheTTL)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() failed: %v", err)
+	}
+	server := grpc.NewServer()
+	topoproxyservicepb.RegisterTopoProxyServer(server, proxy)
+	go server.Serve(lis)
+	t.Cleanup(func() {
+		proxy.Close()
+		server.Stop()
+	})
+
+	ts, err := topo.OpenServer("topoproxy", lis.Addr().String(), "")
+	if err != nil {
+		t.Fatalf("OpenServer() failed: %v", err)
+	}
+	return ts, proxy, conn
+}
+
+func TestTopoProxy(t *testing.T) {
+	test.TopoServerTestSuite(t, func() *topo.Server {
+		ts, _, _ := startProxy(t, time.Minute)
+		return ts
+	})
+}
+
+func TestTopoProxyCache(t *testing.T) {
+	ctx := context.Background()
+	ts, proxy, upstream := startProxy(t, 200*time.Millisecond)
+	defer ts.Close()
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell() failed: %v", err)
+	}
+
+	version, err := conn.Create(ctx, "myfile", []byte("a"))
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+
+	// All the client watches share one upstream watch.
+	var changes []<-chan *topo.WatchData
+	for i := 0; i < 5; i++ {
+		current, c, cancel := conn.Watch(ctx, "myfile")
+		if current.Err != nil {
+			t.Fatalf("Watch() failed: %v", current.Err)
+		}
+		if string(current.Contents) != "a" || current.Version.String() != version.String() {
+			t.Fatalf("Watch() returned %q at %v, want %q at %v", current.Contents, current.Version, "a", version)
+		}
+		defer cancel()
+		changes = append(changes, c)
+	}
+	if n := atomic.LoadInt32(&upstream.watches); n != 1 {
+		t.Errorf("got %v upstream watches, want 1", n)
+	}
+
+	// Gets are served by the watch.
+	for i := 0; i < 10; i++ {
+		contents, _, err := conn.Get(ctx, "myfile")
+		if err != nil || string(contents) != "a" {
+			t.Fatalf("Get() returned %q, %v", contents, err)
+		}
+	}
+	if n := atomic.LoadInt32(&upstream.gets); n != 0 {
+		t.Errorf("got %v upstream gets, want 0", n)
+	}
+
+	// A write through the proxy is visible right away, and sent to
+	// all the watches.
+	version, err = conn.Update(ctx, "myfile", []byte("b"), version)
+	if err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+	contents, got, err := conn.Get(ctx, "myfile")
+	if err != nil || string(contents) != "b" || got.String() != version.String() {
+		t.Fatalf("Get() after Update() returned %q at %v, %v", contents, got, err)
+	}
+	for _, c := range changes {
+		wd := <-c
+		if wd.Err != nil || string(wd.Contents) != "b" {
+			t.Fatalf("watch returned %q, %v", wd.Contents, wd.Err)
+		}
+	}
+
+	// Once the watch caught up, Gets come from the cache again.
+	gets := atomic.LoadInt32(&upstream.gets)
+	if _, _, err := conn.Get(ctx, "myfile"); err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+	if n := atomic.LoadInt32(&upstream.gets); n != gets {
+		t.Errorf("got %v upstream gets, want %v", n, gets)
+	}
+
+	// Deleting the file ends the watches.
+	if err := conn.Delete(ctx, "myfile", version); err != nil {
+		t.Fatalf("Delete() failed: %v", err)
+	}
+	for _, c := range changes {
+		wd := <-c
+		if !topo.IsErrType(wd.Err, topo.NoNode) {
+			t.Fatalf("watch returned %v, want NoNode", wd.Err)
+		}
+	}
+	if _, _, err := conn.Get(ctx, "myfile"); !topo.IsErrType(err, topo.NoNode) {
+		t.Fatalf("Get() after Delete() returned %v, want NoNode", err)
+	}
+
+	// An unused watch is evicted.
+	if _, err := conn.Create(ctx, "other", []byte("c")); err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	if _, _, err := conn.Get(ctx, "other"); err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		proxy.mu.Lock()
+		n := len(proxy.watches)
+		proxy.mu.Unlock()
+		if n == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %v upstream watches after the cache TTL, want 0", n)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"context"
+	"io"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// Watch is part of the topo.Conn interface.
+func (c *Conn) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	// The stream outlives ctx, which is only used for the initial
+	// value.
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+	stream, err := c.client.Watch(watchCtx, &topoproxydatapb.WatchRequest{
+		Path: filePath,
+	})
+	if err != nil {
+		watchCancel()
+		return &topo.WatchData{Err: convertError(err, filePath)}, nil, nil
+	}
+
+	stop := cancelOnDone(ctx, watchCancel)
+	resp, err := stream.Recv()
+	stop()
+	if err != nil {
+		watchCancel()
+		if ctx.Err() != nil {
+			return &topo.WatchData{Err: contextError(ctx, filePath)}, nil, nil
+		}
+		return &topo.WatchData{Err: convertError(err, filePath)}, nil, nil
+	}
+	current := &topo.WatchData{
+		Contents: resp.Contents,
+		Version:  ProxyVersion(resp.Version),
+	}
+
+	notifications := make(chan *topo.WatchData, 10)
+	go func() {
+		defer close(notifications)
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF || watchCtx.Err() != nil {
+					err = topo.NewError(topo.Interrupted, filePath)
+				} else {
+					err = convertError(err, filePath)
+				}
+				notifications <- &topo.WatchData{Err: err}
+				return
+			}
+			notifications <- &topo.WatchData{
+				Contents: resp.Contents,
+				Version:  ProxyVersion(resp.Version),
+			}
+		}
+	}()
+	return current, notifications, topo.CancelFunc(watchCancel)
+}
+
+// cancelOnDone calls cancel if ctx is done before the returned
+// function is called.
+func cancelOnDone(ctx context.Context, cancel context.CancelFunc) func() {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-done:
+		}
+	}()
+	return func() {
+		close(done)
+	}
+}
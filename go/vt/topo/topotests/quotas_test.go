@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
+)
+
+func TestQuotas(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+
+	// No quotas.
+	quotas, err := ts.GetQuotas(ctx, "ks1")
+	require.NoError(t, err)
+	assert.Empty(t, quotas.Quotas)
+	current, _, _ := ts.WatchQuotas(ctx, "ks1")
+	assert.True(t, topo.IsErrType(current.Err, topo.NoNode), "unexpected error: %v", current.Err)
+
+	// Invalid quotas are rejected.
+	err = ts.SaveQuotas(ctx, "ks1", &quotapb.Quotas{Quotas: []*quotapb.Quota{{MaxQps: 10}}})
+	assert.EqualError(t, err, "quota #0 has no name")
+	err = ts.SaveQuotas(ctx, "ks1", &quotapb.Quotas{Quotas: []*quotapb.Quota{{Name: "q1"}, {Name: "q1"}}})
+	assert.EqualError(t, err, "duplicate quota name: q1")
+	err = ts.SaveQuotas(ctx, "ks1", &quotapb.Quotas{Quotas: []*quotapb.Quota{{Name: "q1", MaxQps: -1}}})
+	assert.EqualError(t, err, "quota q1 has a negative value")
+
+	// Save, get and watch the quotas.
+	want := &quotapb.Quotas{Quotas: []*quotapb.Quota{{Name: "batch", Principal: "batch", MaxQps: 100, Action: quotapb.Action_DELAY}}}
+	require.NoError(t, ts.SaveQuotas(ctx, "ks1", want))
+	quotas, err = ts.GetQuotas(ctx, "ks1")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(want, quotas), "got %v, want %v", quotas, want)
+
+	current, changes, cancel := ts.WatchQuotas(ctx, "ks1")
+	require.NoError(t, current.Err)
+	assert.True(t, proto.Equal(want, current.Value), "got %v, want %v", current.Value, want)
+
+	want.Quotas[0].MaxQps = 50
+	require.NoError(t, ts.SaveQuotas(ctx, "ks1", want))
+	wd := <-changes
+	require.NoError(t, wd.Err)
+	assert.True(t, proto.Equal(want, wd.Value), "got %v, want %v", wd.Value, want)
+
+	// Saving no quotas deletes the file.
+	require.NoError(t, ts.SaveQuotas(ctx, "ks1", &quotapb.Quotas{}))
+	wd = <-changes
+	assert.True(t, topo.IsErrType(wd.Err, topo.NoNode), "unexpected error: %v", wd.Err)
+	cancel()
+	for range changes {
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func checkpoint(gtid string, t time.Time) *vtgatepb.VStreamCheckpoint {
+	return &vtgatepb.VStreamCheckpoint{
+		Vgtid: &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "0", Gtid: gtid}}},
+		Time:  logutil.TimeToProto(t),
+	}
+}
+
+func TestVStreamConsumers(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+
+	names, err := ts.GetVStreamConsumerNames(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+	_, err = ts.GetVStreamConsumer(ctx, "c1")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "unexpected error: %v", err)
+	err = ts.CreateVStreamConsumer(ctx, &vtgatepb.VStreamConsumer{Name: "a/b"})
+	assert.EqualError(t, err, `invalid VStream consumer name: "a/b"`)
+
+	now := time.Now()
+	require.NoError(t, ts.CreateVStreamConsumer(ctx, &vtgatepb.VStreamConsumer{Name: "c1", Position: checkpoint("MySQL56/x:1-10", now)}))
+	require.NoError(t, ts.CreateVStreamConsumer(ctx, &vtgatepb.VStreamConsumer{Name: "c2"}))
+	err = ts.CreateVStreamConsumer(ctx, &vtgatepb.VStreamConsumer{Name: "c1"})
+	assert.True(t, topo.IsErrType(err, topo.NodeExists), "unexpected error: %v", err)
+	names, err = ts.GetVStreamConsumerNames(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c1", "c2"}, names)
+
+	err = ts.UpdateVStreamConsumerFields(ctx, "c1", func(c *vtgatepb.VStreamConsumer) error {
+		return topo.AddVStreamCheckpoint(c, checkpoint("MySQL56/x:1-20", now.Add(time.Second)), time.Minute, 2)
+	})
+	require.NoError(t, err)
+	consumer, err := ts.GetVStreamConsumer(ctx, "c1")
+	require.NoError(t, err)
+	assert.Equal(t, "MySQL56/x:1-20", consumer.Position.Vgtid.ShardGtids[0].Gtid)
+	assert.Len(t, consumer.History, 1)
+
+	err = ts.UpdateVStreamConsumerFields(ctx, "absent", func(c *vtgatepb.VStreamConsumer) error { return nil })
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "unexpected error: %v", err)
+
+	require.NoError(t, ts.DeleteVStreamConsumer(ctx, "c2"))
+	names, err = ts.GetVStreamConsumerNames(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c1"}, names)
+}
+
+func TestVStreamCheckpointAck(t *testing.T) {
+	const (
+		uuid1 = "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+		uuid2 = "4e11fa47-71ca-11e1-9e33-c80aa9429562"
+	)
+	shardGtid := func(keyspace, shard, gtid string) *binlogdatapb.ShardGtid {
+		return &binlogdatapb.ShardGtid{Keyspace: keyspace, Shard: shard, Gtid: gtid}
+	}
+	vgtid := func(shardGtids ...*binlogdatapb.ShardGtid) *binlogdatapb.VGtid {
+		return &binlogdatapb.VGtid{ShardGtids: shardGtids}
+	}
+	consumer := &vtgatepb.VStreamConsumer{
+		Name: "c1",
+		Position: &vtgatepb.VStreamCheckpoint{Vgtid: vgtid(
+			shardGtid("ks", "-80", "MySQL56/"+uuid1+":1-10"),
+			shardGtid("ks", "80-", "MySQL56/"+uuid1+":1-5"),
+			shardGtid("unsharded", "0", "current"),
+		)},
+	}
+
+	tests := []struct {
+		name  string
+		vgtid *binlogdatapb.VGtid
+		err   string
+	}{{
+		name: "after the position",
+		vgtid: vgtid(
+			shardGtid("ks", "80-", "MySQL56/"+uuid1+":1-6"),
+			shardGtid("ks", "-80", "MySQL56/"+uuid1+":1-10,"+uuid2+":1"),
+			shardGtid("unsharded", "0", "MySQL56/"+uuid1+":1-3"),
+		),
+	}, {
+		name: "behind the position",
+		vgtid: vgtid(
+			shardGtid("ks", "-80", "MySQL56/"+uuid1+":1-8"),
+			shardGtid("ks", "80-", "MySQL56/"+uuid1+":1-6"),
+			shardGtid("unsharded", "0", "MySQL56/"+uuid1+":1-3"),
+		),
+		err: "VStream consumer c1: position MySQL56/" + uuid1 + ":1-8 of shard ks/-80 is behind its position MySQL56/" + uuid1 + ":1-10",
+	}, {
+		name: "not a position",
+		vgtid: vgtid(
+			shardGtid("ks", "-80", "current"),
+			shardGtid("ks", "80-", "MySQL56/"+uuid1+":1-6"),
+			shardGtid("unsharded", "0", "current"),
+		),
+		err: "VStream consumer c1: position current of shard ks/-80 is behind its position MySQL56/" + uuid1 + ":1-10",
+	}, {
+		name: "missing shard",
+		vgtid: vgtid(
+			shardGtid("ks", "-80", "MySQL56/"+uuid1+":1-10"),
+			shardGtid("unsharded", "0", "current"),
+		),
+		err: "VStream consumer c1: shards [-80] of keyspace ks do not match the shards [-80 80-] of its position",
+	}, {
+		name: "missing keyspace",
+		vgtid: vgtid(
+			shardGtid("ks", "-80", "MySQL56/"+uuid1+":1-10"),
+			shardGtid("ks", "80-", "MySQL56/"+uuid1+":1-5"),
+		),
+		err: "VStream consumer c1: position of keyspace unsharded is missing",
+	}, {
+		name: "other keyspace",
+		vgtid: vgtid(
+			shardGtid("ks", "-80", "MySQL56/"+uuid1+":1-10"),
+			shardGtid("ks", "80-", "MySQL56/"+uuid1+":1-5"),
+			shardGtid("unsharded", "0", "current"),
+			shardGtid("other", "0", "current"),
+		),
+		err: "VStream consumer c1: keyspace other is not in its position",
+	}, {
+		name: "resharded",
+		vgtid: vgtid(
+			shardGtid("ks", "-40", "MySQL56/"+uuid2+":1-3"),
+			shardGtid("ks", "40-80", "MySQL56/"+uuid2+":1-4"),
+			shardGtid("ks", "80-", "MySQL56/"+uuid1+":1-5"),
+			shardGtid("unsharded", "0", "current"),
+		),
+	}, {
+		name: "resharded with a missing shard",
+		vgtid: vgtid(
+			shardGtid("ks", "-40", "MySQL56/"+uuid2+":1-3"),
+			shardGtid("ks", "80-", "MySQL56/"+uuid1+":1-5"),
+			shardGtid("unsharded", "0", "current"),
+		),
+		err: "VStream consumer c1: shards [-40 80-] of keyspace ks do not match the shards [-80 80-] of its position",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := proto.Clone(consumer).(*vtgatepb.VStreamConsumer)
+			err := topo.AddVStreamCheckpoint(c, &vtgatepb.VStreamCheckpoint{Vgtid: tt.vgtid, Time: logutil.TimeToProto(time.Now())}, time.Minute, 2)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				assert.True(t, proto.Equal(consumer, c), "the consumer should be unchanged")
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.vgtid, c.Position.Vgtid))
+		})
+	}
+}
+
+func TestVStreamCheckpointHistory(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	consumer := &vtgatepb.VStreamConsumer{Name: "c1"}
+	for i := 0; i < 10; i++ {
+		require.NoError(t, topo.AddVStreamCheckpoint(consumer, checkpoint(string(rune('a'+i)), start.Add(time.Duration(i)*30*time.Second)), time.Minute, 3))
+	}
+	// One checkpoint per minute is kept, at most 3.
+	var gtids []string
+	for _, c := range consumer.History {
+		gtids = append(gtids, c.Vgtid.ShardGtids[0].Gtid)
+	}
+	assert.Equal(t, []string{"i", "g", "e"}, gtids)
+	assert.Equal(t, "j", consumer.Position.Vgtid.ShardGtids[0].Gtid)
+
+	at := func(d time.Duration) string {
+		c := topo.VStreamCheckpointAt(consumer, start.Add(d))
+		if c == nil {
+			return ""
+		}
+		return c.Vgtid.ShardGtids[0].Gtid
+	}
+	assert.Equal(t, "j", at(time.Hour))
+	assert.Equal(t, "i", at(4*time.Minute+10*time.Second))
+	assert.Equal(t, "e", at(2*time.Minute))
+	assert.Equal(t, "", at(time.Minute))
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the utility methods to manage the VStream consumers.
+// A consumer stores the position its VStream clients acknowledged, so they
+// can resume from it. Consumers are stored in the global cell.
+
+func pathForVStreamConsumer(name string) string {
+	return path.Join(VStreamConsumersPath, name, VStreamConsumerFile)
+}
+
+// ValidateVStreamConsumerName checks a consumer name can be used as a
+// topo path component.
+func ValidateVStreamConsumerName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.Contains(name, "/") {
+		return fmt.Errorf("invalid VStream consumer name: %q", name)
+	}
+	return nil
+}
+
+// GetVStreamConsumerNames returns the names of the existing VStream
+// consumers. They are sorted by name.
+func (ts *Server) GetVStreamConsumerNames(ctx context.Context) ([]string, error) {
+	entries, err := ts.globalCell.ListDir(ctx, VStreamConsumersPath, false /*full*/)
+	switch {
+	case IsErrType(err, NoNode):
+		return nil, nil
+	case err == nil:
+		return DirEntriesToStringArray(entries), nil
+	default:
+		return nil, err
+	}
+}
+
+// GetVStreamConsumer reads a VStream consumer from the global cell.
+func (ts *Server) GetVStreamConsumer(ctx context.Context, name string) (*vtgatepb.VStreamConsumer, error) {
+	if err := ValidateVStreamConsumerName(name); err != nil {
+		return nil, err
+	}
+	contents, _, err := ts.globalCell.Get(ctx, pathForVStreamConsumer(name))
+	if err != nil {
+		return nil, err
+	}
+	consumer := &vtgatepb.VStreamConsumer{}
+	if err := proto.Unmarshal(contents, consumer); err != nil {
+		return nil, vterrors.Wrapf(err, "bad VStream consumer data: %q", contents)
+	}
+	return consumer, nil
+}
+
+// CreateVStreamConsumer creates a new VStream consumer. It returns a
+// NodeExists error if the consumer already exists.
+func (ts *Server) CreateVStreamConsumer(ctx context.Context, consumer *vtgatepb.VStreamConsumer) error {
+	if err := ValidateVStreamConsumerName(consumer.Name); err != nil {
+		return err
+	}
+	contents, err := proto.Marshal(consumer)
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Create(ctx, pathForVStreamConsumer(consumer.Name), contents)
+	return err
+}
+
+// UpdateVStreamConsumerFields is a high level helper method to read a
+// VStream consumer, update its fields, and then write it back. If the write
+// fails due to a version mismatch, it will re-read the record and retry the
+// update. If the update method returns ErrNoUpdateNeeded, nothing is written,
+// and nil is returned. Unlike the cell info, the consumer must exist.
+func (ts *Server) UpdateVStreamConsumerFields(ctx context.Context, name string, update func(*vtgatepb.VStreamConsumer) error) error {
+	if err := ValidateVStreamConsumerName(name); err != nil {
+		return err
+	}
+	filePath := pathForVStreamConsumer(name)
+	for {
+		contents, version, err := ts.globalCell.Get(ctx, filePath)
+		if err != nil {
+			return err
+		}
+		consumer := &vtgatepb.VStreamConsumer{}
+		if err := proto.Unmarshal(contents, consumer); err != nil {
+			return vterrors.Wrapf(err, "bad VStream consumer data: %q", contents)
+		}
+
+		if err = update(consumer); err != nil {
+			if IsErrType(err, NoUpdateNeeded) {
+				return nil
+			}
+			return err
+		}
+
+		contents, err = proto.Marshal(consumer)
+		if err != nil {
+			return err
+		}
+		if _, err = ts.globalCell.Update(ctx, filePath, contents, version); !IsErrType(err, BadVersion) {
+			// This includes the 'err=nil' case.
+			return err
+		}
+	}
+}
+
+// DeleteVStreamConsumer deletes a VStream consumer.
+func (ts *Server) DeleteVStreamConsumer(ctx context.Context, name string) error {
+	if err := ValidateVStreamConsumerName(name); err != nil {
+		return err
+	}
+	return ts.globalCell.Delete(ctx, pathForVStreamConsumer(name), nil)
+}
+
+// AddVStreamCheckpoint makes checkpoint the position of the consumer. The
+// checkpoint is also added to the history if the most recent history entry
+// is at least historyInterval older, and the history is trimmed to
+// historySize entries. It returns a FAILED_PRECONDITION error, and leaves the
+// consumer unchanged, if the checkpoint is not after the position of the
+// consumer (see CheckVStreamCheckpoint).
+func AddVStreamCheckpoint(consumer *vtgatepb.VStreamConsumer, checkpoint *vtgatepb.VStreamCheckpoint, historyInterval time.Duration, historySize int) error {
+	if err := CheckVStreamCheckpoint(consumer, checkpoint.GetVgtid()); err != nil {
+		return err
+	}
+	consumer.Position = checkpoint
+	if historySize <= 0 {
+		consumer.History = nil
+		return nil
+	}
+	if len(consumer.History) > 0 {
+		last := logutil.ProtoToTime(consumer.History[0].Time)
+		if logutil.ProtoToTime(checkpoint.Time).Sub(last) < historyInterval {
+			return nil
+		}
+	}
+	consumer.History = append([]*vtgatepb.VStreamCheckpoint{checkpoint}, consumer.History...)
+	if len(consumer.History) > historySize {
+		consumer.History = consumer.History[:historySize]
+	}
+	return nil
+}
+
+// CheckVStreamCheckpoint returns a FAILED_PRECONDITION error if vgtid cannot
+// replace the position of the consumer, because it would make the streams of
+// the consumer skip or replay events:
+//   - vgtid must have the keyspaces and shards of the position. After a
+//     reshard, the shards of a keyspace may differ if they cover the same key
+//     range.
+//   - the GTID set of every shard of vgtid must contain the GTID set of the
+//     shard in the position, unless the latter is not a GTID position, e.g.
+//     "current".
+func CheckVStreamCheckpoint(consumer *vtgatepb.VStreamConsumer, vgtid *binlogdatapb.VGtid) error {
+	position := consumer.GetPosition().GetVgtid()
+	if len(position.GetShardGtids()) == 0 {
+		return nil
+	}
+
+	stored := vstreamShardGtids(position)
+	acked := vstreamShardGtids(vgtid)
+	for keyspace, storedShards := range stored {
+		if _, ok := acked[keyspace]; !ok {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "VStream consumer %s: position of keyspace %s is missing", consumer.Name, keyspace)
+		}
+		if !sameShards(storedShards, acked[keyspace]) && !sameKeyRange(storedShards, acked[keyspace]) {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "VStream consumer %s: shards %v of keyspace %s do not match the shards %v of its position",
+				consumer.Name, shardNames(acked[keyspace]), keyspace, shardNames(storedShards))
+		}
+	}
+	for keyspace, ackedShards := range acked {
+		storedShards, ok := stored[keyspace]
+		if !ok {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "VStream consumer %s: keyspace %s is not in its position", consumer.Name, keyspace)
+		}
+		for shard, sgtid := range ackedShards {
+			storedGtid, ok := storedShards[shard]
+			if !ok {
+				// The keyspace was resharded.
+				continue
+			}
+			storedPos, err := mysql.DecodePosition(storedGtid.Gtid)
+			if err != nil || storedPos.IsZero() {
+				continue
+			}
+			pos, err := mysql.DecodePosition(sgtid.Gtid)
+			if err != nil || !pos.AtLeast(storedPos) {
+				return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "VStream consumer %s: position %s of shard %s/%s is behind its position %s",
+					consumer.Name, sgtid.Gtid, keyspace, shard, storedGtid.Gtid)
+			}
+		}
+	}
+	return nil
+}
+
+// vstreamShardGtids returns the shard positions of vgtid by keyspace and shard.
+func vstreamShardGtids(vgtid *binlogdatapb.VGtid) map[string]map[string]*binlogdatapb.ShardGtid {
+	shardGtids := make(map[string]map[string]*binlogdatapb.ShardGtid)
+	for _, sgtid := range vgtid.GetShardGtids() {
+		if shardGtids[sgtid.Keyspace] == nil {
+			shardGtids[sgtid.Keyspace] = make(map[string]*binlogdatapb.ShardGtid)
+		}
+		shardGtids[sgtid.Keyspace][sgtid.Shard] = sgtid
+	}
+	return shardGtids
+}
+
+func shardNames(shards map[string]*binlogdatapb.ShardGtid) []string {
+	names := make([]string, 0, len(shards))
+	for shard := range shards {
+		names = append(names, shard)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sameShards(a, b map[string]*binlogdatapb.ShardGtid) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for shard := range a {
+		if _, ok := b[shard]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// sameKeyRange returns true if both sets of range-based shards cover the
+// same contiguous key range.
+func sameKeyRange(a, b map[string]*binlogdatapb.ShardGtid) bool {
+	aRange, ok := shardsKeyRange(a)
+	if !ok {
+		return false
+	}
+	bRange, ok := shardsKeyRange(b)
+	if !ok {
+		return false
+	}
+	return key.KeyRangeEqual(aRange, bRange)
+}
+
+// shardsKeyRange returns the key range covered by the shards, and false if
+// they are not range-based or do not cover a contiguous key range.
+func shardsKeyRange(shards map[string]*binlogdatapb.ShardGtid) (*topodatapb.KeyRange, bool) {
+	var keyRanges []*topodatapb.KeyRange
+	for shard := range shards {
+		_, keyRange, err := ValidateShardName(shard)
+		if err != nil || keyRange == nil {
+			return nil, false
+		}
+		keyRanges = append(keyRanges, keyRange)
+	}
+	sort.Slice(keyRanges, func(i, j int) bool {
+		return key.KeyRangeStartSmaller(keyRanges[i], keyRanges[j])
+	})
+	covered := keyRanges[0]
+	for _, keyRange := range keyRanges[1:] {
+		var ok bool
+		if covered, ok = key.KeyRangeAdd(covered, keyRange); !ok {
+			return nil, false
+		}
+	}
+	return covered, true
+}
+
+// VStreamCheckpointAt returns the most recent checkpoint of the consumer,
+// among its position and history, which is not after t. It returns nil if
+// there is none.
+func VStreamCheckpointAt(consumer *vtgatepb.VStreamConsumer, t time.Time) *vtgatepb.VStreamCheckpoint {
+	if consumer.Position != nil && !logutil.ProtoToTime(consumer.Position.Time).After(t) {
+		return consumer.Position
+	}
+	for _, checkpoint := range consumer.History {
+		if !logutil.ProtoToTime(checkpoint.Time).After(t) {
+			return checkpoint
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamsink
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// EventType is the type of an Event.
+type EventType string
+
+// The types of events.
+const (
+	EventInsert = EventType("insert")
+	EventUpdate = EventType("update")
+	EventDelete = EventType("delete")
+	EventDDL    = EventType("ddl")
+)
+
+// Event is a change delivered to the sinks: a row change, or a DDL.
+type Event struct {
+	Type     EventType `json:"type"`
+	Keyspace string    `json:"keyspace"`
+	Shard    string    `json:"shard"`
+	Table    string    `json:"table,omitempty"`
+	// Before and After are the row before and after the change, by column
+	// name. Numbers are JSON numbers, binary values are base64 encoded, and
+	// the other values are strings.
+	Before map[string]interface{} `json:"before,omitempty"`
+	After  map[string]interface{} `json:"after,omitempty"`
+	// Statement is the statement of a DDL.
+	Statement string `json:"statement,omitempty"`
+	// Gtid is the position of the transaction in its shard. Events are
+	// delivered at least once, so it can be used to detect duplicates.
+	Gtid string `json:"gtid,omitempty"`
+	// Timestamp is the time of the transaction, in seconds.
+	Timestamp int64 `json:"timestamp"`
+	// Key identifies the row: it has the keyspace, table and primary key
+	// values of the row. It is empty if the table has no primary key, and
+	// for DDLs.
+	Key string `json:"-"`
+}
+
+// eventBuilder converts the VEvents of a stream to Events. It keeps the
+// fields of each table, and the position of each shard.
+type eventBuilder struct {
+	fields map[string][]*querypb.Field
+	gtids  map[string]string
+}
+
+func newEventBuilder() *eventBuilder {
+	return &eventBuilder{
+		fields: make(map[string][]*querypb.Field),
+		gtids:  make(map[string]string),
+	}
+}
+
+// add returns the Events of a VEvent, if any.
+func (b *eventBuilder) add(ev *binlogdatapb.VEvent) ([]*Event, error) {
+	switch ev.Type {
+	case binlogdatapb.VEventType_VGTID:
+		for _, sgtid := range ev.Vgtid.GetShardGtids() {
+			b.gtids[sgtid.Keyspace+"/"+sgtid.Shard] = sgtid.Gtid
+		}
+	case binlogdatapb.VEventType_FIELD:
+		b.fields[ev.FieldEvent.TableName] = ev.FieldEvent.Fields
+	case binlogdatapb.VEventType_DDL:
+		return []*Event{{
+			Type:      EventDDL,
+			Keyspace:  ev.Keyspace,
+			Shard:     ev.Shard,
+			Statement: ev.Statement,
+			Gtid:      b.gtids[ev.Keyspace+"/"+ev.Shard],
+			Timestamp: ev.Timestamp,
+		}}, nil
+	case binlogdatapb.VEventType_ROW:
+		fields, ok := b.fields[ev.RowEvent.TableName]
+		if !ok {
+			return nil, fmt.Errorf("no fields for table %v", ev.RowEvent.TableName)
+		}
+		var events []*Event
+		for _, change := range ev.RowEvent.RowChanges {
+			event := &Event{
+				Keyspace:  ev.Keyspace,
+				Shard:     ev.Shard,
+				Table:     strings.TrimPrefix(ev.RowEvent.TableName, ev.Keyspace+"."),
+				Gtid:      b.gtids[ev.Keyspace+"/"+ev.Shard],
+				Timestamp: ev.Timestamp,
+			}
+			var keyRow *querypb.Row
+			switch {
+			case change.Before == nil:
+				event.Type = EventInsert
+				keyRow = change.After
+			case change.After == nil:
+				event.Type = EventDelete
+				keyRow = change.Before
+			default:
+				event.Type = EventUpdate
+				keyRow = change.After
+			}
+			if change.Before != nil {
+				event.Before = rowValues(fields, change.Before)
+			}
+			if change.After != nil {
+				event.After = rowValues(fields, change.After)
+			}
+			key, err := rowKey(event.Keyspace, event.Table, fields, keyRow)
+			if err != nil {
+				return nil, err
+			}
+			event.Key = key
+			events = append(events, event)
+		}
+		return events, nil
+	}
+	return nil, nil
+}
+
+func rowValues(fields []*querypb.Field, row *querypb.Row) map[string]interface{} {
+	values := make(map[string]interface{}, len(fields))
+	for i, v := range sqltypes.MakeRowTrusted(fields, row) {
+		values[fields[i].Name] = jsonValue(v)
+	}
+	return values
+}
+
+func jsonValue(v sqltypes.Value) interface{} {
+	switch {
+	case v.IsNull():
+		return nil
+	case v.IsIntegral(), v.IsFloat(), v.Type() == sqltypes.Decimal:
+		return json.RawMessage(v.Raw())
+	case v.IsBinary():
+		return v.Raw()
+	default:
+		return v.ToString()
+	}
+}
+
+// rowKey returns the key of a row, made of its primary key values.
+func rowKey(keyspace, table string, fields []*querypb.Field, row *querypb.Row) (string, error) {
+	var pk []interface{}
+	for i, v := range sqltypes.MakeRowTrusted(fields, row) {
+		if fields[i].Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) != 0 {
+			pk = append(pk, jsonValue(v))
+		}
+	}
+	if len(pk) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(pk)
+	if err != nil {
+		return "", err
+	}
+	return keyspace + "." + table + ":" + string(b), nil
+}
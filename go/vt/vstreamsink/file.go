@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamsink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// This file contains the file sink, which writes the events as
+// newline-delimited JSON to rotating files.
+
+func init() {
+	RegisterSink("file", NewFileSink)
+}
+
+// FileSinkOptions are the options of the file sink.
+type FileSinkOptions struct {
+	// Directory is where the files are written.
+	Directory string `json:"directory"`
+	// Prefix is the prefix of the file names, which are followed by the
+	// time the file was created, and the .ndjson extension.
+	Prefix string `json:"prefix"`
+	// MaxBytes and MaxAgeSeconds bound the size and age of a file, after
+	// which a new file is started. Zero disables the bound.
+	MaxBytes      int64   `json:"max_bytes"`
+	MaxAgeSeconds float64 `json:"max_age_seconds"`
+}
+
+// FileSink writes the events as newline-delimited JSON to rotating files.
+// The files are synced after each write. A file is never written again
+// once the next one is started.
+type FileSink struct {
+	options FileSinkOptions
+
+	file    *os.File
+	size    int64
+	created time.Time
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// NewFileSink creates a file sink.
+func NewFileSink(options json.RawMessage) (Sink, error) {
+	s := &FileSink{
+		options: FileSinkOptions{
+			Prefix:        "vstream",
+			MaxBytes:      64 * 1024 * 1024,
+			MaxAgeSeconds: 3600,
+		},
+		now: time.Now,
+	}
+	if err := decodeOptions(options, &s.options); err != nil {
+		return nil, err
+	}
+	if s.options.Directory == "" {
+		return nil, errors.New("file sink: directory is required")
+	}
+	if err := os.MkdirAll(s.options.Directory, 0755); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Write is part of the Sink interface.
+func (s *FileSink) Write(ctx context.Context, events []*Event) error {
+	var data []byte
+	for _, event := range events {
+		b, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		data = append(data, b...)
+		data = append(data, '\n')
+	}
+
+	if err := s.rotate(); err != nil {
+		return err
+	}
+	n, err := s.file.Write(data)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("file sink: %v", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("file sink: %v", err)
+	}
+	return nil
+}
+
+// rotate starts a new file if there is none, or if the current one is
+// too large or too old.
+func (s *FileSink) rotate() error {
+	now := s.now()
+	if s.file != nil {
+		full := s.options.MaxBytes > 0 && s.size >= s.options.MaxBytes
+		old := s.options.MaxAgeSeconds > 0 && now.Sub(s.created) >= time.Duration(s.options.MaxAgeSeconds*float64(time.Second))
+		if !full && !old {
+			return nil
+		}
+		if err := s.file.Close(); err != nil {
+			return fmt.Errorf("file sink: %v", err)
+		}
+		s.file = nil
+	}
+
+	name := filepath.Join(s.options.Directory, fmt.Sprintf("%s-%s.ndjson", s.options.Prefix, now.UTC().Format("20060102T150405.000000000Z")))
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return fmt.Errorf("file sink: %v", err)
+	}
+	s.file = file
+	s.size = 0
+	s.created = now
+	return nil
+}
+
+// Close is part of the Sink interface.
+func (s *FileSink) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
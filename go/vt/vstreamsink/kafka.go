@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamsink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"vitess.io/vitess/go/vt/vstreamsink/kafka"
+)
+
+// This file contains the Kafka sink, which produces the events to a Kafka
+// topic.
+
+func init() {
+	RegisterSink("kafka", NewKafkaSink)
+}
+
+// KafkaSinkOptions are the options of the Kafka sink.
+type KafkaSinkOptions struct {
+	// Brokers are the addresses used to fetch the metadata of the cluster.
+	Brokers []string `json:"brokers"`
+	// Topic is where the events are produced.
+	Topic string `json:"topic"`
+	// ClientID identifies the producer to the brokers.
+	ClientID string `json:"client_id"`
+	// TimeoutSeconds is the timeout of each request.
+	TimeoutSeconds float64 `json:"timeout_seconds"`
+	// MaxRetries is the number of times a failed produce is retried.
+	MaxRetries int `json:"max_retries"`
+	// RetryDelaySeconds is the delay before the first retry. It doubles
+	// with each retry.
+	RetryDelaySeconds float64 `json:"retry_delay_seconds"`
+}
+
+// KafkaSink produces the events to a Kafka topic, as JSON messages. The
+// key of the messages is the key of the row, so that the changes of a row
+// go to the same partition, in order. The writes wait for all the in-sync
+// replicas.
+type KafkaSink struct {
+	topic  string
+	client *kafka.Client
+}
+
+// NewKafkaSink creates a Kafka sink.
+func NewKafkaSink(options json.RawMessage) (Sink, error) {
+	opts := KafkaSinkOptions{
+		ClientID:          "vstreamsink",
+		TimeoutSeconds:    30,
+		MaxRetries:        5,
+		RetryDelaySeconds: 1,
+	}
+	if err := decodeOptions(options, &opts); err != nil {
+		return nil, err
+	}
+	if len(opts.Brokers) == 0 || opts.Topic == "" {
+		return nil, errors.New("kafka sink: brokers and topic are required")
+	}
+	return &KafkaSink{
+		topic: opts.Topic,
+		client: kafka.NewClient(opts.Brokers, kafka.Options{
+			ClientID:   opts.ClientID,
+			Timeout:    time.Duration(opts.TimeoutSeconds * float64(time.Second)),
+			MaxRetries: opts.MaxRetries,
+			RetryDelay: time.Duration(opts.RetryDelaySeconds * float64(time.Second)),
+		}),
+	}, nil
+}
+
+// Write is part of the Sink interface.
+func (s *KafkaSink) Write(ctx context.Context, events []*Event) error {
+	messages := make([]kafka.Message, 0, len(events))
+	for _, event := range events {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		m := kafka.Message{
+			Value:     value,
+			Timestamp: time.Unix(event.Timestamp, 0),
+		}
+		if event.Key != "" {
+			m.Key = []byte(event.Key)
+		}
+		messages = append(messages, m)
+	}
+	return s.client.Produce(ctx, s.topic, messages)
+}
+
+// Close is part of the Sink interface.
+func (s *KafkaSink) Close() error {
+	s.client.Close()
+	return nil
+}
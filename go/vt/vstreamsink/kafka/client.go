@@ -0,0 +1,395 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kafka is a minimal producer for the Kafka protocol, and a fake
+// broker to test it. It only produces uncompressed record batches, waits
+// for all the in-sync replicas (acks=all), and partitions the messages by
+// key like the default partitioner of the Java client.
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// Options are the options of a Client.
+type Options struct {
+	// ClientID is sent with every request.
+	ClientID string
+	// Timeout is the timeout of each request, and the produce timeout
+	// sent to the brokers.
+	Timeout time.Duration
+	// MaxRetries is the number of times a failed produce is retried.
+	MaxRetries int
+	// RetryDelay is the delay before the first retry. It doubles with
+	// each retry.
+	RetryDelay time.Duration
+}
+
+// Client produces messages to a Kafka cluster. It is safe for concurrent
+// use, but the produce requests are sent one at a time, so that the order
+// of the messages of a partition is kept.
+type Client struct {
+	bootstrap []string
+	opts      Options
+
+	mu            sync.Mutex
+	correlationID int32
+	nextPartition int32
+	conns         map[string]net.Conn
+	// brokers maps the node id of the brokers to their address.
+	brokers map[int32]string
+	// leaders holds the node id of the leader of each partition of the
+	// topics.
+	leaders map[string][]int32
+}
+
+// NewClient creates a Client. brokers are the addresses used to fetch the
+// metadata of the cluster.
+func NewClient(brokers []string, opts Options) *Client {
+	return &Client{
+		bootstrap: brokers,
+		opts:      opts,
+		conns:     make(map[string]net.Conn),
+		brokers:   make(map[int32]string),
+		leaders:   make(map[string][]int32),
+	}
+}
+
+// Close closes the connections to the brokers.
+func (c *Client) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for addr, conn := range c.conns {
+		conn.Close()
+		delete(c.conns, addr)
+	}
+}
+
+// retriableError is a network error, or a retriable error code.
+type retriableError struct {
+	err error
+}
+
+func (e *retriableError) Error() string {
+	return e.err.Error()
+}
+
+// Produce sends messages to topic, and returns once they are all
+// acknowledged. Failed partitions are retried up to MaxRetries times, so
+// a message may be written more than once.
+func (c *Client) Produce(ctx context.Context, topic string, messages []Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delay := c.opts.RetryDelay
+	for attempt := 0; ; attempt++ {
+		failed, err := c.produce(ctx, topic, messages)
+		if err == nil {
+			return nil
+		}
+		var rerr *retriableError
+		if !errors.As(err, &rerr) || attempt >= c.opts.MaxRetries {
+			return err
+		}
+		log.Warningf("Retrying %d messages to kafka topic %v: %v", len(failed), topic, err)
+		messages = failed
+		delete(c.leaders, topic)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// produce sends one produce request to the leader of each partition. It
+// returns the messages of the partitions which failed, in order.
+func (c *Client) produce(ctx context.Context, topic string, messages []Message) ([]Message, error) {
+	leaders, err := c.partitionLeaders(ctx, topic)
+	if err != nil {
+		return messages, err
+	}
+
+	// Group the messages by leader, then by partition.
+	byPartition := make(map[int32][]Message)
+	var partitions []int32
+	for _, m := range messages {
+		var partition int32
+		if m.Key != nil {
+			partition = partitionFor(m.Key, len(leaders))
+		} else {
+			partition = c.nextPartition % int32(len(leaders))
+			c.nextPartition++
+		}
+		if _, ok := byPartition[partition]; !ok {
+			partitions = append(partitions, partition)
+		}
+		byPartition[partition] = append(byPartition[partition], m)
+	}
+	byLeader := make(map[int32][]int32)
+	var nodes []int32
+	for _, partition := range partitions {
+		leader := leaders[partition]
+		if _, ok := byLeader[leader]; !ok {
+			nodes = append(nodes, leader)
+		}
+		byLeader[leader] = append(byLeader[leader], partition)
+	}
+
+	var failed []Message
+	var firstErr error
+	for _, node := range nodes {
+		failedPartitions, err := c.produceToLeader(ctx, node, topic, byLeader[node], byPartition)
+		for _, partition := range failedPartitions {
+			failed = append(failed, byPartition[partition]...)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return failed, firstErr
+}
+
+func (c *Client) produceToLeader(ctx context.Context, node int32, topic string, partitions []int32, byPartition map[int32][]Message) ([]int32, error) {
+	addr, ok := c.brokers[node]
+	if !ok {
+		return partitions, &retriableError{fmt.Errorf("kafka: unknown broker %d", node)}
+	}
+
+	req := &encoder{}
+	req.nullableString(nil) // transactional id
+	req.int16(-1)           // acks: all in-sync replicas
+	req.int32(int32(c.opts.Timeout / time.Millisecond))
+	req.int32(1)
+	req.string(topic)
+	req.int32(int32(len(partitions)))
+	for _, partition := range partitions {
+		req.int32(partition)
+		req.bytes(encodeRecordBatch(byPartition[partition]))
+	}
+	resp, err := c.roundTrip(ctx, addr, apiKeyProduce, produceVersion, req.buf)
+	if err != nil {
+		return partitions, &retriableError{err}
+	}
+
+	d := &decoder{buf: resp}
+	errs := make(map[int32]int16)
+	for i, n := 0, d.arrayLen(); i < n; i++ {
+		d.string()
+		for j, m := 0, d.arrayLen(); j < m; j++ {
+			partition := d.int32()
+			errs[partition] = d.int16()
+			d.int64() // base offset
+			d.int64() // log append time
+		}
+	}
+	if d.err != nil {
+		return partitions, fmt.Errorf("kafka: bad produce response: %v", d.err)
+	}
+
+	var failed []int32
+	var firstErr error
+	for _, partition := range partitions {
+		code, ok := errs[partition]
+		if !ok {
+			code = ErrUnknownTopicOrPartition
+		}
+		if code == ErrNone {
+			continue
+		}
+		failed = append(failed, partition)
+		if firstErr != nil {
+			continue
+		}
+		err := fmt.Errorf("kafka: produce to %v/%d failed: %w", topic, partition, &Error{Code: code})
+		if retriable(code) {
+			err = &retriableError{err}
+		}
+		firstErr = err
+	}
+	return failed, firstErr
+}
+
+// partitionLeaders returns the leader of each partition of topic, fetching
+// the metadata if needed.
+func (c *Client) partitionLeaders(ctx context.Context, topic string) ([]int32, error) {
+	if leaders, ok := c.leaders[topic]; ok {
+		return leaders, nil
+	}
+
+	req := &encoder{}
+	req.int32(1)
+	req.string(topic)
+
+	// Try the known brokers, then the bootstrap ones.
+	var addrs []string
+	for _, addr := range c.brokers {
+		addrs = append(addrs, addr)
+	}
+	addrs = append(addrs, c.bootstrap...)
+	var resp []byte
+	var err error
+	for _, addr := range addrs {
+		resp, err = c.roundTrip(ctx, addr, apiKeyMetadata, metadataVersion, req.buf)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, &retriableError{fmt.Errorf("kafka: cannot fetch metadata: %v", err)}
+	}
+
+	d := &decoder{buf: resp}
+	for i, n := 0, d.arrayLen(); i < n; i++ {
+		node := d.int32()
+		host := d.string()
+		port := d.int32()
+		d.string() // rack
+		c.brokers[node] = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	d.int32() // controller id
+	var leaders []int32
+	var code int16
+	for i, n := 0, d.arrayLen(); i < n; i++ {
+		topicCode := d.int16()
+		name := d.string()
+		d.int8() // is internal
+		var partitionLeaders []int32
+		for j, m := 0, d.arrayLen(); j < m; j++ {
+			d.int16() // partition error code
+			partition := d.int32()
+			leader := d.int32()
+			for k, l := 0, d.arrayLen(); k < l; k++ {
+				d.int32() // replica
+			}
+			for k, l := 0, d.arrayLen(); k < l; k++ {
+				d.int32() // in-sync replica
+			}
+			if partition >= 0 && int(partition) < m {
+				if partitionLeaders == nil {
+					partitionLeaders = make([]int32, m)
+				}
+				partitionLeaders[partition] = leader
+			}
+		}
+		if name == topic {
+			code = topicCode
+			leaders = partitionLeaders
+		}
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("kafka: bad metadata response: %v", d.err)
+	}
+	if code != ErrNone || len(leaders) == 0 {
+		if code == ErrNone {
+			code = ErrLeaderNotAvailable
+		}
+		err := fmt.Errorf("kafka: no metadata for topic %v: %w", topic, &Error{Code: code})
+		if retriable(code) {
+			return nil, &retriableError{err}
+		}
+		return nil, err
+	}
+	c.leaders[topic] = leaders
+	return leaders, nil
+}
+
+// roundTrip sends a request to a broker and returns the response body.
+// The connection is closed on error.
+func (c *Client) roundTrip(ctx context.Context, addr string, apiKey, version int16, body []byte) ([]byte, error) {
+	conn, ok := c.conns[addr]
+	if !ok {
+		dialer := net.Dialer{Timeout: c.opts.Timeout}
+		var err error
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+		c.conns[addr] = conn
+	}
+	resp, err := c.exchange(ctx, conn, apiKey, version, body)
+	if err != nil {
+		conn.Close()
+		delete(c.conns, addr)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) exchange(ctx context.Context, conn net.Conn, apiKey, version int16, body []byte) ([]byte, error) {
+	deadline := time.Now().Add(c.opts.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	c.correlationID++
+	correlationID := c.correlationID
+	header := &encoder{}
+	header.int16(apiKey)
+	header.int16(version)
+	header.int32(correlationID)
+	header.string(c.opts.ClientID)
+	req := &encoder{}
+	req.int32(int32(len(header.buf) + len(body)))
+	req.buf = append(req.buf, header.buf...)
+	req.buf = append(req.buf, body...)
+	if _, err := conn.Write(req.buf); err != nil {
+		return nil, err
+	}
+
+	resp, err := readFrame(conn)
+	if err != nil {
+		return nil, err
+	}
+	d := &decoder{buf: resp}
+	if id := d.int32(); id != correlationID {
+		return nil, fmt.Errorf("kafka: got response %d, want %d", id, correlationID)
+	}
+	return d.buf, d.err
+}
+
+// maxFrameSize bounds the size of the requests and responses read.
+const maxFrameSize = 100 * 1024 * 1024
+
+// readFrame reads a size-prefixed request or response.
+func readFrame(r io.Reader) ([]byte, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, err
+	}
+	n := (&decoder{buf: size[:]}).int32()
+	if n < 0 || n > maxFrameSize {
+		return nil, fmt.Errorf("kafka: bad frame size %d", n)
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
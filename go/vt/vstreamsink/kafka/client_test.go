@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMurmur2(t *testing.T) {
+	// Values from the tests of the Java client.
+	cases := map[string]int32{
+		"21":                         -973932308,
+		"foobar":                     -790332482,
+		"a-little-bit-long-string":   -985981536,
+		"a-little-bit-longer-string": -1486304829,
+		"lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8": -58897971,
+		"abc": 479470107,
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, murmur2([]byte(in)), in)
+	}
+}
+
+func TestRecordBatch(t *testing.T) {
+	now := time.Unix(1600000000, 123000000)
+	in := []Message{
+		{Key: []byte("k1"), Value: []byte("v1"), Timestamp: now},
+		{Value: []byte("v2"), Timestamp: now.Add(time.Second)},
+	}
+	batch := encodeRecordBatch(in)
+	out, err := decodeRecordBatches(batch)
+	require.NoError(t, err)
+	assert.Equal(t, in[0].Key, out[0].Key)
+	assert.Nil(t, out[1].Key)
+	assert.Equal(t, in[1].Value, out[1].Value)
+	assert.True(t, in[1].Timestamp.Equal(out[1].Timestamp), "got %v", out[1].Timestamp)
+
+	batch[len(batch)-1] ^= 0xff
+	_, err = decodeRecordBatches(batch)
+	assert.EqualError(t, err, "kafka: bad record batch checksum")
+}
+
+func TestProduce(t *testing.T) {
+	ctx := context.Background()
+	broker, err := NewFakeBroker(3)
+	require.NoError(t, err)
+	defer broker.Close()
+	client := NewClient([]string{broker.Addr()}, Options{ClientID: "test", Timeout: 5 * time.Second, MaxRetries: 2, RetryDelay: time.Millisecond})
+	defer client.Close()
+
+	var messages []Message
+	for _, key := range []string{"a", "b", "c", "a", "d", "a"} {
+		messages = append(messages, Message{Key: []byte(key), Value: []byte("value " + key), Timestamp: time.Now()})
+	}
+	require.NoError(t, client.Produce(ctx, "t1", messages))
+
+	// The messages of a key go to the same partition, in order.
+	total := 0
+	for partition, got := range broker.Messages("t1") {
+		for _, m := range got {
+			assert.Equal(t, int32(partition), partitionFor(m.Key, 3))
+			assert.Equal(t, "value "+string(m.Key), string(m.Value))
+		}
+		total += len(got)
+	}
+	assert.Equal(t, 6, total)
+
+	// Retriable errors are retried.
+	broker.FailProduce(ErrNotLeaderForPartition)
+	require.NoError(t, client.Produce(ctx, "t2", messages[:1]))
+	assert.Len(t, broker.Messages("t2")[partitionFor([]byte("a"), 3)], 1)
+
+	// Other errors are not.
+	broker.FailProduce(ErrUnsupportedVersion)
+	err = client.Produce(ctx, "t3", messages[:1])
+	assert.EqualError(t, err, "kafka: produce to t3/1 failed: kafka error code 35")
+
+	// Retries are limited.
+	broker.FailProduce(ErrRequestTimedOut, ErrRequestTimedOut, ErrRequestTimedOut)
+	err = client.Produce(ctx, "t3", messages[:1])
+	assert.EqualError(t, err, "kafka: produce to t3/1 failed: kafka error code 7")
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"net"
+	"strconv"
+	"sync"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// FakeBroker is an in-process Kafka broker, for tests. It serves the
+// Metadata and Produce requests sent by Client, creates the topics when
+// they are first used, and keeps the produced messages in memory.
+type FakeBroker struct {
+	listener   net.Listener
+	partitions int
+
+	mu            sync.Mutex
+	topics        map[string][][]Message
+	produceErrors []int16
+	conns         map[net.Conn]bool
+	wg            sync.WaitGroup
+}
+
+// NewFakeBroker starts a FakeBroker listening on a local port. Its topics
+// have the given number of partitions.
+func NewFakeBroker(partitions int) (*FakeBroker, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	b := &FakeBroker{
+		listener:   listener,
+		partitions: partitions,
+		topics:     make(map[string][][]Message),
+		conns:      make(map[net.Conn]bool),
+	}
+	b.wg.Add(1)
+	go b.serve()
+	return b, nil
+}
+
+// Addr returns the address of the broker.
+func (b *FakeBroker) Addr() string {
+	return b.listener.Addr().String()
+}
+
+// Messages returns the messages of each partition of a topic.
+func (b *FakeBroker) Messages(topic string) [][]Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var result [][]Message
+	for _, messages := range b.topics[topic] {
+		result = append(result, append([]Message(nil), messages...))
+	}
+	return result
+}
+
+// FailProduce makes the next produce requests fail, one per error code.
+func (b *FakeBroker) FailProduce(codes ...int16) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.produceErrors = append(b.produceErrors, codes...)
+}
+
+// Close stops the broker.
+func (b *FakeBroker) Close() {
+	b.listener.Close()
+	b.mu.Lock()
+	for conn := range b.conns {
+		conn.Close()
+	}
+	b.mu.Unlock()
+	b.wg.Wait()
+}
+
+func (b *FakeBroker) serve() {
+	defer b.wg.Done()
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		b.mu.Lock()
+		b.conns[conn] = true
+		b.mu.Unlock()
+		b.wg.Add(1)
+		go b.handle(conn)
+	}
+}
+
+func (b *FakeBroker) handle(conn net.Conn) {
+	defer b.wg.Done()
+	defer func() {
+		b.mu.Lock()
+		delete(b.conns, conn)
+		b.mu.Unlock()
+		conn.Close()
+	}()
+	for {
+		req, err := readFrame(conn)
+		if err != nil {
+			return
+		}
+		d := &decoder{buf: req}
+		apiKey := d.int16()
+		version := d.int16()
+		correlationID := d.int32()
+		d.string() // client id
+		if d.err != nil {
+			return
+		}
+
+		resp := &encoder{}
+		switch {
+		case apiKey == apiKeyMetadata && version == metadataVersion:
+			b.metadata(d, resp)
+		case apiKey == apiKeyProduce && version == produceVersion:
+			b.produce(d, resp)
+		default:
+			log.Errorf("FakeBroker: unsupported request %d v%d", apiKey, version)
+			return
+		}
+		if d.err != nil {
+			log.Errorf("FakeBroker: bad request %d v%d: %v", apiKey, version, d.err)
+			return
+		}
+
+		frame := &encoder{}
+		frame.int32(int32(4 + len(resp.buf)))
+		frame.int32(correlationID)
+		frame.buf = append(frame.buf, resp.buf...)
+		if _, err := conn.Write(frame.buf); err != nil {
+			return
+		}
+	}
+}
+
+func (b *FakeBroker) metadata(d *decoder, resp *encoder) {
+	var topics []string
+	for i, n := 0, d.arrayLen(); i < n; i++ {
+		topics = append(topics, d.string())
+	}
+	host, portStr, _ := net.SplitHostPort(b.Addr())
+	port, _ := strconv.Atoi(portStr)
+
+	resp.int32(1)
+	resp.int32(0) // node id
+	resp.string(host)
+	resp.int32(int32(port))
+	resp.nullableString(nil) // rack
+	resp.int32(0)            // controller id
+	resp.int32(int32(len(topics)))
+	for _, topic := range topics {
+		resp.int16(ErrNone)
+		resp.string(topic)
+		resp.int8(0) // is internal
+		resp.int32(int32(b.partitions))
+		for partition := 0; partition < b.partitions; partition++ {
+			resp.int16(ErrNone)
+			resp.int32(int32(partition))
+			resp.int32(0) // leader
+			resp.int32(1) // replicas
+			resp.int32(0)
+			resp.int32(1) // in-sync replicas
+			resp.int32(0)
+		}
+	}
+}
+
+func (b *FakeBroker) produce(d *decoder, resp *encoder) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var code int16
+	if len(b.produceErrors) > 0 {
+		code = b.produceErrors[0]
+		b.produceErrors = b.produceErrors[1:]
+	}
+
+	d.string() // transactional id
+	d.int16()  // acks
+	d.int32()  // timeout
+	n := d.arrayLen()
+	resp.int32(int32(n))
+	for i := 0; i < n; i++ {
+		topic := d.string()
+		resp.string(topic)
+		m := d.arrayLen()
+		resp.int32(int32(m))
+		for j := 0; j < m; j++ {
+			partition := d.int32()
+			records := d.bytes()
+			partitionCode := code
+			var offset int64
+			if partitionCode == ErrNone {
+				messages, err := decodeRecordBatches(records)
+				switch {
+				case err != nil:
+					log.Errorf("FakeBroker: %v", err)
+					partitionCode = ErrCorruptMessage
+				case partition < 0 || int(partition) >= b.partitions:
+					partitionCode = ErrUnknownTopicOrPartition
+				default:
+					if b.topics[topic] == nil {
+						b.topics[topic] = make([][]Message, b.partitions)
+					}
+					offset = int64(len(b.topics[topic][partition]))
+					b.topics[topic][partition] = append(b.topics[topic][partition], messages...)
+				}
+			}
+			resp.int32(partition)
+			resp.int16(partitionCode)
+			resp.int64(offset)
+			resp.int64(-1) // log append time
+		}
+	}
+	resp.int32(0) // throttle time
+}
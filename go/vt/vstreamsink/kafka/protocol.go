@@ -0,0 +1,388 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"time"
+)
+
+// This file contains the encoding of the subset of the Kafka protocol
+// used by the client and the fake broker: the request and response
+// headers, Metadata v1, Produce v3 and record batches (message format v2).
+
+const (
+	apiKeyProduce  int16 = 0
+	apiKeyMetadata int16 = 3
+
+	produceVersion  int16 = 3
+	metadataVersion int16 = 1
+)
+
+// Error codes used by the client and the fake broker.
+const (
+	ErrNone                    int16 = 0
+	ErrCorruptMessage          int16 = 2
+	ErrUnknownTopicOrPartition int16 = 3
+	ErrLeaderNotAvailable      int16 = 5
+	ErrNotLeaderForPartition   int16 = 6
+	ErrRequestTimedOut         int16 = 7
+	ErrNetworkException        int16 = 13
+	ErrNotEnoughReplicas       int16 = 19
+	ErrNotEnoughReplicasAfter  int16 = 20
+	ErrUnsupportedVersion      int16 = 35
+)
+
+// retriable returns true if a produce request which failed with code can
+// be retried, after refreshing the metadata.
+func retriable(code int16) bool {
+	switch code {
+	case ErrUnknownTopicOrPartition, ErrLeaderNotAvailable, ErrNotLeaderForPartition, ErrRequestTimedOut,
+		ErrNetworkException, ErrNotEnoughReplicas, ErrNotEnoughReplicasAfter:
+		return true
+	}
+	return false
+}
+
+// Error is an error code returned by a broker.
+type Error struct {
+	Code int16
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("kafka error code %d", e.Code)
+}
+
+var errShortBuffer = errors.New("kafka: short buffer")
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// encoder appends big-endian values to a buffer.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) int8(v int8) {
+	e.buf = append(e.buf, byte(v))
+}
+
+func (e *encoder) int16(v int16) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], uint16(v))
+	e.buf = append(e.buf, b[:]...)
+}
+
+func (e *encoder) int32(v int32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(v))
+	e.buf = append(e.buf, b[:]...)
+}
+
+func (e *encoder) int64(v int64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(v))
+	e.buf = append(e.buf, b[:]...)
+}
+
+func (e *encoder) varint(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	e.buf = append(e.buf, b[:n]...)
+}
+
+func (e *encoder) string(s string) {
+	e.int16(int16(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *encoder) nullableString(s *string) {
+	if s == nil {
+		e.int16(-1)
+		return
+	}
+	e.string(*s)
+}
+
+func (e *encoder) bytes(b []byte) {
+	e.int32(int32(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *encoder) varintBytes(b []byte) {
+	if b == nil {
+		e.varint(-1)
+		return
+	}
+	e.varint(int64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+// decoder reads big-endian values from a buffer. The first error is kept,
+// and the following reads return zero values.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) take(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.buf) < n {
+		d.err = errShortBuffer
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) int8() int8 {
+	b := d.take(1)
+	if b == nil {
+		return 0
+	}
+	return int8(b[0])
+}
+
+func (d *decoder) int16() int16 {
+	b := d.take(2)
+	if b == nil {
+		return 0
+	}
+	return int16(binary.BigEndian.Uint16(b))
+}
+
+func (d *decoder) int32() int32 {
+	b := d.take(4)
+	if b == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(b))
+}
+
+func (d *decoder) int64() int64 {
+	b := d.take(8)
+	if b == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = errShortBuffer
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) string() string {
+	n := d.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.take(int(n)))
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.int32()
+	if n < 0 {
+		return nil
+	}
+	return d.take(int(n))
+}
+
+func (d *decoder) varintBytes() []byte {
+	n := d.varint()
+	if n < 0 {
+		return nil
+	}
+	return d.take(int(n))
+}
+
+// arrayLen reads the length of an array, and checks each element takes
+// at least one byte, so that a bad length cannot cause a huge allocation.
+func (d *decoder) arrayLen() int {
+	n := d.int32()
+	if n < 0 {
+		return 0
+	}
+	if int(n) > len(d.buf) {
+		d.err = errShortBuffer
+		return 0
+	}
+	return int(n)
+}
+
+// Message is a Kafka record.
+type Message struct {
+	Key       []byte
+	Value     []byte
+	Timestamp time.Time
+}
+
+// encodeRecordBatch encodes messages as a record batch of message format
+// v2, without compression nor headers.
+func encodeRecordBatch(messages []Message) []byte {
+	var first, max int64
+	for i, m := range messages {
+		ts := m.Timestamp.UnixNano() / int64(time.Millisecond)
+		if i == 0 || ts < first {
+			first = ts
+		}
+		if ts > max {
+			max = ts
+		}
+	}
+
+	records := &encoder{}
+	for i, m := range messages {
+		record := &encoder{}
+		record.int8(0) // attributes
+		record.varint(m.Timestamp.UnixNano()/int64(time.Millisecond) - first)
+		record.varint(int64(i)) // offset delta
+		record.varintBytes(m.Key)
+		record.varintBytes(m.Value)
+		record.varint(0) // headers
+		records.varint(int64(len(record.buf)))
+		records.buf = append(records.buf, record.buf...)
+	}
+
+	// The CRC covers everything from the attributes to the end.
+	body := &encoder{}
+	body.int16(0) // attributes: no compression, create time
+	body.int32(int32(len(messages) - 1))
+	body.int64(first)
+	body.int64(max)
+	body.int64(-1) // producer id
+	body.int16(-1) // producer epoch
+	body.int32(-1) // base sequence
+	body.int32(int32(len(messages)))
+	body.buf = append(body.buf, records.buf...)
+
+	batch := &encoder{}
+	batch.int64(0) // base offset, assigned by the broker
+	batch.int32(int32(4 + 1 + 4 + len(body.buf)))
+	batch.int32(-1) // partition leader epoch
+	batch.int8(2)   // magic
+	batch.int32(int32(crc32.Checksum(body.buf, crc32c)))
+	batch.buf = append(batch.buf, body.buf...)
+	return batch.buf
+}
+
+// decodeRecordBatches decodes the record batches of a produce request.
+func decodeRecordBatches(data []byte) ([]Message, error) {
+	var messages []Message
+	d := &decoder{buf: data}
+	for len(d.buf) > 0 && d.err == nil {
+		d.int64() // base offset
+		length := d.int32()
+		batch := &decoder{buf: d.take(int(length))}
+		if d.err != nil {
+			break
+		}
+		batch.int32() // partition leader epoch
+		if magic := batch.int8(); magic != 2 {
+			return nil, fmt.Errorf("kafka: unsupported message format %d", magic)
+		}
+		crc := uint32(batch.int32())
+		if batch.err == nil && crc32.Checksum(batch.buf, crc32c) != crc {
+			return nil, errors.New("kafka: bad record batch checksum")
+		}
+		if attributes := batch.int16(); attributes&0x7 != 0 {
+			return nil, errors.New("kafka: compressed record batches are not supported")
+		}
+		batch.int32() // last offset delta
+		first := batch.int64()
+		batch.int64() // max timestamp
+		batch.int64() // producer id
+		batch.int16() // producer epoch
+		batch.int32() // base sequence
+		count := batch.arrayLen()
+		for i := 0; i < count && batch.err == nil; i++ {
+			record := &decoder{buf: batch.take(int(batch.varint()))}
+			record.int8() // attributes
+			ts := first + record.varint()
+			record.varint() // offset delta
+			m := Message{
+				Key:       record.varintBytes(),
+				Value:     record.varintBytes(),
+				Timestamp: time.Unix(0, ts*int64(time.Millisecond)),
+			}
+			if record.err != nil {
+				return nil, record.err
+			}
+			messages = append(messages, m)
+		}
+		if batch.err != nil {
+			return nil, batch.err
+		}
+	}
+	return messages, d.err
+}
+
+// murmur2 is the hash used by the default partitioner of the Java client,
+// so that the messages of a key go to the same partition whatever the
+// client.
+func murmur2(data []byte) int32 {
+	const (
+		seed uint32 = 0x9747b28c
+		m    uint32 = 0x5bd1e995
+		r           = 24
+	)
+	length := len(data)
+	h := seed ^ uint32(length)
+	for i := 0; i+4 <= length; i += 4 {
+		k := binary.LittleEndian.Uint32(data[i:])
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+	tail := length &^ 3
+	switch length % 4 {
+	case 3:
+		h ^= uint32(data[tail+2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(data[tail+1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(data[tail])
+		h *= m
+	}
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return int32(h)
+}
+
+// partitionFor returns the partition of a key, like the default
+// partitioner of the Java client.
+func partitionFor(key []byte, partitions int) int32 {
+	return (murmur2(key) & 0x7fffffff) % int32(partitions)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamsink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vstreamsink/kafka"
+)
+
+func testEvents() []*Event {
+	return []*Event{{
+		Type:      EventInsert,
+		Keyspace:  "ks",
+		Shard:     "-80",
+		Table:     "t1",
+		After:     map[string]interface{}{"id": json.RawMessage("1"), "name": "a"},
+		Timestamp: 1600000000,
+		Key:       "ks.t1:[1]",
+	}, {
+		Type:      EventDDL,
+		Keyspace:  "ks",
+		Shard:     "-80",
+		Statement: "alter table t1 add column c int",
+		Timestamp: 1600000001,
+	}}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte(`
+consumer: c1
+max_batch_events: 10
+sinks:
+- type: file
+  options:
+    directory: /tmp/events
+`), 0644)
+	require.NoError(t, err)
+	config, err := LoadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, "c1", config.Consumer)
+	assert.Equal(t, "PRIMARY", config.TabletType)
+	assert.Equal(t, 10, config.MaxBatchEvents)
+	require.Len(t, config.Sinks, 1)
+	assert.Equal(t, "file", config.Sinks[0].Type)
+	assert.JSONEq(t, `{"directory": "/tmp/events"}`, string(config.Sinks[0].Options))
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewFileSink(json.RawMessage(`{"directory": "` + dir + `", "max_bytes": 200, "max_age_seconds": 60}`))
+	require.NoError(t, err)
+	defer sink.Close()
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	sink.(*FileSink).now = func() time.Time { return now }
+
+	ctx := context.Background()
+	events := testEvents()
+	// The first write fills the file, so the second one starts a new file.
+	require.NoError(t, sink.Write(ctx, events))
+	now = now.Add(time.Second)
+	require.NoError(t, sink.Write(ctx, events[:1]))
+	// The third write fits, and the fourth one goes to a new file because
+	// the second file is too old.
+	now = now.Add(time.Second)
+	require.NoError(t, sink.Write(ctx, events[1:]))
+	now = now.Add(time.Minute)
+	require.NoError(t, sink.Write(ctx, events[:1]))
+
+	names, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "vstream-20220101T000000.000000000Z.ndjson"),
+		filepath.Join(dir, "vstream-20220101T000001.000000000Z.ndjson"),
+		filepath.Join(dir, "vstream-20220101T000102.000000000Z.ndjson"),
+	}, names)
+
+	f, err := os.Open(names[0])
+	require.NoError(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	var lines []string
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	assert.Equal(t, []string{
+		`{"type":"insert","keyspace":"ks","shard":"-80","table":"t1","after":{"id":1,"name":"a"},"timestamp":1600000000}`,
+		`{"type":"ddl","keyspace":"ks","shard":"-80","statement":"alter table t1 add column c int","timestamp":1600000001}`,
+	}, lines)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var requests int32
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&requests, 1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	sink, err := NewWebhookSink(json.RawMessage(`{"url": "` + server.URL + `", "headers": {"Authorization": "Bearer token"}, "retry_delay_seconds": 0.01}`))
+	require.NoError(t, err)
+	defer sink.Close()
+
+	// The 503 is retried.
+	ctx := context.Background()
+	require.NoError(t, sink.Write(ctx, testEvents()))
+	assert.EqualValues(t, 2, atomic.LoadInt32(&requests))
+	assert.Equal(t, `{"type":"insert","keyspace":"ks","shard":"-80","table":"t1","after":{"id":1,"name":"a"},"timestamp":1600000000}
+{"type":"ddl","keyspace":"ks","shard":"-80","statement":"alter table t1 add column c int","timestamp":1600000001}
+`, body)
+
+	// The 400 is not.
+	err = sink.Write(ctx, testEvents())
+	assert.EqualError(t, err, "webhook sink: "+server.URL+" returned 400 Bad Request")
+	assert.EqualValues(t, 3, atomic.LoadInt32(&requests))
+}
+
+func TestKafkaSink(t *testing.T) {
+	broker, err := kafka.NewFakeBroker(4)
+	require.NoError(t, err)
+	defer broker.Close()
+
+	sink, err := NewKafkaSink(json.RawMessage(`{"brokers": ["` + broker.Addr() + `"], "topic": "events", "retry_delay_seconds": 0.01}`))
+	require.NoError(t, err)
+	defer sink.Close()
+	require.NoError(t, sink.Write(context.Background(), testEvents()))
+
+	var messages []kafka.Message
+	for _, partition := range broker.Messages("events") {
+		messages = append(messages, partition...)
+	}
+	require.Len(t, messages, 2)
+	keys := map[string]bool{}
+	for _, m := range messages {
+		keys[string(m.Key)] = true
+	}
+	assert.Equal(t, map[string]bool{"ks.t1:[1]": true, "": true}, keys)
+}
@@ -0,0 +1,311 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vstreamsink runs a VStream and delivers its events to sinks:
+// rotating files, webhooks or Kafka. The position of the stream is stored
+// by vtgate as a VStream consumer, and is only acknowledged once the
+// events before it were written to all the sinks, so that every event is
+// delivered at least once.
+package vstreamsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+	"vitess.io/vitess/go/yaml2"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Config is the configuration of a Runner. It is read from a YAML file.
+type Config struct {
+	// Consumer is the name of the VStream consumer.
+	Consumer string `json:"consumer"`
+	// TabletType is the type of the tablets to stream from.
+	TabletType string `json:"tablet_type"`
+	// Position is the position the consumer is created with, if it
+	// doesn't exist yet. It is ignored otherwise.
+	Position *binlogdatapb.VGtid `json:"position"`
+	// Filter selects the tables and columns to stream. If it's empty, the
+	// filter of the consumer is used.
+	Filter *binlogdatapb.Filter `json:"filter"`
+	// MaxBatchEvents and MaxBatchDelaySeconds bound the number of events
+	// written to the sinks at once, and how long they are buffered.
+	MaxBatchEvents       int     `json:"max_batch_events"`
+	MaxBatchDelaySeconds float64 `json:"max_batch_delay_seconds"`
+	// ReconnectDelaySeconds is the delay before reconnecting after a
+	// stream error.
+	ReconnectDelaySeconds float64 `json:"reconnect_delay_seconds"`
+	// Sinks are the sinks the events are written to.
+	Sinks []*SinkConfig `json:"sinks"`
+}
+
+// SinkConfig is the configuration of a sink.
+type SinkConfig struct {
+	// Type is the name the sink factory is registered with.
+	Type string `json:"type"`
+	// Options are passed to the sink factory.
+	Options json.RawMessage `json:"options"`
+}
+
+// LoadConfig reads a Config from a YAML file, and applies the defaults.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{
+		TabletType:            "PRIMARY",
+		MaxBatchEvents:        1000,
+		MaxBatchDelaySeconds:  1,
+		ReconnectDelaySeconds: 5,
+	}
+	if err := yaml2.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("cannot parse %v: %v", path, err)
+	}
+	return config, nil
+}
+
+// Sink delivers events. Write must only return once the events are
+// durably delivered, since their position is then acknowledged. If Write
+// fails, the runner stops, and the events are delivered again when it is
+// restarted.
+type Sink interface {
+	Write(ctx context.Context, events []*Event) error
+	Close() error
+}
+
+// SinkFactory creates a sink from its options.
+type SinkFactory func(options json.RawMessage) (Sink, error)
+
+var (
+	sinkFactoriesMu sync.Mutex
+	sinkFactories   = make(map[string]SinkFactory)
+)
+
+// RegisterSink registers a sink factory under a name, which is the type
+// of the sink in the configuration.
+func RegisterSink(name string, factory SinkFactory) {
+	sinkFactoriesMu.Lock()
+	defer sinkFactoriesMu.Unlock()
+	if _, ok := sinkFactories[name]; ok {
+		log.Fatalf("sink %v is already registered", name)
+	}
+	sinkFactories[name] = factory
+}
+
+// NewSink creates a sink from its configuration.
+func NewSink(config *SinkConfig) (Sink, error) {
+	sinkFactoriesMu.Lock()
+	factory, ok := sinkFactories[config.Type]
+	sinkFactoriesMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown sink type: %q", config.Type)
+	}
+	return factory(config.Options)
+}
+
+// decodeOptions decodes the options of a sink, rejecting unknown fields.
+func decodeOptions(options json.RawMessage, v interface{}) error {
+	if len(options) == 0 {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(options))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("bad sink options: %v", err)
+	}
+	return nil
+}
+
+// Conn is the part of vtgateconn.VTGateConn used by the Runner.
+type Conn interface {
+	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
+		filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error)
+	VStreamAck(ctx context.Context, consumer string, vgtid *binlogdatapb.VGtid) error
+}
+
+// Runner runs the VStream of a consumer, and writes its events to sinks.
+type Runner struct {
+	conn       Conn
+	config     *Config
+	sinks      []Sink
+	tabletType topodatapb.TabletType
+
+	// create is set when the consumer doesn't exist, so the next stream
+	// starts at the configured position.
+	create bool
+}
+
+// sinkError is returned by the Runner when a sink fails.
+type sinkError struct {
+	err error
+}
+
+func (e *sinkError) Error() string {
+	return e.err.Error()
+}
+
+// NewRunner creates a Runner.
+func NewRunner(conn Conn, config *Config, sinks []Sink) (*Runner, error) {
+	if config.Consumer == "" {
+		return nil, errors.New("the consumer name is required")
+	}
+	if len(sinks) == 0 {
+		return nil, errors.New("at least one sink is required")
+	}
+	tabletType, err := topoproto.ParseTabletType(config.TabletType)
+	if err != nil {
+		return nil, err
+	}
+	return &Runner{
+		conn:       conn,
+		config:     config,
+		sinks:      sinks,
+		tabletType: tabletType,
+	}, nil
+}
+
+// Run streams the events until ctx is done or a sink fails. Stream errors
+// are retried after ReconnectDelaySeconds.
+func (r *Runner) Run(ctx context.Context) error {
+	for {
+		err := r.stream(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		var serr *sinkError
+		if errors.As(err, &serr) {
+			return err
+		}
+		if vterrors.Code(err) == vtrpcpb.Code_NOT_FOUND && !r.create && r.config.Position != nil {
+			log.Infof("Creating VStream consumer %v at %v", r.config.Consumer, r.config.Position)
+			r.create = true
+			continue
+		}
+		delay := time.Duration(r.config.ReconnectDelaySeconds * float64(time.Second))
+		log.Warningf("VStream of consumer %v failed, reconnecting in %v: %v", r.config.Consumer, delay, err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+	}
+}
+
+// stream runs one VStream. It starts at the position of the consumer, so
+// the events which were not acknowledged by the previous stream are
+// delivered again.
+func (r *Runner) stream(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var vgtid *binlogdatapb.VGtid
+	if r.create {
+		vgtid = r.config.Position
+	}
+	maxDelay := time.Duration(r.config.MaxBatchDelaySeconds * float64(time.Second))
+	flags := &vtgatepb.VStreamFlags{
+		Consumer: r.config.Consumer,
+		// Heartbeats let the batches be flushed when the stream is idle.
+		HeartbeatInterval: uint32(math.Max(1, math.Ceil(r.config.MaxBatchDelaySeconds))),
+	}
+	reader, err := r.conn.VStream(ctx, r.tabletType, vgtid, r.config.Filter, flags)
+	if err != nil {
+		return err
+	}
+
+	builder := newEventBuilder()
+	var (
+		batch      []*Event
+		last       *binlogdatapb.VGtid
+		checkpoint *binlogdatapb.VGtid
+		batchStart time.Time
+	)
+	for {
+		vevents, err := reader.Recv()
+		if err != nil {
+			return err
+		}
+		// The consumer exists once the stream started.
+		r.create = false
+
+		for _, ev := range vevents {
+			events, err := builder.add(ev)
+			if err != nil {
+				return err
+			}
+			if len(batch) == 0 && checkpoint == nil && (len(events) > 0 || ev.Type == binlogdatapb.VEventType_VGTID) {
+				batchStart = time.Now()
+			}
+			batch = append(batch, events...)
+
+			// The position of a transaction is sent before its events, and
+			// becomes a checkpoint once the transaction is complete.
+			switch ev.Type {
+			case binlogdatapb.VEventType_VGTID:
+				last = ev.Vgtid
+			case binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER:
+				checkpoint = last
+			}
+		}
+
+		if checkpoint == nil || (len(batch) < r.config.MaxBatchEvents && time.Since(batchStart) < maxDelay) {
+			continue
+		}
+		if err := r.flush(ctx, batch, checkpoint); err != nil {
+			return err
+		}
+		batch = nil
+		checkpoint = nil
+	}
+}
+
+// flush writes the events to all the sinks, then acknowledges the
+// position.
+func (r *Runner) flush(ctx context.Context, events []*Event, checkpoint *binlogdatapb.VGtid) error {
+	if len(events) > 0 {
+		for _, sink := range r.sinks {
+			if err := sink.Write(ctx, events); err != nil {
+				return &sinkError{err: err}
+			}
+		}
+	}
+	return r.conn.VStreamAck(ctx, r.config.Consumer, checkpoint)
+}
+
+// Close closes the sinks.
+func (r *Runner) Close() {
+	for _, sink := range r.sinks {
+		if err := sink.Close(); err != nil {
+			log.Errorf("Error closing sink: %v", err)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamsink
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// fakeStream is the scripted result of a VStream call: the events returned
+// by each Recv, then err.
+type fakeStream struct {
+	events [][]*binlogdatapb.VEvent
+	err    error
+}
+
+func (s *fakeStream) Recv() ([]*binlogdatapb.VEvent, error) {
+	if len(s.events) == 0 {
+		return nil, s.err
+	}
+	events := s.events[0]
+	s.events = s.events[1:]
+	return events, nil
+}
+
+type fakeConn struct {
+	mu      sync.Mutex
+	streams []*fakeStream
+	vgtids  []*binlogdatapb.VGtid
+	flags   []*vtgatepb.VStreamFlags
+	acks    []string
+}
+
+func (c *fakeConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
+	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.vgtids = append(c.vgtids, vgtid)
+	c.flags = append(c.flags, flags)
+	stream := c.streams[0]
+	c.streams = c.streams[1:]
+	return stream, nil
+}
+
+func (c *fakeConn) VStreamAck(ctx context.Context, consumer string, vgtid *binlogdatapb.VGtid) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.acks = append(c.acks, consumer+"@"+vgtid.ShardGtids[0].Gtid)
+	return nil
+}
+
+type fakeSink struct {
+	writes [][]*Event
+	err    error
+}
+
+func (s *fakeSink) Write(ctx context.Context, events []*Event) error {
+	s.writes = append(s.writes, events)
+	if len(s.writes) > 1 {
+		return s.err
+	}
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	return nil
+}
+
+func vgtidEvent(gtid string) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{
+		Type:  binlogdatapb.VEventType_VGTID,
+		Vgtid: &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "0", Gtid: gtid}}},
+	}
+}
+
+func rowEvent(before, after []sqltypes.Value) *binlogdatapb.VEvent {
+	change := &binlogdatapb.RowChange{}
+	if before != nil {
+		change.Before = sqltypes.RowToProto3(before)
+	}
+	if after != nil {
+		change.After = sqltypes.RowToProto3(after)
+	}
+	return &binlogdatapb.VEvent{
+		Type:      binlogdatapb.VEventType_ROW,
+		Keyspace:  "ks",
+		Shard:     "0",
+		Timestamp: 1600000000,
+		RowEvent:  &binlogdatapb.RowEvent{TableName: "ks.t1", RowChanges: []*binlogdatapb.RowChange{change}},
+	}
+}
+
+func TestRunner(t *testing.T) {
+	fieldEvent := &binlogdatapb.VEvent{
+		Type: binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.t1", Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64, Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG)},
+			{Name: "name", Type: sqltypes.VarChar},
+			{Name: "data", Type: sqltypes.VarBinary},
+		}},
+	}
+	row1 := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NULL}
+	row2 := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("b"), sqltypes.NewVarBinary("\x00")}
+	commit := &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_COMMIT}
+	position := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "0", Gtid: "g0"}}}
+
+	conn := &fakeConn{streams: []*fakeStream{{
+		// The consumer doesn't exist.
+		err: vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "VStream consumer c1 does not exist"),
+	}, {
+		// The stream is interrupted after the first batch.
+		events: [][]*binlogdatapb.VEvent{
+			{vgtidEvent("g1"), fieldEvent, rowEvent(nil, row1), commit},
+			{vgtidEvent("g2"), rowEvent(row1, row2), commit},
+			{vgtidEvent("g3"), rowEvent(row2, nil)},
+		},
+		err: vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "connection reset"),
+	}, {
+		// The sink fails on the second batch.
+		events: [][]*binlogdatapb.VEvent{
+			{vgtidEvent("g3"), fieldEvent, rowEvent(row2, nil), commit, vgtidEvent("g4"), {Type: binlogdatapb.VEventType_DDL, Keyspace: "ks", Shard: "0", Statement: "alter table t1"}},
+		},
+	}}}
+	sink := &fakeSink{err: errors.New("sink failed")}
+	config := &Config{
+		Consumer:             "c1",
+		TabletType:           "replica",
+		Position:             position,
+		MaxBatchEvents:       2,
+		MaxBatchDelaySeconds: 3600,
+	}
+	runner, err := NewRunner(conn, config, []Sink{sink})
+	require.NoError(t, err)
+	err = runner.Run(context.Background())
+	assert.EqualError(t, err, "sink failed")
+
+	// The consumer is created at the configured position, and the next
+	// streams resume from the acknowledged one.
+	assert.Equal(t, []*binlogdatapb.VGtid{nil, position, nil}, conn.vgtids)
+	assert.Equal(t, "c1", conn.flags[0].Consumer)
+	assert.Equal(t, uint32(3600), conn.flags[0].HeartbeatInterval)
+	assert.Equal(t, []string{"c1@g2"}, conn.acks)
+
+	require.Len(t, sink.writes, 2)
+	insert, update := sink.writes[0][0], sink.writes[0][1]
+	assert.Equal(t, EventInsert, insert.Type)
+	assert.Equal(t, "t1", insert.Table)
+	assert.Equal(t, "g1", insert.Gtid)
+	assert.Equal(t, `ks.t1:[1]`, insert.Key)
+	assert.Nil(t, insert.Before)
+	assert.Nil(t, insert.After["data"])
+	assert.Equal(t, EventUpdate, update.Type)
+	assert.Equal(t, "a", update.Before["name"])
+	assert.Equal(t, []byte("\x00"), update.After["data"])
+
+	// The delete of the interrupted stream was delivered again.
+	require.Len(t, sink.writes[1], 2)
+	assert.Equal(t, EventDelete, sink.writes[1][0].Type)
+	assert.Equal(t, "g3", sink.writes[1][0].Gtid)
+	assert.Equal(t, EventDDL, sink.writes[1][1].Type)
+	assert.Equal(t, "alter table t1", sink.writes[1][1].Statement)
+}
+
+func TestNewSink(t *testing.T) {
+	_, err := NewSink(&SinkConfig{Type: "unknown"})
+	assert.EqualError(t, err, `unknown sink type: "unknown"`)
+	_, err = NewSink(&SinkConfig{Type: "webhook", Options: []byte(`{"urll": "http://localhost"}`)})
+	assert.EqualError(t, err, `bad sink options: json: unknown field "urll"`)
+	_, err = NewSink(&SinkConfig{Type: "webhook"})
+	assert.EqualError(t, err, "webhook sink: url is required")
+}
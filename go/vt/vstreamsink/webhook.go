@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// This file contains the webhook sink, which posts the events to an HTTP
+// endpoint.
+
+func init() {
+	RegisterSink("webhook", NewWebhookSink)
+}
+
+// WebhookSinkOptions are the options of the webhook sink.
+type WebhookSinkOptions struct {
+	// URL is where the events are posted.
+	URL string `json:"url"`
+	// Headers are added to the requests, e.g. for authentication.
+	Headers map[string]string `json:"headers"`
+	// TimeoutSeconds is the timeout of each request.
+	TimeoutSeconds float64 `json:"timeout_seconds"`
+	// MaxRetries is the number of times a failed request is retried.
+	MaxRetries int `json:"max_retries"`
+	// RetryDelaySeconds is the delay before the first retry. It doubles
+	// with each retry.
+	RetryDelaySeconds float64 `json:"retry_delay_seconds"`
+}
+
+// WebhookSink posts the events to an HTTP endpoint, as newline-delimited
+// JSON. Requests which fail with a network error, a 429 or a 5xx status
+// are retried; the other errors fail the write.
+type WebhookSink struct {
+	options WebhookSinkOptions
+	client  *http.Client
+}
+
+// NewWebhookSink creates a webhook sink.
+func NewWebhookSink(options json.RawMessage) (Sink, error) {
+	s := &WebhookSink{
+		options: WebhookSinkOptions{
+			TimeoutSeconds:    10,
+			MaxRetries:        5,
+			RetryDelaySeconds: 1,
+		},
+	}
+	if err := decodeOptions(options, &s.options); err != nil {
+		return nil, err
+	}
+	if s.options.URL == "" {
+		return nil, errors.New("webhook sink: url is required")
+	}
+	s.client = &http.Client{Timeout: time.Duration(s.options.TimeoutSeconds * float64(time.Second))}
+	return s, nil
+}
+
+// Write is part of the Sink interface.
+func (s *WebhookSink) Write(ctx context.Context, events []*Event) error {
+	var body []byte
+	for _, event := range events {
+		b, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		body = append(body, b...)
+		body = append(body, '\n')
+	}
+
+	delay := time.Duration(s.options.RetryDelaySeconds * float64(time.Second))
+	for attempt := 0; ; attempt++ {
+		retry, err := s.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= s.options.MaxRetries {
+			return fmt.Errorf("webhook sink: %v", err)
+		}
+		log.Warningf("Retrying webhook %v in %v: %v", s.options.URL, delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// post sends one request. It returns whether a failed request can be
+// retried.
+func (s *WebhookSink) post(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.options.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	for name, value := range s.options.Headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	// Read the body, so that the connection is reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("%v returned %v", s.options.URL, resp.Status)
+	default:
+		return false, fmt.Errorf("%v returned %v", s.options.URL, resp.Status)
+	}
+}
+
+// Close is part of the Sink interface.
+func (s *WebhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
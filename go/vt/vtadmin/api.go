@@ -21,6 +21,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	stderrors "errors"
+	"expvar"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
@@ -39,6 +40,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtadmin/audit"
 	"vitess.io/vitess/go/vt/vtadmin/cluster"
 	"vitess.io/vitess/go/vt/vtadmin/errors"
 	"vitess.io/vitess/go/vt/vtadmin/grpcserver"
@@ -70,6 +72,7 @@ type API struct {
 	router       *mux.Router
 
 	authz *rbac.Authorizer
+	audit *audit.Log
 
 	// See https://github.com/vitessio/vitess/issues/7723 for why this exists.
 	vtexplainLock sync.Mutex
@@ -82,6 +85,7 @@ type Options struct {
 	GRPCOpts grpcserver.Options
 	HTTPOpts vtadminhttp.Options
 	RBAC     *rbac.Config
+	Audit    *audit.Config
 }
 
 type DynamicClusterJSON struct {
@@ -141,6 +145,15 @@ func NewAPI(clusters []*cluster.Cluster, opts Options) *API {
 		})
 	}
 
+	var auditLog *audit.Log
+	if opts.Audit != nil {
+		auditLog = opts.Audit.GetLog()
+	}
+
+	if auditLog == nil {
+		auditLog = audit.NewLog(audit.DefaultRecentEntries, audit.DefaultBufferedEntries)
+	}
+
 	serv := grpcserver.New("vtadmin", opts.GRPCOpts)
 	serv.Router().HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("ok\n"))
@@ -153,6 +166,7 @@ func NewAPI(clusters []*cluster.Cluster, opts Options) *API {
 		router:     router,
 		serv:       serv,
 		authz:      authz,
+		audit:      auditLog,
 		options:    opts,
 	}
 	router.PathPrefix("/").Handler(api).Methods("DELETE", "OPTIONS", "GET", "POST", "PUT")
@@ -171,6 +185,7 @@ func NewAPI(clusters []*cluster.Cluster, opts Options) *API {
 		dapi := &debugAPI{api}
 		debugRouter := serv.Router().PathPrefix("/debug").Subrouter()
 		debugRouter.HandleFunc("/env", debug.Env)
+		debugRouter.Handle("/vars", expvar.Handler())
 		debugRouter.HandleFunc("/cluster/{cluster_id}", debug.Cluster(dapi))
 		debugRouter.HandleFunc("/clusters", debug.Clusters(dapi))
 	}
@@ -230,6 +245,7 @@ func (api *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		router:     api.router,
 		serv:       api.serv,
 		authz:      api.authz,
+		audit:      api.audit,
 		options:    api.options,
 	}
 
@@ -284,6 +300,7 @@ func (api *API) Handler() http.Handler {
 
 	httpAPI := vtadminhttp.NewAPI(api, api.options.HTTPOpts)
 
+	router.HandleFunc("/audit", httpAPI.Adapt(vtadminhttp.GetAuditEntries)).Name("API.GetAuditEntries")
 	router.HandleFunc("/backups", httpAPI.Adapt(vtadminhttp.GetBackups)).Name("API.GetBackups")
 	router.HandleFunc("/clusters", httpAPI.Adapt(vtadminhttp.GetClusters)).Name("API.GetClusters")
 	router.HandleFunc("/gates", httpAPI.Adapt(vtadminhttp.GetGates)).Name("API.GetGates")
@@ -292,8 +309,14 @@ func (api *API) Handler() http.Handler {
 	router.HandleFunc("/keyspace/{cluster_id}/{name}", httpAPI.Adapt(vtadminhttp.GetKeyspace)).Name("API.GetKeyspace")
 	router.HandleFunc("/keyspaces", httpAPI.Adapt(vtadminhttp.GetKeyspaces)).Name("API.GetKeyspaces")
 	router.HandleFunc("/schema/{table}", httpAPI.Adapt(vtadminhttp.FindSchema)).Name("API.FindSchema")
+	router.HandleFunc("/schema/{cluster_id}/{keyspace}", httpAPI.Adapt(vtadminhttp.ApplySchema)).Name("API.ApplySchema").Methods("POST")
 	router.HandleFunc("/schema/{cluster_id}/{keyspace}/{table}", httpAPI.Adapt(vtadminhttp.GetSchema)).Name("API.GetSchema")
 	router.HandleFunc("/schemas", httpAPI.Adapt(vtadminhttp.GetSchemas)).Name("API.GetSchemas")
+	router.HandleFunc("/schema_migration/{cluster_id}/{keyspace}/{uuid}/cancel", httpAPI.Adapt(vtadminhttp.CancelSchemaMigration)).Name("API.CancelSchemaMigration").Methods("PUT", "OPTIONS")
+	router.HandleFunc("/schema_migration/{cluster_id}/{keyspace}/{uuid}/complete", httpAPI.Adapt(vtadminhttp.CompleteSchemaMigration)).Name("API.CompleteSchemaMigration").Methods("PUT", "OPTIONS")
+	router.HandleFunc("/schema_migration/{cluster_id}/{keyspace}/{uuid}/retry", httpAPI.Adapt(vtadminhttp.RetrySchemaMigration)).Name("API.RetrySchemaMigration").Methods("PUT", "OPTIONS")
+	router.HandleFunc("/schema_migration/{cluster_id}/{keyspace}/{uuid}/revert", httpAPI.Adapt(vtadminhttp.RevertSchemaMigration)).Name("API.RevertSchemaMigration").Methods("PUT", "OPTIONS")
+	router.HandleFunc("/schema_migrations", httpAPI.Adapt(vtadminhttp.GetSchemaMigrations)).Name("API.GetSchemaMigrations")
 	router.HandleFunc("/shard_replication_positions", httpAPI.Adapt(vtadminhttp.GetShardReplicationPositions)).Name("API.GetShardReplicationPositions")
 	router.HandleFunc("/shards/{cluster_id}", httpAPI.Adapt(vtadminhttp.CreateShard)).Name("API.CreateShard").Methods("POST")
 	router.HandleFunc("/shards/{cluster_id}", httpAPI.Adapt(vtadminhttp.DeleteShards)).Name("API.DeleteShards").Methods("DELETE")
@@ -314,7 +337,11 @@ func (api *API) Handler() http.Handler {
 	router.HandleFunc("/vschemas", httpAPI.Adapt(vtadminhttp.GetVSchemas)).Name("API.GetVSchemas")
 	router.HandleFunc("/vtctlds", httpAPI.Adapt(vtadminhttp.GetVtctlds)).Name("API.GetVtctlds")
 	router.HandleFunc("/vtexplain", httpAPI.Adapt(vtadminhttp.VTExplain)).Name("API.VTExplain")
+	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}", httpAPI.Adapt(vtadminhttp.CreateWorkflow)).Name("API.CreateWorkflow").Methods("POST")
+	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}", httpAPI.Adapt(vtadminhttp.CancelWorkflow)).Name("API.CancelWorkflow").Methods("DELETE")
 	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}", httpAPI.Adapt(vtadminhttp.GetWorkflow)).Name("API.GetWorkflow")
+	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}/complete", httpAPI.Adapt(vtadminhttp.CompleteWorkflow)).Name("API.CompleteWorkflow").Methods("PUT", "OPTIONS")
+	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}/switch_traffic", httpAPI.Adapt(vtadminhttp.SwitchWorkflowTraffic)).Name("API.SwitchWorkflowTraffic").Methods("PUT", "OPTIONS")
 	router.HandleFunc("/workflows", httpAPI.Adapt(vtadminhttp.GetWorkflows)).Name("API.GetWorkflows")
 
 	experimentalRouter := router.PathPrefix("/experimental").Subrouter()
@@ -332,11 +359,153 @@ func (api *API) EjectDynamicCluster(key string, value interface{}) {
 	}
 }
 
+// ApplySchema is part of the vtadminpb.VTAdminServer interface.
+func (api *API) ApplySchema(ctx context.Context, req *vtadminpb.ApplySchemaRequest) (resp *vtctldatapb.ApplySchemaResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.ApplySchema")
+	defer span.Finish()
+
+	rec := api.audit.Begin(ctx, "ApplySchema", rbac.SchemaResource, rbac.CreateAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.SchemaResource, rbac.CreateAction) {
+		return nil, fmt.Errorf("%w: cannot apply schema in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.ApplySchema(ctx, req.Options)
+}
+
+// CancelSchemaMigration is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CancelSchemaMigration(ctx context.Context, req *vtadminpb.CancelSchemaMigrationRequest) (resp *vtadminpb.CancelSchemaMigrationResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.CancelSchemaMigration")
+	defer span.Finish()
+
+	rec := api.audit.Begin(ctx, "CancelSchemaMigration", rbac.SchemaResource, rbac.PutAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("uuid", req.Uuid)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.SchemaResource, rbac.PutAction) {
+		return nil, fmt.Errorf("%w: cannot cancel schema migration in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.CancelSchemaMigration(ctx, req)
+}
+
+// CancelWorkflow is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CancelWorkflow(ctx context.Context, req *vtadminpb.CancelWorkflowRequest) (resp *vtadminpb.CancelWorkflowResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.CancelWorkflow")
+	defer span.Finish()
+
+	rec := api.audit.Begin(ctx, "CancelWorkflow", rbac.WorkflowResource, rbac.DeleteAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow_name", req.Name)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.WorkflowResource, rbac.DeleteAction) {
+		return nil, fmt.Errorf("%w: cannot cancel workflow in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.CancelWorkflow(ctx, req)
+}
+
+// CompleteSchemaMigration is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CompleteSchemaMigration(ctx context.Context, req *vtadminpb.CompleteSchemaMigrationRequest) (resp *vtadminpb.CompleteSchemaMigrationResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.CompleteSchemaMigration")
+	defer span.Finish()
+
+	rec := api.audit.Begin(ctx, "CompleteSchemaMigration", rbac.SchemaResource, rbac.PutAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("uuid", req.Uuid)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.SchemaResource, rbac.PutAction) {
+		return nil, fmt.Errorf("%w: cannot complete schema migration in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.CompleteSchemaMigration(ctx, req)
+}
+
+// CompleteWorkflow is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CompleteWorkflow(ctx context.Context, req *vtadminpb.CompleteWorkflowRequest) (resp *vtadminpb.CompleteWorkflowResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.CompleteWorkflow")
+	defer span.Finish()
+
+	rec := api.audit.Begin(ctx, "CompleteWorkflow", rbac.WorkflowResource, rbac.PutAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow_name", req.Name)
+	span.Annotate("dry_run", req.DryRun)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.WorkflowResource, rbac.PutAction) {
+		return nil, fmt.Errorf("%w: cannot complete workflow in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.CompleteWorkflow(ctx, req)
+}
+
 // CreateKeyspace is part of the vtadminpb.VTAdminServer interface.
-func (api *API) CreateKeyspace(ctx context.Context, req *vtadminpb.CreateKeyspaceRequest) (*vtadminpb.CreateKeyspaceResponse, error) {
+func (api *API) CreateKeyspace(ctx context.Context, req *vtadminpb.CreateKeyspaceRequest) (resp *vtadminpb.CreateKeyspaceResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.CreateKeyspace")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "CreateKeyspace", rbac.KeyspaceResource, rbac.CreateAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
 	span.Annotate("cluster_id", req.ClusterId)
 
 	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.KeyspaceResource, rbac.CreateAction) {
@@ -363,10 +532,13 @@ func (api *API) CreateKeyspace(ctx context.Context, req *vtadminpb.CreateKeyspac
 }
 
 // CreateShard is part of the vtadminpb.VTAdminServer interface.
-func (api *API) CreateShard(ctx context.Context, req *vtadminpb.CreateShardRequest) (*vtctldatapb.CreateShardResponse, error) {
+func (api *API) CreateShard(ctx context.Context, req *vtadminpb.CreateShardRequest) (resp *vtctldatapb.CreateShardResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.CreateShard")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "CreateShard", rbac.ShardResource, rbac.CreateAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
 	span.Annotate("cluster_id", req.ClusterId)
 
 	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.ShardResource, rbac.CreateAction) {
@@ -385,11 +557,43 @@ func (api *API) CreateShard(ctx context.Context, req *vtadminpb.CreateShardReque
 	return c.CreateShard(ctx, req.Options)
 }
 
+// CreateWorkflow is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CreateWorkflow(ctx context.Context, req *vtadminpb.CreateWorkflowRequest) (resp *vtadminpb.CreateWorkflowResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.CreateWorkflow")
+	defer span.Finish()
+
+	rec := api.audit.Begin(ctx, "CreateWorkflow", rbac.WorkflowResource, rbac.CreateAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow_name", req.Name)
+	span.Annotate("workflow_type", req.WorkflowType)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.WorkflowResource, rbac.CreateAction) {
+		return nil, fmt.Errorf("%w: cannot create workflow in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.CreateWorkflow(ctx, req)
+}
+
 // DeleteKeyspace is part of the vtadminpb.VTAdminServer interface.
-func (api *API) DeleteKeyspace(ctx context.Context, req *vtadminpb.DeleteKeyspaceRequest) (*vtctldatapb.DeleteKeyspaceResponse, error) {
+func (api *API) DeleteKeyspace(ctx context.Context, req *vtadminpb.DeleteKeyspaceRequest) (resp *vtctldatapb.DeleteKeyspaceResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.DeleteKeyspace")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "DeleteKeyspace", rbac.KeyspaceResource, rbac.DeleteAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
 	span.Annotate("cluster_id", req.ClusterId)
 
 	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.KeyspaceResource, rbac.DeleteAction) {
@@ -409,10 +613,13 @@ func (api *API) DeleteKeyspace(ctx context.Context, req *vtadminpb.DeleteKeyspac
 }
 
 // DeleteShards is part of the vtadminpb.VTAdminServer interface.
-func (api *API) DeleteShards(ctx context.Context, req *vtadminpb.DeleteShardsRequest) (*vtctldatapb.DeleteShardsResponse, error) {
+func (api *API) DeleteShards(ctx context.Context, req *vtadminpb.DeleteShardsRequest) (resp *vtctldatapb.DeleteShardsResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.DeleteShards")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "DeleteShards", rbac.ShardResource, rbac.DeleteAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
 	span.Annotate("cluster_id", req.ClusterId)
 
 	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.ShardResource, rbac.DeleteAction) {
@@ -516,6 +723,38 @@ func (api *API) FindSchema(ctx context.Context, req *vtadminpb.FindSchemaRequest
 	}
 }
 
+// GetAuditEntries is part of the vtadminpb.VTAdminServer interface.
+func (api *API) GetAuditEntries(ctx context.Context, req *vtadminpb.GetAuditEntriesRequest) (*vtadminpb.GetAuditEntriesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.GetAuditEntries")
+	defer span.Finish()
+
+	span.Annotate("actor", req.Actor)
+	span.Annotate("method", req.Method)
+	span.Annotate("limit", req.Limit)
+
+	clusterIDs := sets.NewString(req.ClusterIds...)
+
+	entries := api.audit.Entries(func(entry *vtadminpb.AuditEntry) bool {
+		if clusterIDs.Len() > 0 && !clusterIDs.Has(entry.ClusterId) {
+			return false
+		}
+
+		if req.Actor != "" && entry.Actor != req.Actor {
+			return false
+		}
+
+		if req.Method != "" && entry.Method != req.Method {
+			return false
+		}
+
+		return api.authz.IsAuthorized(ctx, entry.ClusterId, rbac.AuditLogResource, rbac.GetAction)
+	}, int(req.Limit))
+
+	return &vtadminpb.GetAuditEntriesResponse{
+		Entries: entries,
+	}, nil
+}
+
 // GetBackups is part of the vtadminpb.VTAdminServer interface.
 func (api *API) GetBackups(ctx context.Context, req *vtadminpb.GetBackupsRequest) (*vtadminpb.GetBackupsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "API.GetBackups")
@@ -870,6 +1109,59 @@ func (api *API) getSchemas(ctx context.Context, c *cluster.Cluster, opts cluster
 	return schemas, nil
 }
 
+// GetSchemaMigrations is part of the vtadminpb.VTAdminServer interface.
+func (api *API) GetSchemaMigrations(ctx context.Context, req *vtadminpb.GetSchemaMigrationsRequest) (*vtadminpb.GetSchemaMigrationsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.GetSchemaMigrations")
+	defer span.Finish()
+
+	span.Annotate("filter", req.Filter)
+
+	clusters, _ := api.getClustersForRequest(req.ClusterIds)
+
+	var (
+		migrations []*vtadminpb.SchemaMigration
+		wg         sync.WaitGroup
+		er         concurrency.AllErrorRecorder
+		m          sync.Mutex
+	)
+
+	for _, c := range clusters {
+		if !api.authz.IsAuthorized(ctx, c.ID, rbac.SchemaResource, rbac.GetAction) {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(c *cluster.Cluster) {
+			defer wg.Done()
+
+			ms, err := c.GetSchemaMigrations(ctx, req.Keyspaces, req.Filter)
+			if err != nil {
+				er.RecordError(err)
+				return
+			}
+
+			m.Lock()
+			migrations = append(migrations, ms...)
+			m.Unlock()
+		}(c)
+	}
+
+	wg.Wait()
+
+	if er.HasErrors() {
+		return nil, er.Error()
+	}
+
+	stdsort.SliceStable(migrations, func(i, j int) bool {
+		return migrations[i].Cluster.Id < migrations[j].Cluster.Id
+	})
+
+	return &vtadminpb.GetSchemaMigrationsResponse{
+		SchemaMigrations: migrations,
+	}, nil
+}
+
 // GetShardReplicationPositions is part of the vtadminpb.VTAdminServer interface.
 func (api *API) GetShardReplicationPositions(ctx context.Context, req *vtadminpb.GetShardReplicationPositionsRequest) (*vtadminpb.GetShardReplicationPositionsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "API.GetShardReplicationPositions")
@@ -993,15 +1285,20 @@ func (api *API) GetTablet(ctx context.Context, req *vtadminpb.GetTabletRequest)
 	return api.getTabletForAction(ctx, span, rbac.GetAction, req.Alias, req.ClusterIds)
 }
 
-func (api *API) DeleteTablet(ctx context.Context, req *vtadminpb.DeleteTabletRequest) (*vtadminpb.DeleteTabletResponse, error) {
+func (api *API) DeleteTablet(ctx context.Context, req *vtadminpb.DeleteTabletRequest) (resp *vtadminpb.DeleteTabletResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.DeleteTablet")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "DeleteTablet", rbac.TabletResource, rbac.DeleteAction, "", req)
+	defer func() { rec.End(resp, err) }()
+
 	tablet, err := api.getTabletForAction(ctx, span, rbac.DeleteAction, req.Alias, req.ClusterIds)
 	if err != nil {
 		return nil, err
 	}
 
+	rec.SetClusterID(tablet.Cluster.Id)
+
 	c, ok := api.clusterMap[tablet.Cluster.Id]
 	if !ok {
 		return nil, fmt.Errorf("%w: no such cluster %s", errors.ErrUnsupportedCluster, tablet.Cluster.Id)
@@ -1026,15 +1323,20 @@ func (api *API) DeleteTablet(ctx context.Context, req *vtadminpb.DeleteTabletReq
 	return &vtadminpb.DeleteTabletResponse{Status: "ok"}, nil
 }
 
-func (api *API) ReparentTablet(ctx context.Context, req *vtadminpb.ReparentTabletRequest) (*vtadminpb.ReparentTabletResponse, error) {
+func (api *API) ReparentTablet(ctx context.Context, req *vtadminpb.ReparentTabletRequest) (resp *vtadminpb.ReparentTabletResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.ReparentTablet")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "ReparentTablet", rbac.TabletResource, rbac.PutAction, "", req)
+	defer func() { rec.End(resp, err) }()
+
 	tablet, err := api.getTabletForAction(ctx, span, rbac.PutAction, req.Alias, req.ClusterIds)
 	if err != nil {
 		return nil, err
 	}
 
+	rec.SetClusterID(tablet.Cluster.Id)
+
 	c, ok := api.clusterMap[tablet.Cluster.Id]
 	if !ok {
 		return nil, fmt.Errorf("%w: no such cluster %s", errors.ErrUnsupportedCluster, tablet.Cluster.Id)
@@ -1057,6 +1359,62 @@ func (api *API) ReparentTablet(ctx context.Context, req *vtadminpb.ReparentTable
 	return &vtadminpb.ReparentTabletResponse{Keyspace: r.Keyspace, Primary: r.Primary.String(), Shard: r.Shard}, nil
 }
 
+// RetrySchemaMigration is part of the vtadminpb.VTAdminServer interface.
+func (api *API) RetrySchemaMigration(ctx context.Context, req *vtadminpb.RetrySchemaMigrationRequest) (resp *vtadminpb.RetrySchemaMigrationResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.RetrySchemaMigration")
+	defer span.Finish()
+
+	rec := api.audit.Begin(ctx, "RetrySchemaMigration", rbac.SchemaResource, rbac.PutAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("uuid", req.Uuid)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.SchemaResource, rbac.PutAction) {
+		return nil, fmt.Errorf("%w: cannot retry schema migration in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.RetrySchemaMigration(ctx, req)
+}
+
+// RevertSchemaMigration is part of the vtadminpb.VTAdminServer interface.
+func (api *API) RevertSchemaMigration(ctx context.Context, req *vtadminpb.RevertSchemaMigrationRequest) (resp *vtadminpb.RevertSchemaMigrationResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.RevertSchemaMigration")
+	defer span.Finish()
+
+	rec := api.audit.Begin(ctx, "RevertSchemaMigration", rbac.SchemaResource, rbac.CreateAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("uuid", req.Uuid)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.SchemaResource, rbac.CreateAction) {
+		return nil, fmt.Errorf("%w: cannot revert schema migration in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.RevertSchemaMigration(ctx, req)
+}
+
 // PingTablet is part of the vtadminpb.VTAdminServer interface.
 func (api *API) RunHealthCheck(ctx context.Context, req *vtadminpb.RunHealthCheckRequest) (*vtadminpb.RunHealthCheckResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "API.RunHealthCheck")
@@ -1122,15 +1480,20 @@ func (api *API) PingTablet(ctx context.Context, req *vtadminpb.PingTabletRequest
 }
 
 // SetReadOnly sets the tablet to read only mode
-func (api *API) SetReadOnly(ctx context.Context, req *vtadminpb.SetReadOnlyRequest) (*vtadminpb.SetReadOnlyResponse, error) {
+func (api *API) SetReadOnly(ctx context.Context, req *vtadminpb.SetReadOnlyRequest) (resp *vtadminpb.SetReadOnlyResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.SetReadOnly")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "SetReadOnly", rbac.TabletResource, rbac.PutAction, "", req)
+	defer func() { rec.End(resp, err) }()
+
 	tablet, err := api.getTabletForAction(ctx, span, rbac.PutAction, req.Alias, req.ClusterIds)
 	if err != nil {
 		return nil, err
 	}
 
+	rec.SetClusterID(tablet.Cluster.Id)
+
 	c, ok := api.clusterMap[tablet.Cluster.Id]
 	if !ok {
 		return nil, fmt.Errorf("%w: no such cluster %s", errors.ErrUnsupportedCluster, tablet.Cluster.Id)
@@ -1155,15 +1518,20 @@ func (api *API) SetReadOnly(ctx context.Context, req *vtadminpb.SetReadOnlyReque
 }
 
 // SetReadWrite sets the tablet to read-write mode
-func (api *API) SetReadWrite(ctx context.Context, req *vtadminpb.SetReadWriteRequest) (*vtadminpb.SetReadWriteResponse, error) {
+func (api *API) SetReadWrite(ctx context.Context, req *vtadminpb.SetReadWriteRequest) (resp *vtadminpb.SetReadWriteResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.SetReadWrite")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "SetReadWrite", rbac.TabletResource, rbac.PutAction, "", req)
+	defer func() { rec.End(resp, err) }()
+
 	tablet, err := api.getTabletForAction(ctx, span, rbac.PutAction, req.Alias, req.ClusterIds)
 	if err != nil {
 		return nil, err
 	}
 
+	rec.SetClusterID(tablet.Cluster.Id)
+
 	c, ok := api.clusterMap[tablet.Cluster.Id]
 	if !ok {
 		return nil, fmt.Errorf("%w: no such cluster %s", errors.ErrUnsupportedCluster, tablet.Cluster.Id)
@@ -1188,15 +1556,20 @@ func (api *API) SetReadWrite(ctx context.Context, req *vtadminpb.SetReadWriteReq
 }
 
 // StartReplication starts replication on the specified tablet.
-func (api *API) StartReplication(ctx context.Context, req *vtadminpb.StartReplicationRequest) (*vtadminpb.StartReplicationResponse, error) {
+func (api *API) StartReplication(ctx context.Context, req *vtadminpb.StartReplicationRequest) (resp *vtadminpb.StartReplicationResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.StartReplication")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "StartReplication", rbac.TabletResource, rbac.PutAction, "", req)
+	defer func() { rec.End(resp, err) }()
+
 	tablet, err := api.getTabletForAction(ctx, span, rbac.PutAction, req.Alias, req.ClusterIds)
 	if err != nil {
 		return nil, err
 	}
 
+	rec.SetClusterID(tablet.Cluster.Id)
+
 	c, ok := api.clusterMap[tablet.Cluster.Id]
 	if !ok {
 		return nil, fmt.Errorf("%w: no such cluster %s", errors.ErrUnsupportedCluster, tablet.Cluster.Id)
@@ -1220,15 +1593,20 @@ func (api *API) StartReplication(ctx context.Context, req *vtadminpb.StartReplic
 }
 
 // StopReplication stops replication on the specified tablet.
-func (api *API) StopReplication(ctx context.Context, req *vtadminpb.StopReplicationRequest) (*vtadminpb.StopReplicationResponse, error) {
+func (api *API) StopReplication(ctx context.Context, req *vtadminpb.StopReplicationRequest) (resp *vtadminpb.StopReplicationResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.StopReplication")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "StopReplication", rbac.TabletResource, rbac.PutAction, "", req)
+	defer func() { rec.End(resp, err) }()
+
 	tablet, err := api.getTabletForAction(ctx, span, rbac.PutAction, req.Alias, req.ClusterIds)
 	if err != nil {
 		return nil, err
 	}
 
+	rec.SetClusterID(tablet.Cluster.Id)
+
 	c, ok := api.clusterMap[tablet.Cluster.Id]
 	if !ok {
 		return nil, fmt.Errorf("%w: no such cluster %s", errors.ErrUnsupportedCluster, tablet.Cluster.Id)
@@ -1251,6 +1629,36 @@ func (api *API) StopReplication(ctx context.Context, req *vtadminpb.StopReplicat
 	return &vtadminpb.StopReplicationResponse{Status: "ok"}, nil
 }
 
+// SwitchWorkflowTraffic is part of the vtadminpb.VTAdminServer interface.
+func (api *API) SwitchWorkflowTraffic(ctx context.Context, req *vtadminpb.SwitchWorkflowTrafficRequest) (resp *vtadminpb.SwitchWorkflowTrafficResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.SwitchWorkflowTraffic")
+	defer span.Finish()
+
+	rec := api.audit.Begin(ctx, "SwitchWorkflowTraffic", rbac.WorkflowResource, rbac.PutAction, req.ClusterId, req)
+	defer func() { rec.End(resp, err) }()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow_name", req.Name)
+	span.Annotate("reverse", req.Reverse)
+	span.Annotate("dry_run", req.DryRun)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.WorkflowResource, rbac.PutAction) {
+		return nil, fmt.Errorf("%w: cannot switch traffic for workflow in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.SwitchWorkflowTraffic(ctx, req)
+}
+
 // GetTablets is part of the vtadminpb.VTAdminServer interface.
 func (api *API) GetTablets(ctx context.Context, req *vtadminpb.GetTabletsRequest) (*vtadminpb.GetTabletsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "API.GetTablets")
@@ -1545,15 +1953,20 @@ func (api *API) GetWorkflows(ctx context.Context, req *vtadminpb.GetWorkflowsReq
 }
 
 // RefreshState reloads the tablet record on the specified tablet.
-func (api *API) RefreshState(ctx context.Context, req *vtadminpb.RefreshStateRequest) (*vtadminpb.RefreshStateResponse, error) {
+func (api *API) RefreshState(ctx context.Context, req *vtadminpb.RefreshStateRequest) (resp *vtadminpb.RefreshStateResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.RefreshState")
 	defer span.Finish()
 
+	rec := api.audit.Begin(ctx, "RefreshState", rbac.TabletResource, rbac.PutAction, "", req)
+	defer func() { rec.End(resp, err) }()
+
 	tablet, err := api.getTabletForAction(ctx, span, rbac.PutAction, req.Alias, req.ClusterIds)
 	if err != nil {
 		return nil, err
 	}
 
+	rec.SetClusterID(tablet.Cluster.Id)
+
 	c, ok := api.clusterMap[tablet.Cluster.Id]
 	if !ok {
 		return nil, fmt.Errorf("%w: no such cluster %s", errors.ErrUnsupportedCluster, tablet.Cluster.Id)
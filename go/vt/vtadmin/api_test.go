@@ -37,6 +37,7 @@ import (
 	"vitess.io/vitess/go/vt/vtadmin/cluster/discovery/fakediscovery"
 	vtadminerrors "vitess.io/vitess/go/vt/vtadmin/errors"
 	vtadminhttp "vitess.io/vitess/go/vt/vtadmin/http"
+	"vitess.io/vitess/go/vt/vtadmin/rbac"
 	vtadmintestutil "vitess.io/vitess/go/vt/vtadmin/testutil"
 	"vitess.io/vitess/go/vt/vtadmin/vtctldclient/fakevtctldclient"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
@@ -777,6 +778,191 @@ func TestFindSchema(t *testing.T) {
 	})
 }
 
+func TestGetAuditEntries(t *testing.T) {
+	t.Parallel()
+
+	sql := "alter table customer add column email varchar(128)"
+	clusters := []*cluster.Cluster{
+		vtadmintestutil.BuildCluster(t, vtadmintestutil.TestClusterConfig{
+			Cluster: &vtadminpb.Cluster{
+				Id:   "c1",
+				Name: "cluster1",
+			},
+			VtctldClient: &fakevtctldclient.VtctldClient{
+				ApplySchemaResults: map[string]struct {
+					Response *vtctldatapb.ApplySchemaResponse
+					Error    error
+				}{
+					"commerce/" + sql: {
+						Response: &vtctldatapb.ApplySchemaResponse{
+							UuidList: []string{"aa89f255_8d68_11eb_815f_f875a4d24e90"},
+						},
+					},
+				},
+			},
+		}),
+		vtadmintestutil.BuildCluster(t, vtadmintestutil.TestClusterConfig{
+			Cluster: &vtadminpb.Cluster{
+				Id:   "c2",
+				Name: "cluster2",
+			},
+			VtctldClient: &fakevtctldclient.VtctldClient{},
+		}),
+	}
+
+	rbacCfg := &rbac.Config{
+		Rules: []*struct {
+			Resource string
+			Actions  []string
+			Subjects []string
+			Clusters []string
+		}{
+			{
+				Resource: "Schema",
+				Actions:  []string{"create"},
+				Subjects: []string{"user:alice"},
+				Clusters: []string{"*"},
+			},
+			{
+				Resource: "AuditLog",
+				Actions:  []string{"get"},
+				Subjects: []string{"user:auditor"},
+				Clusters: []string{"c1"},
+			},
+			{
+				Resource: "*",
+				Actions:  []string{"*"},
+				Subjects: []string{"user:admin"},
+				Clusters: []string{"*"},
+			},
+		},
+	}
+	require.NoError(t, rbacCfg.Reify())
+
+	api := NewAPI(clusters, Options{RBAC: rbacCfg})
+	ctx := context.Background()
+	actorCtx := func(name string) context.Context {
+		return rbac.NewContext(ctx, &rbac.Actor{Name: name, Roles: []string{"dba"}})
+	}
+	applySchemaReq := func(clusterID string) *vtadminpb.ApplySchemaRequest {
+		return &vtadminpb.ApplySchemaRequest{
+			ClusterId: clusterID,
+			Options: &vtctldatapb.ApplySchemaRequest{
+				Keyspace: "commerce",
+				Sql:      []string{sql},
+			},
+		}
+	}
+
+	_, err := api.ApplySchema(actorCtx("alice"), applySchemaReq("c1"))
+	require.NoError(t, err)
+	_, err = api.ApplySchema(actorCtx("alice"), applySchemaReq("c2"))
+	require.Error(t, err)
+	_, err = api.ApplySchema(actorCtx("bob"), applySchemaReq("c1"))
+	require.ErrorIs(t, err, vtadminerrors.ErrUnauthorized)
+
+	type summary struct {
+		Actor     string
+		ClusterID string
+		Failed    bool
+	}
+
+	tests := []struct {
+		name     string
+		actor    string
+		req      *vtadminpb.GetAuditEntriesRequest
+		expected []summary
+	}{
+		{
+			name:  "all entries, most recent first",
+			actor: "admin",
+			req:   &vtadminpb.GetAuditEntriesRequest{},
+			expected: []summary{
+				{Actor: "bob", ClusterID: "c1", Failed: true},
+				{Actor: "alice", ClusterID: "c2", Failed: true},
+				{Actor: "alice", ClusterID: "c1", Failed: false},
+			},
+		},
+		{
+			name:  "filtered by actor",
+			actor: "admin",
+			req: &vtadminpb.GetAuditEntriesRequest{
+				Actor: "alice",
+			},
+			expected: []summary{
+				{Actor: "alice", ClusterID: "c2", Failed: true},
+				{Actor: "alice", ClusterID: "c1", Failed: false},
+			},
+		},
+		{
+			name:  "filtered by cluster, with limit",
+			actor: "admin",
+			req: &vtadminpb.GetAuditEntriesRequest{
+				ClusterIds: []string{"c1"},
+				Limit:      1,
+			},
+			expected: []summary{
+				{Actor: "bob", ClusterID: "c1", Failed: true},
+			},
+		},
+		{
+			name:  "filtered by method",
+			actor: "admin",
+			req: &vtadminpb.GetAuditEntriesRequest{
+				Method: "DeleteKeyspace",
+			},
+			expected: nil,
+		},
+		{
+			name:  "partial access",
+			actor: "auditor",
+			req:   &vtadminpb.GetAuditEntriesRequest{},
+			expected: []summary{
+				{Actor: "bob", ClusterID: "c1", Failed: true},
+				{Actor: "alice", ClusterID: "c1", Failed: false},
+			},
+		},
+		{
+			name:     "no access",
+			actor:    "alice",
+			req:      &vtadminpb.GetAuditEntriesRequest{},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := api.GetAuditEntries(actorCtx(tt.actor), tt.req)
+			require.NoError(t, err)
+
+			var actual []summary
+			for _, entry := range resp.Entries {
+				assert.Equal(t, "ApplySchema", entry.Method)
+				assert.Equal(t, "Schema", entry.Resource)
+				assert.Equal(t, "create", entry.Action)
+				assert.Equal(t, []string{"dba"}, entry.ActorRoles)
+				assert.Contains(t, entry.Request, sql)
+
+				if entry.Error == "" {
+					assert.Contains(t, entry.Response, "aa89f255_8d68_11eb_815f_f875a4d24e90")
+				}
+
+				actual = append(actual, summary{
+					Actor:     entry.Actor,
+					ClusterID: entry.ClusterId,
+					Failed:    entry.Error != "",
+				})
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
 func TestGetClusters(t *testing.T) {
 	t.Parallel()
 
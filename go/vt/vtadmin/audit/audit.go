@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package audit records the mutating operations performed through the vtadmin
+API.
+
+Each mutating API method (DeleteTablet, ReparentTablet, CreateKeyspace, and so
+on) begins an audit record before its authorization check, and ends it with the
+result of the operation, so that both denied and failed operations are
+recorded. A completed entry includes the authenticated actor (see
+rbac.Authenticator), the cluster, the rbac resource and action, and the request
+and response of the operation.
+
+Completed entries are retained in a bounded in-memory buffer, which backs the
+GetAuditEntries API, and are written in the background to each of the
+configured sinks. Sinks are pluggable: "file" and "http" sinks are provided by this package, and additional
+implementations may be registered at runtime via RegisterSink.
+*/
+package audit
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/hack"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtadmin/rbac"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+// marshaler encodes entries, and the requests and responses they record, with
+// their proto field names, matching the JSON returned by the vtadmin HTTP API.
+var marshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+func init() {
+	hack.DisableProtoBufRandomness()
+}
+
+// Record is an in-progress audit entry for a single operation. Records are
+// created by Log.Begin, and must be completed by calling End.
+type Record struct {
+	log   *Log
+	start time.Time
+	entry *vtadminpb.AuditEntry
+}
+
+// Begin starts an audit record for the named API method, which takes the given
+// action on the given resource in the given cluster. The actor is taken from
+// the context, where it is stored by the rbac authentication interceptors and
+// middlewares.
+//
+// The clusterID may be empty for operations whose cluster is not known up
+// front (e.g. tablet actions, which search for the tablet across clusters), in
+// which case callers should call SetClusterID once it is.
+func (l *Log) Begin(ctx context.Context, method string, resource rbac.Resource, action rbac.Action, clusterID string, req proto.Message) *Record {
+	start := time.Now()
+	entry := &vtadminpb.AuditEntry{
+		Time:      protoutil.TimeToProto(start),
+		ClusterId: clusterID,
+		Method:    method,
+		Resource:  string(resource),
+		Action:    string(action),
+		Request:   marshal(req),
+	}
+
+	if actor, ok := rbac.FromContext(ctx); ok && actor != nil {
+		entry.Actor = actor.Name
+		entry.ActorRoles = actor.Roles
+	}
+
+	return &Record{
+		log:   l,
+		start: start,
+		entry: entry,
+	}
+}
+
+// SetClusterID sets the cluster of the operation being recorded.
+func (r *Record) SetClusterID(id string) {
+	r.entry.ClusterId = id
+}
+
+// End completes the record with the result of the operation, and writes it to
+// the log. It is meant to be deferred with the named results of an API method:
+//
+//	rec := api.audit.Begin(ctx, "DeleteTablet", rbac.TabletResource, rbac.DeleteAction, "", req)
+//	defer func() { rec.End(resp, err) }()
+func (r *Record) End(resp proto.Message, err error) {
+	r.entry.Duration = protoutil.DurationToProto(time.Since(r.start))
+
+	if err != nil {
+		r.entry.Error = err.Error()
+	} else {
+		r.entry.Response = marshal(resp)
+	}
+
+	r.log.Write(r.entry)
+}
+
+func marshal(m proto.Message) string {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return ""
+	}
+
+	data, err := marshaler.Marshal(m)
+	if err != nil {
+		log.Warningf("[audit]: failed to marshal %T: %s", m, err)
+		return ""
+	}
+
+	return string(data)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vtadmin/rbac"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	req := &vtadminpb.SetReadOnlyRequest{
+		Alias: "zone1-0000000100",
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		resp     *vtadminpb.SetReadOnlyResponse
+		err      error
+		expected *vtadminpb.AuditEntry
+	}{
+		{
+			name: "success",
+			ctx:  rbac.NewContext(context.Background(), &rbac.Actor{Name: "alice", Roles: []string{"dba"}}),
+			resp: &vtadminpb.SetReadOnlyResponse{},
+			expected: &vtadminpb.AuditEntry{
+				Actor:      "alice",
+				ActorRoles: []string{"dba"},
+				ClusterId:  "c1",
+				Method:     "SetReadOnly",
+				Resource:   "Tablet",
+				Action:     "put",
+				Request:    `{"alias":"zone1-0000000100"}`,
+				Response:   `{}`,
+			},
+		},
+		{
+			name: "failure",
+			ctx:  rbac.NewContext(context.Background(), &rbac.Actor{Name: "alice"}),
+			err:  errors.New("tablet not found"),
+			expected: &vtadminpb.AuditEntry{
+				Actor:     "alice",
+				ClusterId: "c1",
+				Method:    "SetReadOnly",
+				Resource:  "Tablet",
+				Action:    "put",
+				Request:   `{"alias":"zone1-0000000100"}`,
+				Error:     "tablet not found",
+			},
+		},
+		{
+			name: "unauthenticated",
+			ctx:  context.Background(),
+			resp: &vtadminpb.SetReadOnlyResponse{},
+			expected: &vtadminpb.AuditEntry{
+				ClusterId: "c1",
+				Method:    "SetReadOnly",
+				Resource:  "Tablet",
+				Action:    "put",
+				Request:   `{"alias":"zone1-0000000100"}`,
+				Response:  `{}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sink := &fakeSink{}
+			l := NewLog(0, 0, sink)
+
+			rec := l.Begin(tt.ctx, "SetReadOnly", rbac.TabletResource, rbac.PutAction, "", req)
+			rec.SetClusterID("c1")
+			rec.End(tt.resp, tt.err)
+			require.NoError(t, l.Close())
+
+			require.Len(t, sink.entries, 1)
+			entry := sink.entries[0]
+
+			assert.NotNil(t, entry.Time)
+			assert.NotNil(t, entry.Duration)
+
+			entry.Time = nil
+			entry.Duration = nil
+			utils.MustMatch(t, tt.expected, entry)
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	var nilResp *vtadminpb.ReparentTabletResponse
+	assert.Equal(t, "", marshal(nil))
+	assert.Equal(t, "", marshal(nilResp))
+	assert.Equal(t, `{"cell":"zone1","uid":100}`, marshal(&topodatapb.TabletAlias{Cell: "zone1", Uid: 100}))
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// Config is the audit configuration representation. The public fields are
+// populated by viper during LoadConfig, and the private fields are set during
+// cfg.Reify. A config must be reified before first use.
+type Config struct {
+	// Recent is the number of entries to retain in memory for the
+	// GetAuditEntries API. If not positive, DefaultRecentEntries is used.
+	Recent int
+	// Buffered is the number of entries that may wait to be written to the
+	// sinks before new entries are dropped. If not positive,
+	// DefaultBufferedEntries is used.
+	Buffered int
+	Sinks    []*struct {
+		Type    string
+		Options map[string]string
+	}
+
+	reified bool
+
+	log *Log
+}
+
+// LoadConfig reads the file at path into a Config struct, and then reifies
+// the config so its log may be used. Errors during loading/parsing, or errors
+// constructing the sinks during reification are returned to the caller.
+//
+// Any file format supported by viper is supported. Currently this is yaml, json
+// or toml.
+func LoadConfig(path string) (*Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := v.UnmarshalExact(&cfg); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Reify(); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+// Reify makes a config that was loaded from a file usable, by constructing
+// each of its sinks and the log that writes to them. A config must be reified
+// before first use. Calling Reify multiple times has no effect after the first
+// call. Reify is called by LoadConfig, so a config loaded that way does not need
+// to be manually reified.
+func (c *Config) Reify() error {
+	if c.reified {
+		return nil
+	}
+
+	reified := make([]Sink, 0, len(c.Sinks))
+	for i, s := range c.Sinks {
+		factory, ok := sinks[s.Type]
+		if !ok {
+			closeSinks(reified)
+			return fmt.Errorf("sink %d: %w %s", i, ErrUnregisteredSinkImpl, s.Type)
+		}
+
+		sink, err := factory(s.Options)
+		if err != nil {
+			closeSinks(reified)
+			return fmt.Errorf("sink %d: %w", i, err)
+		}
+
+		reified = append(reified, sink)
+	}
+
+	log.Infof("[audit]: loaded audit log with %d sinks", len(reified))
+
+	c.log = NewLog(c.Recent, c.Buffered, reified...)
+	c.reified = true
+	return nil
+}
+
+// GetLog returns the Log specified by the config. It returns nil if a call to
+// Reify has not been made.
+func (c *Config) GetLog() *Log {
+	return c.log
+}
+
+func closeSinks(reified []Sink) {
+	for _, sink := range reified {
+		if err := sink.Close(); err != nil {
+			log.Warningf("[audit]: failed to close %T sink: %s", sink, err)
+		}
+	}
+}
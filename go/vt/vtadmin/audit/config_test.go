@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "audit.log")
+
+	tests := []struct {
+		name      string
+		config    string
+		shouldErr bool
+		errIs     error
+	}{
+		{
+			name: "file sink",
+			config: fmt.Sprintf(`recent: 5
+buffered: 10
+sinks:
+  - type: file
+    options:
+      path: %s
+`, logPath),
+		},
+		{
+			name:   "no sinks",
+			config: "recent: 5\nbuffered: 10\n",
+		},
+		{
+			name: "unregistered sink",
+			config: `sinks:
+  - type: kafka
+`,
+			shouldErr: true,
+			errIs:     ErrUnregisteredSinkImpl,
+		},
+		{
+			name: "invalid sink options",
+			config: `sinks:
+  - type: http
+    options:
+      timeout: 5s
+`,
+			shouldErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(dir, fmt.Sprintf("config%d.yaml", i))
+			require.NoError(t, os.WriteFile(path, []byte(tt.config), 0644))
+
+			cfg, err := LoadConfig(path)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, cfg.GetLog())
+			assert.Equal(t, 5, len(cfg.GetLog().recent))
+			assert.Equal(t, 10, cap(cfg.GetLog().pending))
+		})
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Write(&vtadminpb.AuditEntry{Method: "DeleteTablet", ClusterId: "c1"}))
+	require.NoError(t, sink.Write(&vtadminpb.AuditEntry{Method: "ReparentTablet", ClusterId: "c2"}))
+	require.NoError(t, sink.Close())
+
+	// Reopening the sink appends to the existing file.
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(&vtadminpb.AuditEntry{Method: "SetReadOnly", ClusterId: "c1"}))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	expected := []string{
+		`{"cluster_id":"c1","method":"DeleteTablet"}`,
+		`{"cluster_id":"c2","method":"ReparentTablet"}`,
+		`{"cluster_id":"c1","method":"SetReadOnly"}`,
+	}
+	assert.Equal(t, expected, strings.Split(strings.TrimSuffix(string(data), "\n"), "\n"))
+}
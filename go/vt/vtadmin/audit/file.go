@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"errors"
+	"os"
+	"sync"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+func init() {
+	RegisterSink("file", func(opts map[string]string) (Sink, error) {
+		path, ok := opts["path"]
+		if !ok || path == "" {
+			return nil, errors.New("file sink requires a path option")
+		}
+
+		return NewFileSink(path)
+	})
+}
+
+// FileSink writes audit entries to a file, one JSON-encoded entry per line.
+type FileSink struct {
+	m sync.Mutex
+	f *os.File
+}
+
+// NewFileSink returns a FileSink appending to the file at path, which is
+// created if it does not exist.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSink{f: f}, nil
+}
+
+// Write is part of the Sink interface.
+func (sink *FileSink) Write(entry *vtadminpb.AuditEntry) error {
+	data, err := marshaler.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	sink.m.Lock()
+	defer sink.m.Unlock()
+
+	_, err = sink.f.Write(append(data, '\n'))
+	return err
+}
+
+// Close is part of the Sink interface.
+func (sink *FileSink) Close() error {
+	sink.m.Lock()
+	defer sink.m.Unlock()
+
+	return sink.f.Close()
+}
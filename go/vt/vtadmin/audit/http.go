@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+// DefaultHTTPSinkTimeout is the timeout for each request made by an HTTPSink
+// if no timeout is specified.
+const DefaultHTTPSinkTimeout = 5 * time.Second
+
+func init() {
+	RegisterSink("http", func(opts map[string]string) (Sink, error) {
+		url, ok := opts["url"]
+		if !ok || url == "" {
+			return nil, errors.New("http sink requires a url option")
+		}
+
+		timeout := DefaultHTTPSinkTimeout
+		if s, ok := opts["timeout"]; ok {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return nil, fmt.Errorf("http sink: cannot parse timeout: %w", err)
+			}
+
+			timeout = d
+		}
+
+		return NewHTTPSink(url, timeout), nil
+	})
+}
+
+// HTTPSink writes audit entries by POSTing each JSON-encoded entry to a URL.
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSink returns an HTTPSink that POSTs to the given url, with the given
+// timeout for each request.
+func NewHTTPSink(url string, timeout time.Duration) *HTTPSink {
+	return &HTTPSink{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// Write is part of the Sink interface. Any non-2xx response is considered a
+// failure.
+func (sink *HTTPSink) Write(entry *vtadminpb.AuditEntry) error {
+	data, err := marshaler.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	resp, err := sink.client.Post(sink.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection may be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("POST %s: unexpected status %s", sink.url, resp.Status)
+	}
+
+	return nil
+}
+
+// Close is part of the Sink interface.
+func (sink *HTTPSink) Close() error {
+	sink.client.CloseIdleConnections()
+	return nil
+}
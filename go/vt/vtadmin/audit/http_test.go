@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+func TestHTTPSink(t *testing.T) {
+	t.Parallel()
+
+	var (
+		m      sync.Mutex
+		bodies []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		if r.URL.Path == "/unavailable" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		m.Lock()
+		defer m.Unlock()
+		bodies = append(bodies, string(body))
+	}))
+	defer srv.Close()
+
+	sink := NewHTTPSink(srv.URL+"/audit", time.Second)
+	defer sink.Close()
+
+	require.NoError(t, sink.Write(&vtadminpb.AuditEntry{Method: "DeleteTablet", ClusterId: "c1"}))
+	assert.Equal(t, []string{`{"cluster_id":"c1","method":"DeleteTablet"}`}, bodies)
+
+	sink = NewHTTPSink(srv.URL+"/unavailable", time.Second)
+	defer sink.Close()
+
+	err := sink.Write(&vtadminpb.AuditEntry{Method: "DeleteTablet", ClusterId: "c1"})
+	assert.Error(t, err, "non-2xx responses should fail the write")
+}
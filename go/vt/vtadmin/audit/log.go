@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"sync"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/log"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+const (
+	// DefaultRecentEntries is the number of entries a Log retains in memory if
+	// no size is specified.
+	DefaultRecentEntries = 1000
+	// DefaultBufferedEntries is the number of entries a Log queues for its
+	// sinks if no size is specified.
+	DefaultBufferedEntries = 1000
+)
+
+var (
+	entriesDropped = stats.NewCounter("AuditEntriesDropped", "Number of audit entries not written to the sinks because too many entries were waiting to be written")
+	sinkErrors     = stats.NewCounter("AuditSinkErrors", "Number of failures to write an audit entry to a sink")
+)
+
+// Log writes audit entries to a set of sinks, and retains the most recent
+// entries in memory. It is safe for concurrent use.
+//
+// The entries are written to the sinks in the background, in the order they
+// were completed, so that a slow or unavailable sink does not delay the
+// operations being audited. Entries completed while the buffer of entries
+// waiting to be written is full are dropped, and counted in
+// AuditEntriesDropped. Failures to write to a sink are counted in
+// AuditSinkErrors.
+type Log struct {
+	sinks   []Sink
+	pending chan *vtadminpb.AuditEntry
+	done    chan struct{}
+
+	m      sync.Mutex
+	recent []*vtadminpb.AuditEntry // ring buffer, next is the oldest entry once full
+	next   int
+	full   bool
+	closed bool
+}
+
+// NewLog returns a Log that retains up to size entries in memory and writes
+// each entry to the given sinks, with up to buffered entries waiting to be
+// written. If size or buffered is not positive, DefaultRecentEntries or
+// DefaultBufferedEntries is used, respectively.
+func NewLog(size int, buffered int, sinks ...Sink) *Log {
+	if size <= 0 {
+		size = DefaultRecentEntries
+	}
+
+	if buffered <= 0 {
+		buffered = DefaultBufferedEntries
+	}
+
+	l := &Log{
+		sinks:   sinks,
+		pending: make(chan *vtadminpb.AuditEntry, buffered),
+		done:    make(chan struct{}),
+		recent:  make([]*vtadminpb.AuditEntry, size),
+	}
+
+	if len(sinks) == 0 {
+		close(l.done)
+	} else {
+		go l.run()
+	}
+
+	return l
+}
+
+// Write records a completed entry, and queues it to be written to the sinks.
+// If too many entries are already waiting to be written, or if the log is
+// closed, the entry is only retained in memory.
+func (l *Log) Write(entry *vtadminpb.AuditEntry) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	l.recent[l.next] = entry
+	l.next = (l.next + 1) % len(l.recent)
+	if l.next == 0 {
+		l.full = true
+	}
+
+	if len(l.sinks) == 0 {
+		return
+	}
+
+	if !l.closed {
+		select {
+		case l.pending <- entry:
+			return
+		default:
+		}
+	}
+
+	entriesDropped.Add(1)
+	log.Errorf("[audit]: dropped %s entry, %d entries are waiting to be written to the sinks", entry.Method, len(l.pending))
+}
+
+// run writes the queued entries to the sinks, until the log is closed. Failures
+// to write to a sink are logged, and do not prevent the entry from being
+// written to the remaining sinks.
+func (l *Log) run() {
+	defer close(l.done)
+
+	for entry := range l.pending {
+		for _, sink := range l.sinks {
+			if err := sink.Write(entry); err != nil {
+				sinkErrors.Add(1)
+				log.Errorf("[audit]: failed to write %s entry to %T sink: %s", entry.Method, sink, err)
+			}
+		}
+	}
+}
+
+// Entries returns the retained entries for which filter returns true, ordered
+// from most to least recent. A nil filter matches all entries. If limit is
+// positive, at most limit entries are returned.
+func (l *Log) Entries(filter func(entry *vtadminpb.AuditEntry) bool, limit int) []*vtadminpb.AuditEntry {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	n := l.next
+	if l.full {
+		n = len(l.recent)
+	}
+
+	var entries []*vtadminpb.AuditEntry
+
+	for i := 1; i <= n; i++ {
+		if limit > 0 && len(entries) >= limit {
+			break
+		}
+
+		entry := l.recent[(l.next-i+len(l.recent))%len(l.recent)]
+		if filter != nil && !filter(entry) {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// Close writes the queued entries to the sinks, and then closes each of the
+// log's sinks. Entries completed after Close are only retained in memory.
+func (l *Log) Close() error {
+	l.m.Lock()
+	if !l.closed {
+		l.closed = true
+		close(l.pending)
+	}
+	l.m.Unlock()
+
+	<-l.done
+
+	rec := concurrency.AllErrorRecorder{}
+
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			rec.RecordError(err)
+		}
+	}
+
+	return rec.Error()
+}
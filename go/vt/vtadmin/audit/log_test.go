@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+type fakeSink struct {
+	m       sync.Mutex
+	entries []*vtadminpb.AuditEntry
+	err     error
+	closed  bool
+}
+
+func (sink *fakeSink) Write(entry *vtadminpb.AuditEntry) error {
+	sink.m.Lock()
+	defer sink.m.Unlock()
+
+	if sink.err != nil {
+		return sink.err
+	}
+
+	sink.entries = append(sink.entries, entry)
+	return nil
+}
+
+func (sink *fakeSink) Close() error {
+	sink.closed = true
+	return sink.err
+}
+
+func methods(entries []*vtadminpb.AuditEntry) []string {
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Method
+	}
+
+	return names
+}
+
+func TestLogEntries(t *testing.T) {
+	t.Parallel()
+
+	l := NewLog(3, 0)
+	assert.Empty(t, l.Entries(nil, 0))
+
+	for _, method := range []string{"a", "b"} {
+		l.Write(&vtadminpb.AuditEntry{Method: method})
+	}
+
+	assert.Equal(t, []string{"b", "a"}, methods(l.Entries(nil, 0)))
+
+	for _, method := range []string{"c", "d", "e"} {
+		l.Write(&vtadminpb.AuditEntry{Method: method})
+	}
+
+	assert.Equal(t, []string{"e", "d", "c"}, methods(l.Entries(nil, 0)), "oldest entries should be evicted")
+	assert.Equal(t, []string{"e", "d"}, methods(l.Entries(nil, 2)))
+
+	notD := func(entry *vtadminpb.AuditEntry) bool { return entry.Method != "d" }
+	assert.Equal(t, []string{"e", "c"}, methods(l.Entries(notD, 0)))
+	assert.Equal(t, []string{"e"}, methods(l.Entries(notD, 1)))
+}
+
+func TestLogWrite(t *testing.T) {
+	t.Parallel()
+
+	failing := &fakeSink{err: errors.New("sink unavailable")}
+	sink := &fakeSink{}
+	l := NewLog(0, 0, failing, sink)
+	errorsBefore := sinkErrors.Get()
+
+	l.Write(&vtadminpb.AuditEntry{Method: "DeleteTablet"})
+
+	err := l.Close()
+	assert.Error(t, err)
+	assert.True(t, failing.closed)
+	assert.True(t, sink.closed)
+
+	assert.Equal(t, []string{"DeleteTablet"}, methods(sink.entries), "a failing sink should not prevent writes to other sinks")
+	assert.Equal(t, []string{"DeleteTablet"}, methods(l.Entries(nil, 0)), "a failing sink should not prevent the entry from being retained")
+	assert.Equal(t, int64(1), sinkErrors.Get()-errorsBefore, "the sink failure should be counted")
+
+	l.Write(&vtadminpb.AuditEntry{Method: "SetReadOnly"})
+	assert.Equal(t, []string{"DeleteTablet"}, methods(sink.entries), "entries completed after Close should not be written to the sinks")
+	assert.Equal(t, []string{"SetReadOnly", "DeleteTablet"}, methods(l.Entries(nil, 0)))
+}
+
+// blockingSink blocks its writes until unblock is closed, and signals each
+// write it receives on started.
+type blockingSink struct {
+	fakeSink
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (sink *blockingSink) Write(entry *vtadminpb.AuditEntry) error {
+	sink.started <- struct{}{}
+	<-sink.unblock
+	return sink.fakeSink.Write(entry)
+}
+
+func TestLogWriteDoesNotWaitForSinks(t *testing.T) {
+	t.Parallel()
+
+	sink := &blockingSink{
+		started: make(chan struct{}, 10),
+		unblock: make(chan struct{}),
+	}
+	l := NewLog(0, 1, sink)
+	droppedBefore := entriesDropped.Get()
+
+	// The first entry is being written to the sink, the second one waits to be
+	// written, and the third one is dropped.
+	l.Write(&vtadminpb.AuditEntry{Method: "a"})
+	<-sink.started
+	l.Write(&vtadminpb.AuditEntry{Method: "b"})
+	l.Write(&vtadminpb.AuditEntry{Method: "c"})
+
+	assert.Equal(t, int64(1), entriesDropped.Get()-droppedBefore)
+	assert.Equal(t, []string{"c", "b", "a"}, methods(l.Entries(nil, 0)), "dropped entries should still be retained")
+
+	close(sink.unblock)
+	require.NoError(t, l.Close())
+	assert.Equal(t, []string{"a", "b"}, methods(sink.entries))
+}
+
+func TestLogConcurrentWrites(t *testing.T) {
+	t.Parallel()
+
+	sink := &fakeSink{}
+	l := NewLog(10, 100, sink)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.Write(&vtadminpb.AuditEntry{})
+		}()
+	}
+
+	wg.Wait()
+	require.NoError(t, l.Close())
+
+	require.Len(t, sink.entries, 100)
+	assert.Len(t, l.Entries(nil, 0), 10)
+}
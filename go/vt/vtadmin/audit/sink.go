@@ -0,0 +1,55 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+// Sink defines the interface audit sinks must implement. A sink receives every
+// completed entry written to the Log it belongs to.
+type Sink interface {
+	// Write records a single audit entry. It may be called concurrently.
+	//
+	// Write is called in the background, after the operation being audited
+	// completed. Returning an error does not fail the operation; the error is
+	// logged and counted in AuditSinkErrors instead.
+	Write(entry *vtadminpb.AuditEntry) error
+	// Close releases any resources held by the sink.
+	Close() error
+}
+
+var (
+	// ErrUnregisteredSinkImpl is returned when an audit config specifies a
+	// sink type that was not registered.
+	ErrUnregisteredSinkImpl = errors.New("unregistered Sink implementation")
+	sinks                   = map[string]func(opts map[string]string) (Sink, error){}
+)
+
+// RegisterSink registers a sink implementation by type name. The factory is
+// called with the options of each sink of that type in an audit config. It is
+// not safe for concurrent use.
+func RegisterSink(name string, f func(opts map[string]string) (Sink, error)) {
+	if _, ok := sinks[name]; ok {
+		panic(fmt.Sprintf("sink already registered with name: %s", name))
+	}
+
+	sinks[name] = f
+}
@@ -21,8 +21,10 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"text/template"
@@ -32,11 +34,14 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtadmin/cluster/discovery"
 	"vitess.io/vitess/go/vt/vtadmin/debug"
@@ -45,9 +50,11 @@ import (
 	"vitess.io/vitess/go/vt/vtadmin/vtctldclient"
 	"vitess.io/vitess/go/vt/vtadmin/vtsql"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // Cluster is the self-contained unit of services required for vtadmin to talk
@@ -244,6 +251,169 @@ func (c *Cluster) parseTablet(rows *sql.Rows) (*vtadminpb.Tablet, error) {
 	return tablet, nil
 }
 
+// ApplySchema applies a schema change to a keyspace in the given cluster,
+// proxying an ApplySchemaRequest to a vtctld in that cluster.
+func (c *Cluster) ApplySchema(ctx context.Context, req *vtctldatapb.ApplySchemaRequest) (*vtctldatapb.ApplySchemaResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.ApplySchema")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("ddl_strategy", req.DdlStrategy)
+	span.Annotate("skip_preflight", req.SkipPreflight)
+
+	if req.Keyspace == "" {
+		return nil, fmt.Errorf("%w: keyspace name is required", errors.ErrInvalidRequest)
+	}
+
+	if len(req.Sql) == 0 {
+		return nil, fmt.Errorf("%w: at least one sql statement is required", errors.ErrInvalidRequest)
+	}
+
+	if err := c.topoRWPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("ApplySchema(%+v) failed to acquire topoRWPool: %w", req, err)
+	}
+	defer c.topoRWPool.Release()
+
+	return c.Vtctld.ApplySchema(ctx, req)
+}
+
+// CancelSchemaMigration cancels an online schema migration on all shards of a
+// keyspace in the given cluster.
+func (c *Cluster) CancelSchemaMigration(ctx context.Context, req *vtadminpb.CancelSchemaMigrationRequest) (*vtadminpb.CancelSchemaMigrationResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.CancelSchemaMigration")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("uuid", req.Uuid)
+
+	if err := c.alterSchemaMigration(ctx, req.Keyspace, req.Uuid, sqlparser.CancelMigrationType); err != nil {
+		return nil, err
+	}
+
+	return &vtadminpb.CancelSchemaMigrationResponse{}, nil
+}
+
+// CancelWorkflow cancels a MoveTables or Reshard workflow in the given
+// cluster, running the corresponding vtctl command on a vtctld in that cluster.
+func (c *Cluster) CancelWorkflow(ctx context.Context, req *vtadminpb.CancelWorkflowRequest) (*vtadminpb.CancelWorkflowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.CancelWorkflow")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	if err := validateWorkflowName(req.Keyspace, req.Name); err != nil {
+		return nil, err
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow_name", req.Name)
+	span.Annotate("keep_data", req.KeepData)
+	span.Annotate("keep_routing_rules", req.KeepRoutingRules)
+
+	var flags []string
+	if req.KeepData {
+		flags = append(flags, "-keep_data")
+	}
+
+	if req.KeepRoutingRules {
+		flags = append(flags, "-keep_routing_rules")
+	}
+
+	output, err := c.runWorkflowAction(ctx, req.Keyspace, req.Name, "Cancel", flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtadminpb.CancelWorkflowResponse{Output: output}, nil
+}
+
+// CompleteSchemaMigration completes an online schema migration that was
+// submitted with postponed completion, on all shards of a keyspace in the given
+// cluster.
+func (c *Cluster) CompleteSchemaMigration(ctx context.Context, req *vtadminpb.CompleteSchemaMigrationRequest) (*vtadminpb.CompleteSchemaMigrationResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.CompleteSchemaMigration")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("uuid", req.Uuid)
+
+	if err := c.alterSchemaMigration(ctx, req.Keyspace, req.Uuid, sqlparser.CompleteMigrationType); err != nil {
+		return nil, err
+	}
+
+	return &vtadminpb.CompleteSchemaMigrationResponse{}, nil
+}
+
+// CompleteWorkflow completes a MoveTables or Reshard workflow in the given
+// cluster, running the corresponding vtctl command on a vtctld in that cluster.
+func (c *Cluster) CompleteWorkflow(ctx context.Context, req *vtadminpb.CompleteWorkflowRequest) (*vtadminpb.CompleteWorkflowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.CompleteWorkflow")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	if err := validateWorkflowName(req.Keyspace, req.Name); err != nil {
+		return nil, err
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow_name", req.Name)
+	span.Annotate("keep_data", req.KeepData)
+	span.Annotate("keep_routing_rules", req.KeepRoutingRules)
+	span.Annotate("rename_tables", req.RenameTables)
+	span.Annotate("dry_run", req.DryRun)
+
+	var flags []string
+	if req.KeepData {
+		flags = append(flags, "-keep_data")
+	}
+
+	if req.KeepRoutingRules {
+		flags = append(flags, "-keep_routing_rules")
+	}
+
+	if req.RenameTables {
+		flags = append(flags, "-rename_tables")
+	}
+
+	if req.DryRun {
+		flags = append(flags, "-dry_run")
+	}
+
+	output, err := c.runWorkflowAction(ctx, req.Keyspace, req.Name, "Complete", flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtadminpb.CompleteWorkflowResponse{Output: output}, nil
+}
+
 // CreateKeyspace creates a keyspace in the given cluster, proxying a
 // CreateKeyspaceRequest to a vtctld in that cluster.
 func (c *Cluster) CreateKeyspace(ctx context.Context, req *vtctldatapb.CreateKeyspaceRequest) (*vtadminpb.Keyspace, error) {
@@ -310,6 +480,99 @@ func (c *Cluster) CreateShard(ctx context.Context, req *vtctldatapb.CreateShardR
 	return c.Vtctld.CreateShard(ctx, req)
 }
 
+// CreateWorkflow creates a MoveTables or Reshard workflow in the given cluster,
+// running the corresponding vtctl command on a vtctld in that cluster.
+func (c *Cluster) CreateWorkflow(ctx context.Context, req *vtadminpb.CreateWorkflowRequest) (*vtadminpb.CreateWorkflowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.CreateWorkflow")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	if err := validateWorkflowName(req.Keyspace, req.Name); err != nil {
+		return nil, err
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow_name", req.Name)
+	span.Annotate("workflow_type", req.WorkflowType)
+
+	var (
+		command string
+		flags   []string
+	)
+
+	switch {
+	case strings.EqualFold(req.WorkflowType, moveTablesWorkflow):
+		command = moveTablesWorkflow
+
+		if req.SourceKeyspace == "" {
+			return nil, fmt.Errorf("%w: source keyspace is required for %s workflows", errors.ErrInvalidRequest, moveTablesWorkflow)
+		}
+
+		if len(req.Tables) == 0 && !req.AllTables {
+			return nil, fmt.Errorf("%w: either tables or all_tables is required for %s workflows", errors.ErrInvalidRequest, moveTablesWorkflow)
+		}
+
+		span.Annotate("source_keyspace", req.SourceKeyspace)
+
+		flags = append(flags, "-source="+req.SourceKeyspace)
+
+		if req.AllTables {
+			flags = append(flags, "-all")
+
+			if len(req.ExcludeTables) > 0 {
+				flags = append(flags, "-exclude="+strings.Join(req.ExcludeTables, ","))
+			}
+		} else {
+			flags = append(flags, "-tables="+strings.Join(req.Tables, ","))
+		}
+	case strings.EqualFold(req.WorkflowType, reshardWorkflow):
+		command = reshardWorkflow
+
+		if len(req.SourceShards) == 0 || len(req.TargetShards) == 0 {
+			return nil, fmt.Errorf("%w: source and target shards are required for %s workflows", errors.ErrInvalidRequest, reshardWorkflow)
+		}
+
+		flags = append(flags,
+			"-source_shards="+strings.Join(req.SourceShards, ","),
+			"-target_shards="+strings.Join(req.TargetShards, ","),
+		)
+
+		if req.SkipSchemaCopy {
+			flags = append(flags, "-skip_schema_copy")
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported workflow type %q; must be one of %s or %s", errors.ErrInvalidRequest, req.WorkflowType, moveTablesWorkflow, reshardWorkflow)
+	}
+
+	if len(req.Cells) > 0 {
+		flags = append(flags, "-cells="+strings.Join(req.Cells, ","))
+	}
+
+	if len(req.TabletTypes) > 0 {
+		flags = append(flags, "-tablet_types="+strings.Join(topoproto.MakeStringTypeList(req.TabletTypes), ","))
+	}
+
+	if req.SkipStart {
+		flags = append(flags, "-auto_start=false")
+	}
+
+	if req.StopAfterCopy {
+		flags = append(flags, "-stop_after_copy")
+	}
+
+	output, err := c.execWorkflowCommand(ctx, command, "Create", req.Keyspace, req.Name, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtadminpb.CreateWorkflowResponse{Output: output}, nil
+}
+
 // DeleteKeyspace deletes a keyspace in the given cluster, proxying a
 // DeleteKeyspaceRequest to a vtctld in that cluster.
 func (c *Cluster) DeleteKeyspace(ctx context.Context, req *vtctldatapb.DeleteKeyspaceRequest) (*vtctldatapb.DeleteKeyspaceResponse, error) {
@@ -1189,6 +1452,323 @@ func (c *Cluster) getTabletsToQueryForSchemas(ctx context.Context, keyspace stri
 	return []*vtadminpb.Tablet{randomServingTablet}, nil
 }
 
+// GetSchemaMigrations returns the online schema migrations in this cluster,
+// across all shards of the given keyspaces. The filter is passed along to the
+// OnlineDDL vtctl command; see GetSchemaMigrationsRequest for its format.
+//
+// If the list of keyspaces is empty, then GetSchemaMigrations lists migrations
+// from all keyspaces in the cluster.
+func (c *Cluster) GetSchemaMigrations(ctx context.Context, keyspaces []string, filter string) ([]*vtadminpb.SchemaMigration, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.GetSchemaMigrations")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+	span.Annotate("filter", filter)
+
+	if filter == "" {
+		filter = "all"
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, fmt.Errorf("GetSchemaMigrations(cluster = %v, keyspaces = %v) dial failed: %w", c.ID, keyspaces, err)
+	}
+
+	if len(keyspaces) == 0 {
+		if err := c.topoReadPool.Acquire(ctx); err != nil {
+			return nil, fmt.Errorf("GetSchemaMigrations(keyspaces = %v) failed to acquire topoReadPool: %w", keyspaces, err)
+		}
+
+		resp, err := c.Vtctld.GetKeyspaces(ctx, &vtctldatapb.GetKeyspacesRequest{})
+		c.topoReadPool.Release()
+
+		if err != nil {
+			return nil, fmt.Errorf("GetKeyspaces(cluster = %s) failed: %w", c.ID, err)
+		}
+
+		for _, ks := range resp.Keyspaces {
+			keyspaces = append(keyspaces, ks.Name)
+		}
+	}
+
+	span.Annotate("keyspaces", strings.Join(keyspaces, ","))
+
+	var (
+		m       sync.Mutex
+		wg      sync.WaitGroup
+		rec     concurrency.AllErrorRecorder
+		results []*vtadminpb.SchemaMigration
+	)
+
+	for _, ks := range keyspaces {
+		wg.Add(1)
+
+		go func(ks string) {
+			defer wg.Done()
+
+			migrations, err := c.getSchemaMigrationsForKeyspace(ctx, ks, filter)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+
+			m.Lock()
+			results = append(results, migrations...)
+			m.Unlock()
+		}(ks)
+	}
+
+	wg.Wait()
+
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Keyspace != results[j].Keyspace {
+			return results[i].Keyspace < results[j].Keyspace
+		}
+
+		if results[i].Uuid != results[j].Uuid {
+			return results[i].Uuid < results[j].Uuid
+		}
+
+		return results[i].Shard < results[j].Shard
+	})
+
+	return results, nil
+}
+
+// getSchemaMigrationsForKeyspace lists the rows of _vt.schema_migrations on the
+// primary tablets of a keyspace, by running the OnlineDDL vtctl command.
+func (c *Cluster) getSchemaMigrationsForKeyspace(ctx context.Context, keyspace string, filter string) ([]*vtadminpb.SchemaMigration, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.getSchemaMigrationsForKeyspace")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+	span.Annotate("keyspace", keyspace)
+	span.Annotate("filter", filter)
+
+	if err := c.schemaReadPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("GetSchemaMigrations(%s, %s) failed to acquire schemaReadPool: %w", keyspace, filter, err)
+	}
+	defer c.schemaReadPool.Release()
+
+	stream, err := c.Vtctld.ExecuteVtctlCommand(ctx, []string{"OnlineDDL", "-json", keyspace, "show", filter}, vtctlActionTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("GetSchemaMigrations(%s, %s) failed: %w", keyspace, filter, err)
+	}
+
+	events, err := recvVtctlEvents(stream)
+	if err != nil {
+		return nil, fmt.Errorf("GetSchemaMigrations(%s, %s) failed: %w", keyspace, filter, err)
+	}
+
+	// The command prints its result as JSON to the console, while any other
+	// events are log messages.
+	var output strings.Builder
+	for _, event := range events {
+		if event.Level == logutilpb.Level_CONSOLE {
+			output.WriteString(event.Value)
+		}
+	}
+
+	var result struct {
+		Fields []struct {
+			Name string `json:"name"`
+		} `json:"fields"`
+		Rows [][]json.RawMessage `json:"rows"`
+	}
+
+	if err := json.Unmarshal([]byte(output.String()), &result); err != nil {
+		return nil, fmt.Errorf("GetSchemaMigrations(%s, %s) failed to parse output: %w", keyspace, filter, err)
+	}
+
+	migrations := make([]*vtadminpb.SchemaMigration, 0, len(result.Rows))
+
+	for _, row := range result.Rows {
+		values := make(map[string]string, len(row))
+
+		for i, raw := range row {
+			if i >= len(result.Fields) {
+				break
+			}
+
+			var value interface{}
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return nil, fmt.Errorf("GetSchemaMigrations(%s, %s) failed to parse output: %w", keyspace, filter, err)
+			}
+
+			switch value := value.(type) {
+			case nil:
+				// NULL columns are left unset.
+			case string:
+				values[result.Fields[i].Name] = value
+			default:
+				values[result.Fields[i].Name] = string(raw)
+			}
+		}
+
+		migration, err := c.parseSchemaMigration(values)
+		if err != nil {
+			return nil, fmt.Errorf("GetSchemaMigrations(%s, %s) failed to parse migration: %w", keyspace, filter, err)
+		}
+
+		migrations = append(migrations, migration)
+	}
+
+	return migrations, nil
+}
+
+// parseSchemaMigration builds a SchemaMigration from a row of
+// _vt.schema_migrations, keyed by column name. Columns missing from older
+// versions of the table are left at their zero values.
+func (c *Cluster) parseSchemaMigration(row map[string]string) (*vtadminpb.SchemaMigration, error) {
+	migration := &vtadminpb.SchemaMigration{
+		Cluster:          c.ToProto(),
+		Keyspace:         row["keyspace"],
+		Shard:            row["shard"],
+		Uuid:             row["migration_uuid"],
+		Table:            row["mysql_table"],
+		Statement:        row["migration_statement"],
+		Strategy:         row["strategy"],
+		Options:          row["options"],
+		DdlAction:        row["ddl_action"],
+		MigrationContext: row["migration_context"],
+		Status:           row["migration_status"],
+		Message:          row["message"],
+		RevertedUuid:     row["reverted_uuid"],
+		EtaSeconds:       -1,
+	}
+
+	// The tablet column is only set once a tablet has picked up the migration,
+	// so fall back to the primary tablet the row was read from.
+	alias := row["tablet"]
+	if alias == "" {
+		alias = row["Tablet"]
+	}
+
+	if alias != "" {
+		var err error
+
+		migration.Tablet, err = topoproto.ParseTabletAlias(alias)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tablet alias %s: %w", alias, err)
+		}
+	}
+
+	if s, ok := row["progress"]; ok {
+		progress, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse progress %s: %w", s, err)
+		}
+
+		migration.Progress = progress
+	}
+
+	for column, field := range map[string]*int64{
+		"eta_seconds": &migration.EtaSeconds,
+		"rows_copied": &migration.RowsCopied,
+		"table_rows":  &migration.TableRows,
+		"retries":     &migration.Retries,
+	} {
+		s, ok := row[column]
+		if !ok {
+			continue
+		}
+
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s %s: %w", column, s, err)
+		}
+
+		*field = n
+	}
+
+	migration.PostponeCompletion = row["postpone_completion"] == "1"
+	migration.ReadyToComplete = row["ready_to_complete"] == "1"
+
+	for column, field := range map[string]**vttimepb.Time{
+		"added_timestamp":     &migration.AddedAt,
+		"requested_timestamp": &migration.RequestedAt,
+		"started_timestamp":   &migration.StartedAt,
+		"completed_timestamp": &migration.CompletedAt,
+	} {
+		s, ok := row[column]
+		if !ok {
+			continue
+		}
+
+		// Tablets write these timestamps in UTC.
+		t, err := time.Parse(mysqlTimestampLayout, s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s %s: %w", column, s, err)
+		}
+
+		*field = protoutil.TimeToProto(t)
+	}
+
+	return migration, nil
+}
+
+// alterSchemaMigration runs an ALTER VITESS_MIGRATION statement of the given
+// type (cancel, retry or complete) for a migration on all shards of a keyspace.
+func (c *Cluster) alterSchemaMigration(ctx context.Context, keyspace string, uuid string, typ sqlparser.AlterMigrationType) error {
+	if err := validateSchemaMigrationUUID(keyspace, uuid); err != nil {
+		return err
+	}
+
+	_, err := c.applySchemaMigrationStatement(ctx, keyspace, sqlparser.String(&sqlparser.AlterMigration{
+		Type: typ,
+		UUID: uuid,
+	}))
+	return err
+}
+
+// applySchemaMigrationStatement applies a statement managing existing schema
+// migrations to a keyspace, through the vtctld ApplySchema rpc.
+func (c *Cluster) applySchemaMigrationStatement(ctx context.Context, keyspace string, sql string) (*vtctldatapb.ApplySchemaResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.applySchemaMigrationStatement")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+	span.Annotate("keyspace", keyspace)
+	span.Annotate("sql", sql)
+
+	if err := c.topoRWPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("ApplySchema(%s, %s) failed to acquire topoRWPool: %w", keyspace, sql, err)
+	}
+	defer c.topoRWPool.Release()
+
+	resp, err := c.Vtctld.ApplySchema(ctx, &vtctldatapb.ApplySchemaRequest{
+		Keyspace: keyspace,
+		Sql:      []string{sql},
+		// These statements do not change any table definitions, so there is
+		// nothing to preflight.
+		SkipPreflight: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ApplySchema(%s, %s) failed: %w", keyspace, sql, err)
+	}
+
+	return resp, nil
+}
+
+// mysqlTimestampLayout is the layout of MySQL TIMESTAMP columns when formatted
+// as text.
+const mysqlTimestampLayout = "2006-01-02 15:04:05"
+
+func validateSchemaMigrationUUID(keyspace string, uuid string) error {
+	if keyspace == "" {
+		return fmt.Errorf("%w: keyspace name is required", errors.ErrInvalidRequest)
+	}
+
+	if !schema.IsOnlineDDLUUID(uuid) {
+		return fmt.Errorf("%w: invalid migration uuid %q", errors.ErrInvalidRequest, uuid)
+	}
+
+	return nil
+}
+
 // GetShardReplicationPositions returns a ClusterShardReplicationPosition object
 // for each keyspace/shard in the cluster.
 func (c *Cluster) GetShardReplicationPositions(ctx context.Context, req *vtadminpb.GetShardReplicationPositionsRequest) ([]*vtadminpb.ClusterShardReplicationPosition, error) {
@@ -1456,6 +2036,226 @@ func (c *Cluster) GetWorkflows(ctx context.Context, keyspaces []string, opts Get
 	})
 }
 
+// RetrySchemaMigration retries a failed or cancelled online schema migration
+// on all shards of a keyspace in the given cluster.
+func (c *Cluster) RetrySchemaMigration(ctx context.Context, req *vtadminpb.RetrySchemaMigrationRequest) (*vtadminpb.RetrySchemaMigrationResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.RetrySchemaMigration")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("uuid", req.Uuid)
+
+	if err := c.alterSchemaMigration(ctx, req.Keyspace, req.Uuid, sqlparser.RetryMigrationType); err != nil {
+		return nil, err
+	}
+
+	return &vtadminpb.RetrySchemaMigrationResponse{}, nil
+}
+
+// RevertSchemaMigration submits a migration reverting a completed online schema
+// migration on all shards of a keyspace in the given cluster.
+func (c *Cluster) RevertSchemaMigration(ctx context.Context, req *vtadminpb.RevertSchemaMigrationRequest) (*vtadminpb.RevertSchemaMigrationResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.RevertSchemaMigration")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("uuid", req.Uuid)
+
+	if err := validateSchemaMigrationUUID(req.Keyspace, req.Uuid); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.applySchemaMigrationStatement(ctx, req.Keyspace, sqlparser.String(&sqlparser.RevertMigration{
+		UUID: req.Uuid,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.UuidList) == 0 {
+		return nil, fmt.Errorf("RevertSchemaMigration(%s, %s) did not submit a revert migration", req.Keyspace, req.Uuid)
+	}
+
+	return &vtadminpb.RevertSchemaMigrationResponse{Uuid: resp.UuidList[0]}, nil
+}
+
+// SwitchWorkflowTraffic switches the traffic of a MoveTables or Reshard
+// workflow in the given cluster, running the corresponding vtctl command on a
+// vtctld in that cluster.
+func (c *Cluster) SwitchWorkflowTraffic(ctx context.Context, req *vtadminpb.SwitchWorkflowTrafficRequest) (*vtadminpb.SwitchWorkflowTrafficResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.SwitchWorkflowTraffic")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	if err := validateWorkflowName(req.Keyspace, req.Name); err != nil {
+		return nil, err
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow_name", req.Name)
+	span.Annotate("reverse", req.Reverse)
+	span.Annotate("dry_run", req.DryRun)
+
+	timeout, _, err := protoutil.DurationFromProto(req.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid timeout: %s", errors.ErrInvalidRequest, err)
+	}
+
+	maxLag, _, err := protoutil.DurationFromProto(req.MaxReplicationLagAllowed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid max_replication_lag_allowed: %s", errors.ErrInvalidRequest, err)
+	}
+
+	var flags []string
+	if len(req.TabletTypes) > 0 {
+		flags = append(flags, "-tablet_types="+strings.Join(topoproto.MakeStringTypeList(req.TabletTypes), ","))
+	}
+
+	if len(req.Cells) > 0 {
+		flags = append(flags, "-cells="+strings.Join(req.Cells, ","))
+	}
+
+	if req.DryRun {
+		flags = append(flags, "-dry_run")
+	}
+
+	if timeout > 0 {
+		flags = append(flags, "-timeout="+timeout.String())
+	}
+
+	if maxLag > 0 {
+		flags = append(flags, "-max_replication_lag_allowed="+maxLag.String())
+	}
+
+	if req.SkipReverseReplication {
+		flags = append(flags, "-reverse_replication=false")
+	}
+
+	action := "SwitchTraffic"
+	if req.Reverse {
+		action = "ReverseTraffic"
+	}
+
+	output, err := c.runWorkflowAction(ctx, req.Keyspace, req.Name, action, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtadminpb.SwitchWorkflowTrafficResponse{Output: output}, nil
+}
+
+const (
+	moveTablesWorkflow = "MoveTables"
+	reshardWorkflow    = "Reshard"
+
+	// vtctlActionTimeout is the action timeout passed along with vtctl
+	// commands. It matches the default of vtctlclient.
+	vtctlActionTimeout = time.Hour
+)
+
+func validateWorkflowName(keyspace string, name string) error {
+	if keyspace == "" {
+		return fmt.Errorf("%w: keyspace name is required", errors.ErrInvalidRequest)
+	}
+
+	if name == "" {
+		return fmt.Errorf("%w: workflow name is required", errors.ErrInvalidRequest)
+	}
+
+	return nil
+}
+
+// runWorkflowAction runs an action of the vtctl command managing an existing
+// workflow. Reshard workflows replicate between shards of a single keyspace,
+// while MoveTables workflows replicate between keyspaces, which is how the
+// command is chosen.
+func (c *Cluster) runWorkflowAction(ctx context.Context, keyspace string, name string, action string, flags []string) ([]string, error) {
+	workflow, err := c.GetWorkflow(ctx, keyspace, name, GetWorkflowOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	command := moveTablesWorkflow
+	if workflow.Workflow.Source.GetKeyspace() == workflow.Workflow.Target.GetKeyspace() {
+		command = reshardWorkflow
+	}
+
+	return c.execWorkflowCommand(ctx, command, action, keyspace, name, flags)
+}
+
+// execWorkflowCommand runs a MoveTables or Reshard vtctl command on a vtctld in
+// the cluster, returning the lines of output logged by the command.
+func (c *Cluster) execWorkflowCommand(ctx context.Context, command string, action string, keyspace string, name string, flags []string) ([]string, error) {
+	args := append([]string{command}, flags...)
+	args = append(args, action, fmt.Sprintf("%s.%s", keyspace, name))
+
+	span, ctx := trace.NewSpan(ctx, "Cluster.execWorkflowCommand")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+	span.Annotate("args", strings.Join(args, " "))
+
+	if err := c.topoRWPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("%s %s(%s.%s) failed to acquire topoRWPool: %w", command, action, keyspace, name, err)
+	}
+
+	// The pool only bounds the commands being dispatched: a workflow command
+	// can run for up to vtctlActionTimeout, and holding a slot while streaming
+	// its output would starve the other topo writes of the cluster.
+	stream, err := c.Vtctld.ExecuteVtctlCommand(ctx, args, vtctlActionTimeout)
+	c.topoRWPool.Release()
+	if err != nil {
+		return nil, fmt.Errorf("%s %s(%s.%s) failed: %w", command, action, keyspace, name, err)
+	}
+
+	events, err := recvVtctlEvents(stream)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s(%s.%s) failed: %w", command, action, keyspace, name, err)
+	}
+
+	var output []string
+	for _, event := range events {
+		output = append(output, strings.Split(strings.Trim(event.Value, "\n"), "\n")...)
+	}
+
+	return output, nil
+}
+
+// recvVtctlEvents reads the events logged by a vtctl command until the command
+// finishes.
+func recvVtctlEvents(stream logutil.EventStream) ([]*logutilpb.Event, error) {
+	var events []*logutilpb.Event
+
+	for {
+		event, err := stream.Recv()
+		switch err {
+		case nil:
+			events = append(events, event)
+		case io.EOF:
+			return events, nil
+		default:
+			return nil, err
+		}
+	}
+}
+
 // FindTablet returns the first tablet in a given cluster that satisfies the filter function.
 func (c *Cluster) FindTablet(ctx context.Context, filter func(*vtadminpb.Tablet) bool) (*vtadminpb.Tablet, error) {
 	span, ctx := trace.NewSpan(ctx, "Cluster.FindTablet")
@@ -18,17 +18,22 @@ package cluster
 
 import (
 	"context"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtadmin/vtctldclient/fakevtctldclient"
 	"vitess.io/vitess/go/vt/vtctl/vtctldclient"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
@@ -40,6 +45,73 @@ type vtctldProxy struct {
 
 func (fake *vtctldProxy) Dial(ctx context.Context) error { return fake.dialErr }
 
+func (fake *vtctldProxy) ExecuteVtctlCommand(ctx context.Context, args []string, actionTimeout time.Duration) (logutil.EventStream, error) {
+	return nil, assert.AnError
+}
+
+// blockingVtctldProxy runs vtctl commands whose output is only logged once
+// unblock is closed.
+type blockingVtctldProxy struct {
+	vtctldProxy
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (fake *blockingVtctldProxy) ExecuteVtctlCommand(ctx context.Context, args []string, actionTimeout time.Duration) (logutil.EventStream, error) {
+	close(fake.started)
+	return &blockingEventStream{unblock: fake.unblock, event: &logutilpb.Event{Value: strings.Join(args, " ")}}, nil
+}
+
+type blockingEventStream struct {
+	unblock <-chan struct{}
+	event   *logutilpb.Event
+}
+
+func (stream *blockingEventStream) Recv() (*logutilpb.Event, error) {
+	<-stream.unblock
+	if stream.event == nil {
+		return nil, io.EOF
+	}
+	event := stream.event
+	stream.event = nil
+	return event, nil
+}
+
+func Test_execWorkflowCommandReleasesPool(t *testing.T) {
+	t.Parallel()
+
+	vtctld := &blockingVtctldProxy{
+		started: make(chan struct{}),
+		unblock: make(chan struct{}),
+	}
+	c := &Cluster{
+		Vtctld:     vtctld,
+		topoRWPool: pools.NewRPCPool(1, 0, nil),
+	}
+
+	type result struct {
+		output []string
+		err    error
+	}
+	results := make(chan result, 1)
+	go func() {
+		output, err := c.execWorkflowCommand(context.Background(), moveTablesWorkflow, "SwitchTraffic", "customer", "commerce2customer", nil)
+		results <- result{output, err}
+	}()
+	<-vtctld.started
+
+	// The pool is released while the command is still running.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, c.topoRWPool.Acquire(ctx))
+	c.topoRWPool.Release()
+
+	close(vtctld.unblock)
+	res := <-results
+	require.NoError(t, res.err)
+	assert.Equal(t, []string{"MoveTables SwitchTraffic customer.commerce2customer"}, res.output)
+}
+
 func Test_getShardSets(t *testing.T) {
 	t.Parallel()
 
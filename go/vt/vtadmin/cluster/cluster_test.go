@@ -41,6 +41,7 @@ import (
 	"vitess.io/vitess/go/vt/vtadmin/vtsql"
 	"vitess.io/vitess/go/vt/vtctl/vtctldclient"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -49,6 +50,486 @@ import (
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
+// workflowVtctldClient returns a fake vtctld that knows of a single workflow,
+// replicating from sourceKeyspace into keyspace.
+func workflowVtctldClient(keyspace string, name string, sourceKeyspace string) *fakevtctldclient.VtctldClient {
+	return &fakevtctldclient.VtctldClient{
+		GetWorkflowsResults: map[string]struct {
+			Response *vtctldatapb.GetWorkflowsResponse
+			Error    error
+		}{
+			keyspace: {
+				Response: &vtctldatapb.GetWorkflowsResponse{
+					Workflows: []*vtctldatapb.Workflow{
+						{
+							Name:   name,
+							Source: &vtctldatapb.Workflow_ReplicationLocation{Keyspace: sourceKeyspace},
+							Target: &vtctldatapb.Workflow_ReplicationLocation{Keyspace: keyspace},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// workflowVtctlClient returns a fake vtctl service that runs a single command,
+// logging the given values and then failing with err, if set.
+func workflowVtctlClient(command string, err error, values ...string) *fakevtctldclient.VtctlClient {
+	events := make([]*logutilpb.Event, len(values))
+	for i, value := range values {
+		events[i] = &logutilpb.Event{Level: logutilpb.Level_CONSOLE, Value: value}
+	}
+
+	return &fakevtctldclient.VtctlClient{
+		ExecuteVtctlCommandResults: map[string]struct {
+			Events []*logutilpb.Event
+			Error  error
+		}{
+			command: {
+				Events: events,
+				Error:  err,
+			},
+		},
+	}
+}
+
+// applySchemaVtctldClient returns a fake vtctld that applies a single schema
+// change, keyed by keyspace and sql.
+func applySchemaVtctldClient(key string, resp *vtctldatapb.ApplySchemaResponse, err error) *fakevtctldclient.VtctldClient {
+	return &fakevtctldclient.VtctldClient{
+		ApplySchemaResults: map[string]struct {
+			Response *vtctldatapb.ApplySchemaResponse
+			Error    error
+		}{
+			key: {
+				Response: resp,
+				Error:    err,
+			},
+		},
+	}
+}
+
+func TestApplySchema(t *testing.T) {
+	t.Parallel()
+
+	testCluster := &vtadminpb.Cluster{
+		Id:   "c1",
+		Name: "cluster1",
+	}
+
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		req       *vtctldatapb.ApplySchemaRequest
+		expected  *vtctldatapb.ApplySchemaResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+				VtctldClient: applySchemaVtctldClient("commerce/alter table customer add column email varchar(128)", &vtctldatapb.ApplySchemaResponse{
+					UuidList: []string{"aa89f255_8d68_11eb_815f_f875a4d24e90"},
+				}, nil),
+			},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace:    "commerce",
+				Sql:         []string{"alter table customer add column email varchar(128)"},
+				DdlStrategy: "vitess",
+			},
+			expected: &vtctldatapb.ApplySchemaResponse{
+				UuidList: []string{"aa89f255_8d68_11eb_815f_f875a4d24e90"},
+			},
+		},
+		{
+			name: "nil request",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name: "no keyspace in request",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Sql: []string{"alter table customer add column email varchar(128)"},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no sql in request",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace: "commerce",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "vtctld.ApplySchema fails",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: applySchemaVtctldClient("commerce/alter table customer add column email varchar(128)", nil, assert.AnError),
+			},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace: "commerce",
+				Sql:      []string{"alter table customer add column email varchar(128)"},
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.BuildCluster(t, tt.cfg)
+			require.NoError(t, c.Vtctld.Dial(ctx))
+
+			resp, err := c.ApplySchema(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestCancelSchemaMigration(t *testing.T) {
+	t.Parallel()
+
+	testCluster := &vtadminpb.Cluster{
+		Id:   "c1",
+		Name: "cluster1",
+	}
+
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		req       *vtadminpb.CancelSchemaMigrationRequest
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: applySchemaVtctldClient("commerce/alter vitess_migration 'aa89f255_8d68_11eb_815f_f875a4d24e90' cancel", &vtctldatapb.ApplySchemaResponse{}, nil),
+			},
+			req: &vtadminpb.CancelSchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "aa89f255_8d68_11eb_815f_f875a4d24e90",
+			},
+		},
+		{
+			name: "invalid uuid",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtadminpb.CancelSchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "' cancel; drop table t",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "vtctld.ApplySchema fails",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: applySchemaVtctldClient("commerce/alter vitess_migration 'aa89f255_8d68_11eb_815f_f875a4d24e90' cancel", nil, assert.AnError),
+			},
+			req: &vtadminpb.CancelSchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "aa89f255_8d68_11eb_815f_f875a4d24e90",
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.BuildCluster(t, tt.cfg)
+			require.NoError(t, c.Vtctld.Dial(ctx))
+
+			_, err := c.CancelSchemaMigration(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCancelWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testCluster := &vtadminpb.Cluster{
+		Id:   "c1",
+		Name: "cluster1",
+	}
+
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		req       *vtadminpb.CancelWorkflowRequest
+		expected  *vtadminpb.CancelWorkflowResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: workflowVtctldClient("customer", "commerce2customer", "commerce"),
+				VtctlClient:  workflowVtctlClient("MoveTables -keep_routing_rules Cancel customer.commerce2customer", nil, "\nCancel was successful for workflow customer.commerce2customer\n"),
+			},
+			req: &vtadminpb.CancelWorkflowRequest{
+				Keyspace:         "customer",
+				Name:             "commerce2customer",
+				KeepRoutingRules: true,
+			},
+			expected: &vtadminpb.CancelWorkflowResponse{
+				Output: []string{"Cancel was successful for workflow customer.commerce2customer"},
+			},
+		},
+		{
+			name: "nil request",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name: "no workflow name in request",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtadminpb.CancelWorkflowRequest{
+				Keyspace: "customer",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "workflow not found",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: workflowVtctldClient("customer", "commerce2customer", "commerce"),
+			},
+			req: &vtadminpb.CancelWorkflowRequest{
+				Keyspace: "customer",
+				Name:     "doesnotexist",
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.BuildCluster(t, tt.cfg)
+			require.NoError(t, c.Vtctld.Dial(ctx))
+
+			resp, err := c.CancelWorkflow(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestCompleteSchemaMigration(t *testing.T) {
+	t.Parallel()
+
+	testCluster := &vtadminpb.Cluster{
+		Id:   "c1",
+		Name: "cluster1",
+	}
+
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		req       *vtadminpb.CompleteSchemaMigrationRequest
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: applySchemaVtctldClient("commerce/alter vitess_migration 'aa89f255_8d68_11eb_815f_f875a4d24e90' complete", &vtctldatapb.ApplySchemaResponse{}, nil),
+			},
+			req: &vtadminpb.CompleteSchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "aa89f255_8d68_11eb_815f_f875a4d24e90",
+			},
+		},
+		{
+			name: "invalid uuid",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtadminpb.CompleteSchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "' complete; drop table t",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "vtctld.ApplySchema fails",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: applySchemaVtctldClient("commerce/alter vitess_migration 'aa89f255_8d68_11eb_815f_f875a4d24e90' complete", nil, assert.AnError),
+			},
+			req: &vtadminpb.CompleteSchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "aa89f255_8d68_11eb_815f_f875a4d24e90",
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.BuildCluster(t, tt.cfg)
+			require.NoError(t, c.Vtctld.Dial(ctx))
+
+			_, err := c.CompleteSchemaMigration(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCompleteWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testCluster := &vtadminpb.Cluster{
+		Id:   "c1",
+		Name: "cluster1",
+	}
+
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		req       *vtadminpb.CompleteWorkflowRequest
+		expected  *vtadminpb.CompleteWorkflowResponse
+		shouldErr bool
+	}{
+		{
+			name: "dry run",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: workflowVtctldClient("customer", "commerce2customer", "commerce"),
+				VtctlClient: workflowVtctlClient("MoveTables -rename_tables -dry_run Complete customer.commerce2customer", nil,
+					"Dry Run results for Complete\n\n",
+					"Lock keyspace commerce\nRename tables [customer] in keyspace commerce\nUnlock keyspace commerce\n",
+				),
+			},
+			req: &vtadminpb.CompleteWorkflowRequest{
+				Keyspace:     "customer",
+				Name:         "commerce2customer",
+				RenameTables: true,
+				DryRun:       true,
+			},
+			expected: &vtadminpb.CompleteWorkflowResponse{
+				Output: []string{
+					"Dry Run results for Complete",
+					"Lock keyspace commerce",
+					"Rename tables [customer] in keyspace commerce",
+					"Unlock keyspace commerce",
+				},
+			},
+		},
+		{
+			name: "reshard",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: workflowVtctldClient("customer", "cust2cust", "customer"),
+				VtctlClient:  workflowVtctlClient("Reshard Complete customer.cust2cust", nil, "Complete was successful\n"),
+			},
+			req: &vtadminpb.CompleteWorkflowRequest{
+				Keyspace: "customer",
+				Name:     "cust2cust",
+			},
+			expected: &vtadminpb.CompleteWorkflowResponse{
+				Output: []string{"Complete was successful"},
+			},
+		},
+		{
+			name: "command fails",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: workflowVtctldClient("customer", "cust2cust", "customer"),
+				VtctlClient:  workflowVtctlClient("Reshard Complete customer.cust2cust", assert.AnError),
+			},
+			req: &vtadminpb.CompleteWorkflowRequest{
+				Keyspace: "customer",
+				Name:     "cust2cust",
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.BuildCluster(t, tt.cfg)
+			require.NoError(t, c.Vtctld.Dial(ctx))
+
+			resp, err := c.CompleteWorkflow(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestCreateKeyspace(t *testing.T) {
 	t.Parallel()
 
@@ -261,22 +742,204 @@ func TestCreateShard(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.tc.Cluster.Vtctld.Dial(ctx)
-			require.NoError(t, err, "could not dial in-process vtctld")
+			err := tt.tc.Cluster.Vtctld.Dial(ctx)
+			require.NoError(t, err, "could not dial in-process vtctld")
+
+			_, err = tt.tc.Cluster.CreateShard(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.assertion != nil {
+				func() {
+					t.Helper()
+					tt.assertion(t, tt)
+				}()
+			}
+		})
+	}
+}
+
+func TestCreateWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testCluster := &vtadminpb.Cluster{
+		Id:   "c1",
+		Name: "cluster1",
+	}
+
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		req       *vtadminpb.CreateWorkflowRequest
+		expected  *vtadminpb.CreateWorkflowResponse
+		shouldErr bool
+	}{
+		{
+			name: "MoveTables",
+			cfg: testutil.TestClusterConfig{
+				Cluster:     testCluster,
+				VtctlClient: workflowVtctlClient("MoveTables -source=commerce -tables=customer,corder -tablet_types=primary,replica -auto_start=false Create customer.commerce2customer", nil, "Workflow has been created in Stopped state\n"),
+			},
+			req: &vtadminpb.CreateWorkflowRequest{
+				Keyspace:       "customer",
+				Name:           "commerce2customer",
+				WorkflowType:   "MoveTables",
+				SourceKeyspace: "commerce",
+				Tables:         []string{"customer", "corder"},
+				TabletTypes:    []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_PRIMARY},
+				SkipStart:      true,
+			},
+			expected: &vtadminpb.CreateWorkflowResponse{
+				Output: []string{"Workflow has been created in Stopped state"},
+			},
+		},
+		{
+			name: "MoveTables with all tables",
+			cfg: testutil.TestClusterConfig{
+				Cluster:     testCluster,
+				VtctlClient: workflowVtctlClient("MoveTables -source=commerce -all -exclude=product -cells=zone1 Create customer.commerce2customer", nil),
+			},
+			req: &vtadminpb.CreateWorkflowRequest{
+				Keyspace:       "customer",
+				Name:           "commerce2customer",
+				WorkflowType:   "movetables",
+				SourceKeyspace: "commerce",
+				AllTables:      true,
+				ExcludeTables:  []string{"product"},
+				Cells:          []string{"zone1"},
+			},
+			expected: &vtadminpb.CreateWorkflowResponse{},
+		},
+		{
+			name: "Reshard",
+			cfg: testutil.TestClusterConfig{
+				Cluster:     testCluster,
+				VtctlClient: workflowVtctlClient("Reshard -source_shards=0 -target_shards=-80,80- -skip_schema_copy -stop_after_copy Create customer.cust2cust", nil, "\nWorkflow started successfully with 2 stream(s)\n"),
+			},
+			req: &vtadminpb.CreateWorkflowRequest{
+				Keyspace:       "customer",
+				Name:           "cust2cust",
+				WorkflowType:   "Reshard",
+				SourceShards:   []string{"0"},
+				TargetShards:   []string{"-80", "80-"},
+				SkipSchemaCopy: true,
+				StopAfterCopy:  true,
+			},
+			expected: &vtadminpb.CreateWorkflowResponse{
+				Output: []string{"Workflow started successfully with 2 stream(s)"},
+			},
+		},
+		{
+			name: "nil request",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name: "no keyspace in request",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtadminpb.CreateWorkflowRequest{
+				Name:         "cust2cust",
+				WorkflowType: "Reshard",
+				SourceShards: []string{"0"},
+				TargetShards: []string{"-80", "80-"},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "unsupported workflow type",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtadminpb.CreateWorkflowRequest{
+				Keyspace:     "customer",
+				Name:         "customer_lookup",
+				WorkflowType: "Materialize",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "MoveTables without source keyspace",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtadminpb.CreateWorkflowRequest{
+				Keyspace:     "customer",
+				Name:         "commerce2customer",
+				WorkflowType: "MoveTables",
+				AllTables:    true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "MoveTables without tables",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtadminpb.CreateWorkflowRequest{
+				Keyspace:       "customer",
+				Name:           "commerce2customer",
+				WorkflowType:   "MoveTables",
+				SourceKeyspace: "commerce",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "Reshard without target shards",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtadminpb.CreateWorkflowRequest{
+				Keyspace:     "customer",
+				Name:         "cust2cust",
+				WorkflowType: "Reshard",
+				SourceShards: []string{"0"},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "command fails",
+			cfg: testutil.TestClusterConfig{
+				Cluster:     testCluster,
+				VtctlClient: workflowVtctlClient("Reshard -source_shards=0 -target_shards=-80,80- Create customer.cust2cust", assert.AnError, "keyspace customer not found\n"),
+			},
+			req: &vtadminpb.CreateWorkflowRequest{
+				Keyspace:     "customer",
+				Name:         "cust2cust",
+				WorkflowType: "Reshard",
+				SourceShards: []string{"0"},
+				TargetShards: []string{"-80", "80-"},
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-			_, err = tt.tc.Cluster.CreateShard(ctx, tt.req)
+			c := testutil.BuildCluster(t, tt.cfg)
+			require.NoError(t, c.Vtctld.Dial(ctx))
+
+			resp, err := c.CreateWorkflow(ctx, tt.req)
 			if tt.shouldErr {
 				assert.Error(t, err)
-			} else {
-				require.NoError(t, err)
+				return
 			}
 
-			if tt.assertion != nil {
-				func() {
-					t.Helper()
-					tt.assertion(t, tt)
-				}()
-			}
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
 		})
 	}
 }
@@ -2397,6 +3060,158 @@ func TestGetSchema(t *testing.T) {
 	})
 }
 
+func TestGetSchemaMigrations(t *testing.T) {
+	t.Parallel()
+
+	testCluster := &vtadminpb.Cluster{
+		Id:   "c1",
+		Name: "cluster1",
+	}
+
+	// This is the output of `OnlineDDL -json <keyspace> show all`, trimmed to
+	// a subset of the _vt.schema_migrations columns.
+	commerceMigrations := `{
+  "fields": [{"name": "Tablet"}, {"name": "keyspace"}, {"name": "shard"}, {"name": "migration_uuid"}, {"name": "mysql_table"}, {"name": "strategy"}, {"name": "migration_status"}, {"name": "progress"}, {"name": "eta_seconds"}, {"name": "requested_timestamp"}, {"name": "started_timestamp"}, {"name": "tablet"}, {"name": "postpone_completion"}],
+  "rows": [
+    ["zone1-0000000101", "commerce", "80-", "aa89f255_8d68_11eb_815f_f875a4d24e90", "customer", "vitess", "running", 42.5, 120, "2022-03-01 10:00:00", "2022-03-01 10:00:05", "zone1-0000000101", 1],
+    ["zone1-0000000100", "commerce", "-80", "aa89f255_8d68_11eb_815f_f875a4d24e90", "customer", "vitess", "queued", 0, -1, "2022-03-01 10:00:00", null, "", 1]
+  ]
+}`
+
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		keyspaces []string
+		filter    string
+		expected  []*vtadminpb.SchemaMigration
+		shouldErr bool
+	}{
+		{
+			name: "all keyspaces",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+				VtctldClient: &fakevtctldclient.VtctldClient{
+					GetKeyspacesResults: struct {
+						Keyspaces []*vtctldatapb.Keyspace
+						Error     error
+					}{
+						Keyspaces: []*vtctldatapb.Keyspace{{Name: "commerce"}, {Name: "customer"}},
+					},
+				},
+				VtctlClient: &fakevtctldclient.VtctlClient{
+					ExecuteVtctlCommandResults: map[string]struct {
+						Events []*logutilpb.Event
+						Error  error
+					}{
+						"OnlineDDL -json commerce show all": {
+							Events: []*logutilpb.Event{
+								{Level: logutilpb.Level_INFO, Value: "running vexec"},
+								{Level: logutilpb.Level_CONSOLE, Value: commerceMigrations},
+							},
+						},
+						"OnlineDDL -json customer show all": {
+							Events: []*logutilpb.Event{
+								{Level: logutilpb.Level_CONSOLE, Value: `{"fields": [{"name": "Tablet"}], "rows": null}`},
+							},
+						},
+					},
+				},
+			},
+			expected: []*vtadminpb.SchemaMigration{
+				{
+					Cluster:            testCluster,
+					Keyspace:           "commerce",
+					Shard:              "-80",
+					Tablet:             &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+					Uuid:               "aa89f255_8d68_11eb_815f_f875a4d24e90",
+					Table:              "customer",
+					Strategy:           "vitess",
+					Status:             "queued",
+					EtaSeconds:         -1,
+					PostponeCompletion: true,
+					RequestedAt:        protoutil.TimeToProto(time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)),
+				},
+				{
+					Cluster:            testCluster,
+					Keyspace:           "commerce",
+					Shard:              "80-",
+					Tablet:             &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+					Uuid:               "aa89f255_8d68_11eb_815f_f875a4d24e90",
+					Table:              "customer",
+					Strategy:           "vitess",
+					Status:             "running",
+					Progress:           42.5,
+					EtaSeconds:         120,
+					PostponeCompletion: true,
+					RequestedAt:        protoutil.TimeToProto(time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)),
+					StartedAt:          protoutil.TimeToProto(time.Date(2022, time.March, 1, 10, 0, 5, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name: "filtered keyspace",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+				VtctlClient: workflowVtctlClient("OnlineDDL -json commerce show complete", nil,
+					`{"fields": [{"name": "Tablet"}, {"name": "keyspace"}, {"name": "shard"}, {"name": "migration_uuid"}, {"name": "migration_status"}],
+					"rows": [["zone1-0000000100", "commerce", "0", "aa89f255_8d68_11eb_815f_f875a4d24e90", "complete"]]}`),
+			},
+			keyspaces: []string{"commerce"},
+			filter:    "complete",
+			expected: []*vtadminpb.SchemaMigration{
+				{
+					Cluster:    testCluster,
+					Keyspace:   "commerce",
+					Shard:      "0",
+					Tablet:     &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+					Uuid:       "aa89f255_8d68_11eb_815f_f875a4d24e90",
+					Status:     "complete",
+					EtaSeconds: -1,
+				},
+			},
+		},
+		{
+			name: "command fails",
+			cfg: testutil.TestClusterConfig{
+				Cluster:     testCluster,
+				VtctlClient: workflowVtctlClient("OnlineDDL -json commerce show all", assert.AnError),
+			},
+			keyspaces: []string{"commerce"},
+			shouldErr: true,
+		},
+		{
+			name: "unparseable output",
+			cfg: testutil.TestClusterConfig{
+				Cluster:     testCluster,
+				VtctlClient: workflowVtctlClient("OnlineDDL -json commerce show all", nil, "+----+\n| Tablet |\n"),
+			},
+			keyspaces: []string{"commerce"},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.BuildCluster(t, tt.cfg)
+
+			migrations, err := c.GetSchemaMigrations(ctx, tt.keyspaces, tt.filter)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, migrations)
+		})
+	}
+}
+
 func TestGetShardReplicationPositions(t *testing.T) {
 	t.Parallel()
 
@@ -3076,3 +3891,271 @@ func TestGetWorkflows(t *testing.T) {
 		})
 	}
 }
+
+func TestRetrySchemaMigration(t *testing.T) {
+	t.Parallel()
+
+	testCluster := &vtadminpb.Cluster{
+		Id:   "c1",
+		Name: "cluster1",
+	}
+
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		req       *vtadminpb.RetrySchemaMigrationRequest
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: applySchemaVtctldClient("commerce/alter vitess_migration 'aa89f255_8d68_11eb_815f_f875a4d24e90' retry", &vtctldatapb.ApplySchemaResponse{}, nil),
+			},
+			req: &vtadminpb.RetrySchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "aa89f255_8d68_11eb_815f_f875a4d24e90",
+			},
+		},
+		{
+			name: "invalid uuid",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtadminpb.RetrySchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "' retry; drop table t",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "vtctld.ApplySchema fails",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: applySchemaVtctldClient("commerce/alter vitess_migration 'aa89f255_8d68_11eb_815f_f875a4d24e90' retry", nil, assert.AnError),
+			},
+			req: &vtadminpb.RetrySchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "aa89f255_8d68_11eb_815f_f875a4d24e90",
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.BuildCluster(t, tt.cfg)
+			require.NoError(t, c.Vtctld.Dial(ctx))
+
+			_, err := c.RetrySchemaMigration(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRevertSchemaMigration(t *testing.T) {
+	t.Parallel()
+
+	testCluster := &vtadminpb.Cluster{
+		Id:   "c1",
+		Name: "cluster1",
+	}
+
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		req       *vtadminpb.RevertSchemaMigrationRequest
+		expected  *vtadminpb.RevertSchemaMigrationResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+				VtctldClient: applySchemaVtctldClient("commerce/revert vitess_migration 'aa89f255_8d68_11eb_815f_f875a4d24e90'", &vtctldatapb.ApplySchemaResponse{
+					UuidList: []string{"bb89f255_8d68_11eb_815f_f875a4d24e90"},
+				}, nil),
+			},
+			req: &vtadminpb.RevertSchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "aa89f255_8d68_11eb_815f_f875a4d24e90",
+			},
+			expected: &vtadminpb.RevertSchemaMigrationResponse{
+				Uuid: "bb89f255_8d68_11eb_815f_f875a4d24e90",
+			},
+		},
+		{
+			name: "no keyspace in request",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req: &vtadminpb.RevertSchemaMigrationRequest{
+				Uuid: "aa89f255_8d68_11eb_815f_f875a4d24e90",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no revert migration submitted",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: applySchemaVtctldClient("commerce/revert vitess_migration 'aa89f255_8d68_11eb_815f_f875a4d24e90'", &vtctldatapb.ApplySchemaResponse{}, nil),
+			},
+			req: &vtadminpb.RevertSchemaMigrationRequest{
+				Keyspace: "commerce",
+				Uuid:     "aa89f255_8d68_11eb_815f_f875a4d24e90",
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.BuildCluster(t, tt.cfg)
+			require.NoError(t, c.Vtctld.Dial(ctx))
+
+			resp, err := c.RevertSchemaMigration(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestSwitchWorkflowTraffic(t *testing.T) {
+	t.Parallel()
+
+	testCluster := &vtadminpb.Cluster{
+		Id:   "c1",
+		Name: "cluster1",
+	}
+
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		req       *vtadminpb.SwitchWorkflowTrafficRequest
+		expected  *vtadminpb.SwitchWorkflowTrafficResponse
+		shouldErr bool
+	}{
+		{
+			name: "dry run",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: workflowVtctldClient("customer", "commerce2customer", "commerce"),
+				VtctlClient: workflowVtctlClient("MoveTables -tablet_types=rdonly,replica -cells=zone1 -dry_run SwitchTraffic customer.commerce2customer", nil,
+					"Dry Run results for SwitchTraffic\n\n",
+					"Lock keyspace commerce\nSwitch reads for tables [customer] to keyspace customer for tablet types [REPLICA, RDONLY]\nUnlock keyspace commerce\n",
+				),
+			},
+			req: &vtadminpb.SwitchWorkflowTrafficRequest{
+				Keyspace:    "customer",
+				Name:        "commerce2customer",
+				TabletTypes: []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY},
+				Cells:       []string{"zone1"},
+				DryRun:      true,
+			},
+			expected: &vtadminpb.SwitchWorkflowTrafficResponse{
+				Output: []string{
+					"Dry Run results for SwitchTraffic",
+					"Lock keyspace commerce",
+					"Switch reads for tables [customer] to keyspace customer for tablet types [REPLICA, RDONLY]",
+					"Unlock keyspace commerce",
+				},
+			},
+		},
+		{
+			name: "reverse reshard",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: workflowVtctldClient("customer", "cust2cust", "customer"),
+				VtctlClient:  workflowVtctlClient("Reshard -timeout=1m0s -max_replication_lag_allowed=10s -reverse_replication=false ReverseTraffic customer.cust2cust", nil, "ReverseTraffic was successful for workflow customer.cust2cust\n\n"),
+			},
+			req: &vtadminpb.SwitchWorkflowTrafficRequest{
+				Keyspace:                 "customer",
+				Name:                     "cust2cust",
+				Reverse:                  true,
+				Timeout:                  protoutil.DurationToProto(time.Minute),
+				MaxReplicationLagAllowed: protoutil.DurationToProto(10 * time.Second),
+				SkipReverseReplication:   true,
+			},
+			expected: &vtadminpb.SwitchWorkflowTrafficResponse{
+				Output: []string{"ReverseTraffic was successful for workflow customer.cust2cust"},
+			},
+		},
+		{
+			name: "nil request",
+			cfg: testutil.TestClusterConfig{
+				Cluster: testCluster,
+			},
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name: "workflow not found",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: workflowVtctldClient("customer", "commerce2customer", "commerce"),
+			},
+			req: &vtadminpb.SwitchWorkflowTrafficRequest{
+				Keyspace: "customer",
+				Name:     "doesnotexist",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "command fails",
+			cfg: testutil.TestClusterConfig{
+				Cluster:      testCluster,
+				VtctldClient: workflowVtctldClient("customer", "commerce2customer", "commerce"),
+				VtctlClient:  workflowVtctlClient("MoveTables SwitchTraffic customer.commerce2customer", assert.AnError),
+			},
+			req: &vtadminpb.SwitchWorkflowTrafficRequest{
+				Keyspace: "customer",
+				Name:     "commerce2customer",
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testutil.BuildCluster(t, tt.cfg)
+			require.NoError(t, c.Vtctld.Dial(ctx))
+
+			resp, err := c.SwitchWorkflowTraffic(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
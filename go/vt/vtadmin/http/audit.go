@@ -0,0 +1,43 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+// GetAuditEntries implements the http wrapper for
+// /audit[?cluster=[&cluster=]][&actor=][&method=][&limit=].
+func GetAuditEntries(ctx context.Context, r Request, api *API) *JSONResponse {
+	query := r.URL.Query()
+
+	limit, err := r.ParseQueryParamAsUint32("limit", 0)
+	if err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	entries, err := api.server.GetAuditEntries(ctx, &vtadminpb.GetAuditEntriesRequest{
+		ClusterIds: query["cluster"],
+		Actor:      query.Get("actor"),
+		Method:     query.Get("method"),
+		Limit:      limit,
+	})
+
+	return NewJSONResponse(entries, err)
+}
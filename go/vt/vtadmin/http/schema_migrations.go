@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+// CancelSchemaMigration implements the http wrapper for the
+// VTAdminServer.CancelSchemaMigration method.
+//
+// Its route is PUT /schema_migration/{cluster_id}/{keyspace}/{uuid}/cancel.
+func CancelSchemaMigration(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	resp, err := api.server.CancelSchemaMigration(ctx, &vtadminpb.CancelSchemaMigrationRequest{
+		ClusterId: vars["cluster_id"],
+		Keyspace:  vars["keyspace"],
+		Uuid:      vars["uuid"],
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// CompleteSchemaMigration implements the http wrapper for the
+// VTAdminServer.CompleteSchemaMigration method.
+//
+// Its route is PUT /schema_migration/{cluster_id}/{keyspace}/{uuid}/complete.
+func CompleteSchemaMigration(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	resp, err := api.server.CompleteSchemaMigration(ctx, &vtadminpb.CompleteSchemaMigrationRequest{
+		ClusterId: vars["cluster_id"],
+		Keyspace:  vars["keyspace"],
+		Uuid:      vars["uuid"],
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// GetSchemaMigrations implements the http wrapper for the
+// VTAdminServer.GetSchemaMigrations method.
+//
+// Its route is GET /schema_migrations[?cluster=[&cluster=]][&keyspace=[&keyspace=]][&filter=].
+func GetSchemaMigrations(ctx context.Context, r Request, api *API) *JSONResponse {
+	query := r.URL.Query()
+
+	resp, err := api.server.GetSchemaMigrations(ctx, &vtadminpb.GetSchemaMigrationsRequest{
+		ClusterIds: query["cluster"],
+		Keyspaces:  query["keyspace"],
+		Filter:     query.Get("filter"),
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// RetrySchemaMigration implements the http wrapper for the
+// VTAdminServer.RetrySchemaMigration method.
+//
+// Its route is PUT /schema_migration/{cluster_id}/{keyspace}/{uuid}/retry.
+func RetrySchemaMigration(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	resp, err := api.server.RetrySchemaMigration(ctx, &vtadminpb.RetrySchemaMigrationRequest{
+		ClusterId: vars["cluster_id"],
+		Keyspace:  vars["keyspace"],
+		Uuid:      vars["uuid"],
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// RevertSchemaMigration implements the http wrapper for the
+// VTAdminServer.RevertSchemaMigration method.
+//
+// Its route is PUT /schema_migration/{cluster_id}/{keyspace}/{uuid}/revert.
+func RevertSchemaMigration(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	resp, err := api.server.RevertSchemaMigration(ctx, &vtadminpb.RevertSchemaMigrationRequest{
+		ClusterId: vars["cluster_id"],
+		Keyspace:  vars["keyspace"],
+		Uuid:      vars["uuid"],
+	})
+
+	return NewJSONResponse(resp, err)
+}
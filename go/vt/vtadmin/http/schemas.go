@@ -18,10 +18,41 @@ package http
 
 import (
 	"context"
+	"encoding/json"
+
+	"vitess.io/vitess/go/vt/vtadmin/errors"
 
 	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
+// ApplySchema implements the http wrapper for the
+// VTAdminServer.ApplySchema method.
+//
+// Its route is POST /schema/{cluster_id}/{keyspace}, with the options of a
+// vtctldatapb.ApplySchemaRequest (sql, ddl_strategy, etc) as the JSON body.
+func ApplySchema(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+	decoder := json.NewDecoder(r.Body)
+	defer r.Body.Close()
+
+	var req vtctldatapb.ApplySchemaRequest
+	if err := decoder.Decode(&req); err != nil {
+		return NewJSONResponse(nil, &errors.BadRequest{
+			Err: err,
+		})
+	}
+
+	req.Keyspace = vars["keyspace"]
+
+	resp, err := api.server.ApplySchema(ctx, &vtadminpb.ApplySchemaRequest{
+		ClusterId: vars["cluster_id"],
+		Options:   &req,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
 // FindSchema implements the http wrapper for the
 // /schema/{table}[?cluster=[&cluster=]] route.
 func FindSchema(ctx context.Context, r Request, api *API) *JSONResponse {
@@ -18,10 +18,104 @@ package http
 
 import (
 	"context"
+	"encoding/json"
 
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtadmin/errors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
 )
 
+// CancelWorkflow implements the http wrapper for the
+// VTAdminServer.CancelWorkflow method.
+//
+// Its route is DELETE /workflow/{cluster_id}/{keyspace}/{name}[?keep_data=&keep_routing_rules=].
+func CancelWorkflow(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	keepData, err := r.ParseQueryParamAsBool("keep_data", false)
+	if err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	keepRoutingRules, err := r.ParseQueryParamAsBool("keep_routing_rules", false)
+	if err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	resp, err := api.server.CancelWorkflow(ctx, &vtadminpb.CancelWorkflowRequest{
+		ClusterId:        vars["cluster_id"],
+		Keyspace:         vars["keyspace"],
+		Name:             vars["name"],
+		KeepData:         keepData,
+		KeepRoutingRules: keepRoutingRules,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// CompleteWorkflow implements the http wrapper for the
+// VTAdminServer.CompleteWorkflow method.
+//
+// Its route is PUT /workflow/{cluster_id}/{keyspace}/{name}/complete, with the
+// options of a vtadminpb.CompleteWorkflowRequest as the JSON body.
+func CompleteWorkflow(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+	decoder := json.NewDecoder(r.Body)
+	defer r.Body.Close()
+
+	var req vtadminpb.CompleteWorkflowRequest
+	if err := decoder.Decode(&req); err != nil {
+		return NewJSONResponse(nil, &errors.BadRequest{
+			Err: err,
+		})
+	}
+
+	req.ClusterId = vars["cluster_id"]
+	req.Keyspace = vars["keyspace"]
+	req.Name = vars["name"]
+
+	resp, err := api.server.CompleteWorkflow(ctx, &req)
+	return NewJSONResponse(resp, err)
+}
+
+// CreateWorkflow implements the http wrapper for the
+// VTAdminServer.CreateWorkflow method.
+//
+// Its route is POST /workflow/{cluster_id}/{keyspace}/{name}, with the options
+// of a vtadminpb.CreateWorkflowRequest as the JSON body, the tablet types being
+// given by name.
+func CreateWorkflow(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+	decoder := json.NewDecoder(r.Body)
+	defer r.Body.Close()
+
+	var body struct {
+		vtadminpb.CreateWorkflowRequest
+		TabletTypes []string `json:"tablet_types"`
+	}
+	if err := decoder.Decode(&body); err != nil {
+		return NewJSONResponse(nil, &errors.BadRequest{
+			Err: err,
+		})
+	}
+
+	tabletTypes, err := parseTabletTypes(body.TabletTypes)
+	if err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	req := &body.CreateWorkflowRequest
+	req.ClusterId = vars["cluster_id"]
+	req.Keyspace = vars["keyspace"]
+	req.Name = vars["name"]
+	req.TabletTypes = tabletTypes
+
+	resp, err := api.server.CreateWorkflow(ctx, req)
+	return NewJSONResponse(resp, err)
+}
+
 // GetWorkflow implements the http wrapper for the VTAdminServer.GetWorkflow
 // method.
 //
@@ -69,3 +163,62 @@ func GetWorkflows(ctx context.Context, r Request, api *API) *JSONResponse {
 
 	return NewJSONResponse(workflows, err)
 }
+
+// SwitchWorkflowTraffic implements the http wrapper for the
+// VTAdminServer.SwitchWorkflowTraffic method.
+//
+// Its route is PUT /workflow/{cluster_id}/{keyspace}/{name}/switch_traffic,
+// with the options of a vtadminpb.SwitchWorkflowTrafficRequest as the JSON
+// body, the tablet types being given by name. Setting "dry_run" in the body returns the actions that would be taken,
+// without taking them.
+func SwitchWorkflowTraffic(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+	decoder := json.NewDecoder(r.Body)
+	defer r.Body.Close()
+
+	var body struct {
+		vtadminpb.SwitchWorkflowTrafficRequest
+		TabletTypes []string `json:"tablet_types"`
+	}
+	if err := decoder.Decode(&body); err != nil {
+		return NewJSONResponse(nil, &errors.BadRequest{
+			Err: err,
+		})
+	}
+
+	tabletTypes, err := parseTabletTypes(body.TabletTypes)
+	if err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	req := &body.SwitchWorkflowTrafficRequest
+	req.ClusterId = vars["cluster_id"]
+	req.Keyspace = vars["keyspace"]
+	req.Name = vars["name"]
+	req.TabletTypes = tabletTypes
+
+	resp, err := api.server.SwitchWorkflowTraffic(ctx, req)
+	return NewJSONResponse(resp, err)
+}
+
+// parseTabletTypes parses the tablet types of a workflow request body, which
+// are given by name (e.g. "replica") rather than by their enum value.
+func parseTabletTypes(names []string) ([]topodatapb.TabletType, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	tabletTypes := make([]topodatapb.TabletType, len(names))
+	for i, name := range names {
+		tabletType, err := topoproto.ParseTabletType(name)
+		if err != nil {
+			return nil, &errors.BadRequest{
+				Err: err,
+			}
+		}
+
+		tabletTypes[i] = tabletType
+	}
+
+	return tabletTypes, nil
+}
@@ -92,6 +92,7 @@ const (
 
 	/* misc resources */
 
+	AuditLogResource                 Resource = "AuditLog"
 	BackupResource                   Resource = "Backup"
 	SchemaResource                   Resource = "Schema"
 	ShardReplicationPositionResource Resource = "ShardReplicationPosition"
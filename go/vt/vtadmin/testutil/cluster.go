@@ -39,6 +39,7 @@ import (
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
 	grpcvtctldtestutil "vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
 	"vitess.io/vitess/go/vt/vtctl/localvtctldclient"
+	"vitess.io/vitess/go/vt/vtctl/vtctlclient"
 	"vitess.io/vitess/go/vt/vtctl/vtctldclient"
 
 	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
@@ -63,6 +64,10 @@ type TestClusterConfig struct {
 	// the VtctldClient type provided by this package in order to mock out the
 	// vtctld layer.
 	VtctldClient vtctldclient.VtctldClient
+	// VtctlClient provides the legacy vtctlclient.VtctlClient implementation
+	// the cluster's vtctld proxy will use to run vtctl commands, such as the
+	// workflow commands. It is optional for tests that do not run any.
+	VtctlClient vtctlclient.VtctlClient
 	// Tablets provides the set of tablets reachable by this cluster's vtsql.DB.
 	// Tablets are copied, and then mutated to have their Cluster field set to
 	// match the Cluster provided by this TestClusterConfig, so mutations are
@@ -127,6 +132,13 @@ func BuildCluster(t testing.TB, cfg TestClusterConfig) *cluster.Cluster {
 	vtctld.DialFunc = func(addr string, ff grpcclient.FailFast, opts ...grpc.DialOption) (vtctldclient.VtctldClient, error) {
 		return cfg.VtctldClient, nil
 	}
+	vtctld.VtctlDialFunc = func(addr string, ff grpcclient.FailFast, opts ...grpc.DialOption) (vtctlclient.VtctlClient, error) {
+		if cfg.VtctlClient == nil {
+			return nil, fmt.Errorf("no VtctlClient set on test cluster %s", cfg.Cluster.Id)
+		}
+
+		return cfg.VtctlClient, nil
+	}
 
 	return c
 }
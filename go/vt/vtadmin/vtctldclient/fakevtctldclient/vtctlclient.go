@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakevtctldclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vtctl/vtctlclient"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+)
+
+// VtctlClient provides a partial mock implementation of the legacy
+// vtctlclient.VtctlClient interface for use in testing.
+type VtctlClient struct {
+	// ExecuteVtctlCommandResults is keyed by the space-separated args of a
+	// command. Each result streams its Events, and then fails with Error, if
+	// set.
+	ExecuteVtctlCommandResults map[string]struct {
+		Events []*logutilpb.Event
+		Error  error
+	}
+}
+
+// Compile-time type assertion to make sure we haven't overriden a method
+// incorrectly.
+var _ vtctlclient.VtctlClient = (*VtctlClient)(nil)
+
+// ExecuteVtctlCommand is part of the vtctlclient.VtctlClient interface.
+func (fake *VtctlClient) ExecuteVtctlCommand(ctx context.Context, args []string, actionTimeout time.Duration) (logutil.EventStream, error) {
+	if fake.ExecuteVtctlCommandResults == nil {
+		return nil, fmt.Errorf("%w: ExecuteVtctlCommandResults not set on fake vtctlclient", assert.AnError)
+	}
+
+	command := strings.Join(args, " ")
+	if result, ok := fake.ExecuteVtctlCommandResults[command]; ok {
+		return &eventStream{events: result.Events, err: result.Error}, nil
+	}
+
+	return nil, fmt.Errorf("%w: no result set for command %s", assert.AnError, command)
+}
+
+// Close is part of the vtctlclient.VtctlClient interface.
+func (fake *VtctlClient) Close() {}
+
+type eventStream struct {
+	events []*logutilpb.Event
+	err    error
+}
+
+func (stream *eventStream) Recv() (*logutilpb.Event, error) {
+	if len(stream.events) == 0 {
+		if stream.err != nil {
+			return nil, stream.err
+		}
+
+		return nil, io.EOF
+	}
+
+	event := stream.events[0]
+	stream.events = stream.events[1:]
+
+	return event, nil
+}
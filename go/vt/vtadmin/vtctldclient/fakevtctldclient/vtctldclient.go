@@ -19,6 +19,7 @@ package fakevtctldclient
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
@@ -35,6 +36,12 @@ import (
 type VtctldClient struct {
 	vtctldclient.VtctldClient
 
+	// ApplySchemaResults is keyed by the keyspace and the semicolon-separated
+	// statements of a request, e.g. "ks/alter table t engine=innodb".
+	ApplySchemaResults map[string]struct {
+		Response *vtctldatapb.ApplySchemaResponse
+		Error    error
+	}
 	CreateKeyspaceShouldErr        bool
 	DeleteKeyspaceShouldErr        bool
 	FindAllShardsInKeyspaceResults map[string]struct {
@@ -71,6 +78,20 @@ type VtctldClient struct {
 // incorrectly.
 var _ vtctldclient.VtctldClient = (*VtctldClient)(nil)
 
+// ApplySchema is part of the vtctldclient.VtctldClient interface.
+func (fake *VtctldClient) ApplySchema(ctx context.Context, req *vtctldatapb.ApplySchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplySchemaResponse, error) {
+	if fake.ApplySchemaResults == nil {
+		return nil, fmt.Errorf("%w: ApplySchemaResults not set on fake vtctldclient", assert.AnError)
+	}
+
+	key := fmt.Sprintf("%s/%s", req.Keyspace, strings.Join(req.Sql, ";"))
+	if result, ok := fake.ApplySchemaResults[key]; ok {
+		return result.Response, result.Error
+	}
+
+	return nil, fmt.Errorf("%w: no result set for %s", assert.AnError, key)
+}
+
 // CreateKeyspace is part of the vtctldclient.VtctldClient interface.
 func (fake *VtctldClient) CreateKeyspace(ctx context.Context, req *vtctldatapb.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.CreateKeyspaceResponse, error) {
 	if fake.CreateKeyspaceShouldErr {
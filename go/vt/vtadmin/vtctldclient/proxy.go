@@ -26,10 +26,13 @@ import (
 
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/vtadmin/cluster/discovery"
 	"vitess.io/vitess/go/vt/vtadmin/debug"
 	"vitess.io/vitess/go/vt/vtadmin/vtadminproto"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctlclient"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldclient"
+	"vitess.io/vitess/go/vt/vtctl/vtctlclient"
 	"vitess.io/vitess/go/vt/vtctl/vtctldclient"
 
 	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
@@ -49,6 +52,12 @@ type Proxy interface {
 	// connection, but that connection may not be to the same particular vtctld.
 	Close() error
 
+	// ExecuteVtctlCommand runs a command on the legacy Vtctl service of the
+	// vtctld the Proxy is connected to, returning the stream of log events the
+	// command produces. It is used for operations that have no VtctldServer
+	// RPC yet, such as the MoveTables and Reshard workflow commands.
+	ExecuteVtctlCommand(ctx context.Context, args []string, actionTimeout time.Duration) (logutil.EventStream, error)
+
 	vtctlservicepb.VtctldClient
 }
 
@@ -66,10 +75,16 @@ type ClientProxy struct {
 	// this should always be grpcvtctldclient.NewWithDialOpts, but it is
 	// exported for testing purposes.
 	DialFunc func(addr string, ff grpcclient.FailFast, opts ...grpc.DialOption) (vtctldclient.VtctldClient, error)
+	// VtctlDialFunc is called to open a new connection to the legacy Vtctl
+	// service of the same vtctld. In production, this should always be
+	// grpcvtctlclient.NewWithDialOpts, but it is exported for testing purposes.
+	VtctlDialFunc func(addr string, ff grpcclient.FailFast, opts ...grpc.DialOption) (vtctlclient.VtctlClient, error)
 
 	m        sync.Mutex
 	closed   bool
 	host     string
+	dialOpts []grpc.DialOption
+	vtctl    vtctlclient.VtctlClient
 	lastPing time.Time
 	dialedAt time.Time
 }
@@ -83,12 +98,13 @@ type ClientProxy struct {
 // use.
 func New(cfg *Config) *ClientProxy {
 	return &ClientProxy{
-		cfg:       cfg,
-		cluster:   cfg.Cluster,
-		creds:     cfg.Credentials,
-		discovery: cfg.Discovery,
-		DialFunc:  grpcvtctldclient.NewWithDialOpts,
-		closed:    true,
+		cfg:           cfg,
+		cluster:       cfg.Cluster,
+		creds:         cfg.Credentials,
+		discovery:     cfg.Discovery,
+		DialFunc:      grpcvtctldclient.NewWithDialOpts,
+		VtctlDialFunc: grpcvtctlclient.NewWithDialOpts,
+		closed:        true,
 	}
 }
 
@@ -115,7 +131,7 @@ func (vtctld *ClientProxy) Dial(ctx context.Context) error {
 		span.Annotate("is_stale", true)
 
 		// close before reopen. this is safe to call on an already-closed client.
-		if err := vtctld.Close(); err != nil {
+		if err := vtctld.closeLocked(); err != nil {
 			return fmt.Errorf("error closing possibly-stale connection before re-dialing: %w", err)
 		}
 	}
@@ -146,6 +162,7 @@ func (vtctld *ClientProxy) Dial(ctx context.Context) error {
 
 	vtctld.dialedAt = time.Now()
 	vtctld.host = addr
+	vtctld.dialOpts = opts
 	vtctld.VtctldClient = client
 	vtctld.closed = false
 
@@ -154,6 +171,19 @@ func (vtctld *ClientProxy) Dial(ctx context.Context) error {
 
 // Close is part of the Proxy interface.
 func (vtctld *ClientProxy) Close() error {
+	vtctld.m.Lock()
+	defer vtctld.m.Unlock()
+
+	return vtctld.closeLocked()
+}
+
+// closeLocked closes the connections of the proxy. Callers must hold vtctld.m.
+func (vtctld *ClientProxy) closeLocked() error {
+	if vtctld.vtctl != nil {
+		vtctld.vtctl.Close()
+		vtctld.vtctl = nil
+	}
+
 	if vtctld.VtctldClient == nil {
 		vtctld.closed = true
 
@@ -170,6 +200,38 @@ func (vtctld *ClientProxy) Close() error {
 	return nil
 }
 
+// ExecuteVtctlCommand is part of the Proxy interface.
+func (vtctld *ClientProxy) ExecuteVtctlCommand(ctx context.Context, args []string, actionTimeout time.Duration) (logutil.EventStream, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldClientProxy.ExecuteVtctlCommand")
+	defer span.Finish()
+
+	vtadminproto.AnnotateClusterSpan(vtctld.cluster, span)
+
+	vtctld.m.Lock()
+
+	if vtctld.closed {
+		vtctld.m.Unlock()
+		return nil, fmt.Errorf("cannot execute vtctl command %v: proxy is not connected to a vtctld", args)
+	}
+
+	span.Annotate("vtctld_host", vtctld.host)
+
+	if vtctld.vtctl == nil {
+		client, err := vtctld.VtctlDialFunc(vtctld.host, grpcclient.FailFast(false), vtctld.dialOpts...)
+		if err != nil {
+			vtctld.m.Unlock()
+			return nil, fmt.Errorf("error dialing vtctl service on %s: %w", vtctld.host, err)
+		}
+
+		vtctld.vtctl = client
+	}
+
+	client := vtctld.vtctl
+	vtctld.m.Unlock()
+
+	return client.ExecuteVtctlCommand(ctx, args, actionTimeout)
+}
+
 // Debug implements debug.Debuggable for ClientProxy.
 func (vtctld *ClientProxy) Debug() map[string]interface{} {
 	vtctld.m.Lock()
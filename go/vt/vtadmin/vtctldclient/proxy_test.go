@@ -18,14 +18,20 @@ package vtctldclient
 
 import (
 	"context"
+	"io"
 	"net"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
 	"vitess.io/vitess/go/vt/vtadmin/cluster/discovery/fakediscovery"
 )
@@ -34,6 +40,16 @@ type fakeVtctld struct {
 	vtctlservicepb.VtctlServer
 }
 
+// ExecuteVtctlCommand echoes the args of the command back as a single event.
+func (fake *fakeVtctld) ExecuteVtctlCommand(req *vtctldatapb.ExecuteVtctlCommandRequest, stream vtctlservicepb.Vtctl_ExecuteVtctlCommandServer) error {
+	return stream.Send(&vtctldatapb.ExecuteVtctlCommandResponse{
+		Event: &logutilpb.Event{
+			Level: logutilpb.Level_CONSOLE,
+			Value: strings.Join(req.Args, " "),
+		},
+	})
+}
+
 func TestDial(t *testing.T) {
 	listener, err := net.Listen("tcp", "127.0.0.1:0")
 	require.NoError(t, err)
@@ -67,3 +83,99 @@ func TestDial(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, listener.Addr().String(), proxy.host)
 }
+
+func TestExecuteVtctlCommand(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer listener.Close()
+
+	vtctld := &fakeVtctld{}
+	server := grpc.NewServer()
+	vtctlservicepb.RegisterVtctlServer(server, vtctld)
+
+	go server.Serve(listener)
+	defer server.Stop()
+
+	disco := fakediscovery.New()
+	disco.AddTaggedVtctlds(nil, &vtadminpb.Vtctld{
+		Hostname: listener.Addr().String(),
+	})
+
+	proxy := New(&Config{
+		Cluster: &vtadminpb.Cluster{
+			Id:   "test",
+			Name: "testcluster",
+		},
+		Discovery: disco,
+	})
+
+	ctx := context.Background()
+	args := []string{"MoveTables", "Cancel", "customer.commerce2customer"}
+
+	// We can't run commands until we call Dial
+	_, err = proxy.ExecuteVtctlCommand(ctx, args, time.Minute)
+	require.Error(t, err)
+
+	err = proxy.Dial(ctx)
+	require.NoError(t, err)
+
+	defer proxy.Close()
+
+	stream, err := proxy.ExecuteVtctlCommand(ctx, args, time.Minute)
+	require.NoError(t, err)
+
+	event, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "MoveTables Cancel customer.commerce2customer", event.Value)
+
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestCloseWhileExecutingVtctlCommand(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer listener.Close()
+
+	vtctld := &fakeVtctld{}
+	server := grpc.NewServer()
+	vtctlservicepb.RegisterVtctlServer(server, vtctld)
+
+	go server.Serve(listener)
+	defer server.Stop()
+
+	disco := fakediscovery.New()
+	disco.AddTaggedVtctlds(nil, &vtadminpb.Vtctld{
+		Hostname: listener.Addr().String(),
+	})
+
+	proxy := New(&Config{
+		Cluster: &vtadminpb.Cluster{
+			Id:   "test",
+			Name: "testcluster",
+		},
+		Discovery: disco,
+	})
+
+	ctx := context.Background()
+	err = proxy.Dial(ctx)
+	require.NoError(t, err)
+
+	// Run with -race: the vtctl connection is shared by the commands and Close.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = proxy.ExecuteVtctlCommand(ctx, []string{"GetKeyspaces"}, time.Minute)
+		}()
+	}
+
+	assert.NoError(t, proxy.Close())
+	wg.Wait()
+
+	_, err = proxy.ExecuteVtctlCommand(ctx, []string{"GetKeyspaces"}, time.Minute)
+	assert.Error(t, err)
+}
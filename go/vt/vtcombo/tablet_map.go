@@ -713,6 +713,19 @@ func (itc *internalTabletConn) VStreamResults(
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
+// VStreamPositionAt is part of the QueryService interface.
+func (itc *internalTabletConn) VStreamPositionAt(
+	ctx context.Context,
+	target *querypb.Target,
+	timestamp int64,
+) (string, error) {
+	pos, err := itc.tablet.qsc.QueryService().VStreamPositionAt(ctx, target, timestamp)
+	if err != nil {
+		return "", tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+	}
+	return pos, nil
+}
+
 //
 // TabletManagerClient implementation
 //
@@ -845,6 +858,14 @@ func (itmc *internalTabletManagerClient) ExecuteFetchAsApp(context.Context, *top
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) ListQueries(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.ListQueriesRequest) ([]*tabletmanagerdatapb.LiveQuery, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) KillQueries(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.KillQueriesRequest) (int64, error) {
+	return 0, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) MasterStatus(context.Context, *topodatapb.Tablet) (*replicationdatapb.PrimaryStatus, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
@@ -925,11 +946,11 @@ func (itmc *internalTabletManagerClient) PromoteReplica(context.Context, *topoda
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) Backup(context.Context, *topodatapb.Tablet, int, bool) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) Backup(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.BackupRequest) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) RestoreFromBackup(context.Context, *topodatapb.Tablet, time.Time) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) RestoreFromBackup(context.Context, *topodatapb.Tablet, *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
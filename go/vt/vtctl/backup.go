@@ -22,6 +22,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"vitess.io/vitess/go/vt/logutil"
@@ -31,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -45,33 +47,34 @@ func init() {
 	addCommand("Shards", command{
 		name:   "BackupShard",
 		method: commandBackupShard,
-		params: "[-allow_primary=false] <keyspace/shard>",
+		params: "[-allow_primary=false] [-incremental] <keyspace/shard>",
 		help:   "Chooses a tablet and creates a backup for a shard.",
 	})
 	addCommand("Shards", command{
 		name:   "RemoveBackup",
 		method: commandRemoveBackup,
 		params: "<keyspace/shard> <backup name>",
-		help:   "Removes a backup for the BackupStorage.",
+		help:   "Removes a backup for the BackupStorage. A backup that incremental backups are based on can't be removed.",
 	})
 
 	addCommand("Tablets", command{
 		name:   "Backup",
 		method: commandBackup,
-		params: "[-concurrency=4] [-allow_primary=false] <tablet alias>",
-		help:   "Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes.",
+		params: "[-concurrency=4] [-allow_primary=false] [-incremental] <tablet alias>",
+		help:   "Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes. With -incremental, mysqld is not stopped, and only the binary logs written since the latest backup are stored.",
 	})
 	addCommand("Tablets", command{
 		name:   "RestoreFromBackup",
 		method: commandRestoreFromBackup,
-		params: "[-backup_timestamp=yyyy-MM-dd.HHmmss] <tablet alias>",
-		help:   "Stops mysqld and restores the data from the latest backup or if a timestamp is specified then the most recent backup at or before that time.",
+		params: "[-backup_timestamp=yyyy-MM-dd.HHmmss] [-restore_to_timestamp=yyyy-MM-dd.HHmmss | -restore_to_pos=<position>] <tablet alias>",
+		help:   "Stops mysqld and restores the data from the latest backup or if a timestamp is specified then the most recent backup at or before that time. If a restore_to_timestamp or restore_to_pos is specified, archived binary logs are then applied up to that point in time, and the tablet is left DRAINED.",
 	})
 }
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowPrimary := subFlags.Bool("allow_primary", false, "Allows backups to be taken on primary. Warning!! If you are using the builtin backup engine, this will shutdown your primary mysql for as long as it takes to create a backup.")
+	incremental := subFlags.Bool("incremental", false, "Only backs up the binary logs written since the latest backup, which this backup is then based on. Only supported by the builtin backup engine.")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -89,12 +92,17 @@ func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fl
 		return err
 	}
 
-	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowPrimary)
+	return execBackup(ctx, wr, tabletInfo.Tablet, &tabletmanagerdatapb.BackupRequest{
+		Concurrency:  int64(*concurrency),
+		AllowPrimary: *allowPrimary,
+		Incremental:  *incremental,
+	})
 }
 
 func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowPrimary := subFlags.Bool("allow_primary", false, "Whether to use primary tablet for backup. Warning!! If you are using the builtin backup engine, this will shutdown your primary mysql for as long as it takes to create a backup.")
+	incremental := subFlags.Bool("incremental", false, "Only backs up the binary logs written since the latest backup, which this backup is then based on. Only supported by the builtin backup engine.")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -156,12 +164,16 @@ func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		return errors.New("no tablet available for backup")
 	}
 
-	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowPrimary)
+	return execBackup(ctx, wr, tabletForBackup, &tabletmanagerdatapb.BackupRequest{
+		Concurrency:  int64(*concurrency),
+		AllowPrimary: *allowPrimary,
+		Incremental:  *incremental,
+	})
 }
 
 // execBackup is shared by Backup and BackupShard
-func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowPrimary bool) error {
-	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowPrimary)
+func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.BackupRequest) error {
+	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, req)
 	if err != nil {
 		return err
 	}
@@ -227,11 +239,21 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		return err
 	}
 	defer bs.Close()
+
+	bhs, err := bs.ListBackups(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if dependents := mysqlctl.GetBackupDependents(ctx, bhs)[name]; len(dependents) > 0 {
+		return fmt.Errorf("cannot remove backup %v, incremental backups are based on it: %v", name, strings.Join(dependents, ", "))
+	}
 	return bs.RemoveBackup(ctx, bucket, name)
 }
 
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	backupTimestampStr := subFlags.String("backup_timestamp", "", "Use the backup taken at or before this timestamp rather than using the latest backup.")
+	restoreToTimestampStr := subFlags.String("restore_to_timestamp", "", "Run a point-in-time recovery that ends at this timestamp, by applying archived binary logs on top of the latest backup taken before it.")
+	restoreToPos := subFlags.String("restore_to_pos", "", "Run a point-in-time recovery that ends at this replication position, by applying archived binary logs on top of the latest backup taken before it.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -251,6 +273,21 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 		}
 	}
 
+	req := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		BackupTime:   logutil.TimeToProto(backupTime),
+		RestoreToPos: *restoreToPos,
+	}
+	if *restoreToTimestampStr != "" {
+		if *restoreToPos != "" {
+			return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "-restore_to_timestamp and -restore_to_pos are mutually exclusive")
+		}
+		restoreToTimestamp, err := time.Parse(mysqlctl.BackupTimestampFormat, *restoreToTimestampStr)
+		if err != nil {
+			return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, fmt.Sprintf("unable to parse the restore_to_timestamp value provided of '%s'", *restoreToTimestampStr))
+		}
+		req.RestoreToTimestamp = logutil.TimeToProto(restoreToTimestamp)
+	}
+
 	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
 	if err != nil {
 		return err
@@ -259,7 +296,7 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, backupTime)
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, req)
 	if err != nil {
 		return err
 	}
@@ -57,6 +57,20 @@ func gRPCVtctlClientFactory(addr string) (vtctlclient.VtctlClient, error) {
 	}, nil
 }
 
+// NewWithDialOpts returns a vtctlclient.VtctlClient configured with the given
+// DialOptions. It is exported for use in vtadmin.
+func NewWithDialOpts(addr string, failFast grpcclient.FailFast, opts ...grpc.DialOption) (vtctlclient.VtctlClient, error) {
+	cc, err := grpcclient.Dial(addr, failFast, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gRPCVtctlClient{
+		cc: cc,
+		c:  vtctlservicepb.NewVtctlClient(cc),
+	}, nil
+}
+
 type eventStreamAdapter struct {
 	stream vtctlservicepb.Vtctl_ExecuteVtctlCommandClient
 }
@@ -26,6 +26,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/mysql"
@@ -6956,6 +6957,154 @@ func TestReparentTablet(t *testing.T) {
 	}
 }
 
+type restoreFromBackupStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*vtctldatapb.RestoreFromBackupResponse
+}
+
+func (stream *restoreFromBackupStream) Context() context.Context { return stream.ctx }
+
+func (stream *restoreFromBackupStream) Send(resp *vtctldatapb.RestoreFromBackupResponse) error {
+	stream.responses = append(stream.responses, resp)
+	return nil
+}
+
+func TestRestoreFromBackup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tablets := []*topodatapb.Tablet{
+		{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Keyspace: "ks",
+			Shard:    "-",
+		},
+	}
+	events := []*logutilpb.Event{
+		{Value: "restore started"},
+		{Value: "applying binlog vt-bin.000001"},
+	}
+
+	tests := []struct {
+		name      string
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.RestoreFromBackupRequest
+		expected  []*vtctldatapb.RestoreFromBackupResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			tmc: testutil.TabletManagerClient{
+				RestoreFromBackupResults: map[string]struct {
+					Events []*logutilpb.Event
+					Error  error
+				}{
+					"zone1-0000000100": {
+						Events: events,
+					},
+				},
+			},
+			req: &vtctldatapb.RestoreFromBackupRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+				RestoreToPos: "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5",
+			},
+			expected: []*vtctldatapb.RestoreFromBackupResponse{
+				{
+					TabletAlias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Keyspace: "ks",
+					Shard:    "-",
+					Event:    events[0],
+				},
+				{
+					TabletAlias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Keyspace: "ks",
+					Shard:    "-",
+					Event:    events[1],
+				},
+			},
+		},
+		{
+			name: "restore fails",
+			tmc: testutil.TabletManagerClient{
+				RestoreFromBackupResults: map[string]struct {
+					Events []*logutilpb.Event
+					Error  error
+				}{
+					"zone1-0000000100": {
+						Events: events[:1],
+						Error:  assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.RestoreFromBackupRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no tablet",
+			req: &vtctldatapb.RestoreFromBackupRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  404,
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "both restore_to_pos and restore_to_timestamp",
+			req: &vtctldatapb.RestoreFromBackupRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+				RestoreToPos:       "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5",
+				RestoreToTimestamp: protoutil.TimeToProto(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, nil, tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			stream := &restoreFromBackupStream{ctx: ctx}
+			err := vtctld.RestoreFromBackup(tt.req, stream)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, stream.responses)
+		})
+	}
+}
+
 func TestRunHealthCheck(t *testing.T) {
 	t.Parallel()
 
@@ -7059,6 +7208,118 @@ func TestRunHealthCheck(t *testing.T) {
 	}
 }
 
+func TestSetKeyspaceDurabilityPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		keyspaces []*vtctldatapb.Keyspace
+		req       *vtctldatapb.SetKeyspaceDurabilityPolicyRequest
+		expected  *vtctldatapb.SetKeyspaceDurabilityPolicyResponse
+		shouldErr bool
+	}{
+		{
+			name: "registered policy",
+			keyspaces: []*vtctldatapb.Keyspace{
+				{
+					Name:     "ks1",
+					Keyspace: &topodatapb.Keyspace{},
+				},
+			},
+			req: &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+				Keyspace:         "ks1",
+				DurabilityPolicy: "semi_sync",
+			},
+			expected: &vtctldatapb.SetKeyspaceDurabilityPolicyResponse{
+				Keyspace: &topodatapb.Keyspace{
+					DurabilityPolicy: "semi_sync",
+				},
+			},
+		},
+		{
+			name: "declarative policy",
+			keyspaces: []*vtctldatapb.Keyspace{
+				{
+					Name:     "ks1",
+					Keyspace: &topodatapb.Keyspace{},
+				},
+			},
+			req: &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+				Keyspace:         "ks1",
+				DurabilityPolicy: "ackers=2;never_promote_cells=zone3",
+			},
+			expected: &vtctldatapb.SetKeyspaceDurabilityPolicyResponse{
+				Keyspace: &topodatapb.Keyspace{
+					DurabilityPolicy: "ackers=2;never_promote_cells=zone3",
+				},
+			},
+		},
+		{
+			name: "reset to default policy",
+			keyspaces: []*vtctldatapb.Keyspace{
+				{
+					Name: "ks1",
+					Keyspace: &topodatapb.Keyspace{
+						DurabilityPolicy: "semi_sync",
+					},
+				},
+			},
+			req: &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+				Keyspace: "ks1",
+			},
+			expected: &vtctldatapb.SetKeyspaceDurabilityPolicyResponse{
+				Keyspace: &topodatapb.Keyspace{},
+			},
+		},
+		{
+			name: "invalid policy",
+			keyspaces: []*vtctldatapb.Keyspace{
+				{
+					Name:     "ks1",
+					Keyspace: &topodatapb.Keyspace{},
+				},
+			},
+			req: &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+				Keyspace:         "ks1",
+				DurabilityPolicy: "ackers=two",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "keyspace not found",
+			req: &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+				Keyspace:         "ks1",
+				DurabilityPolicy: "semi_sync",
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddKeyspaces(ctx, t, ts, tt.keyspaces...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.SetKeyspaceDurabilityPolicy(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestSetKeyspaceServedFrom(t *testing.T) {
 	t.Parallel()
 
@@ -19,6 +19,7 @@ package testutil
 import (
 	"context"
 	"fmt"
+	"io"
 	"path"
 	"sync"
 	"testing"
@@ -27,12 +28,14 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	hk "vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
@@ -203,6 +206,12 @@ type TabletManagerClient struct {
 		Position *replicationdatapb.Status
 		Error    error
 	}
+	// keyed by tablet alias.
+	RestoreFromBackupResults map[string]struct {
+		Events []*logutilpb.Event
+		// Error, if set, is returned by the stream after all Events.
+		Error error
+	}
 	// keyed by tablet alias
 	RunHealthCheckDelays map[string]time.Duration
 	// keyed by tablet alias
@@ -570,6 +579,54 @@ func (fake *TabletManagerClient) ReloadSchema(ctx context.Context, tablet *topod
 	return fmt.Errorf("%w: no ReloadSchema result set for tablet %s", assert.AnError, key)
 }
 
+type eventStream struct {
+	ctx    context.Context
+	events chan *logutilpb.Event
+	err    error
+}
+
+// Recv is part of the logutil.EventStream interface.
+func (stream *eventStream) Recv() (*logutilpb.Event, error) {
+	select {
+	case <-stream.ctx.Done():
+		return nil, stream.ctx.Err()
+	case event, ok := <-stream.events:
+		if !ok {
+			return nil, stream.err
+		}
+
+		return event, nil
+	}
+}
+
+// RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error) {
+	if fake.RestoreFromBackupResults == nil {
+		return nil, fmt.Errorf("%w: no RestoreFromBackup results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	result, ok := fake.RestoreFromBackupResults[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: no RestoreFromBackup result set for tablet %s", assert.AnError, key)
+	}
+
+	stream := &eventStream{
+		ctx:    ctx,
+		events: make(chan *logutilpb.Event, len(result.Events)),
+		err:    io.EOF,
+	}
+	for _, event := range result.Events {
+		stream.events <- event
+	}
+	if result.Error != nil {
+		stream.err = result.Error
+	}
+	close(stream.events)
+
+	return stream, nil
+}
+
 // ReplicationStatus is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) ReplicationStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
 	if fake.ReplicationStatusResults == nil {
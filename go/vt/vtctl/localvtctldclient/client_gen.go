@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc"
 
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
 )
 
 // AddCellInfo is part of the vtctlservicepb.VtctldClient interface.
@@ -261,11 +262,62 @@ func (client *localVtctldClient) ReparentTablet(ctx context.Context, in *vtctlda
 	return client.s.ReparentTablet(ctx, in)
 }
 
+type restoreFromBackupStreamAdapter struct {
+	*bidiStream
+	ch chan *vtctldatapb.RestoreFromBackupResponse
+}
+
+func (stream *restoreFromBackupStreamAdapter) Recv() (*vtctldatapb.RestoreFromBackupResponse, error) {
+	select {
+	case <-stream.ctx.Done():
+		return nil, stream.ctx.Err()
+	case err := <-stream.errch:
+		return nil, err
+	case msg := <-stream.ch:
+		return msg, nil
+	}
+}
+
+func (stream *restoreFromBackupStreamAdapter) Send(msg *vtctldatapb.RestoreFromBackupResponse) error {
+	stream.m.RLock()
+	defer stream.m.RUnlock()
+
+	if stream.sendClosed {
+		return errStreamClosed
+	}
+
+	select {
+	case <-stream.ctx.Done():
+		return stream.ctx.Err()
+	case stream.ch <- msg:
+		return nil
+	}
+}
+
+// RestoreFromBackup is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) RestoreFromBackup(ctx context.Context, in *vtctldatapb.RestoreFromBackupRequest, opts ...grpc.CallOption) (vtctlservicepb.Vtctld_RestoreFromBackupClient, error) {
+	stream := &restoreFromBackupStreamAdapter{
+		bidiStream: newBidiStream(ctx),
+		ch:         make(chan *vtctldatapb.RestoreFromBackupResponse, 1),
+	}
+	go func() {
+		err := client.s.RestoreFromBackup(in, stream)
+		stream.close(err)
+	}()
+
+	return stream, nil
+}
+
 // RunHealthCheck is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) RunHealthCheck(ctx context.Context, in *vtctldatapb.RunHealthCheckRequest, opts ...grpc.CallOption) (*vtctldatapb.RunHealthCheckResponse, error) {
 	return client.s.RunHealthCheck(ctx, in)
 }
 
+// SetKeyspaceDurabilityPolicy is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) SetKeyspaceDurabilityPolicy(ctx context.Context, in *vtctldatapb.SetKeyspaceDurabilityPolicyRequest, opts ...grpc.CallOption) (*vtctldatapb.SetKeyspaceDurabilityPolicyResponse, error) {
+	return client.s.SetKeyspaceDurabilityPolicy(ctx, in)
+}
+
 // SetKeyspaceServedFrom is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) SetKeyspaceServedFrom(ctx context.Context, in *vtctldatapb.SetKeyspaceServedFromRequest, opts ...grpc.CallOption) (*vtctldatapb.SetKeyspaceServedFromResponse, error) {
 	return client.s.SetKeyspaceServedFrom(ctx, in)
@@ -17,11 +17,18 @@ limitations under the License.
 package reparentutil
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
 
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
@@ -32,35 +39,35 @@ import (
 // A newDurabler is a function that creates a new durabler based on the
 // properties specified in the input map. Every durabler must
 // register a newDurabler function.
-type newDurabler func() durabler
+type newDurabler func() Durabler
 
 var (
 	// durabilityPolicies is a map that stores the functions needed to create a new durabler
 	durabilityPolicies = make(map[string]newDurabler)
-	// curDurabilityPolicy is the current durability policy in use
-	curDurabilityPolicy durabler
-	// curDurabilityPolicyMutex is the mutex protecting the curDurabilityPolicy variable
-	curDurabilityPolicyMutex sync.Mutex
+	// defaultDurabilityPolicy is the durability policy used for the keyspaces that don't have one
+	defaultDurabilityPolicy Durabler = &durabilityNone{}
+	// defaultDurabilityPolicyMutex is the mutex protecting the defaultDurabilityPolicy variable
+	defaultDurabilityPolicyMutex sync.Mutex
 )
 
 func init() {
 	// register all the durability rules with their functions to create them
-	registerDurability("none", func() durabler {
+	registerDurability("none", func() Durabler {
 		return &durabilityNone{}
 	})
-	registerDurability("semi_sync", func() durabler {
+	registerDurability("semi_sync", func() Durabler {
 		return &durabilitySemiSync{}
 	})
-	registerDurability("cross_cell", func() durabler {
+	registerDurability("cross_cell", func() Durabler {
 		return &durabilityCrossCell{}
 	})
-	registerDurability("test", func() durabler {
+	registerDurability("test", func() Durabler {
 		return &durabilityTest{}
 	})
 }
 
-// durabler is the interface which is used to get the promotion rules for candidates and the semi sync setup
-type durabler interface {
+// Durabler is the interface which is used to get the promotion rules for candidates and the semi sync setup
+type Durabler interface {
 	promotionRule(*topodatapb.Tablet) promotionrule.CandidatePromotionRule
 	semiSyncAckers(*topodatapb.Tablet) int
 	isReplicaSemiSync(primary, replica *topodatapb.Tablet) bool
@@ -75,40 +82,84 @@ func registerDurability(name string, newDurablerFunc newDurabler) {
 
 //=======================================================================
 
-// SetDurabilityPolicy is used to set the durability policy from the registered policies
+// GetDurabilityPolicy returns the durability policy with the given name.
+// The name is either the name of a registered policy, or a declarative
+// policy (see parseDeclarativeDurability). If it is empty, the default
+// policy set by SetDurabilityPolicy is returned.
+func GetDurabilityPolicy(name string) (Durabler, error) {
+	if name == "" {
+		defaultDurabilityPolicyMutex.Lock()
+		defer defaultDurabilityPolicyMutex.Unlock()
+		return defaultDurabilityPolicy, nil
+	}
+	if newDurabilityCreationFunc, found := durabilityPolicies[name]; found {
+		return newDurabilityCreationFunc(), nil
+	}
+	if !strings.Contains(name, "=") {
+		return nil, fmt.Errorf("durability policy %v not found", name)
+	}
+	return parseDeclarativeDurability(name)
+}
+
+// GetKeyspaceDurability returns the durability policy of the keyspace, as
+// stored in its topo record.
+func GetKeyspaceDurability(ctx context.Context, ts *topo.Server, keyspace string) (Durabler, error) {
+	ki, err := ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	durability, err := GetDurabilityPolicy(ki.DurabilityPolicy)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "invalid durability policy of keyspace %v", keyspace)
+	}
+	return durability, nil
+}
+
+// SetDurabilityPolicy is used to set the default durability policy, used
+// for the keyspaces that don't have a durability policy in their record.
 func SetDurabilityPolicy(name string) error {
-	newDurabilityCreationFunc, found := durabilityPolicies[name]
-	if !found {
-		return fmt.Errorf("durability policy %v not found", name)
-	}
-	log.Infof("Setting durability policy to %v", name)
-	curDurabilityPolicyMutex.Lock()
-	defer curDurabilityPolicyMutex.Unlock()
-	curDurabilityPolicy = newDurabilityCreationFunc()
+	if name == "" {
+		return fmt.Errorf("durability policy can't be empty")
+	}
+	durability, err := GetDurabilityPolicy(name)
+	if err != nil {
+		return err
+	}
+	log.Infof("Setting default durability policy to %v", name)
+	defaultDurabilityPolicyMutex.Lock()
+	defer defaultDurabilityPolicyMutex.Unlock()
+	defaultDurabilityPolicy = durability
 	return nil
 }
 
+// orDefault returns durability, or the default durability policy if it is nil.
+func orDefault(durability Durabler) Durabler {
+	if durability != nil {
+		return durability
+	}
+	defaultDurabilityPolicyMutex.Lock()
+	defer defaultDurabilityPolicyMutex.Unlock()
+	return defaultDurabilityPolicy
+}
+
 // PromotionRule returns the promotion rule for the instance.
-func PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
-	curDurabilityPolicyMutex.Lock()
-	defer curDurabilityPolicyMutex.Unlock()
-	return curDurabilityPolicy.promotionRule(tablet)
+// A nil durability means the default durability policy.
+func PromotionRule(durability Durabler, tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	return orDefault(durability).promotionRule(tablet)
 }
 
 // SemiSyncAckers returns the primary semi-sync setting for the instance.
 // 0 means none. Non-zero specifies the number of required ackers.
-func SemiSyncAckers(tablet *topodatapb.Tablet) int {
-	curDurabilityPolicyMutex.Lock()
-	defer curDurabilityPolicyMutex.Unlock()
-	return curDurabilityPolicy.semiSyncAckers(tablet)
+// A nil durability means the default durability policy.
+func SemiSyncAckers(durability Durabler, tablet *topodatapb.Tablet) int {
+	return orDefault(durability).semiSyncAckers(tablet)
 }
 
 // IsReplicaSemiSync returns the replica semi-sync setting from the tablet record.
 // Prefer using this function if tablet record is available.
-func IsReplicaSemiSync(primary, replica *topodatapb.Tablet) bool {
-	curDurabilityPolicyMutex.Lock()
-	defer curDurabilityPolicyMutex.Unlock()
-	return curDurabilityPolicy.isReplicaSemiSync(primary, replica)
+// A nil durability means the default durability policy.
+func IsReplicaSemiSync(durability Durabler, primary, replica *topodatapb.Tablet) bool {
+	return orDefault(durability).isReplicaSemiSync(primary, replica)
 }
 
 //=======================================================================
@@ -213,3 +264,125 @@ func (d *durabilityTest) semiSyncAckers(tablet *topodatapb.Tablet) int {
 func (d *durabilityTest) isReplicaSemiSync(primary, replica *topodatapb.Tablet) bool {
 	return false
 }
+
+//=======================================================================
+
+// durabilityDeclarative is a durability policy described by a list of
+// key=value settings separated by semicolons, instead of a Go registration:
+//
+//	ackers=N                  number of semi-sync acks the primary waits for
+//	cross_cell=true           only replicas in another cell than the primary ack
+//	ack_cells=c1,c2           only replicas in these cells ack
+//	never_promote_cells=c1,c2 tablets in these cells are never promoted
+//	prefer_promote_cells=c1   tablets in these cells are preferred for promotion
+//
+// For example, "ackers=2;ack_cells=zone1,zone2;never_promote_cells=zone3"
+// requires two acks from replicas in zone1 or zone2, and never promotes a
+// tablet in zone3. Only PRIMARY and REPLICA tablets ack or get promoted.
+type durabilityDeclarative struct {
+	ackers             int
+	crossCell          bool
+	ackCells           sets.String
+	neverPromoteCells  sets.String
+	preferPromoteCells sets.String
+}
+
+// parseDeclarativeDurability parses a declarative durability policy.
+func parseDeclarativeDurability(policy string) (*durabilityDeclarative, error) {
+	d := &durabilityDeclarative{}
+	for _, setting := range strings.Split(policy, ";") {
+		setting = strings.TrimSpace(setting)
+		if setting == "" {
+			continue
+		}
+		parts := strings.SplitN(setting, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid durability policy setting %q: expected key=value", setting)
+		}
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		switch key {
+		case "ackers":
+			ackers, err := strconv.Atoi(value)
+			if err != nil || ackers < 0 {
+				return nil, fmt.Errorf("invalid durability policy setting %q: ackers must be a non-negative integer", setting)
+			}
+			d.ackers = ackers
+		case "cross_cell":
+			crossCell, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid durability policy setting %q: %v", setting, err)
+			}
+			d.crossCell = crossCell
+		case "ack_cells":
+			d.ackCells = parseCells(value)
+		case "never_promote_cells":
+			d.neverPromoteCells = parseCells(value)
+		case "prefer_promote_cells":
+			d.preferPromoteCells = parseCells(value)
+		default:
+			return nil, fmt.Errorf("invalid durability policy setting %q: unknown key %v", setting, key)
+		}
+	}
+	if d.ackers == 0 && (d.crossCell || d.ackCells != nil) {
+		return nil, fmt.Errorf("invalid durability policy %q: cross_cell and ack_cells require ackers", policy)
+	}
+	if d.neverPromoteCells != nil && d.preferPromoteCells != nil && d.neverPromoteCells.HasAny(d.preferPromoteCells.List()...) {
+		return nil, fmt.Errorf("invalid durability policy %q: a cell can't be in both never_promote_cells and prefer_promote_cells", policy)
+	}
+	return d, nil
+}
+
+func parseCells(value string) sets.String {
+	cells := sets.NewString()
+	for _, cell := range strings.Split(value, ",") {
+		if cell = strings.TrimSpace(cell); cell != "" {
+			cells.Insert(cell)
+		}
+	}
+	return cells
+}
+
+func (d *durabilityDeclarative) promotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	switch tablet.Type {
+	case topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA:
+	default:
+		return promotionrule.MustNot
+	}
+	if tablet.Alias != nil {
+		if d.neverPromoteCells.Has(tablet.Alias.Cell) {
+			return promotionrule.MustNot
+		}
+		if d.preferPromoteCells.Has(tablet.Alias.Cell) {
+			return promotionrule.Prefer
+		}
+	}
+	return promotionrule.Neutral
+}
+
+func (d *durabilityDeclarative) semiSyncAckers(tablet *topodatapb.Tablet) int {
+	return d.ackers
+}
+
+func (d *durabilityDeclarative) isReplicaSemiSync(primary, replica *topodatapb.Tablet) bool {
+	if d.ackers == 0 {
+		return false
+	}
+	switch replica.Type {
+	case topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA:
+	default:
+		return false
+	}
+	if d.crossCell || d.ackCells != nil {
+		// Prevent panics.
+		if primary.Alias == nil || replica.Alias == nil {
+			return false
+		}
+		if d.crossCell && primary.Alias.Cell == replica.Alias.Cell {
+			return false
+		}
+		if d.ackCells != nil && !d.ackCells.Has(replica.Alias.Cell) {
+			return false
+		}
+	}
+	return true
+}
@@ -17,101 +17,103 @@ limitations under the License.
 package reparentutil
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
 )
 
 func TestDurabilityNone(t *testing.T) {
-	err := SetDurabilityPolicy("none")
+	durability, err := GetDurabilityPolicy("none")
 	require.NoError(t, err)
 
-	promoteRule := PromotionRule(&topodatapb.Tablet{
+	promoteRule := PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_PRIMARY,
 	})
 	assert.Equal(t, promotionrule.Neutral, promoteRule)
 
-	promoteRule = PromotionRule(&topodatapb.Tablet{
+	promoteRule = PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_REPLICA,
 	})
 	assert.Equal(t, promotionrule.Neutral, promoteRule)
 
-	promoteRule = PromotionRule(&topodatapb.Tablet{
+	promoteRule = PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_RDONLY,
 	})
 	assert.Equal(t, promotionrule.MustNot, promoteRule)
 
-	promoteRule = PromotionRule(&topodatapb.Tablet{
+	promoteRule = PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_SPARE,
 	})
 	assert.Equal(t, promotionrule.MustNot, promoteRule)
-	assert.Equal(t, 0, SemiSyncAckers(nil))
-	assert.Equal(t, false, IsReplicaSemiSync(nil, nil))
+	assert.Equal(t, 0, SemiSyncAckers(durability, nil))
+	assert.Equal(t, false, IsReplicaSemiSync(durability, nil, nil))
 }
 
 func TestDurabilitySemiSync(t *testing.T) {
-	err := SetDurabilityPolicy("semi_sync")
+	durability, err := GetDurabilityPolicy("semi_sync")
 	require.NoError(t, err)
 
-	promoteRule := PromotionRule(&topodatapb.Tablet{
+	promoteRule := PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_PRIMARY,
 	})
 	assert.Equal(t, promotionrule.Neutral, promoteRule)
 
-	promoteRule = PromotionRule(&topodatapb.Tablet{
+	promoteRule = PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_REPLICA,
 	})
 	assert.Equal(t, promotionrule.Neutral, promoteRule)
 
-	promoteRule = PromotionRule(&topodatapb.Tablet{
+	promoteRule = PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_RDONLY,
 	})
 	assert.Equal(t, promotionrule.MustNot, promoteRule)
 
-	promoteRule = PromotionRule(&topodatapb.Tablet{
+	promoteRule = PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_SPARE,
 	})
 	assert.Equal(t, promotionrule.MustNot, promoteRule)
-	assert.Equal(t, 1, SemiSyncAckers(nil))
-	assert.Equal(t, true, IsReplicaSemiSync(nil, &topodatapb.Tablet{
+	assert.Equal(t, 1, SemiSyncAckers(durability, nil))
+	assert.Equal(t, true, IsReplicaSemiSync(durability, nil, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_REPLICA,
 	}))
-	assert.Equal(t, false, IsReplicaSemiSync(nil, &topodatapb.Tablet{
+	assert.Equal(t, false, IsReplicaSemiSync(durability, nil, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_EXPERIMENTAL,
 	}))
 }
 
 func TestDurabilityCrossCell(t *testing.T) {
-	err := SetDurabilityPolicy("cross_cell")
+	durability, err := GetDurabilityPolicy("cross_cell")
 	require.NoError(t, err)
 
-	promoteRule := PromotionRule(&topodatapb.Tablet{
+	promoteRule := PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_PRIMARY,
 	})
 	assert.Equal(t, promotionrule.Neutral, promoteRule)
 
-	promoteRule = PromotionRule(&topodatapb.Tablet{
+	promoteRule = PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_REPLICA,
 	})
 	assert.Equal(t, promotionrule.Neutral, promoteRule)
 
-	promoteRule = PromotionRule(&topodatapb.Tablet{
+	promoteRule = PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_RDONLY,
 	})
 	assert.Equal(t, promotionrule.MustNot, promoteRule)
 
-	promoteRule = PromotionRule(&topodatapb.Tablet{
+	promoteRule = PromotionRule(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_SPARE,
 	})
 	assert.Equal(t, promotionrule.MustNot, promoteRule)
-	assert.Equal(t, 1, SemiSyncAckers(nil))
-	assert.Equal(t, false, IsReplicaSemiSync(&topodatapb.Tablet{
+	assert.Equal(t, 1, SemiSyncAckers(durability, nil))
+	assert.Equal(t, false, IsReplicaSemiSync(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_PRIMARY,
 		Alias: &topodatapb.TabletAlias{
 			Cell: "cell1",
@@ -122,7 +124,7 @@ func TestDurabilityCrossCell(t *testing.T) {
 			Cell: "cell1",
 		},
 	}))
-	assert.Equal(t, true, IsReplicaSemiSync(&topodatapb.Tablet{
+	assert.Equal(t, true, IsReplicaSemiSync(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_PRIMARY,
 		Alias: &topodatapb.TabletAlias{
 			Cell: "cell1",
@@ -133,7 +135,7 @@ func TestDurabilityCrossCell(t *testing.T) {
 			Cell: "cell2",
 		},
 	}))
-	assert.Equal(t, false, IsReplicaSemiSync(&topodatapb.Tablet{
+	assert.Equal(t, false, IsReplicaSemiSync(durability, &topodatapb.Tablet{
 		Type: topodatapb.TabletType_PRIMARY,
 		Alias: &topodatapb.TabletAlias{
 			Cell: "cell1",
@@ -204,3 +206,93 @@ func TestDurabilityTest(t *testing.T) {
 		})
 	}
 }
+
+func TestDurabilityDeclarative(t *testing.T) {
+	durability, err := GetDurabilityPolicy("ackers=2; ack_cells=zone1,zone2; never_promote_cells=zone3; prefer_promote_cells=zone1")
+	require.NoError(t, err)
+
+	tablet := func(cell string, tabletType topodatapb.TabletType) *topodatapb.Tablet {
+		return &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{Cell: cell, Uid: 100},
+			Type:  tabletType,
+		}
+	}
+	primary := tablet("zone1", topodatapb.TabletType_PRIMARY)
+
+	assert.Equal(t, promotionrule.Prefer, PromotionRule(durability, tablet("zone1", topodatapb.TabletType_REPLICA)))
+	assert.Equal(t, promotionrule.Neutral, PromotionRule(durability, tablet("zone2", topodatapb.TabletType_REPLICA)))
+	assert.Equal(t, promotionrule.MustNot, PromotionRule(durability, tablet("zone3", topodatapb.TabletType_REPLICA)))
+	assert.Equal(t, promotionrule.MustNot, PromotionRule(durability, tablet("zone1", topodatapb.TabletType_RDONLY)))
+
+	assert.Equal(t, 2, SemiSyncAckers(durability, primary))
+	assert.True(t, IsReplicaSemiSync(durability, primary, tablet("zone1", topodatapb.TabletType_REPLICA)))
+	assert.True(t, IsReplicaSemiSync(durability, primary, tablet("zone2", topodatapb.TabletType_REPLICA)))
+	assert.False(t, IsReplicaSemiSync(durability, primary, tablet("zone3", topodatapb.TabletType_REPLICA)))
+	assert.False(t, IsReplicaSemiSync(durability, primary, tablet("zone2", topodatapb.TabletType_RDONLY)))
+
+	durability, err = GetDurabilityPolicy("ackers=1;cross_cell=true")
+	require.NoError(t, err)
+	assert.False(t, IsReplicaSemiSync(durability, primary, tablet("zone1", topodatapb.TabletType_REPLICA)))
+	assert.True(t, IsReplicaSemiSync(durability, primary, tablet("zone2", topodatapb.TabletType_REPLICA)))
+}
+
+func TestDurabilityDeclarativeErrors(t *testing.T) {
+	testcases := []struct {
+		policy string
+		err    string
+	}{{
+		policy: "ackers=-1",
+		err:    `invalid durability policy setting "ackers=-1": ackers must be a non-negative integer`,
+	}, {
+		policy: "ackers=1;quorum=2",
+		err:    `invalid durability policy setting "quorum=2": unknown key quorum`,
+	}, {
+		policy: "ackers=1;cross_cell",
+		err:    `invalid durability policy setting "cross_cell": expected key=value`,
+	}, {
+		policy: "cross_cell=true",
+		err:    `invalid durability policy "cross_cell=true": cross_cell and ack_cells require ackers`,
+	}, {
+		policy: "never_promote_cells=zone1;prefer_promote_cells=zone1,zone2",
+		err:    `invalid durability policy "never_promote_cells=zone1;prefer_promote_cells=zone1,zone2": a cell can't be in both never_promote_cells and prefer_promote_cells`,
+	}}
+	for _, testcase := range testcases {
+		t.Run(testcase.policy, func(t *testing.T) {
+			_, err := GetDurabilityPolicy(testcase.policy)
+			assert.EqualError(t, err, testcase.err)
+		})
+	}
+}
+
+func TestDefaultDurabilityPolicy(t *testing.T) {
+	defer SetDurabilityPolicy("none")
+
+	err := SetDurabilityPolicy("ackers=3")
+	require.NoError(t, err)
+	durability, err := GetDurabilityPolicy("")
+	require.NoError(t, err)
+	assert.Equal(t, 3, SemiSyncAckers(durability, nil))
+	assert.Equal(t, 3, SemiSyncAckers(nil, nil))
+
+	err = SetDurabilityPolicy("")
+	assert.EqualError(t, err, "durability policy can't be empty")
+}
+
+func TestGetKeyspaceDurability(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks1", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks2", &topodatapb.Keyspace{DurabilityPolicy: "semi_sync"}))
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks3", &topodatapb.Keyspace{DurabilityPolicy: "unknown"}))
+
+	durability, err := GetKeyspaceDurability(ctx, ts, "ks1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, SemiSyncAckers(durability, nil))
+
+	durability, err = GetKeyspaceDurability(ctx, ts, "ks2")
+	require.NoError(t, err)
+	assert.Equal(t, 1, SemiSyncAckers(durability, nil))
+
+	_, err = GetKeyspaceDurability(ctx, ts, "ks3")
+	assert.EqualError(t, err, "invalid durability policy of keyspace ks3: durability policy unknown not found")
+}
@@ -63,6 +63,7 @@ type EmergencyReparentOptions struct {
 	// Private options managed internally. We use value passing to avoid leaking
 	// these details back out.
 	lockAction string
+	durability Durabler
 }
 
 // counters for Emergency Reparent Shard
@@ -158,6 +159,11 @@ func (erp *EmergencyReparenter) reparentShardLocked(ctx context.Context, ev *eve
 	}
 	ev.ShardInfo = *shardInfo
 
+	opts.durability, err = GetKeyspaceDurability(ctx, erp.ts, keyspace)
+	if err != nil {
+		return err
+	}
+
 	// get the previous primary according to the topology server,
 	// we use this information to choose the best candidate in the same cell
 	// and to undo promotion in case of failure
@@ -367,7 +373,7 @@ func (erp *EmergencyReparenter) findMostAdvanced(
 	}
 
 	// sort the tablets for finding the best intermediate source in ERS
-	err = sortTabletsForReparent(validTablets, tabletPositions)
+	err = sortTabletsForReparent(validTablets, tabletPositions, opts.durability)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -499,7 +505,7 @@ func (erp *EmergencyReparenter) reparentReplicas(
 			forceStart = fs
 		}
 
-		err := erp.tmc.SetReplicationSource(replCtx, ti.Tablet, newPrimaryTablet.Alias, 0, "", forceStart, IsReplicaSemiSync(newPrimaryTablet, ti.Tablet))
+		err := erp.tmc.SetReplicationSource(replCtx, ti.Tablet, newPrimaryTablet.Alias, 0, "", forceStart, IsReplicaSemiSync(opts.durability, newPrimaryTablet, ti.Tablet))
 		if err != nil {
 			err = vterrors.Wrapf(err, "tablet %v SetReplicationSource failed: %v", alias, err)
 			rec.RecordError(err)
@@ -638,7 +644,7 @@ func (erp *EmergencyReparenter) identifyPrimaryCandidate(
 		neutralReplicas     []*topodatapb.Tablet
 	)
 	for _, candidate := range validCandidates {
-		promotionRule := PromotionRule(candidate)
+		promotionRule := PromotionRule(opts.durability, candidate)
 		if promotionRule == promotionrule.Must || promotionRule == promotionrule.Prefer {
 			preferredCandidates = append(preferredCandidates, candidate)
 		}
@@ -698,7 +704,7 @@ func (erp *EmergencyReparenter) checkIfConstraintsSatisfied(newPrimary, prevPrim
 	if opts.PreventCrossCellPromotion && prevPrimary != nil && newPrimary.Alias.Cell != prevPrimary.Alias.Cell {
 		return vterrors.Errorf(vtrpc.Code_ABORTED, "elected primary does not satisfy geographic constraint - %s", topoproto.TabletAliasString(newPrimary.Alias))
 	}
-	if PromotionRule(newPrimary) == promotionrule.MustNot {
+	if PromotionRule(opts.durability, newPrimary) == promotionrule.MustNot {
 		return vterrors.Errorf(vtrpc.Code_ABORTED, "elected primary does not satisfy promotion rule constraint - %s", topoproto.TabletAliasString(newPrimary.Alias))
 	}
 	return nil
@@ -716,11 +722,11 @@ func (erp *EmergencyReparenter) promoteNewPrimary(
 	if ev.ShardInfo.PrimaryAlias == nil {
 		erp.logger.Infof("setting up %v as new primary for an uninitialized cluster", newPrimary.Alias)
 		// we call InitPrimary when the PrimaryAlias in the ShardInfo is empty. This happens when we have an uninitialized cluster.
-		_, err = erp.tmc.InitPrimary(ctx, newPrimary, SemiSyncAckers(newPrimary) > 0)
+		_, err = erp.tmc.InitPrimary(ctx, newPrimary, SemiSyncAckers(opts.durability, newPrimary) > 0)
 	} else {
 		erp.logger.Infof("starting promotion for the new primary - %v", newPrimary.Alias)
 		// we call PromoteReplica which changes the tablet type, fixes the semi-sync, set the primary to read-write and flushes the binlogs
-		_, err = erp.tmc.PromoteReplica(ctx, newPrimary, SemiSyncAckers(newPrimary) > 0)
+		_, err = erp.tmc.PromoteReplica(ctx, newPrimary, SemiSyncAckers(opts.durability, newPrimary) > 0)
 	}
 	if err != nil {
 		return vterrors.Wrapf(err, "primary-elect tablet %v failed to be upgraded to primary: %v", newPrimary.Alias, err)
@@ -29,15 +29,17 @@ import (
 // reparentSorter sorts tablets by GTID positions and Promotion rules aimed at finding the best
 // candidate for intermediate promotion in emergency reparent shard, and the new primary in planned reparent shard
 type reparentSorter struct {
-	tablets   []*topodatapb.Tablet
-	positions []mysql.Position
+	tablets    []*topodatapb.Tablet
+	positions  []mysql.Position
+	durability Durabler
 }
 
 // newReparentSorter creates a new reparentSorter
-func newReparentSorter(tablets []*topodatapb.Tablet, positions []mysql.Position) *reparentSorter {
+func newReparentSorter(tablets []*topodatapb.Tablet, positions []mysql.Position, durability Durabler) *reparentSorter {
 	return &reparentSorter{
-		tablets:   tablets,
-		positions: positions,
+		tablets:    tablets,
+		positions:  positions,
+		durability: durability,
 	}
 }
 
@@ -75,20 +77,20 @@ func (rs *reparentSorter) Less(i, j int) bool {
 
 	// at this point, both have the same GTIDs
 	// so we check their promotion rules
-	jPromotionRule := PromotionRule(rs.tablets[j])
-	iPromotionRule := PromotionRule(rs.tablets[i])
+	jPromotionRule := PromotionRule(rs.durability, rs.tablets[j])
+	iPromotionRule := PromotionRule(rs.durability, rs.tablets[i])
 	return !jPromotionRule.BetterThan(iPromotionRule)
 }
 
 // sortTabletsForReparent sorts the tablets, given their positions for emergency reparent shard and planned reparent shard.
-// Tablets are sorted first by their replication positions, with ties broken by the promotion rules.
-func sortTabletsForReparent(tablets []*topodatapb.Tablet, positions []mysql.Position) error {
+// Tablets are sorted first by their replication positions, with ties broken by the promotion rules of the durability policy.
+func sortTabletsForReparent(tablets []*topodatapb.Tablet, positions []mysql.Position, durability Durabler) error {
 	// throw an error internal error in case of unequal number of tablets and positions
 	// fail-safe code prevents panic in sorting in case the lengths are unequal
 	if len(tablets) != len(positions) {
 		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unequal number of tablets and positions")
 	}
 
-	sort.Sort(newReparentSorter(tablets, positions))
+	sort.Sort(newReparentSorter(tablets, positions, durability))
 	return nil
 }
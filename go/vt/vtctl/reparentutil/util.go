@@ -25,6 +25,7 @@ import (
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
@@ -41,13 +42,15 @@ import (
 // Note that the search for the most advanced replication position will race
 // with transactions being executed on the current primary, so when all tablets
 // are at roughly the same position, then the choice of new primary-elect will
-// be somewhat unpredictable.
+// be somewhat unpredictable. Tablets that must not be promoted according to
+// the durability policy are never chosen.
 func ChooseNewPrimary(
 	ctx context.Context,
 	tmc tmclient.TabletManagerClient,
 	shardInfo *topo.ShardInfo,
 	tabletMap map[string]*topo.TabletInfo,
 	avoidPrimaryAlias *topodatapb.TabletAlias,
+	durability Durabler,
 	waitReplicasTimeout time.Duration,
 	// (TODO:@ajm188) it's a little gross we need to pass this, maybe embed in the context?
 	logger logutil.Logger,
@@ -75,6 +78,8 @@ func ChooseNewPrimary(
 			continue
 		case tablet.Tablet.Type != topodatapb.TabletType_REPLICA:
 			continue
+		case PromotionRule(durability, tablet.Tablet) == promotionrule.MustNot:
+			continue
 		}
 
 		wg.Add(1)
@@ -100,7 +105,7 @@ func ChooseNewPrimary(
 	}
 
 	// sort the tablets for finding the best primary
-	err := sortTabletsForReparent(validTablets, tabletPositions)
+	err := sortTabletsForReparent(validTablets, tabletPositions, durability)
 	if err != nil {
 		return nil, err
 	}
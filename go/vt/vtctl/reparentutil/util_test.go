@@ -65,6 +65,8 @@ func TestChooseNewPrimary(t *testing.T) {
 
 	ctx := context.Background()
 	logger := logutil.NewMemoryLogger()
+	durability, err := GetDurabilityPolicy("none")
+	require.NoError(t, err)
 	tests := []struct {
 		name              string
 		tmc               *chooseNewPrimaryTestTMClient
@@ -440,7 +442,7 @@ func TestChooseNewPrimary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			actual, err := ChooseNewPrimary(ctx, tt.tmc, tt.shardInfo, tt.tabletMap, tt.avoidPrimaryAlias, time.Millisecond*50, logger)
+			actual, err := ChooseNewPrimary(ctx, tt.tmc, tt.shardInfo, tt.tabletMap, tt.avoidPrimaryAlias, durability, time.Millisecond*50, logger)
 			if tt.shouldErr {
 				assert.Error(t, err)
 				return
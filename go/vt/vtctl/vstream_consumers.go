@@ -0,0 +1,237 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// This file contains the commands to manage the VStream consumers, whose
+// positions are stored in the global topo by vtgate.
+
+const vstreamConsumersGroupName = "VStream Consumers"
+
+func init() {
+	addCommandGroup(vstreamConsumersGroupName)
+
+	addCommand(vstreamConsumersGroupName, command{
+		name:   "GetVStreamConsumers",
+		method: commandGetVStreamConsumers,
+		params: "[<consumer name>...]",
+		help:   "Displays the VStream consumers, or the given ones, with the position of each shard and how many transactions it is behind the primary.",
+	})
+	addCommand(vstreamConsumersGroupName, command{
+		name:   "ResetVStreamConsumer",
+		method: commandResetVStreamConsumer,
+		params: "{-position=<vgtid json> || -timestamp=<time>} <consumer name>",
+		help:   "Resets the position of a VStream consumer, to a VGtid or to its latest position acknowledged at or before a time in RFC3339 format. The streams of the consumer use the new position when they reconnect.",
+	})
+	addCommand(vstreamConsumersGroupName, command{
+		name:   "DeleteVStreamConsumer",
+		method: commandDeleteVStreamConsumer,
+		params: "<consumer name>",
+		help:   "Deletes a VStream consumer.",
+	})
+}
+
+// vstreamConsumerStatus is the status of a VStream consumer displayed by
+// GetVStreamConsumers.
+type vstreamConsumerStatus struct {
+	Name     string
+	Filter   *binlogdatapb.Filter `json:",omitempty"`
+	Time     time.Time
+	Shards   []*vstreamConsumerShardStatus
+	History  int
+	Earliest time.Time `json:",omitempty"`
+}
+
+// vstreamConsumerShardStatus is the position of a VStream consumer in one
+// shard.
+type vstreamConsumerShardStatus struct {
+	Keyspace           string
+	Shard              string
+	Position           string
+	PrimaryPosition    string `json:",omitempty"`
+	TransactionsBehind int64
+	Error              string `json:",omitempty"`
+}
+
+func commandGetVStreamConsumers(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	names := subFlags.Args()
+	if len(names) == 0 {
+		var err error
+		names, err = wr.TopoServer().GetVStreamConsumerNames(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	statuses := make([]*vstreamConsumerStatus, 0, len(names))
+	for _, name := range names {
+		consumer, err := wr.TopoServer().GetVStreamConsumer(ctx, name)
+		if err != nil {
+			return fmt.Errorf("cannot get VStream consumer %v: %v", name, err)
+		}
+		status := &vstreamConsumerStatus{
+			Name:    consumer.Name,
+			Filter:  consumer.Filter,
+			Time:    logutil.ProtoToTime(consumer.GetPosition().GetTime()),
+			Shards:  getVStreamConsumerShards(ctx, wr, consumer.GetPosition().GetVgtid()),
+			History: len(consumer.History),
+		}
+		if len(consumer.History) > 0 {
+			status.Earliest = logutil.ProtoToTime(consumer.History[len(consumer.History)-1].Time)
+		}
+		statuses = append(statuses, status)
+	}
+	return printJSON(wr.Logger(), statuses)
+}
+
+// getVStreamConsumerShards compares the position of each shard of a consumer
+// with the position of the shard primary. Errors are reported per shard.
+func getVStreamConsumerShards(ctx context.Context, wr *wrangler.Wrangler, vgtid *binlogdatapb.VGtid) []*vstreamConsumerShardStatus {
+	shards := make([]*vstreamConsumerShardStatus, len(vgtid.GetShardGtids()))
+	wg := sync.WaitGroup{}
+	for i, sgtid := range vgtid.GetShardGtids() {
+		shards[i] = &vstreamConsumerShardStatus{
+			Keyspace: sgtid.Keyspace,
+			Shard:    sgtid.Shard,
+			Position: sgtid.Gtid,
+		}
+		wg.Add(1)
+		go func(status *vstreamConsumerShardStatus) {
+			defer wg.Done()
+			if err := getVStreamConsumerLag(ctx, wr, status); err != nil {
+				status.Error = err.Error()
+			}
+		}(shards[i])
+	}
+	wg.Wait()
+	return shards
+}
+
+func getVStreamConsumerLag(ctx context.Context, wr *wrangler.Wrangler, status *vstreamConsumerShardStatus) error {
+	pos, err := mysql.DecodePosition(status.Position)
+	if err != nil {
+		return err
+	}
+	si, err := wr.TopoServer().GetShard(ctx, status.Keyspace, status.Shard)
+	if err != nil {
+		return err
+	}
+	if !si.HasPrimary() {
+		return fmt.Errorf("shard %v/%v has no primary", status.Keyspace, status.Shard)
+	}
+	ti, err := wr.TopoServer().GetTablet(ctx, si.PrimaryAlias)
+	if err != nil {
+		return err
+	}
+	status.PrimaryPosition, err = wr.TabletManagerClient().PrimaryPosition(ctx, ti.Tablet)
+	if err != nil {
+		return err
+	}
+	primaryPos, err := mysql.DecodePosition(status.PrimaryPosition)
+	if err != nil {
+		return err
+	}
+	gtidSet, ok := pos.GTIDSet.(mysql.Mysql56GTIDSet)
+	primaryGTIDSet, primaryOK := primaryPos.GTIDSet.(mysql.Mysql56GTIDSet)
+	if !ok || !primaryOK {
+		return fmt.Errorf("transactions behind can only be computed for MySQL 5.6 GTID positions")
+	}
+	status.TransactionsBehind = primaryGTIDSet.Difference(gtidSet).Count()
+	return nil
+}
+
+func commandResetVStreamConsumer(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	position := subFlags.String("position", "", "The VGtid to reset the consumer to, in JSON format")
+	timestamp := subFlags.String("timestamp", "", "Resets the consumer to its latest position acknowledged at or before this time, in RFC3339 format")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <consumer name> argument is required for the ResetVStreamConsumer command")
+	}
+	if (*position == "") == (*timestamp == "") {
+		return fmt.Errorf("exactly one of -position or -timestamp must be specified")
+	}
+	name := subFlags.Arg(0)
+
+	var checkpoint *vtgatepb.VStreamCheckpoint
+	var at time.Time
+	if *position != "" {
+		vgtid := &binlogdatapb.VGtid{}
+		if err := json2.Unmarshal([]byte(*position), vgtid); err != nil {
+			return fmt.Errorf("cannot parse -position: %v", err)
+		}
+		if len(vgtid.ShardGtids) == 0 {
+			return fmt.Errorf("-position must have at least one shard position")
+		}
+		checkpoint = &vtgatepb.VStreamCheckpoint{
+			Vgtid: vgtid,
+			Time:  logutil.TimeToProto(time.Now()),
+		}
+	} else {
+		var err error
+		at, err = time.Parse(time.RFC3339, *timestamp)
+		if err != nil {
+			return fmt.Errorf("cannot parse -timestamp: %v", err)
+		}
+	}
+
+	err := wr.TopoServer().UpdateVStreamConsumerFields(ctx, name, func(consumer *vtgatepb.VStreamConsumer) error {
+		if *timestamp != "" {
+			checkpoint = topo.VStreamCheckpointAt(consumer, at)
+			if checkpoint == nil {
+				return fmt.Errorf("VStream consumer %v has no position at or before %v", name, *timestamp)
+			}
+		}
+		consumer.Position = checkpoint
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("VStream consumer %v reset to %v\n", name, checkpoint.Vgtid)
+	return nil
+}
+
+func commandDeleteVStreamConsumer(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <consumer name> argument is required for the DeleteVStreamConsumer command")
+	}
+	return wr.TopoServer().DeleteVStreamConsumer(ctx, subFlags.Arg(0))
+}
@@ -114,10 +114,12 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
@@ -278,6 +280,18 @@ var commands = []commandGroup{
 				params: "[-max_rows=10000] [-disable_binlogs] [-json] <tablet alias> <sql command>",
 				help:   "Runs the given SQL command as a DBA on the remote tablet.",
 			},
+			{
+				name:   "ListQueries",
+				method: commandListQueries,
+				params: "[-caller=<caller>] [-table=<table>] [-fingerprint=<fingerprint>] <tablet alias>",
+				help:   "Lists the queries running on the remote tablet, optionally only the ones of the given caller, table or fingerprint.",
+			},
+			{
+				name:   "KillQueries",
+				method: commandKillQueries,
+				params: "[-caller=<caller>] [-table=<table>] [-fingerprint=<fingerprint>] <tablet alias>",
+				help:   "Kills the queries running on the remote tablet that match the given caller, table and fingerprint. At least one of them must be specified.",
+			},
 			{
 				name:   "VReplicationExec",
 				method: commandVReplicationExec,
@@ -396,7 +410,7 @@ var commands = []commandGroup{
 			{
 				name:   "CreateKeyspace",
 				method: commandCreateKeyspace,
-				params: "[-sharding_column_name=name] [-sharding_column_type=type] [-served_from=tablettype1:ks1,tablettype2:ks2,...] [-force] [-keyspace_type=type] [-base_keyspace=base_keyspace] [-snapshot_time=time] <keyspace name>",
+				params: "[-sharding_column_name=name] [-sharding_column_type=type] [-served_from=tablettype1:ks1,tablettype2:ks2,...] [-force] [-keyspace_type=type] [-base_keyspace=base_keyspace] [-snapshot_time=time] [-durability_policy=policy] <keyspace name>",
 				help:   "Creates the specified keyspace. keyspace_type can be NORMAL or SNAPSHOT. For a SNAPSHOT keyspace you must specify the name of a base_keyspace, and a snapshot_time in UTC, in RFC3339 time format, e.g. 2006-01-02T15:04:05+00:00",
 			},
 			{
@@ -429,6 +443,24 @@ var commands = []commandGroup{
 				params: "[-force] <keyspace name> [<column name>] [<column type>]",
 				help:   "Updates the sharding information for a keyspace.",
 			},
+			{
+				name:   "SetKeyspaceDurabilityPolicy",
+				method: commandSetKeyspaceDurabilityPolicy,
+				params: "<keyspace name> <policy>",
+				help:   "Sets the durability policy of a keyspace, used by reparent operations, vtorc and the tablets. The policy is either the name of a registered policy (none, semi_sync, cross_cell), or a declarative policy such as 'ackers=2;ack_cells=zone1,zone2;never_promote_cells=zone3'. An empty policy resets the keyspace to the default policy set by -durability_policy.",
+			},
+			{
+				name:   "GetQuotas",
+				method: commandGetQuotas,
+				params: "<keyspace name>",
+				help:   "Displays the resource quotas enforced by the tablets of a keyspace.",
+			},
+			{
+				name:   "ApplyQuotas",
+				method: commandApplyQuotas,
+				params: "{-quotas=<quotas> || -quotas_file=<quotas file>} [-dry-run] <keyspace name>",
+				help:   "Replaces the resource quotas enforced by the tablets of a keyspace, which need -enable_quotas. An empty list of quotas removes them.",
+			},
 			{
 				name:   "SetKeyspaceServedFrom",
 				method: commandSetKeyspaceServedFrom,
@@ -655,7 +687,7 @@ var commands = []commandGroup{
 			{
 				name:   "OnlineDDL",
 				method: commandOnlineDDL,
-				params: "[-json] <keyspace> <command> [<migration_uuid>]",
+				params: "[-json] [-ready_timeout=<duration>] <keyspace> <command> [<migration_uuid>]",
 				help: "Operates on online DDL (migrations). Examples:" +
 					" \nvtctl OnlineDDL test_keyspace show 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
 					" \nvtctl OnlineDDL test_keyspace show all" +
@@ -663,7 +695,8 @@ var commands = []commandGroup{
 					" \nvtctl OnlineDDL test_keyspace show complete" +
 					" \nvtctl OnlineDDL test_keyspace show failed" +
 					" \nvtctl OnlineDDL test_keyspace retry 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
-					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
+					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL -ready_timeout=5m test_keyspace cutover 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
 			},
 			{
 				name:   "ValidateVersionShard",
@@ -1406,6 +1439,72 @@ func commandExecuteFetchAsDba(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return nil
 }
 
+func commandListQueries(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	caller := subFlags.String("caller", "", "Only lists the queries of this caller")
+	table := subFlags.String("table", "", "Only lists the queries on this table")
+	fingerprint := subFlags.String("fingerprint", "", "Only lists the queries with this fingerprint")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the ListQueries command")
+	}
+
+	alias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	ti, err := wr.TopoServer().GetTablet(ctx, alias)
+	if err != nil {
+		return err
+	}
+	queries, err := wr.TabletManagerClient().ListQueries(ctx, ti.Tablet, &tabletmanagerdatapb.ListQueriesRequest{
+		Caller:      *caller,
+		Table:       *table,
+		Fingerprint: *fingerprint,
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), queries)
+}
+
+func commandKillQueries(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	caller := subFlags.String("caller", "", "Kills the queries of this caller")
+	table := subFlags.String("table", "", "Kills the queries on this table")
+	fingerprint := subFlags.String("fingerprint", "", "Kills the queries with this fingerprint")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the KillQueries command")
+	}
+	if *caller == "" && *table == "" && *fingerprint == "" {
+		return fmt.Errorf("at least one of -caller, -table or -fingerprint is required for the KillQueries command")
+	}
+
+	alias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	ti, err := wr.TopoServer().GetTablet(ctx, alias)
+	if err != nil {
+		return err
+	}
+	killed, err := wr.TabletManagerClient().KillQueries(ctx, ti.Tablet, &tabletmanagerdatapb.KillQueriesRequest{
+		Caller:      *caller,
+		Table:       *table,
+		Fingerprint: *fingerprint,
+	})
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("Killed %d queries\n", killed)
+	return nil
+}
+
 func commandVReplicationExec(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	json := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
 
@@ -1882,6 +1981,7 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	keyspaceType := subFlags.String("keyspace_type", "", "Specifies the type of the keyspace")
 	baseKeyspace := subFlags.String("base_keyspace", "", "Specifies the base keyspace for a snapshot keyspace")
 	timestampStr := subFlags.String("snapshot_time", "", "Specifies the snapshot time for this keyspace")
+	durabilityPolicy := subFlags.String("durability_policy", "", "Specifies the durability policy of the keyspace. If unset, the default policy set by -durability_policy is used")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1925,12 +2025,18 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 		}
 		snapshotTime = logutil.TimeToProto(timeTime)
 	}
+	if *durabilityPolicy != "" {
+		if _, err := reparentutil.GetDurabilityPolicy(*durabilityPolicy); err != nil {
+			return vterrors.Wrapf(err, "invalid durability_policy")
+		}
+	}
 	ki := &topodatapb.Keyspace{
 		ShardingColumnName: *shardingColumnName,
 		ShardingColumnType: kit,
 		KeyspaceType:       ktype,
 		BaseKeyspace:       *baseKeyspace,
 		SnapshotTime:       snapshotTime,
+		DurabilityPolicy:   *durabilityPolicy,
 	}
 	if len(servedFrom) > 0 {
 		for name, value := range servedFrom {
@@ -2092,6 +2198,87 @@ func commandSetKeyspaceShardingInfo(ctx context.Context, wr *wrangler.Wrangler,
 	return wr.SetKeyspaceShardingInfo(ctx, keyspace, columnName, kit, *force)
 }
 
+func commandSetKeyspaceDurabilityPolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace name> and <policy> arguments are required for the SetKeyspaceDurabilityPolicy command")
+	}
+
+	_, err := wr.VtctldServer().SetKeyspaceDurabilityPolicy(ctx, &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+		Keyspace:         subFlags.Arg(0),
+		DurabilityPolicy: subFlags.Arg(1),
+	})
+	return err
+}
+
+func commandGetQuotas(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace name> argument is required for the GetQuotas command")
+	}
+	quotas, err := wr.TopoServer().GetQuotas(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	b, err := json2.MarshalIndentPB(quotas, "  ")
+	if err != nil {
+		wr.Logger().Printf("%v\n", err)
+		return err
+	}
+	wr.Logger().Printf("%s\n", b)
+	return nil
+}
+
+func commandApplyQuotas(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	quotasStr := subFlags.String("quotas", "", "Specify quotas as a string")
+	quotasFile := subFlags.String("quotas_file", "", "Specify quotas in a file")
+	dryRun := subFlags.Bool("dry-run", false, "Do not upload the quotas, but print what actions would be taken")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace name> argument is required for the ApplyQuotas command")
+	}
+	keyspace := subFlags.Arg(0)
+
+	var quotasBytes []byte
+	if *quotasFile != "" {
+		var err error
+		quotasBytes, err = os.ReadFile(*quotasFile)
+		if err != nil {
+			return err
+		}
+	} else {
+		quotasBytes = []byte(*quotasStr)
+	}
+
+	quotas := &quotapb.Quotas{}
+	if err := json2.Unmarshal(quotasBytes, quotas); err != nil {
+		return err
+	}
+	if err := topo.ValidateQuotas(quotas); err != nil {
+		return err
+	}
+
+	b, err := json2.MarshalIndentPB(quotas, "  ")
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		wr.Logger().Printf("=== DRY RUN ===\nNew Quotas object for keyspace %v:\n%s\n=== (END) DRY RUN ===\n", keyspace, b)
+		return nil
+	}
+	if err := wr.TopoServer().SaveQuotas(ctx, keyspace, quotas); err != nil {
+		return err
+	}
+	wr.Logger().Printf("New Quotas object for keyspace %v:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", keyspace, b)
+	return nil
+}
+
 func commandSetKeyspaceServedFrom(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	source := subFlags.String("source", "", "Specifies the source keyspace name")
 	remove := subFlags.Bool("remove", false, "Indicates whether to add (default) or remove the served from record")
@@ -3293,6 +3480,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 
 func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	json := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
+	readyTimeout := subFlags.Duration("ready_timeout", 0, "With 'cutover': how long to wait for an atomic migration to be ready to complete on all shards")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -3356,6 +3544,13 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 		}
 		uuid = arg
 		query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='cancel' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
+	case "cutover":
+		// Coordinated cut-over of an atomic migration across all shards. vtctld does not trigger it by itself:
+		// atomic migrations keep running until this command is run.
+		if arg == "" {
+			return fmt.Errorf("UUID required")
+		}
+		return wr.OnlineDDLAtomicCutOver(ctx, keyspace, arg, *readyTimeout)
 	case "cancel-all":
 		if arg != "" {
 			return fmt.Errorf("UUID not allowed in %s", command)
@@ -78,6 +78,12 @@ type Options struct {
 	// Target is used to override the "database" target in the
 	// vtgate session to simulate `USE <target>`
 	Target string
+
+	// TableRowCounts optionally maps table names to their total number of
+	// rows across all shards of their keyspace. Keys are either a bare
+	// table name or "keyspace.table". When set, each tablet query is
+	// annotated with an estimate of the rows it reads or writes.
+	TableRowCounts map[string]int64
 }
 
 // TabletQuery defines a query that was sent to a given tablet and how it was
@@ -91,6 +97,10 @@ type TabletQuery struct {
 
 	// BindVars sent with the command
 	BindVars map[string]*querypb.BindVariable
+
+	// EstimatedRows is the estimated number of rows read or written by
+	// the query on the tablet, or -1 if no estimate is available
+	EstimatedRows int64
 }
 
 // MysqlQuery defines a query that was sent to a given tablet and how it was
@@ -113,14 +123,21 @@ func (tq *TabletQuery) MarshalJSON() ([]byte, error) {
 		bindVars[k] = b.String()
 	}
 
+	var estimatedRows *int64
+	if tq.EstimatedRows >= 0 {
+		estimatedRows = &tq.EstimatedRows
+	}
+
 	return jsonutil.MarshalNoEscape(&struct {
-		Time     int
-		SQL      string
-		BindVars map[string]string
+		Time          int
+		SQL           string
+		BindVars      map[string]string
+		EstimatedRows *int64 `json:",omitempty"`
 	}{
-		Time:     tq.Time,
-		SQL:      tq.SQL,
-		BindVars: bindVars,
+		Time:          tq.Time,
+		SQL:           tq.SQL,
+		BindVars:      bindVars,
+		EstimatedRows: estimatedRows,
 	})
 }
 
@@ -144,6 +161,14 @@ type Explain struct {
 
 	// list of queries / bind vars sent to each tablet
 	TabletActions map[string]*TabletActions
+
+	// number of tablets the query was sent to, only set when table row
+	// counts are configured
+	FanOut *int `json:",omitempty"`
+
+	// estimated number of rows read or written across all tablets, or nil
+	// if no estimate is available
+	EstimatedRows *int64 `json:",omitempty"`
 }
 
 // Init sets up the fake execution environment
@@ -286,11 +311,19 @@ func explain(sql string) (*Explain, error) {
 		return nil, err
 	}
 
-	return &Explain{
+	e := &Explain{
 		SQL:           sql,
 		Plans:         plans,
 		TabletActions: tabletActions,
-	}, nil
+	}
+	if tEnv := getGlobalTabletEnv(); tEnv != nil && len(tEnv.tableRowCounts) > 0 {
+		fanOut := len(tabletActions)
+		e.FanOut = &fanOut
+		if rows := estimateRows(tabletActions); rows >= 0 {
+			e.EstimatedRows = &rows
+		}
+	}
+	return e, nil
 }
 
 type outputQuery struct {
@@ -0,0 +1,337 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// estimateRows annotates every tablet query with an estimate of the number
+// of rows it reads or writes, based on the configured table row counts, and
+// returns the total across all tablets. It returns -1 if no row counts were
+// configured or if any of the queries could not be estimated.
+func estimateRows(tabletActions map[string]*TabletActions) int64 {
+	tEnv := getGlobalTabletEnv()
+	if tEnv == nil || len(tEnv.tableRowCounts) == 0 {
+		return -1
+	}
+
+	total := int64(0)
+	for hostname, actions := range tabletActions {
+		keyspace, keyRange := tabletKeyRange(hostname)
+		est := &rowEstimator{
+			tEnv:     tEnv,
+			keyspace: keyspace,
+			fraction: keyRangeFraction(keyRange),
+		}
+
+		for _, tq := range actions.TabletQueries {
+			est.bindVars = tq.BindVars
+			tq.EstimatedRows = est.estimateSQL(tq.SQL)
+			if tq.EstimatedRows < 0 || total < 0 {
+				total = -1
+				continue
+			}
+			total += tq.EstimatedRows
+		}
+	}
+
+	return total
+}
+
+// tabletKeyRange returns the keyspace and key range served by the tablet
+// with the given "keyspace/shard" hostname.
+func tabletKeyRange(hostname string) (string, *topodatapb.KeyRange) {
+	parts := strings.SplitN(hostname, "/", 2)
+	if len(parts) != 2 || explainTopo == nil {
+		return hostname, nil
+	}
+
+	explainTopo.Lock.Lock()
+	defer explainTopo.Lock.Unlock()
+
+	shard, ok := explainTopo.KeyspaceShards[parts[0]][parts[1]]
+	if !ok {
+		return parts[0], nil
+	}
+	return parts[0], shard.KeyRange
+}
+
+// keyRangeFraction returns the fraction of the keyspace id space covered by
+// the given key range. Only the first 8 bytes of the range bounds are
+// considered, which is more than enough precision for an estimate.
+func keyRangeFraction(kr *topodatapb.KeyRange) float64 {
+	if kr == nil {
+		return 1
+	}
+
+	start := float64(keyspaceIDPrefix(kr.Start))
+	end := math.Exp2(64)
+	if len(kr.End) != 0 {
+		end = float64(keyspaceIDPrefix(kr.End))
+	}
+	if end <= start {
+		return 0
+	}
+	return (end - start) / math.Exp2(64)
+}
+
+func keyspaceIDPrefix(id []byte) uint64 {
+	var buf [8]byte
+	copy(buf[:], id)
+	return binary.BigEndian.Uint64(buf[:])
+}
+
+// rowEstimator estimates the rows touched by queries sent to a single tablet.
+type rowEstimator struct {
+	tEnv     *tabletEnv
+	keyspace string
+
+	// fraction of each table's rows stored on the tablet
+	fraction float64
+
+	// bind variables of the query being estimated
+	bindVars map[string]*querypb.BindVariable
+}
+
+// estimateSQL returns the estimated number of rows for the given tablet
+// query, or -1 if it can't be estimated.
+func (est *rowEstimator) estimateSQL(sql string) int64 {
+	switch strings.ToLower(sql) {
+	case "begin", "commit", "rollback":
+		return 0
+	}
+
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return -1
+	}
+
+	rows, ok := est.estimateStatement(stmt)
+	if !ok {
+		return -1
+	}
+	return rows
+}
+
+func (est *rowEstimator) estimateStatement(stmt sqlparser.Statement) (int64, bool) {
+	switch stmt := stmt.(type) {
+	case sqlparser.SelectStatement:
+		return est.estimateSelect(stmt)
+	case *sqlparser.Insert:
+		switch rows := stmt.Rows.(type) {
+		case sqlparser.Values:
+			return int64(len(rows)), true
+		case sqlparser.SelectStatement:
+			return est.estimateSelect(rows)
+		}
+		return 0, false
+	case *sqlparser.Update:
+		rows, ok := est.estimateTables(stmt.TableExprs, stmt.Where)
+		return est.applyLimit(rows, stmt.Limit), ok
+	case *sqlparser.Delete:
+		rows, ok := est.estimateTables(stmt.TableExprs, stmt.Where)
+		return est.applyLimit(rows, stmt.Limit), ok
+	}
+
+	// Everything else (savepoints, set statements, ...) doesn't touch any
+	// table rows.
+	return 0, true
+}
+
+func (est *rowEstimator) estimateSelect(stmt sqlparser.SelectStatement) (int64, bool) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		rows, ok := est.estimateTables(stmt.From, stmt.Where)
+		if !ok {
+			return 0, false
+		}
+		if len(stmt.OrderBy) != 0 || len(stmt.GroupBy) != 0 || sqlparser.ContainsAggregation(stmt.SelectExprs) {
+			// The whole result must be computed before the limit applies.
+			return rows, true
+		}
+		return est.applyLimit(rows, stmt.Limit), true
+	case *sqlparser.Union:
+		left, ok := est.estimateSelect(stmt.Left)
+		if !ok {
+			return 0, false
+		}
+		right, ok := est.estimateSelect(stmt.Right)
+		if !ok {
+			return 0, false
+		}
+		return left + right, true
+	}
+	return 0, false
+}
+
+// estimateTables returns the number of rows read from the largest of the
+// given tables once the where clause is taken into account.
+func (est *rowEstimator) estimateTables(tableExprs sqlparser.TableExprs, where *sqlparser.Where) (int64, bool) {
+	var filters []sqlparser.Expr
+	if where != nil {
+		filters = sqlparser.SplitAndExpression(nil, where.Expr)
+	}
+
+	if len(tableExprs) == 0 {
+		// select without a from clause returns a single row
+		return 1, true
+	}
+
+	max := int64(0)
+	for _, tableExpr := range tableExprs {
+		for _, table := range getTables(tableExpr) {
+			var rows int64
+			switch expr := table.Expr.(type) {
+			case sqlparser.TableName:
+				var ok bool
+				rows, ok = est.estimateTable(expr, table.As, filters)
+				if !ok {
+					return 0, false
+				}
+			case *sqlparser.DerivedTable:
+				var ok bool
+				rows, ok = est.estimateSelect(expr.Select)
+				if !ok {
+					return 0, false
+				}
+			default:
+				return 0, false
+			}
+			if rows > max {
+				max = rows
+			}
+		}
+	}
+	return max, true
+}
+
+// estimateTable returns the number of rows of the given table stored on the
+// tablet, narrowed down to the number of point lookups if the filters
+// cover one of the table's unique keys.
+func (est *rowEstimator) estimateTable(tableName sqlparser.TableName, alias sqlparser.TableIdent, filters []sqlparser.Expr) (int64, bool) {
+	name := tableName.Name.String()
+	if strings.ToLower(name) == "dual" {
+		return 1, true
+	}
+
+	count, ok := est.tEnv.tableRowCounts[est.keyspace+"."+name]
+	if !ok {
+		count, ok = est.tEnv.tableRowCounts[name]
+	}
+	if !ok {
+		return 0, false
+	}
+	rows := int64(math.Round(float64(count) * est.fraction))
+
+	qualifier := alias
+	if qualifier.IsEmpty() {
+		qualifier = tableName.Name
+	}
+	for _, cols := range est.tEnv.tableUniqueKeys[sqlparser.String(tableName.Name)] {
+		lookups, ok := est.pointLookups(qualifier, cols, filters)
+		if ok && lookups < rows {
+			rows = lookups
+		}
+	}
+	return rows, true
+}
+
+// pointLookups returns the number of distinct key values selected by the
+// filters if every column of the given unique key is constrained by an
+// equality or IN condition.
+func (est *rowEstimator) pointLookups(qualifier sqlparser.TableIdent, cols []string, filters []sqlparser.Expr) (int64, bool) {
+	lookups := int64(1)
+	for _, col := range cols {
+		values, ok := est.columnValues(qualifier, col, filters)
+		if !ok {
+			return 0, false
+		}
+		lookups *= values
+	}
+	return lookups, true
+}
+
+// columnValues returns the number of values the given column is
+// constrained to by the filters.
+func (est *rowEstimator) columnValues(qualifier sqlparser.TableIdent, col string, filters []sqlparser.Expr) (int64, bool) {
+	for _, filter := range filters {
+		cmp, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok {
+			continue
+		}
+		colName, ok := cmp.Left.(*sqlparser.ColName)
+		if !ok || !colName.Name.EqualString(col) {
+			continue
+		}
+		if !colName.Qualifier.IsEmpty() && colName.Qualifier.Name != qualifier {
+			continue
+		}
+
+		switch cmp.Operator {
+		case sqlparser.EqualOp:
+			if sqlparser.IsValue(cmp.Right) {
+				return 1, true
+			}
+		case sqlparser.InOp:
+			switch right := cmp.Right.(type) {
+			case sqlparser.ValTuple:
+				return int64(len(right)), true
+			case sqlparser.ListArg:
+				if bv, ok := est.bindVars[string(right)]; ok {
+					return int64(len(bv.Values)), true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+// applyLimit caps the number of rows by the limit clause, if any.
+func (est *rowEstimator) applyLimit(rows int64, limit *sqlparser.Limit) int64 {
+	if limit == nil || limit.Rowcount == nil {
+		return rows
+	}
+
+	var val string
+	switch rowcount := limit.Rowcount.(type) {
+	case *sqlparser.Literal:
+		val = rowcount.Val
+	case sqlparser.Argument:
+		bv, ok := est.bindVars[string(rowcount)]
+		if !ok {
+			return rows
+		}
+		val = string(bv.Value)
+	default:
+		return rows
+	}
+
+	n, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || n >= rows {
+		return rows
+	}
+	return n
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestKeyRangeFraction(t *testing.T) {
+	tests := []struct {
+		shard string
+		want  float64
+	}{
+		{shard: "-", want: 1},
+		{shard: "-80", want: 0.5},
+		{shard: "80-", want: 0.5},
+		{shard: "40-c0", want: 0.5},
+		{shard: "80-90", want: 0.0625},
+		{shard: "e8-", want: 0.09375},
+	}
+
+	for _, test := range tests {
+		t.Run(test.shard, func(t *testing.T) {
+			krs, err := key.ParseShardingSpec(test.shard)
+			require.NoError(t, err)
+			require.Len(t, krs, 1)
+
+			assert.Equal(t, test.want, keyRangeFraction(krs[0]))
+		})
+	}
+
+	assert.Equal(t, float64(1), keyRangeFraction(nil))
+}
+
+func TestEstimateSQL(t *testing.T) {
+	ddls, err := parseSchema(`
+		create table user (id bigint, name varchar(64), primary key (id), unique key name_idx (name));
+		create table music (user_id bigint, id bigint, primary key (user_id, id));
+	`, &Options{StrictDDL: true})
+	require.NoError(t, err)
+
+	tEnv, err := newTabletEnvironment(ddls, &Options{
+		TableRowCounts: map[string]int64{
+			"user":     4000,
+			"ks.music": 40000,
+		},
+	})
+	require.NoError(t, err)
+
+	est := &rowEstimator{
+		tEnv:     tEnv,
+		keyspace: "ks",
+		fraction: 0.25,
+		bindVars: map[string]*querypb.BindVariable{
+			"ids":   sqltypes.TestBindVariable([]interface{}{1, 2, 3}),
+			"limit": sqltypes.Int64BindVariable(5),
+		},
+	}
+
+	tests := []struct {
+		sql  string
+		want int64
+	}{
+		{sql: "begin", want: 0},
+		{sql: "select 1 from dual", want: 1},
+		{sql: "select * from user", want: 1000},
+		{sql: "select * from user where id = 1", want: 1},
+		{sql: "select * from user where name = 'foo'", want: 1},
+		{sql: "select * from user where id in (1, 2)", want: 2},
+		{sql: "select * from user where id in ::ids", want: 3},
+		{sql: "select * from user where id > 1", want: 1000},
+		{sql: "select * from user limit :limit", want: 5},
+		{sql: "select * from user order by name limit 5", want: 1000},
+		{sql: "select count(*) from user limit 5", want: 1000},
+		{sql: "select * from music where user_id = 1", want: 10000},
+		{sql: "select * from music where user_id = 1 and id in (1, 2)", want: 2},
+		{sql: "select * from user join music on user.id = music.user_id where user.id = 1", want: 10000},
+		{sql: "select * from (select * from user where id = 1) as u", want: 1},
+		{sql: "select * from user where id = 1 union select * from user where id in (2, 3)", want: 3},
+		{sql: "insert into user(id, name) values (1, 'a'), (2, 'b')", want: 2},
+		{sql: "update user set name = 'a' where id in ::ids", want: 3},
+		{sql: "delete from music limit 10", want: 10},
+		{sql: "select * from unknown_table", want: -1},
+		{sql: "not valid sql", want: -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.sql, func(t *testing.T) {
+			assert.Equal(t, test.want, est.estimateSQL(test.sql))
+		})
+	}
+}
@@ -244,6 +244,14 @@ func TestJSONOutput(t *testing.T) {
 		t.Errorf("expected SQL, got:\n%s", explainJSON)
 	}
 
+	// Without table row counts, the output has no estimates.
+	if _, ok := explain["FanOut"]; ok {
+		t.Errorf("expected no FanOut, got:\n%s", explainJSON)
+	}
+	if _, ok := explain["EstimatedRows"]; ok {
+		t.Errorf("expected no EstimatedRows, got:\n%s", explainJSON)
+	}
+
 	plans, ok := explain["Plans"].([]interface{})
 	if !ok || len(plans) != 1 {
 		t.Errorf("expected single-element plans array, got:\n%s", explainJSON)
@@ -338,3 +346,94 @@ func TestUsingKeyspaceShardMap(t *testing.T) {
 type vtexplainTestTopoVersion struct{}
 
 func (vtexplain *vtexplainTestTopoVersion) String() string { return "vtexplain-test-topo" }
+
+func TestRowEstimates(t *testing.T) {
+	schema, err := os.ReadFile("testdata/test-schema.sql")
+	require.NoError(t, err)
+
+	vSchema, err := os.ReadFile("testdata/test-vschema.json")
+	require.NoError(t, err)
+
+	srvVSchema := fmt.Sprintf(`{
+		"keyspaces": %s,
+		"routing_rules": {"rules": [{"from_table": "t1_alias", "to_tables": ["ks_unsharded.t1"]}]}
+	}`, vSchema)
+	shardmap := `{"ks_sharded": ["-40", "40-c0", "c0-"]}`
+
+	opts := defaultTestOpts()
+	opts.ExecutionMode = ModeMulti
+	opts.TableRowCounts = map[string]int64{
+		"user":            1000,
+		"ks_unsharded.t1": 50,
+	}
+	err = Init(srvVSchema, string(schema), shardmap, opts)
+	require.NoError(t, err)
+
+	tests := []struct {
+		sql     string
+		fanOut  int
+		rows    int64
+		tablets map[string]int64
+	}{
+		{
+			sql:     "select * from user where id = 1",
+			fanOut:  1,
+			rows:    1,
+			tablets: map[string]int64{"ks_sharded/-40": 1},
+		},
+		{
+			sql:    "select * from user",
+			fanOut: 3,
+			rows:   1000,
+			tablets: map[string]int64{
+				"ks_sharded/-40":   250,
+				"ks_sharded/40-c0": 500,
+				"ks_sharded/c0-":   250,
+			},
+		},
+		{
+			sql:     "select * from user limit 10",
+			fanOut:  3,
+			rows:    30,
+			tablets: map[string]int64{"ks_sharded/-40": 10, "ks_sharded/40-c0": 10, "ks_sharded/c0-": 10},
+		},
+		{
+			sql:     "select * from t1_alias",
+			fanOut:  1,
+			rows:    50,
+			tablets: map[string]int64{"ks_unsharded/-": 50},
+		},
+		{
+			sql:     "select * from music",
+			fanOut:  3,
+			rows:    -1,
+			tablets: map[string]int64{"ks_sharded/-40": -1, "ks_sharded/40-c0": -1, "ks_sharded/c0-": -1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.sql, func(t *testing.T) {
+			explains, err := Run(test.sql)
+			require.NoError(t, err)
+			require.Len(t, explains, 1)
+
+			explain := explains[0]
+			require.NotNil(t, explain.FanOut)
+			require.Equal(t, test.fanOut, *explain.FanOut)
+			if test.rows < 0 {
+				require.Nil(t, explain.EstimatedRows)
+			} else {
+				require.NotNil(t, explain.EstimatedRows)
+				require.Equal(t, test.rows, *explain.EstimatedRows)
+			}
+
+			tablets := map[string]int64{}
+			for tablet, actions := range explain.TabletActions {
+				for _, tq := range actions.TabletQueries {
+					tablets[tablet] = tq.EstimatedRows
+				}
+			}
+			require.Equal(t, test.tablets, tablets)
+		})
+	}
+}
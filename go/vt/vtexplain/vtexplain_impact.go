@@ -0,0 +1,548 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+
+	"vitess.io/vitess/go/jsonutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Schema is a set of SQL table definitions and the vschema used to route
+// queries to them.
+type Schema struct {
+	// SQLSchema holds the CREATE TABLE statements
+	SQLSchema string
+
+	// VSchema is a map of keyspace name to keyspace vschema, or a full
+	// SrvVSchema
+	VSchema string
+}
+
+// LoggedQuery is a distinct query found in a query log.
+type LoggedQuery struct {
+	// SQL of the first occurrence of the query
+	SQL string
+
+	// Bind variables of the first occurrence of the query, which are
+	// substituted into SQL before planning it
+	BindVars map[string]*querypb.BindVariable `json:",omitempty"`
+
+	// Number of occurrences of the query, i.e. of queries that only differ
+	// in their literal values and comments
+	Count int
+}
+
+// QueryImpact describes how a query is planned under the current and the
+// proposed schema.
+type QueryImpact struct {
+	SQL   string
+	Count int
+
+	// JSON descriptions of the vtgate plans
+	CurrentPlan  string `json:",omitempty"`
+	ProposedPlan string `json:",omitempty"`
+
+	// Planning or execution errors
+	CurrentError  string `json:",omitempty"`
+	ProposedError string `json:",omitempty"`
+
+	// Whether or not the query is sent to all shards of a keyspace
+	CurrentScatter  bool
+	ProposedScatter bool
+
+	// Human-readable diff between the current and proposed plans
+	PlanDiff string `json:",omitempty"`
+}
+
+// ImpactReport is the result of analyzing a schema change against a set of
+// queries.
+type ImpactReport struct {
+	// Number of distinct queries that were planned
+	Queries int
+
+	// Number of distinct queries that were skipped because they aren't
+	// SELECT, INSERT, REPLACE, UPDATE or DELETE statements
+	Skipped int
+
+	// Queries that succeed under the current schema but fail under the
+	// proposed one
+	NewFailures []*QueryImpact
+
+	// Queries that fail under the current schema but succeed under the
+	// proposed one
+	FixedQueries []*QueryImpact
+
+	// Queries that fail under both the current and the proposed schema
+	FailingQueries []*QueryImpact
+
+	// Queries that are sent to all shards of a keyspace under the proposed
+	// schema but not under the current one. They are also listed in
+	// PlanChanges.
+	NewScatters []*QueryImpact
+
+	// Queries whose plan differs between the current and proposed schema
+	PlanChanges []*QueryImpact
+}
+
+// queryPlan is the outcome of planning a single query.
+type queryPlan struct {
+	plan    string
+	scatter bool
+	err     error
+}
+
+// ParseQueryLog extracts the distinct queries from a query log. Each line is
+// either an entry of the vtgate query log, in the text or json format, or a
+// plain SQL statement. Queries that only differ in their literal values or
+// comments are grouped together.
+func ParseQueryLog(queryLog string) ([]*LoggedQuery, error) {
+	var queries []*LoggedQuery
+	byKey := make(map[string]*LoggedQuery)
+
+	scanner := bufio.NewScanner(strings.NewReader(queryLog))
+	scanner.Buffer(nil, 16*1024*1024)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		sql, bindVars, err := parseQueryLogLine(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNum, err)
+		}
+		if sql == "" {
+			continue
+		}
+
+		key := queryLogKey(sql)
+		if q, ok := byKey[key]; ok {
+			q.Count++
+			continue
+		}
+
+		q := &LoggedQuery{SQL: sql, BindVars: bindVars, Count: 1}
+		byKey[key] = q
+		queries = append(queries, q)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return queries, nil
+}
+
+// parseQueryLogLine returns the SQL and bind variables of a single query
+// log line.
+func parseQueryLogLine(line string) (string, map[string]*querypb.BindVariable, error) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return "", nil, nil
+	}
+
+	if strings.HasPrefix(line, "{") {
+		var entry struct {
+			SQL      string
+			BindVars json.RawMessage
+		}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return "", nil, err
+		}
+		bindVars, err := parseJSONBindVars(entry.BindVars)
+		if err != nil {
+			return "", nil, fmt.Errorf("cannot parse bind variables: %v", err)
+		}
+		return strings.TrimSpace(entry.SQL), bindVars, nil
+	}
+
+	// The text format of the vtgate query log is tab separated, with the
+	// quoted SQL in the 13th field and the bind variables in the 14th.
+	if fields := strings.Split(line, "\t"); len(fields) >= 14 {
+		sql, err := strconv.Unquote(fields[12])
+		if err != nil {
+			return "", nil, fmt.Errorf("cannot unquote SQL %s: %v", fields[12], err)
+		}
+		bindVars, err := parseTextBindVars(fields[13])
+		if err != nil {
+			return "", nil, fmt.Errorf("cannot parse bind variables %s: %v", fields[13], err)
+		}
+		return strings.TrimSpace(sql), bindVars, nil
+	}
+
+	return strings.TrimSuffix(line, ";"), nil, nil
+}
+
+// parseJSONBindVars parses the bind variables of the json format of the
+// vtgate query log, e.g. {"vtg1": {"type": "INT64", "value": 1}}.
+func parseJSONBindVars(data json.RawMessage) (map[string]*querypb.BindVariable, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var entries map[string]struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		// Redacted bind variables are logged as a plain string.
+		var redacted string
+		if json.Unmarshal(data, &redacted) == nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var bindVars map[string]*querypb.BindVariable
+	for name, entry := range entries {
+		// Numbers are logged as JSON numbers, everything else as strings.
+		value := string(entry.Value)
+		if strings.HasPrefix(value, `"`) {
+			if err := json.Unmarshal(entry.Value, &value); err != nil {
+				return nil, err
+			}
+		}
+		bv, err := newLoggedBindVar(entry.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		if bindVars == nil {
+			bindVars = make(map[string]*querypb.BindVariable)
+		}
+		bindVars[name] = bv
+	}
+	return bindVars, nil
+}
+
+var (
+	textBindVarRE   = regexp.MustCompile(`(\w+):type:\s*(\w+)`)
+	textValueRE     = regexp.MustCompile(`^\s*value:\s*("(?:[^"\\]|\\.)*")`)
+	textTupleValRE  = regexp.MustCompile(`values:\s*\{\s*type:\s*(\w+)\s+value:\s*("(?:[^"\\]|\\.)*")\s*\}`)
+	redactedTupleRE = regexp.MustCompile(`^(\d+) items$`)
+)
+
+// parseTextBindVars parses the bind variables of the text format of the
+// vtgate query log, which are printed as a go map of protobuf text values,
+// e.g. map[vtg1:type:INT64 value:"1"].
+func parseTextBindVars(field string) (map[string]*querypb.BindVariable, error) {
+	if !strings.HasPrefix(field, "map[") || !strings.HasSuffix(field, "]") {
+		// Redacted bind variables
+		return nil, nil
+	}
+	field = field[len("map[") : len(field)-1]
+
+	var bindVars map[string]*querypb.BindVariable
+	matches := textBindVarRE.FindAllStringSubmatchIndex(field, -1)
+	for i, m := range matches {
+		name, typ := field[m[2]:m[3]], field[m[4]:m[5]]
+		end := len(field)
+		if i+1 < len(matches) {
+			end = matches[i+1][0]
+		}
+
+		bv, err := parseTextBindVar(typ, field[m[1]:end])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		if bindVars == nil {
+			bindVars = make(map[string]*querypb.BindVariable)
+		}
+		bindVars[name] = bv
+	}
+	return bindVars, nil
+}
+
+// parseTextBindVar parses the protobuf text of a bind variable of the given
+// type, past its type field.
+func parseTextBindVar(typ, text string) (*querypb.BindVariable, error) {
+	if typ != querypb.Type_TUPLE.String() {
+		var value string
+		if m := textValueRE.FindStringSubmatch(text); m != nil {
+			var err error
+			if value, err = strconv.Unquote(m[1]); err != nil {
+				return nil, err
+			}
+		}
+		return newLoggedBindVar(typ, value)
+	}
+
+	bv := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	for _, m := range textTupleValRE.FindAllStringSubmatch(text, -1) {
+		t, ok := querypb.Type_value[m[1]]
+		if !ok {
+			return nil, fmt.Errorf("unknown type %s", m[1])
+		}
+		value, err := strconv.Unquote(m[2])
+		if err != nil {
+			return nil, err
+		}
+		bv.Values = append(bv.Values, &querypb.Value{Type: querypb.Type(t), Value: []byte(value)})
+	}
+	return bv, nil
+}
+
+// newLoggedBindVar returns the bind variable of the given type and value.
+// The json format only logs the type of tuples, and unless vtgate is asked
+// for the full bind variables, both formats only log their length as an
+// "<n> items" string. Such tuples are turned into tuples of placeholder
+// values, so that the query can still be planned.
+func newLoggedBindVar(typ, value string) (*querypb.BindVariable, error) {
+	t, ok := querypb.Type_value[typ]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %s", typ)
+	}
+	switch querypb.Type(t) {
+	case querypb.Type_TUPLE:
+		return placeholderTuple(1), nil
+	case querypb.Type_VARCHAR:
+		if m := redactedTupleRE.FindStringSubmatch(value); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			return placeholderTuple(n), nil
+		}
+	}
+	return &querypb.BindVariable{Type: querypb.Type(t), Value: []byte(value)}, nil
+}
+
+func placeholderTuple(n int) *querypb.BindVariable {
+	bv := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	for i := 0; i < n; i++ {
+		bv.Values = append(bv.Values, &querypb.Value{Type: querypb.Type_INT64, Value: []byte(strconv.Itoa(i))})
+	}
+	return bv
+}
+
+// boundSQL returns the SQL of the query with its bind variables substituted.
+func (q *LoggedQuery) boundSQL() (string, error) {
+	if len(q.BindVars) == 0 {
+		return q.SQL, nil
+	}
+	stmt, err := sqlparser.Parse(q.SQL)
+	if err != nil {
+		return "", err
+	}
+	return sqlparser.NewParsedQuery(stmt).GenerateQuery(q.BindVars, nil)
+}
+
+// queryLogKey returns the key used to group similar queries together.
+func queryLogKey(sql string) string {
+	stripped, _ := sqlparser.SplitMarginComments(sql)
+	redacted, err := sqlparser.RedactSQLQuery(stripped)
+	if err != nil {
+		return sql
+	}
+	return redacted
+}
+
+// AnalyzeImpact plans the given queries under both the current and the
+// proposed schema and reports the queries whose plans change, that start
+// failing or that become scatter queries. The shard map and options are
+// the same as for Init, and are used for both schemas.
+//
+// AnalyzeImpact initializes the explain environment itself, so the caller
+// doesn't need to call Init, but should call Stop once done.
+func AnalyzeImpact(queries []*LoggedQuery, current, proposed *Schema, ksShardMapStr string, opts *Options) (*ImpactReport, error) {
+	report := &ImpactReport{}
+
+	var planned []*LoggedQuery
+	for _, q := range queries {
+		switch sqlparser.Preview(q.SQL) {
+		case sqlparser.StmtSelect, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+			planned = append(planned, q)
+		default:
+			report.Skipped++
+		}
+	}
+	report.Queries = len(planned)
+
+	currentPlans, err := planQueries(planned, current, ksShardMapStr, opts)
+	if err != nil {
+		return nil, fmt.Errorf("current schema: %v", err)
+	}
+
+	proposedPlans, err := planQueries(planned, proposed, ksShardMapStr, opts)
+	if err != nil {
+		return nil, fmt.Errorf("proposed schema: %v", err)
+	}
+
+	for i, q := range planned {
+		cur, prop := currentPlans[i], proposedPlans[i]
+		impact := &QueryImpact{
+			SQL:             q.SQL,
+			Count:           q.Count,
+			CurrentPlan:     cur.plan,
+			ProposedPlan:    prop.plan,
+			CurrentScatter:  cur.scatter,
+			ProposedScatter: prop.scatter,
+		}
+		if cur.err != nil {
+			impact.CurrentError = cur.err.Error()
+		}
+		if prop.err != nil {
+			impact.ProposedError = prop.err.Error()
+		}
+
+		switch {
+		case cur.err == nil && prop.err != nil:
+			report.NewFailures = append(report.NewFailures, impact)
+		case cur.err != nil && prop.err == nil:
+			report.FixedQueries = append(report.FixedQueries, impact)
+		case cur.err != nil && prop.err != nil:
+			report.FailingQueries = append(report.FailingQueries, impact)
+		case cur.err == nil && cur.plan != prop.plan:
+			impact.PlanDiff = cmp.Diff(cur.plan, prop.plan)
+			report.PlanChanges = append(report.PlanChanges, impact)
+			if !cur.scatter && prop.scatter {
+				report.NewScatters = append(report.NewScatters, impact)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// planQueries initializes the explain environment with the given schema and
+// plans each of the queries.
+func planQueries(queries []*LoggedQuery, schema *Schema, ksShardMapStr string, opts *Options) ([]*queryPlan, error) {
+	Stop()
+	if err := Init(schema.VSchema, schema.SQLSchema, ksShardMapStr, opts); err != nil {
+		return nil, err
+	}
+
+	plans := make([]*queryPlan, 0, len(queries))
+	for _, q := range queries {
+		sql, err := q.boundSQL()
+		if err != nil {
+			plans = append(plans, &queryPlan{err: fmt.Errorf("cannot bind variables: %v", err)})
+			continue
+		}
+
+		explains, err := Run(sql)
+		if err != nil {
+			plans = append(plans, &queryPlan{err: err})
+			continue
+		}
+
+		plans = append(plans, describePlans(explains))
+	}
+	return plans, nil
+}
+
+// describePlans returns the JSON description of the vtgate plans used for
+// the given explains, and whether any of them is a scatter.
+func describePlans(explains []*Explain) *queryPlan {
+	var (
+		descriptions []string
+		scatter      bool
+	)
+	for _, explain := range explains {
+		for _, plan := range explain.Plans {
+			if plan.Instructions == nil {
+				continue
+			}
+
+			description := engine.PrimitiveToPlanDescription(plan.Instructions)
+			if isScatter(description) {
+				scatter = true
+			}
+
+			descJSON, err := jsonutil.MarshalIndentNoEscape(description, "", "  ")
+			if err != nil {
+				return &queryPlan{err: err}
+			}
+			descriptions = append(descriptions, string(descJSON))
+		}
+	}
+
+	// Plans come out of the plan cache in no particular order.
+	sort.Strings(descriptions)
+	return &queryPlan{
+		plan:    strings.Join(descriptions, "\n"),
+		scatter: scatter,
+	}
+}
+
+func isScatter(description engine.PrimitiveDescription) bool {
+	if description.Variant == engine.Scatter.String() {
+		return true
+	}
+	for _, input := range description.Inputs {
+		if isScatter(input) {
+			return true
+		}
+	}
+	return false
+}
+
+// ImpactReportAsText returns a human-readable representation of the report.
+func ImpactReportAsText(report *ImpactReport) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Analyzed %d queries (%d skipped)\n", report.Queries, report.Skipped)
+	fmt.Fprintf(&b, "New failures: %d\n", len(report.NewFailures))
+	fmt.Fprintf(&b, "Fixed queries: %d\n", len(report.FixedQueries))
+	fmt.Fprintf(&b, "Failing queries: %d\n", len(report.FailingQueries))
+	fmt.Fprintf(&b, "New scatter queries: %d\n", len(report.NewScatters))
+	fmt.Fprintf(&b, "Plan changes: %d\n", len(report.PlanChanges))
+
+	writeSection := func(title string, impacts []*QueryImpact, detail func(*QueryImpact) string) {
+		if len(impacts) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n%s\n", title)
+		for _, impact := range impacts {
+			fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
+			fmt.Fprintf(&b, "%s\n", impact.SQL)
+			fmt.Fprintf(&b, "(%d occurrences)\n\n", impact.Count)
+			fmt.Fprintf(&b, "%s\n", strings.TrimRight(detail(impact), "\n"))
+		}
+		fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
+	}
+
+	writeSection("NEW FAILURES", report.NewFailures, func(impact *QueryImpact) string {
+		return impact.ProposedError
+	})
+	writeSection("FIXED QUERIES", report.FixedQueries, func(impact *QueryImpact) string {
+		return impact.CurrentError
+	})
+	writeSection("FAILING QUERIES", report.FailingQueries, func(impact *QueryImpact) string {
+		if impact.CurrentError == impact.ProposedError {
+			return impact.CurrentError
+		}
+		return fmt.Sprintf("current: %s\nproposed: %s", impact.CurrentError, impact.ProposedError)
+	})
+	writeSection("NEW SCATTER QUERIES", report.NewScatters, func(impact *QueryImpact) string {
+		return impact.ProposedPlan
+	})
+	writeSection("PLAN CHANGES", report.PlanChanges, func(impact *QueryImpact) string {
+		return impact.PlanDiff
+	})
+
+	return b.String()
+}
+
+// ImpactReportAsJSON returns a json representation of the report.
+func ImpactReportAsJSON(report *ImpactReport) string {
+	reportJSON, _ := jsonutil.MarshalIndentNoEscape(report, "", "    ")
+	return string(reportJSON)
+}
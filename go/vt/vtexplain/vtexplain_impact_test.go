@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestParseQueryLog(t *testing.T) {
+	textLine := strings.Join([]string{
+		"Execute", "127.0.0.1", "user", "'user'", "'user'",
+		"2022-01-01 00:00:00.000000", "2022-01-01 00:00:00.001000",
+		"0.001000", "0.000100", "0.000800", "0.000000", "SELECT",
+		`"select * from user where id = 2"`, "map[]", "1", "1", `""`, `"ks_sharded"`, `"user"`, `"PRIMARY"`, "",
+	}, "\t")
+
+	queryLog := strings.Join([]string{
+		`{"Method": "Execute", "SQL": "select * from user where id = 1", "BindVars": {}}`,
+		textLine,
+		"/* trace */ select * from user where id = 3;",
+		"",
+		"select * from music",
+	}, "\n")
+
+	queries, err := ParseQueryLog(queryLog)
+	require.NoError(t, err)
+	require.Len(t, queries, 2)
+
+	assert.Equal(t, &LoggedQuery{SQL: "select * from user where id = 1", Count: 3}, queries[0])
+	assert.Equal(t, &LoggedQuery{SQL: "select * from music", Count: 1}, queries[1])
+
+	_, err = ParseQueryLog(`{"SQL": `)
+	require.EqualError(t, err, "line 1: unexpected end of JSON input")
+}
+
+// queryLogTextLine returns a line of the text format of the vtgate query log.
+func queryLogTextLine(sql, bindVars string) string {
+	return strings.Join([]string{
+		"Execute", "127.0.0.1", "user", "'user'", "'user'",
+		"2022-01-01 00:00:00.000000", "2022-01-01 00:00:00.001000",
+		"0.001000", "0.000100", "0.000800", "0.000000", "SELECT",
+		fmt.Sprintf("%q", sql), bindVars, "1", "1", `""`, `"ks_sharded"`, `"user"`, `"PRIMARY"`, "",
+	}, "\t")
+}
+
+func TestParseQueryLogBindVars(t *testing.T) {
+	bindVars := map[string]*querypb.BindVariable{
+		"vtg1": sqltypes.Int64BindVariable(1),
+		"vtg2": sqltypes.StringBindVariable(`al"ice`),
+		"vtg3": sqltypes.TestBindVariable([]interface{}{1, 2}),
+	}
+	redactedBindVars := map[string]*querypb.BindVariable{
+		"vtg1": sqltypes.Int64BindVariable(1),
+		"vtg2": sqltypes.StringBindVariable("6 bytes"),
+		"vtg3": sqltypes.TestBindVariable([]interface{}{0, 1}),
+	}
+	sql := "select * from user where id = :vtg1 and name = :vtg2 and col in ::vtg3"
+
+	testcases := []struct {
+		name string
+		line string
+		want map[string]*querypb.BindVariable
+	}{{
+		name: "json",
+		line: fmt.Sprintf(`{"SQL": %q, "BindVars": %s}`, sql, sqltypes.FormatBindVariables(bindVars, true, true)),
+		want: map[string]*querypb.BindVariable{
+			"vtg1": sqltypes.Int64BindVariable(1),
+			"vtg2": sqltypes.StringBindVariable(`al"ice`),
+			"vtg3": sqltypes.TestBindVariable([]interface{}{0}),
+		},
+	}, {
+		name: "json without full bind variables",
+		line: fmt.Sprintf(`{"SQL": %q, "BindVars": %s}`, sql, sqltypes.FormatBindVariables(bindVars, false, true)),
+		want: redactedBindVars,
+	}, {
+		name: "json with redacted bind variables",
+		line: fmt.Sprintf(`{"SQL": %q, "BindVars": "[REDACTED]"}`, sql),
+	}, {
+		name: "text",
+		line: queryLogTextLine(sql, sqltypes.FormatBindVariables(bindVars, true, false)),
+		want: bindVars,
+	}, {
+		name: "text without full bind variables",
+		line: queryLogTextLine(sql, sqltypes.FormatBindVariables(bindVars, false, false)),
+		want: redactedBindVars,
+	}, {
+		name: "text with redacted bind variables",
+		line: queryLogTextLine(sql, `"[REDACTED]"`),
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			queries, err := ParseQueryLog(tc.line)
+			require.NoError(t, err)
+			require.Len(t, queries, 1)
+			assert.Equal(t, sql, queries[0].SQL)
+			utils.MustMatch(t, tc.want, queries[0].BindVars)
+		})
+	}
+
+	_, err := ParseQueryLog(`{"SQL": "select :vtg1", "BindVars": {"vtg1": {"type": "FOO", "value": 1}}}`)
+	require.EqualError(t, err, "line 1: cannot parse bind variables: vtg1: unknown type FOO")
+}
+
+func TestAnalyzeImpact(t *testing.T) {
+	sqlSchema, err := os.ReadFile("testdata/test-schema.sql")
+	require.NoError(t, err)
+
+	vSchema, err := os.ReadFile("testdata/test-vschema.json")
+	require.NoError(t, err)
+
+	// The proposed vschema drops the name lookup vindex of the user table
+	// and removes the music_extra table.
+	var keyspaces map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal(vSchema, &keyspaces))
+	tables := keyspaces["ks_sharded"]["tables"].(map[string]interface{})
+	tables["user"] = map[string]interface{}{
+		"column_vindexes": []interface{}{
+			map[string]interface{}{"column": "id", "name": "hash"},
+		},
+	}
+	delete(tables, "music_extra")
+	proposedVSchema, err := json.Marshal(keyspaces)
+	require.NoError(t, err)
+
+	queries, err := ParseQueryLog(strings.Join([]string{
+		"select * from user where id = 1",
+		"select * from user where name = 'alice'",
+		"select * from user where name = 'bob'",
+		"select * from music_extra where id = 1",
+		"select * from t1",
+		"select * from nonexistent",
+		`{"SQL": "select * from user where id = :vtg1", "BindVars": {"vtg1": {"type": "INT64", "value": 1}}}`,
+		queryLogTextLine("select * from music_extra where id in ::vtg1", `map[vtg1:type:VARCHAR value:"2 items"]`),
+		"begin",
+	}, "\n"))
+	require.NoError(t, err)
+
+	defer Stop()
+	report, err := AnalyzeImpact(
+		queries,
+		&Schema{SQLSchema: string(sqlSchema), VSchema: string(vSchema)},
+		&Schema{SQLSchema: string(sqlSchema), VSchema: string(proposedVSchema)},
+		"",
+		defaultTestOpts(),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, report.Queries)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Empty(t, report.FixedQueries)
+
+	// Both queries on music_extra are planned, the second one with its
+	// logged bind variables.
+	require.Len(t, report.NewFailures, 2)
+	assert.Equal(t, "select * from music_extra where id = 1", report.NewFailures[0].SQL)
+	assert.Equal(t, "select * from music_extra where id in ::vtg1", report.NewFailures[1].SQL)
+	for _, failure := range report.NewFailures {
+		assert.Empty(t, failure.CurrentError)
+		assert.Contains(t, failure.ProposedError, "table music_extra not found")
+	}
+
+	require.Len(t, report.FailingQueries, 1)
+	assert.Equal(t, "select * from nonexistent", report.FailingQueries[0].SQL)
+	assert.Contains(t, report.FailingQueries[0].CurrentError, "table nonexistent not found")
+	assert.Contains(t, report.FailingQueries[0].ProposedError, "table nonexistent not found")
+
+	require.Len(t, report.NewScatters, 1)
+	scatter := report.NewScatters[0]
+	assert.Equal(t, "select * from user where name = 'alice'", scatter.SQL)
+	assert.Equal(t, 2, scatter.Count)
+	assert.False(t, scatter.CurrentScatter)
+	assert.True(t, scatter.ProposedScatter)
+	assert.NotEmpty(t, scatter.PlanDiff)
+
+	require.Len(t, report.PlanChanges, 1)
+	assert.Same(t, scatter, report.PlanChanges[0])
+
+	text := ImpactReportAsText(report)
+	assert.Contains(t, text, "Analyzed 7 queries (1 skipped)")
+	assert.Contains(t, text, "NEW FAILURES")
+	assert.Contains(t, text, "FAILING QUERIES")
+	assert.Contains(t, text, "NEW SCATTER QUERIES")
+	assert.NotContains(t, text, "FIXED QUERIES")
+
+	var decoded ImpactReport
+	require.NoError(t, json.Unmarshal([]byte(ImpactReportAsJSON(report)), &decoded))
+	assert.Equal(t, report, &decoded)
+}
@@ -33,6 +33,9 @@ type ExplainTopo struct {
 	// Map of keyspace name to vschema
 	Keyspaces map[string]*vschemapb.Keyspace
 
+	// Routing rules of the SrvVSchema, if any
+	RoutingRules *vschemapb.RoutingRules
+
 	// Map of ks/shard to test tablet connection
 	TabletConns map[string]*explainTablet
 
@@ -52,7 +55,8 @@ func (et *ExplainTopo) getSrvVSchema() *vschemapb.SrvVSchema {
 	defer et.Lock.Unlock()
 
 	return &vschemapb.SrvVSchema{
-		Keyspaces: et.Keyspaces,
+		Keyspaces:    et.Keyspaces,
+		RoutingRules: et.RoutingRules,
 	}
 }
 
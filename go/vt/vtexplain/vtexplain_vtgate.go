@@ -20,7 +20,9 @@ limitations under the License.
 package vtexplain
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"vitess.io/vitess/go/cache"
@@ -102,15 +104,12 @@ func buildTopology(opts *Options, vschemaStr string, ksShardMapStr string, numSh
 	explainTopo.Lock.Lock()
 	defer explainTopo.Lock.Unlock()
 
-	// We have to use proto's custom json loader so it can
-	// handle string->enum conversion correctly.
-	var srvVSchema vschemapb.SrvVSchema
-	wrappedStr := fmt.Sprintf(`{"keyspaces": %s}`, vschemaStr)
-	err := json2.Unmarshal([]byte(wrappedStr), &srvVSchema)
+	srvVSchema, err := getSrvVSchema(vschemaStr)
 	if err != nil {
 		return err
 	}
 	explainTopo.Keyspaces = srvVSchema.Keyspaces
+	explainTopo.RoutingRules = srvVSchema.RoutingRules
 
 	ksShardMap, err := getKeyspaceShardMap(ksShardMapStr)
 	if err != nil {
@@ -142,16 +141,67 @@ func buildTopology(opts *Options, vschemaStr string, ksShardMapStr string, numSh
 	return err
 }
 
+// getSrvVSchema parses the given vschema, which is either a full SrvVSchema
+// (as returned by GetSrvVSchema, including routing rules) or a map of
+// keyspace name to keyspace vschema.
+func getSrvVSchema(vschemaStr string) (*vschemapb.SrvVSchema, error) {
+	// We have to use proto's custom json loader so it can
+	// handle string->enum conversion correctly.
+	var srvVSchema vschemapb.SrvVSchema
+	if err := json2.Unmarshal([]byte(vschemaStr), &srvVSchema); err == nil && len(srvVSchema.Keyspaces) != 0 {
+		return &srvVSchema, nil
+	}
+
+	srvVSchema.Reset()
+	wrappedStr := fmt.Sprintf(`{"keyspaces": %s}`, vschemaStr)
+	if err := json2.Unmarshal([]byte(wrappedStr), &srvVSchema); err != nil {
+		return nil, err
+	}
+	return &srvVSchema, nil
+}
+
+// getKeyspaceShardMap parses the given keyspace shard map. For each keyspace,
+// the shards are given either as a map of shard name to ShardInfo (the output
+// of FindAllShardsInKeyspace) or as a list of shard names such as
+// ["-40", "40-c0", "c0-"].
 func getKeyspaceShardMap(ksShardMapStr string) (map[string]map[string]*topo.ShardInfo, error) {
 	if ksShardMapStr == "" {
 		return map[string]map[string]*topo.ShardInfo{}, nil
 	}
 
+	var rawShardMap map[string]json.RawMessage
+	if err := json2.Unmarshal([]byte(ksShardMapStr), &rawShardMap); err != nil {
+		return nil, err
+	}
+
 	// keyspace-name -> shard-name -> ShardInfo
-	var ksShardMap map[string]map[string]*topo.ShardInfo
-	err := json2.Unmarshal([]byte(ksShardMapStr), &ksShardMap)
+	ksShardMap := make(map[string]map[string]*topo.ShardInfo, len(rawShardMap))
+	for ks, raw := range rawShardMap {
+		if !bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+			var shardMap map[string]*topo.ShardInfo
+			if err := json2.Unmarshal(raw, &shardMap); err != nil {
+				return nil, fmt.Errorf("keyspace %s: %v", ks, err)
+			}
+			ksShardMap[ks] = shardMap
+			continue
+		}
+
+		var shardNames []string
+		if err := json2.Unmarshal(raw, &shardNames); err != nil {
+			return nil, fmt.Errorf("keyspace %s: %v", ks, err)
+		}
+
+		ksShardMap[ks] = make(map[string]*topo.ShardInfo, len(shardNames))
+		for _, name := range shardNames {
+			name, kr, err := topo.ValidateShardName(name)
+			if err != nil {
+				return nil, fmt.Errorf("keyspace %s: %v", ks, err)
+			}
+			ksShardMap[ks][name] = topo.NewShardInfo(ks, name, &topodatapb.Shard{KeyRange: kr}, nil)
+		}
+	}
 
-	return ksShardMap, err
+	return ksShardMap, nil
 }
 
 func getShardRanges(ks string, vschema *vschemapb.Keyspace, ksShardMap map[string]map[string]*topo.ShardInfo, numShardsPerKeyspace int) ([]*topodatapb.ShardReference, error) {
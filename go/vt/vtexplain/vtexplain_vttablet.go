@@ -51,6 +51,12 @@ type tabletEnv struct {
 
 	// map for each table from the column name to its type
 	tableColumns map[string]map[string]querypb.Type
+
+	// map for each table to the column lists of its primary and unique keys
+	tableUniqueKeys map[string][][]string
+
+	// total number of rows per table, keyed by "table" or "keyspace.table"
+	tableRowCounts map[string]int64
 }
 
 var (
@@ -144,8 +150,9 @@ func (t *explainTablet) Begin(ctx context.Context, target *querypb.Target, optio
 	t.mu.Lock()
 	t.currentTime = batchTime.Wait()
 	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
-		Time: t.currentTime,
-		SQL:  "begin",
+		Time:          t.currentTime,
+		SQL:           "begin",
+		EstimatedRows: -1,
 	})
 
 	t.mu.Unlock()
@@ -158,8 +165,9 @@ func (t *explainTablet) Commit(ctx context.Context, target *querypb.Target, tran
 	t.mu.Lock()
 	t.currentTime = batchTime.Wait()
 	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
-		Time: t.currentTime,
-		SQL:  "commit",
+		Time:          t.currentTime,
+		SQL:           "commit",
+		EstimatedRows: -1,
 	})
 	t.mu.Unlock()
 
@@ -183,9 +191,10 @@ func (t *explainTablet) Execute(ctx context.Context, target *querypb.Target, sql
 	// copy the bindVars into the executor to avoid a data race.
 	bindVariables = sqltypes.CopyBindVariables(bindVariables)
 	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
-		Time:     t.currentTime,
-		SQL:      sql,
-		BindVars: bindVariables,
+		Time:          t.currentTime,
+		SQL:           sql,
+		BindVars:      bindVariables,
+		EstimatedRows: -1,
 	})
 	t.mu.Unlock()
 
@@ -254,9 +263,10 @@ func (t *explainTablet) BeginExecute(ctx context.Context, target *querypb.Target
 	t.currentTime = batchTime.Wait()
 	bindVariables = sqltypes.CopyBindVariables(bindVariables)
 	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
-		Time:     t.currentTime,
-		SQL:      sql,
-		BindVars: bindVariables,
+		Time:          t.currentTime,
+		SQL:           sql,
+		BindVars:      bindVariables,
+		EstimatedRows: -1,
 	})
 	t.mu.Unlock()
 
@@ -272,6 +282,8 @@ func newTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options) (*tablet
 	var tEnv tabletEnv
 
 	tEnv.tableColumns = make(map[string]map[string]querypb.Type)
+	tEnv.tableUniqueKeys = make(map[string][][]string)
+	tEnv.tableRowCounts = opts.TableRowCounts
 	tEnv.schemaQueries = map[string]*sqltypes.Result{
 		"select unix_timestamp()": {
 			Fields: []*querypb.Field{{
@@ -414,6 +426,13 @@ func newTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options) (*tablet
 			continue
 		}
 		for _, idx := range ddl.GetTableSpec().Indexes {
+			if idx.Info.Primary || idx.Info.Unique {
+				cols := make([]string, 0, len(idx.Columns))
+				for _, col := range idx.Columns {
+					cols = append(cols, col.Column.Lowered())
+				}
+				tEnv.tableUniqueKeys[table] = append(tEnv.tableUniqueKeys[table], cols)
+			}
 			if !idx.Info.Primary {
 				continue
 			}
@@ -0,0 +1,241 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envelope
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// avroFormat encodes the change events in Avro, with the single object
+// encoding: each key and value starts with the fingerprint of its schema.
+// The schemas are sent in their Parsing Canonical Form, so that their
+// fingerprint can be computed by the consumer.
+type avroFormat struct{}
+
+var avroTypes = map[kind]string{
+	kindInt16:   `"int"`,
+	kindInt32:   `"int"`,
+	kindInt64:   `"long"`,
+	kindFloat32: `"float"`,
+	kindFloat64: `"double"`,
+	kindString:  `"string"`,
+	kindBytes:   `"bytes"`,
+}
+
+// avroSourceSchema is the schema of the source of the change events.
+const avroSourceSchema = `{"name":"vitess.Source","type":"record","fields":[` +
+	`{"name":"connector","type":"string"},` +
+	`{"name":"ts_ms","type":"long"},` +
+	`{"name":"snapshot","type":"string"},` +
+	`{"name":"keyspace","type":"string"},` +
+	`{"name":"shard","type":"string"},` +
+	`{"name":"table","type":"string"},` +
+	`{"name":"gtid","type":["null","string"]}]}`
+
+func (avroFormat) schemas(t *table) error {
+	prefix := avroName(t.keyspace) + "." + avroName(t.name)
+	var fields []string
+	for _, i := range t.pk {
+		fields = append(fields, avroField(t.columns[i]))
+	}
+	t.keySchema = `{"name":"` + prefix + `.Key","type":"record","fields":[` + strings.Join(fields, ",") + `]}`
+
+	fields = fields[:0]
+	for _, col := range t.columns {
+		fields = append(fields, avroField(col))
+	}
+	row := `{"name":"` + prefix + `.Value","type":"record","fields":[` + strings.Join(fields, ",") + `]}`
+	t.valueSchema = `{"name":"` + prefix + `.Envelope","type":"record","fields":[` +
+		`{"name":"before","type":["null",` + row + `]},` +
+		`{"name":"after","type":["null","` + prefix + `.Value"]},` +
+		`{"name":"source","type":` + avroSourceSchema + `},` +
+		`{"name":"op","type":"string"},` +
+		`{"name":"ts_ms","type":["null","long"]}]}`
+
+	t.keyPrefix = singleObjectHeader(t.keySchema)
+	t.valuePrefix = singleObjectHeader(t.valueSchema)
+	return nil
+}
+
+func avroField(col column) string {
+	typ := avroTypes[col.kind]
+	if col.optional {
+		typ = `["null",` + typ + `]`
+	}
+	return `{"name":"` + avroName(col.name) + `","type":` + typ + `}`
+}
+
+// avroName turns s into a valid Avro name, by replacing the invalid
+// characters with underscores.
+func avroName(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || i > 0 && c >= '0' && c <= '9') {
+			b[i] = '_'
+		}
+	}
+	if len(b) == 0 {
+		return "_"
+	}
+	return string(b)
+}
+
+// singleObjectHeader returns the header of the values of a schema, in the
+// single object encoding.
+func singleObjectHeader(schema string) []byte {
+	b := []byte{0xc3, 0x01, 0, 0, 0, 0, 0, 0, 0, 0}
+	binary.LittleEndian.PutUint64(b[2:], fingerprint64([]byte(schema)))
+	return b
+}
+
+const emptyFingerprint = 0xc15d213aa4d7a795
+
+var fingerprintTable = func() [256]uint64 {
+	var table [256]uint64
+	for i := range table {
+		fp := uint64(i)
+		for j := 0; j < 8; j++ {
+			fp = (fp >> 1) ^ (emptyFingerprint & -(fp & 1))
+		}
+		table[i] = fp
+	}
+	return table
+}()
+
+// fingerprint64 returns the CRC-64-AVRO fingerprint of a schema.
+func fingerprint64(schema []byte) uint64 {
+	fp := uint64(emptyFingerprint)
+	for _, c := range schema {
+		fp = (fp >> 8) ^ fingerprintTable[byte(fp)^c]
+	}
+	return fp
+}
+
+func (avroFormat) encodeKey(t *table, row []sqltypes.Value) ([]byte, error) {
+	b := append([]byte(nil), t.keyPrefix...)
+	for _, i := range t.pk {
+		var err error
+		if b, err = appendAvroField(b, t.columns[i], row[i]); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func (avroFormat) encodeValue(t *table, c *change, now time.Time) ([]byte, error) {
+	b := append([]byte(nil), t.valuePrefix...)
+	var err error
+	if b, err = appendAvroRow(b, t, c.before); err != nil {
+		return nil, err
+	}
+	if b, err = appendAvroRow(b, t, c.after); err != nil {
+		return nil, err
+	}
+	b = appendAvroString(b, "vitess")
+	b = appendAvroLong(b, c.tsMs)
+	if c.snapshot {
+		b = appendAvroString(b, "true")
+	} else {
+		b = appendAvroString(b, "false")
+	}
+	b = appendAvroString(b, t.keyspace)
+	b = appendAvroString(b, c.shard)
+	b = appendAvroString(b, t.name)
+	if c.gtid == "" {
+		b = appendAvroLong(b, 0)
+	} else {
+		b = appendAvroLong(b, 1)
+		b = appendAvroString(b, c.gtid)
+	}
+	b = appendAvroString(b, c.op)
+	b = appendAvroLong(b, 1)
+	b = appendAvroLong(b, now.UnixNano()/int64(time.Millisecond))
+	return b, nil
+}
+
+// appendAvroRow appends a row, as a union of null and the row record.
+func appendAvroRow(b []byte, t *table, row []sqltypes.Value) ([]byte, error) {
+	if row == nil {
+		return appendAvroLong(b, 0), nil
+	}
+	b = appendAvroLong(b, 1)
+	for i, col := range t.columns {
+		var err error
+		if b, err = appendAvroField(b, col, row[i]); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func appendAvroField(b []byte, col column, v sqltypes.Value) ([]byte, error) {
+	val, err := value(col.kind, v)
+	if err != nil {
+		return nil, err
+	}
+	if col.optional {
+		if val == nil {
+			return appendAvroLong(b, 0), nil
+		}
+		b = appendAvroLong(b, 1)
+	}
+	switch val := val.(type) {
+	case nil:
+		// NULL in a NOT NULL column, which happens with the zero dates
+		// of a non strict SQL mode. Use the zero value of the type.
+		switch col.kind {
+		case kindFloat32:
+			return append(b, 0, 0, 0, 0), nil
+		case kindFloat64:
+			return append(b, 0, 0, 0, 0, 0, 0, 0, 0), nil
+		default:
+			return appendAvroLong(b, 0), nil
+		}
+	case int64:
+		return appendAvroLong(b, val), nil
+	case float64:
+		if col.kind == kindFloat32 {
+			var f [4]byte
+			binary.LittleEndian.PutUint32(f[:], math.Float32bits(float32(val)))
+			return append(b, f[:]...), nil
+		}
+		var f [8]byte
+		binary.LittleEndian.PutUint64(f[:], math.Float64bits(val))
+		return append(b, f[:]...), nil
+	case []byte:
+		b = appendAvroLong(b, int64(len(val)))
+		return append(b, val...), nil
+	default:
+		return appendAvroString(b, val.(string)), nil
+	}
+}
+
+// appendAvroLong appends an int or a long, zigzag encoded.
+func appendAvroLong(b []byte, n int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutVarint(buf[:], n)]...)
+}
+
+func appendAvroString(b []byte, s string) []byte {
+	b = appendAvroLong(b, int64(len(s)))
+	return append(b, s...)
+}
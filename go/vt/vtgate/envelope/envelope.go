@@ -0,0 +1,325 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package envelope encodes the row changes of a VStream as self-describing
+// change events, in the Debezium envelope: the before and after images of
+// the row, its primary key, the operation and the source of the change.
+package envelope
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The operations of the change events.
+const (
+	opCreate = "c"
+	opUpdate = "u"
+	opDelete = "d"
+	opRead   = "r"
+)
+
+// kind is the type of a column in the change events.
+type kind int
+
+const (
+	kindInt16 = kind(iota)
+	kindInt32
+	kindInt64
+	kindFloat32
+	kindFloat64
+	kindString
+	kindBytes
+)
+
+// kindOf returns the kind of a MySQL type. The types which can't be
+// represented exactly by a number, like DECIMAL, BIGINT UNSIGNED and the
+// temporal types, are strings.
+func kindOf(typ querypb.Type) kind {
+	switch typ {
+	case sqltypes.Int8, sqltypes.Uint8, sqltypes.Int16:
+		return kindInt16
+	case sqltypes.Uint16, sqltypes.Int24, sqltypes.Uint24, sqltypes.Int32, sqltypes.Year:
+		return kindInt32
+	case sqltypes.Uint32, sqltypes.Int64:
+		return kindInt64
+	case sqltypes.Float32:
+		return kindFloat32
+	case sqltypes.Float64:
+		return kindFloat64
+	case sqltypes.Binary, sqltypes.VarBinary, sqltypes.Blob, sqltypes.Bit, sqltypes.Geometry:
+		return kindBytes
+	default:
+		return kindString
+	}
+}
+
+type column struct {
+	name     string
+	kind     kind
+	optional bool
+}
+
+// table is the schema of a table, as sent by the last FIELD event.
+type table struct {
+	keyspace string
+	name     string
+	fields   []*querypb.Field
+	columns  []column
+	// pk holds the indexes of the primary key columns.
+	pk []int
+
+	// keySchema and valueSchema are sent in the FIELD event.
+	keySchema   string
+	valueSchema string
+	// keyPrefix and valuePrefix are prepended to the encoded keys and
+	// values by the formats.
+	keyPrefix   []byte
+	valuePrefix []byte
+}
+
+// change is a row change to encode.
+type change struct {
+	op       string
+	before   []sqltypes.Value
+	after    []sqltypes.Value
+	shard    string
+	gtid     string
+	tsMs     int64
+	snapshot bool
+}
+
+// format encodes the change events.
+type format interface {
+	// schemas sets the schemas of a table.
+	schemas(t *table) error
+	encodeKey(t *table, row []sqltypes.Value) ([]byte, error)
+	encodeValue(t *table, c *change, now time.Time) ([]byte, error)
+}
+
+// Encoder encodes the row changes of the stream of a shard.
+type Encoder struct {
+	keyspace string
+	shard    string
+	format   format
+	tables   map[string]*table
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// NewEncoder returns an Encoder for the envelope, or nil if the envelope is
+// RAW.
+func NewEncoder(envelope vtgatepb.VStreamEnvelope, keyspace, shard string) (*Encoder, error) {
+	var f format
+	switch envelope {
+	case vtgatepb.VStreamEnvelope_RAW:
+		return nil, nil
+	case vtgatepb.VStreamEnvelope_DEBEZIUM_JSON:
+		f = jsonFormat{}
+	case vtgatepb.VStreamEnvelope_DEBEZIUM_AVRO:
+		f = avroFormat{}
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown VStream envelope: %v", envelope)
+	}
+	return &Encoder{
+		keyspace: keyspace,
+		shard:    shard,
+		format:   f,
+		tables:   make(map[string]*table),
+		now:      time.Now,
+	}, nil
+}
+
+// Encode encodes the row changes of the events of a transaction, or of a
+// batch of rows copied by the stream, which can be split in chunks. The
+// FIELD events are given the schemas of their table, and the ROW events get
+// encoded row changes instead of their row changes. The table names of the
+// events must be qualified by the keyspace.
+func (e *Encoder) Encode(eventss [][]*binlogdatapb.VEvent) error {
+	gtid := ""
+	snapshot := false
+	for _, events := range eventss {
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_GTID:
+				gtid = event.Gtid
+			case binlogdatapb.VEventType_LASTPK:
+				// The rows of the batch were copied.
+				snapshot = true
+			}
+		}
+	}
+	now := e.now()
+	for _, events := range eventss {
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_FIELD:
+				if err := e.setFields(event.FieldEvent); err != nil {
+					return err
+				}
+			case binlogdatapb.VEventType_ROW:
+				if err := e.encodeRows(event.RowEvent, event.Timestamp, gtid, snapshot, now); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (e *Encoder) setFields(fe *binlogdatapb.FieldEvent) error {
+	t := &table{
+		keyspace: e.keyspace,
+		name:     strings.TrimPrefix(fe.TableName, e.keyspace+"."),
+		fields:   fe.Fields,
+	}
+	for i, field := range fe.Fields {
+		t.columns = append(t.columns, column{
+			name:     field.Name,
+			kind:     kindOf(field.Type),
+			optional: field.Flags&uint32(querypb.MySqlFlag_NOT_NULL_FLAG) == 0,
+		})
+		if field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) != 0 {
+			t.pk = append(t.pk, i)
+		}
+	}
+	if err := e.format.schemas(t); err != nil {
+		return err
+	}
+	e.tables[fe.TableName] = t
+	fe.KeySchema = t.keySchema
+	fe.ValueSchema = t.valueSchema
+	return nil
+}
+
+func (e *Encoder) encodeRows(re *binlogdatapb.RowEvent, timestamp int64, gtid string, snapshot bool, now time.Time) error {
+	t, ok := e.tables[re.TableName]
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "no fields for the rows of table %s", re.TableName)
+	}
+	for _, rc := range re.RowChanges {
+		before, err := t.row(rc.Before)
+		if err != nil {
+			return err
+		}
+		after, err := t.row(rc.After)
+		if err != nil {
+			return err
+		}
+		c := &change{
+			before:   before,
+			after:    after,
+			shard:    e.shard,
+			gtid:     gtid,
+			tsMs:     timestamp * 1000,
+			snapshot: snapshot,
+		}
+		switch {
+		case before == nil && snapshot:
+			c.op = opRead
+		case before == nil:
+			c.op = opCreate
+		case after == nil:
+			c.op = opDelete
+		case !t.samePK(before, after):
+			// Like Debezium, the update of a primary key is a delete of the
+			// old row followed by a create of the new one.
+			del := *c
+			del.op, del.after = opDelete, nil
+			if err := e.appendChange(re, t, &del, now); err != nil {
+				return err
+			}
+			c.op, c.before = opCreate, nil
+		default:
+			c.op = opUpdate
+		}
+		if err := e.appendChange(re, t, c, now); err != nil {
+			return err
+		}
+	}
+	re.RowChanges = nil
+	return nil
+}
+
+func (e *Encoder) appendChange(re *binlogdatapb.RowEvent, t *table, c *change, now time.Time) error {
+	encoded := &binlogdatapb.EncodedRowChange{}
+	if len(t.pk) != 0 {
+		row := c.after
+		if row == nil {
+			row = c.before
+		}
+		key, err := e.format.encodeKey(t, row)
+		if err != nil {
+			return err
+		}
+		encoded.Key = key
+	}
+	value, err := e.format.encodeValue(t, c, now)
+	if err != nil {
+		return err
+	}
+	encoded.Value = value
+	re.EncodedRowChanges = append(re.EncodedRowChanges, encoded)
+	return nil
+}
+
+// row converts a row of the table to values.
+func (t *table) row(row *querypb.Row) ([]sqltypes.Value, error) {
+	if row == nil {
+		return nil, nil
+	}
+	if len(row.Lengths) != len(t.fields) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "row of table %s has %d values, want %d", t.name, len(row.Lengths), len(t.fields))
+	}
+	// The rows were checked against the fields by the stream.
+	return sqltypes.MakeRowTrusted(t.fields, row), nil
+}
+
+func (t *table) samePK(before, after []sqltypes.Value) bool {
+	for _, i := range t.pk {
+		if before[i].IsNull() != after[i].IsNull() || !bytes.Equal(before[i].Raw(), after[i].Raw()) {
+			return false
+		}
+	}
+	return true
+}
+
+// value converts a value to the Go type of its kind: int64, float64,
+// string or []byte, or nil for NULL.
+func value(k kind, v sqltypes.Value) (interface{}, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	switch k {
+	case kindInt16, kindInt32, kindInt64:
+		return v.ToInt64()
+	case kindFloat32, kindFloat64:
+		return v.ToFloat64()
+	case kindBytes:
+		return v.Raw(), nil
+	default:
+		return v.ToString(), nil
+	}
+}
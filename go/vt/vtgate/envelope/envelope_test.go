@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envelope
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var testFields = []*querypb.Field{
+	{Name: "id", Type: sqltypes.Int64, Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG)},
+	{Name: "name", Type: sqltypes.VarChar},
+	{Name: "price", Type: sqltypes.Float32},
+	{Name: "data", Type: sqltypes.VarBinary},
+}
+
+func testRow(id int64, name string) *querypb.Row {
+	return sqltypes.RowToProto3([]sqltypes.Value{
+		sqltypes.NewInt64(id),
+		sqltypes.NewVarChar(name),
+		sqltypes.NewFloat64(1.5),
+		sqltypes.NULL,
+	})
+}
+
+func newTestEncoder(t *testing.T, envelope vtgatepb.VStreamEnvelope) *Encoder {
+	e, err := NewEncoder(envelope, "ks", "-80")
+	require.NoError(t, err)
+	e.now = func() time.Time { return time.Unix(1600000001, 0) }
+	return e
+}
+
+func testEvents(changes ...*binlogdatapb.RowChange) [][]*binlogdatapb.VEvent {
+	return [][]*binlogdatapb.VEvent{{
+		{Type: binlogdatapb.VEventType_BEGIN},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.t1", Fields: testFields}},
+	}, {
+		{Type: binlogdatapb.VEventType_ROW, Timestamp: 1600000000, RowEvent: &binlogdatapb.RowEvent{TableName: "ks.t1", RowChanges: changes}},
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "MySQL56/uuid:1-10"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}}
+}
+
+func TestNewEncoder(t *testing.T) {
+	e, err := NewEncoder(vtgatepb.VStreamEnvelope_RAW, "ks", "-80")
+	require.NoError(t, err)
+	assert.Nil(t, e)
+	_, err = NewEncoder(vtgatepb.VStreamEnvelope(10), "ks", "-80")
+	assert.EqualError(t, err, "unknown VStream envelope: 10")
+}
+
+func TestJSON(t *testing.T) {
+	e := newTestEncoder(t, vtgatepb.VStreamEnvelope_DEBEZIUM_JSON)
+	eventss := testEvents(&binlogdatapb.RowChange{After: testRow(1, "a")}, &binlogdatapb.RowChange{Before: testRow(1, "a"), After: testRow(1, "b")})
+	require.NoError(t, e.Encode(eventss))
+
+	keySchema := `{"type":"struct","fields":[{"type":"int64","optional":false,"field":"id"}],"optional":false,"name":"ks.t1.Key"}`
+	valueSchema := `{"type":"struct","fields":[` +
+		`{"type":"struct","fields":[{"type":"int64","optional":false,"field":"id"},{"type":"string","optional":true,"field":"name"},{"type":"float","optional":true,"field":"price"},{"type":"bytes","optional":true,"field":"data"}],"optional":true,"name":"ks.t1.Value","field":"before"},` +
+		`{"type":"struct","fields":[{"type":"int64","optional":false,"field":"id"},{"type":"string","optional":true,"field":"name"},{"type":"float","optional":true,"field":"price"},{"type":"bytes","optional":true,"field":"data"}],"optional":true,"name":"ks.t1.Value","field":"after"},` +
+		`{"type":"struct","fields":[{"type":"string","optional":false,"field":"connector"},{"type":"int64","optional":false,"field":"ts_ms"},{"type":"string","optional":false,"field":"snapshot"},{"type":"string","optional":false,"field":"keyspace"},{"type":"string","optional":false,"field":"shard"},{"type":"string","optional":false,"field":"table"},{"type":"string","optional":true,"field":"gtid"}],"optional":false,"name":"vitess.Source","field":"source"},` +
+		`{"type":"string","optional":false,"field":"op"},` +
+		`{"type":"int64","optional":true,"field":"ts_ms"}],"optional":false,"name":"ks.t1.Envelope"}`
+	fe := eventss[0][1].FieldEvent
+	assert.Equal(t, keySchema, fe.KeySchema)
+	assert.Equal(t, valueSchema, fe.ValueSchema)
+
+	re := eventss[1][0].RowEvent
+	assert.Nil(t, re.RowChanges)
+	require.Len(t, re.EncodedRowChanges, 2)
+	source := `"source":{"connector":"vitess","ts_ms":1600000000000,"snapshot":"false","keyspace":"ks","shard":"-80","table":"t1","gtid":"MySQL56/uuid:1-10"}`
+	assert.Equal(t, `{"schema":`+keySchema+`,"payload":{"id":1}}`, string(re.EncodedRowChanges[0].Key))
+	assert.Equal(t, `{"schema":`+valueSchema+`,"payload":{"before":null,"after":{"id":1,"name":"a","price":1.5,"data":null},`+source+`,"op":"c","ts_ms":1600000001000}}`,
+		string(re.EncodedRowChanges[0].Value))
+	assert.Equal(t, `{"schema":`+valueSchema+`,"payload":{"before":{"id":1,"name":"a","price":1.5,"data":null},"after":{"id":1,"name":"b","price":1.5,"data":null},`+source+`,"op":"u","ts_ms":1600000001000}}`,
+		string(re.EncodedRowChanges[1].Value))
+}
+
+func TestOperations(t *testing.T) {
+	e := newTestEncoder(t, vtgatepb.VStreamEnvelope_DEBEZIUM_JSON)
+
+	// The update of a primary key is a delete and a create.
+	eventss := testEvents(&binlogdatapb.RowChange{Before: testRow(1, "a"), After: testRow(2, "a")}, &binlogdatapb.RowChange{Before: testRow(2, "a")})
+	require.NoError(t, e.Encode(eventss))
+	changes := eventss[1][0].RowEvent.EncodedRowChanges
+	require.Len(t, changes, 3)
+	assert.Contains(t, string(changes[0].Key), `"payload":{"id":1}`)
+	assert.Contains(t, string(changes[0].Value), `"op":"d"`)
+	assert.Contains(t, string(changes[1].Key), `"payload":{"id":2}`)
+	assert.Contains(t, string(changes[1].Value), `"before":null,"after":{"id":2`)
+	assert.Contains(t, string(changes[1].Value), `"op":"c"`)
+	assert.Contains(t, string(changes[2].Value), `"after":null`)
+	assert.Contains(t, string(changes[2].Value), `"op":"d"`)
+
+	// The rows of a copy are reads.
+	eventss = [][]*binlogdatapb.VEvent{{
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "ks.t1", RowChanges: []*binlogdatapb.RowChange{{After: testRow(3, "c")}}}},
+		{Type: binlogdatapb.VEventType_LASTPK},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}}
+	require.NoError(t, e.Encode(eventss))
+	changes = eventss[0][0].RowEvent.EncodedRowChanges
+	require.Len(t, changes, 1)
+	assert.Contains(t, string(changes[0].Value), `"snapshot":"true","keyspace":"ks","shard":"-80","table":"t1","gtid":null},"op":"r"`)
+
+	// Rows of a table without fields can't be encoded.
+	eventss = [][]*binlogdatapb.VEvent{{
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "ks.t2", RowChanges: []*binlogdatapb.RowChange{{After: testRow(3, "c")}}}},
+	}}
+	assert.EqualError(t, e.Encode(eventss), "no fields for the rows of table ks.t2")
+}
+
+func TestAvro(t *testing.T) {
+	e := newTestEncoder(t, vtgatepb.VStreamEnvelope_DEBEZIUM_AVRO)
+	eventss := testEvents(&binlogdatapb.RowChange{Before: testRow(1, "a")})
+	require.NoError(t, e.Encode(eventss))
+
+	fe := eventss[0][1].FieldEvent
+	keySchema := `{"name":"ks.t1.Key","type":"record","fields":[{"name":"id","type":"long"}]}`
+	assert.Equal(t, keySchema, fe.KeySchema)
+	assert.Equal(t, `{"name":"ks.t1.Envelope","type":"record","fields":[`+
+		`{"name":"before","type":["null",{"name":"ks.t1.Value","type":"record","fields":[{"name":"id","type":"long"},{"name":"name","type":["null","string"]},{"name":"price","type":["null","float"]},{"name":"data","type":["null","bytes"]}]}]},`+
+		`{"name":"after","type":["null","ks.t1.Value"]},`+
+		`{"name":"source","type":{"name":"vitess.Source","type":"record","fields":[{"name":"connector","type":"string"},{"name":"ts_ms","type":"long"},{"name":"snapshot","type":"string"},{"name":"keyspace","type":"string"},{"name":"shard","type":"string"},{"name":"table","type":"string"},{"name":"gtid","type":["null","string"]}]}},`+
+		`{"name":"op","type":"string"},`+
+		`{"name":"ts_ms","type":["null","long"]}]}`, fe.ValueSchema)
+
+	header := func(schema string) []byte {
+		b := []byte{0xc3, 0x01, 0, 0, 0, 0, 0, 0, 0, 0}
+		binary.LittleEndian.PutUint64(b[2:], fingerprint64([]byte(schema)))
+		return b
+	}
+	changes := eventss[1][0].RowEvent.EncodedRowChanges
+	require.Len(t, changes, 1)
+	assert.Equal(t, append(header(keySchema), 0x02), changes[0].Key)
+
+	want := header(fe.ValueSchema)
+	// before: the row, with price 1.5 as a float.
+	want = append(want, 0x02, 0x02, 0x02, 0x02, 'a', 0x02, 0x00, 0x00, 0xc0, 0x3f, 0x00)
+	// after: null.
+	want = append(want, 0x00)
+	want = appendAvroString(want, "vitess")
+	want = appendAvroLong(want, 1600000000000)
+	want = appendAvroString(want, "false")
+	want = appendAvroString(want, "ks")
+	want = appendAvroString(want, "-80")
+	want = appendAvroString(want, "t1")
+	want = append(want, 0x02)
+	want = appendAvroString(want, "MySQL56/uuid:1-10")
+	want = appendAvroString(want, "d")
+	want = append(want, 0x02)
+	want = appendAvroLong(want, 1600000001000)
+	assert.Equal(t, want, changes[0].Value)
+}
+
+func TestFingerprint64(t *testing.T) {
+	// From the test cases of the Avro specification.
+	tcases := []struct {
+		schema string
+		want   int64
+	}{
+		{`"null"`, 7195948357588979594},
+		{`"boolean"`, -6970731678124411036},
+		{`"int"`, 8247732601305521295},
+		{`"long"`, -3434872931120570953},
+		{`"string"`, -8142146995180207161},
+	}
+	for _, tcase := range tcases {
+		assert.Equal(t, tcase.want, int64(fingerprint64([]byte(tcase.schema))), tcase.schema)
+	}
+}
+
+func TestAvroName(t *testing.T) {
+	assert.Equal(t, "my_keyspace", avroName("my-keyspace"))
+	assert.Equal(t, "_1t", avroName("11t"))
+	assert.Equal(t, "_", avroName(""))
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envelope
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// jsonFormat encodes the change events like the Kafka Connect JSON
+// converter of Debezium, with schemas enabled: each key and value is a
+// JSON object with the schema and the payload.
+type jsonFormat struct{}
+
+// connectSchema is a Kafka Connect schema.
+type connectSchema struct {
+	Type     string           `json:"type"`
+	Fields   []*connectSchema `json:"fields,omitempty"`
+	Optional bool             `json:"optional"`
+	Name     string           `json:"name,omitempty"`
+	Field    string           `json:"field,omitempty"`
+}
+
+var connectTypes = map[kind]string{
+	kindInt16:   "int16",
+	kindInt32:   "int32",
+	kindInt64:   "int64",
+	kindFloat32: "float",
+	kindFloat64: "double",
+	kindString:  "string",
+	kindBytes:   "bytes",
+}
+
+// sourceSchema is the schema of the source of the change events.
+var sourceSchema = &connectSchema{
+	Type: "struct",
+	Fields: []*connectSchema{
+		{Type: "string", Field: "connector"},
+		{Type: "int64", Field: "ts_ms"},
+		{Type: "string", Field: "snapshot"},
+		{Type: "string", Field: "keyspace"},
+		{Type: "string", Field: "shard"},
+		{Type: "string", Field: "table"},
+		{Type: "string", Optional: true, Field: "gtid"},
+	},
+	Name:  "vitess.Source",
+	Field: "source",
+}
+
+func (jsonFormat) schemas(t *table) error {
+	prefix := t.keyspace + "." + t.name
+	key := &connectSchema{Type: "struct", Name: prefix + ".Key"}
+	for _, i := range t.pk {
+		key.Fields = append(key.Fields, columnSchema(t.columns[i]))
+	}
+	row := func(field string) *connectSchema {
+		s := &connectSchema{Type: "struct", Optional: true, Name: prefix + ".Value", Field: field}
+		for _, col := range t.columns {
+			s.Fields = append(s.Fields, columnSchema(col))
+		}
+		return s
+	}
+	value := &connectSchema{
+		Type: "struct",
+		Fields: []*connectSchema{
+			row("before"),
+			row("after"),
+			sourceSchema,
+			{Type: "string", Field: "op"},
+			{Type: "int64", Optional: true, Field: "ts_ms"},
+		},
+		Name: prefix + ".Envelope",
+	}
+
+	b, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	t.keySchema = string(b)
+	t.keyPrefix = append(append([]byte(`{"schema":`), b...), `,"payload":`...)
+	b, err = json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	t.valueSchema = string(b)
+	t.valuePrefix = append(append([]byte(`{"schema":`), b...), `,"payload":`...)
+	return nil
+}
+
+func columnSchema(col column) *connectSchema {
+	return &connectSchema{Type: connectTypes[col.kind], Optional: col.optional, Field: col.name}
+}
+
+func (jsonFormat) encodeKey(t *table, row []sqltypes.Value) ([]byte, error) {
+	b := append([]byte(nil), t.keyPrefix...)
+	b = append(b, '{')
+	for n, i := range t.pk {
+		if n != 0 {
+			b = append(b, ',')
+		}
+		var err error
+		if b, err = appendJSONField(b, t.columns[i], row[i]); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, "}}"...), nil
+}
+
+func (jsonFormat) encodeValue(t *table, c *change, now time.Time) ([]byte, error) {
+	b := append([]byte(nil), t.valuePrefix...)
+	var err error
+	b = append(b, `{"before":`...)
+	if b, err = appendJSONRow(b, t, c.before); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"after":`...)
+	if b, err = appendJSONRow(b, t, c.after); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"source":{"connector":"vitess","ts_ms":`...)
+	b = strconv.AppendInt(b, c.tsMs, 10)
+	b = append(b, `,"snapshot":`...)
+	b = appendJSONString(b, strconv.FormatBool(c.snapshot))
+	b = append(b, `,"keyspace":`...)
+	b = appendJSONString(b, t.keyspace)
+	b = append(b, `,"shard":`...)
+	b = appendJSONString(b, c.shard)
+	b = append(b, `,"table":`...)
+	b = appendJSONString(b, t.name)
+	b = append(b, `,"gtid":`...)
+	if c.gtid == "" {
+		b = append(b, "null"...)
+	} else {
+		b = appendJSONString(b, c.gtid)
+	}
+	b = append(b, `},"op":`...)
+	b = appendJSONString(b, c.op)
+	b = append(b, `,"ts_ms":`...)
+	b = strconv.AppendInt(b, now.UnixNano()/int64(time.Millisecond), 10)
+	return append(b, "}}"...), nil
+}
+
+func appendJSONRow(b []byte, t *table, row []sqltypes.Value) ([]byte, error) {
+	if row == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, '{')
+	for i, col := range t.columns {
+		if i != 0 {
+			b = append(b, ',')
+		}
+		var err error
+		if b, err = appendJSONField(b, col, row[i]); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, '}'), nil
+}
+
+func appendJSONField(b []byte, col column, v sqltypes.Value) ([]byte, error) {
+	b = appendJSONString(b, col.name)
+	b = append(b, ':')
+	val, err := value(col.kind, v)
+	if err != nil {
+		return nil, err
+	}
+	switch val := val.(type) {
+	case nil:
+		return append(b, "null"...), nil
+	case int64:
+		return strconv.AppendInt(b, val, 10), nil
+	case float64:
+		bitSize := 64
+		if col.kind == kindFloat32 {
+			bitSize = 32
+		}
+		return strconv.AppendFloat(b, val, 'g', -1, bitSize), nil
+	default:
+		// Strings, and bytes in base64.
+		s, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		return append(b, s...), nil
+	}
+}
+
+func appendJSONString(b []byte, s string) []byte {
+	// Marshalling a string can't fail.
+	j, _ := json.Marshal(s)
+	return append(b, j...)
+}
@@ -181,7 +181,9 @@ func (c *WeightStringCallExpr) simplify(env *ExpressionEnv) error {
 }
 
 func simplifyExpr(env *ExpressionEnv, e Expr) (Expr, error) {
-	if e.constant() {
+	// Tuples are kept as such, since their elements are checked
+	// one by one, e.g. by InExpr.
+	if _, tuple := e.(TupleExpr); !tuple && e.constant() {
 		res, err := env.Evaluate(e)
 		if err != nil {
 			return nil, err
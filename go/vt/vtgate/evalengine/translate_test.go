@@ -257,6 +257,12 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: "false is not false",
 		expected:   False,
+	}, {
+		expression: ":exp in (65, 66)",
+		expected:   True,
+	}, {
+		expression: ":exp in (1, 2)",
+		expected:   False,
 	}}
 
 	for _, test := range tests {
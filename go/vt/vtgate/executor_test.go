@@ -115,6 +115,27 @@ func TestExecutorMaxMemoryRowsExceeded(t *testing.T) {
 	}
 }
 
+func TestExecutorPriorityDirective(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+
+	_, err := executor.Execute(ctx, "TestExecutorPriorityDirective", session, "select /*vt+ PRIORITY=10 */ * from main1", nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, sbclookup.Options)
+	assert.Equal(t, "10", sbclookup.Options[len(sbclookup.Options)-1].Priority)
+	// The directive does not leak into the session.
+	assert.Empty(t, session.Options.GetPriority())
+
+	session.GetOrCreateOptions().Priority = "90"
+	_, err = executor.Execute(ctx, "TestExecutorPriorityDirective", session, "select * from main1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "90", sbclookup.Options[len(sbclookup.Options)-1].Priority)
+
+	_, err = executor.Execute(ctx, "TestExecutorPriorityDirective", session, "select /*vt+ PRIORITY=101 */ * from main1", nil)
+	require.EqualError(t, err, "invalid priority value: 101, must be an integer between 0 and 100")
+	assert.Equal(t, "90", session.Options.GetPriority())
+}
+
 func TestLegacyExecutorTransactionsNoAutoCommit(t *testing.T) {
 	executor, _, _, sbclookup := createLegacyExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
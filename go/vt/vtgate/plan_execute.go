@@ -78,6 +78,11 @@ func (e *Executor) newExecute(
 		return err
 	}
 
+	if vcursor.priority != "" {
+		// The PRIORITY directive only applies to the current statement.
+		defer safeSession.SetQueryPriority(vcursor.priority)()
+	}
+
 	if plan.Type != sqlparser.StmtShow {
 		safeSession.ClearWarnings()
 	}
@@ -178,6 +178,9 @@ type selectPlanner func(sqlparser.Statement, *sqlparser.ReservedVars, plancontex
 func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema, enableOnlineDDL, enableDirectDDL bool) (engine.Primitive, error) {
 	switch stmt := stmt.(type) {
 	case *sqlparser.Select:
+		if _, ok := digestsTable(stmt, vschema); ok {
+			return buildRoutePlan(stmt, reservedVars, vschema, buildDigestsPlan)
+		}
 		configuredPlanner, err := getConfiguredPlanner(vschema, buildSelectPlan, stmt, query)
 		if err != nil {
 			return nil, err
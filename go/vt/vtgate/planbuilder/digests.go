@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/vt/key"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/digest"
+)
+
+// digestsTable returns the vitess_digests table read by the select, if
+// it only reads this virtual table of the tablets and the vschema has no
+// table of that name.
+func digestsTable(sel *sqlparser.Select, vschema plancontext.VSchema) (*sqlparser.AliasedTableExpr, bool) {
+	if len(sel.From) != 1 {
+		return nil, false
+	}
+	aliased, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, false
+	}
+	tableName, ok := aliased.Expr.(sqlparser.TableName)
+	if !ok || tableName.Name.String() != digest.TableName {
+		return nil, false
+	}
+	if table, _, _, _, err := vschema.FindTable(tableName); err == nil && table != nil {
+		return nil, false
+	}
+	return aliased, true
+}
+
+// buildDigestsPlan serves the selects on the vitess_digests virtual
+// table. The select is sent to all the shards of the keyspace, whose
+// tablets evaluate it on their own digests. On a sharded keyspace, the
+// rows of all the shards are then sorted and limited again, so that the
+// top digests of the keyspace are returned. Aggregations are still
+// computed per shard.
+func buildDigestsPlan(stmt sqlparser.Statement, _ *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
+	sel := stmt.(*sqlparser.Select)
+	aliased, _ := digestsTable(sel, vschema)
+	tableName := aliased.Expr.(sqlparser.TableName)
+
+	dest, ks, _, err := vschema.TargetDestination(tableName.Qualifier.String())
+	if err != nil {
+		return nil, err
+	}
+	if ks == nil {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.NoDB, "No database selected: use keyspace<:shard><@type> or keyspace<[range]><@type> (<> are optional)")
+	}
+	if dest == nil {
+		dest = key.DestinationAllShards{}
+	}
+
+	// The tablets do not know about the keyspace.
+	tabletSel := *sel
+	tabletSel.From = sqlparser.TableExprs{&sqlparser.AliasedTableExpr{
+		Expr: sqlparser.TableName{Name: tableName.Name},
+		As:   aliased.As,
+	}}
+	var plan engine.Primitive = &engine.Send{
+		Keyspace:          ks,
+		TargetDestination: dest,
+		Query:             sqlparser.String(&tabletSel),
+	}
+	if !ks.Sharded {
+		return plan, nil
+	}
+
+	if len(sel.OrderBy) > 0 {
+		orderBy, err := digestsOrderBy(sel)
+		if err != nil {
+			return nil, err
+		}
+		plan = &engine.MemorySort{OrderBy: orderBy, Input: plan}
+	}
+	if sel.Limit != nil {
+		if sel.Limit.Offset != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: OFFSET on %s of a sharded keyspace", digest.TableName)
+		}
+		count, err := evalengine.Translate(sel.Limit.Rowcount, semantics.EmptySemTable())
+		if err != nil {
+			return nil, vterrors.Wrap(err, "unexpected expression in LIMIT")
+		}
+		plan = &engine.Limit{Count: count, Input: plan}
+	}
+	return plan, nil
+}
+
+// digestsOrderBy returns the sort of the rows of the shards, which can
+// only be on selected columns.
+func digestsOrderBy(sel *sqlparser.Select) ([]engine.OrderByParams, error) {
+	var columns []string
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *sqlparser.StarExpr:
+			columns = append(columns, digest.Columns...)
+		case *sqlparser.AliasedExpr:
+			name := expr.As.String()
+			if col, ok := expr.Expr.(*sqlparser.ColName); ok && name == "" {
+				name = col.Name.String()
+			}
+			columns = append(columns, name)
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s in select with ORDER BY on %s of a sharded keyspace", sqlparser.String(expr), digest.TableName)
+		}
+	}
+
+	orderBy := make([]engine.OrderByParams, 0, len(sel.OrderBy))
+	for _, order := range sel.OrderBy {
+		col := -1
+		switch expr := order.Expr.(type) {
+		case *sqlparser.ColName:
+			for i, name := range columns {
+				if strings.EqualFold(name, expr.Name.String()) {
+					col = i
+					break
+				}
+			}
+		case *sqlparser.Literal:
+			if n, err := strconv.Atoi(expr.Val); err == nil && expr.Type == sqlparser.IntVal && n >= 1 && n <= len(columns) {
+				col = n - 1
+			}
+		}
+		if col == -1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: ORDER BY on %s of a sharded keyspace must reference a selected column: %s", digest.TableName, sqlparser.String(order))
+		}
+		orderBy = append(orderBy, engine.OrderByParams{
+			Col:             col,
+			WeightStringCol: -1,
+			Desc:            order.Direction == sqlparser.DescOrder,
+			CollationID:     collations.Default(),
+		})
+	}
+	return orderBy, nil
+}
@@ -624,6 +624,21 @@ func (session *SafeSession) GetOrCreateOptions() *querypb.ExecuteOptions {
 	return session.Session.Options
 }
 
+// SetQueryPriority overrides the priority of the session options and
+// returns a function that restores the original options.
+func (session *SafeSession) SetQueryPriority(priority string) func() {
+	previous := session.Session.Options
+	options := &querypb.ExecuteOptions{}
+	if previous != nil {
+		options = proto.Clone(previous).(*querypb.ExecuteOptions)
+	}
+	options.Priority = priority
+	session.Session.Options = options
+	return func() {
+		session.Session.Options = previous
+	}
+}
+
 var _ iQueryOption = (*SafeSession)(nil)
 
 func (session *SafeSession) cachePlan() bool {
@@ -26,12 +26,14 @@ import (
 	"vitess.io/vitess/go/vt/discovery"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/envelope"
 
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 
 	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -86,6 +88,15 @@ type vstream struct {
 	// default behavior is to automatically migrate the resharded streams from the old to the new shards
 	stopOnReshard bool
 
+	// this flag is set by the client, default RAW
+	// the encoding of the row changes sent to the client
+	envelope vtgatepb.VStreamEnvelope
+
+	// this flag is set by the client, default 0
+	// if set, streams starting at the current position start instead at the first
+	// transaction committed at or after this time, in seconds since the epoch
+	startTime int64
+
 	// mutex used to synchronize access to skew detection parameters
 	skewMu sync.Mutex
 	// channel is created whenever there is a skew detected. closing it implies the current skew has been fixed
@@ -122,6 +133,16 @@ func newVStreamManager(resolver *srvtopo.Resolver, serv srvtopo.Server, cell str
 
 func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
 	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error {
+	if consumer := flags.GetConsumer(); consumer != "" {
+		if flags.GetStartTime() != nil {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "a start time cannot be used with a VStream consumer")
+		}
+		var err error
+		vgtid, filter, err = vsm.resolveConsumer(ctx, consumer, vgtid, filter)
+		if err != nil {
+			return err
+		}
+	}
 	vgtid, filter, flags, err := vsm.resolveParams(ctx, tabletType, vgtid, filter, flags)
 	if err != nil {
 		return err
@@ -143,6 +164,8 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 		journaler:          make(map[int64]*journalEvent),
 		minimizeSkew:       flags.GetMinimizeSkew(),
 		stopOnReshard:      flags.GetStopOnReshard(),
+		envelope:           flags.GetEnvelope(),
+		startTime:          flags.GetStartTime().GetSeconds(),
 		skewTimeoutSeconds: maxSkewTimeoutSeconds,
 		timestamps:         make(map[string]int64),
 		vsm:                vsm,
@@ -168,6 +191,12 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 	if flags == nil {
 		flags = &vtgatepb.VStreamFlags{}
 	}
+	if _, ok := vtgatepb.VStreamEnvelope_name[int32(flags.Envelope)]; !ok {
+		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown VStream envelope: %v", flags.Envelope)
+	}
+	if flags.StartTime != nil && flags.StartTime.Seconds <= 0 {
+		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid VStream start time: %v", flags.StartTime)
+	}
 	if vgtid == nil || len(vgtid.ShardGtids) == 0 {
 		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vgtid must have at least one value with a starting position")
 	}
@@ -214,12 +243,96 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 			newvgtid.ShardGtids = append(newvgtid.ShardGtids, sgtid)
 		}
 	}
+	// A start time is resolved to a position for each shard when its stream
+	// starts, so it only applies to streams that would start at the current
+	// position.
+	if flags.StartTime != nil {
+		for _, sgtid := range newvgtid.ShardGtids {
+			if sgtid.Gtid != "current" || len(sgtid.TablePKs) != 0 {
+				return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "with a start time, the Gtid value must be 'current': %v", vgtid)
+			}
+		}
+	}
 
 	//TODO add tablepk validations
 
 	return newvgtid, filter, flags, nil
 }
 
+// resolveConsumer returns the vgtid and filter of a stream of the named
+// consumer. The stored position and filter of the consumer are used if the
+// request has none. If the consumer doesn't exist, it is created with the
+// position and filter of the request.
+func (vsm *vstreamManager) resolveConsumer(ctx context.Context, name string, vgtid *binlogdatapb.VGtid,
+	filter *binlogdatapb.Filter) (*binlogdatapb.VGtid, *binlogdatapb.Filter, error) {
+	ts, err := vsm.toposerv.GetTopoServer()
+	if err != nil {
+		return nil, nil, err
+	}
+	if ts == nil {
+		return nil, nil, fmt.Errorf("unable to get topo server")
+	}
+	consumer, err := ts.GetVStreamConsumer(ctx, name)
+	switch {
+	case err == nil:
+		if len(vgtid.GetShardGtids()) == 0 {
+			if consumer.GetPosition().GetVgtid() == nil {
+				return nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "VStream consumer %s has no position", name)
+			}
+			vgtid = consumer.Position.Vgtid
+		}
+		if len(filter.GetRules()) == 0 {
+			filter = consumer.Filter
+		}
+		return vgtid, filter, nil
+	case topo.IsErrType(err, topo.NoNode):
+		if len(vgtid.GetShardGtids()) == 0 {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "VStream consumer %s does not exist: a vgtid is required to create it", name)
+		}
+		consumer = &vtgatepb.VStreamConsumer{
+			Name:   name,
+			Filter: filter,
+			Position: &vtgatepb.VStreamCheckpoint{
+				Vgtid: vgtid,
+				Time:  logutil.TimeToProto(time.Now()),
+			},
+		}
+		if err := ts.CreateVStreamConsumer(ctx, consumer); err != nil && !topo.IsErrType(err, topo.NodeExists) {
+			return nil, nil, err
+		}
+		return vgtid, filter, nil
+	default:
+		return nil, nil, err
+	}
+}
+
+// Ack stores vgtid as the position of the named consumer. It fails if vgtid
+// is not after the stored position, e.g. if it is an older acknowledgement
+// of a stream which was restarted meanwhile.
+func (vsm *vstreamManager) Ack(ctx context.Context, name string, vgtid *binlogdatapb.VGtid) error {
+	if len(vgtid.GetShardGtids()) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vgtid must have at least one value with a position")
+	}
+	ts, err := vsm.toposerv.GetTopoServer()
+	if err != nil {
+		return err
+	}
+	if ts == nil {
+		return fmt.Errorf("unable to get topo server")
+	}
+	checkpoint := &vtgatepb.VStreamCheckpoint{
+		Vgtid: vgtid,
+		Time:  logutil.TimeToProto(time.Now()),
+	}
+	err = ts.UpdateVStreamConsumerFields(ctx, name, func(consumer *vtgatepb.VStreamConsumer) error {
+		return topo.AddVStreamCheckpoint(consumer, checkpoint, *vstreamConsumerHistoryInterval, *vstreamConsumerHistorySize)
+	})
+	if topo.IsErrType(err, topo.NoNode) {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "VStream consumer %s does not exist", name)
+	}
+	return err
+}
+
 func (vsm *vstreamManager) RecordStreamDelay() {
 	vstreamSkewDelayCount.Add(1)
 }
@@ -451,6 +564,23 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 			log.Errorf(err.Error())
 			return err
 		}
+		if vs.startTime != 0 && sgtid.Gtid == "current" {
+			pos, err := tabletConn.VStreamPositionAt(ctx, target, vs.startTime)
+			if err != nil {
+				log.Errorf(err.Error())
+				return err
+			}
+			log.Infof("Resolved start time %d to %s for %s/%s", vs.startTime, pos, sgtid.Keyspace, sgtid.Shard)
+			vs.mu.Lock()
+			sgtid.Gtid = pos
+			vs.mu.Unlock()
+		}
+		// The tablet sends the fields of the tables again, so the encoder
+		// starts afresh.
+		encoder, err := envelope.NewEncoder(vs.envelope, sgtid.Keyspace, sgtid.Shard)
+		if err != nil {
+			return err
+		}
 
 		errCh := make(chan error, 1)
 		go func() {
@@ -517,6 +647,11 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 						return err
 					}
 
+					if encoder != nil {
+						if err := encoder.Encode(eventss); err != nil {
+							return err
+						}
+					}
 					if err := vs.sendAll(sgtid, eventss); err != nil {
 						return err
 					}
@@ -536,6 +671,11 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 					if vs.stopOnReshard && journal.MigrationType == binlogdatapb.MigrationType_SHARDS {
 						sendevents = append(sendevents, event)
 						eventss = append(eventss, sendevents)
+						if encoder != nil {
+							if err := encoder.Encode(eventss); err != nil {
+								return err
+							}
+						}
 						if err := vs.sendAll(sgtid, eventss); err != nil {
 							return err
 						}
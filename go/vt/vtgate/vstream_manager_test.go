@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 
 	"vitess.io/vitess/go/vt/topo"
@@ -43,7 +44,9 @@ import (
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/proto/binlogdata"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
 	"vitess.io/vitess/go/vt/srvtopo"
 )
 
@@ -1068,6 +1071,82 @@ func TestVStreamIdleHeartbeat(t *testing.T) {
 	}
 }
 
+func TestVStreamConsumer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cell := "aa"
+	ks := "TestVStream"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopo(ctx, cell, ks, []string{"-20"})
+	vsm := newTestVStreamManager(hc, st, cell)
+	sbc0 := hc.AddTestTablet(cell, "1.1.1.1", 1001, ks, "-20", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	addTabletToSandboxTopo(t, st, ks, "-20", sbc0.Tablet())
+	flags := &vtgatepb.VStreamFlags{Consumer: "c1"}
+	vgtid := func(gtid string) *binlogdatapb.VGtid {
+		return &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: ks, Shard: "-20", Gtid: gtid}}}
+	}
+	want := func(gtid string) *binlogdatapb.VStreamResponse {
+		return &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_VGTID, Vgtid: vgtid(gtid)},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}}
+	}
+
+	// The consumer doesn't exist and there is no vgtid to create it.
+	err := vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, nil, nil, flags, func(events []*binlogdatapb.VEvent) error { return nil })
+	assert.EqualError(t, err, "VStream consumer c1 does not exist: a vgtid is required to create it")
+	err = vsm.Ack(ctx, "c1", vgtid("gtid01"))
+	assert.EqualError(t, err, "VStream consumer c1 does not exist")
+
+	// The first stream creates the consumer.
+	sbc0.StartPos = "pos"
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"}, {Type: binlogdatapb.VEventType_COMMIT}}, nil)
+	ctx1, cancel1 := context.WithCancel(ctx)
+	ch, done := startConsumerVStream(ctx1, vsm, vgtid("pos"), flags)
+	verifyEvents(t, ch, want("gtid01"))
+	// Wait for the stream to end, so that it doesn't consume the events of the next one.
+	cancel1()
+	<-done
+	consumer, err := st.topoServer.GetVStreamConsumer(ctx, "c1")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(vgtid("pos"), consumer.Position.Vgtid), "got %v", consumer.Position.Vgtid)
+	assert.Nil(t, consumer.Filter)
+
+	// The next stream resumes from the acknowledged position.
+	require.NoError(t, vsm.Ack(ctx, "c1", vgtid("gtid01")))
+	sbc0.StartPos = "gtid01"
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid02"}, {Type: binlogdatapb.VEventType_COMMIT}}, nil)
+	ctx2, cancel2 := context.WithCancel(ctx)
+	ch, done = startConsumerVStream(ctx2, vsm, nil, flags)
+	verifyEvents(t, ch, want("gtid02"))
+	cancel2()
+	<-done
+
+	// The acknowledged shards must be the shards of the consumer.
+	err = vsm.Ack(ctx, "c1", &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: ks, Shard: "20-40", Gtid: "gtid02"}}})
+	assert.EqualError(t, err, "VStream consumer c1: shards [20-40] of keyspace TestVStream do not match the shards [-20] of its position")
+}
+
+// startConsumerVStream starts a VStream like startVStream, and also returns
+// a channel which is closed when the stream ends.
+func startConsumerVStream(ctx context.Context, vsm *vstreamManager, vgtid *binlogdatapb.VGtid, flags *vtgatepb.VStreamFlags) (<-chan *binlogdatapb.VStreamResponse, <-chan struct{}) {
+	ch := make(chan *binlogdatapb.VStreamResponse)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, flags, func(events []*binlogdatapb.VEvent) error {
+			select {
+			case ch <- &binlogdatapb.VStreamResponse{Events: events}:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return ch, done
+}
+
 func newTestVStreamManager(hc discovery.HealthCheck, serv srvtopo.Server, cell string) *vstreamManager {
 	gw := NewTabletGateway(context.Background(), hc, serv, cell)
 	srvResolver := srvtopo.NewResolver(serv, gw, cell)
@@ -1088,6 +1167,104 @@ func startVStream(ctx context.Context, t *testing.T, vsm *vstreamManager, vgtid
 	return ch
 }
 
+func TestVStreamEnvelope(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cell := "aa"
+	ks := "TestVStream"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopo(ctx, cell, ks, []string{"-20"})
+	vsm := newTestVStreamManager(hc, st, cell)
+	sbc0 := hc.AddTestTablet(cell, "1.1.1.1", 1001, ks, "-20", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	addTabletToSandboxTopo(t, st, ks, "-20", sbc0.Tablet())
+	vgtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: ks, Shard: "-20", Gtid: "pos"}}}
+
+	err := vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, &vtgatepb.VStreamFlags{Envelope: 10}, func(events []*binlogdatapb.VEvent) error { return nil })
+	assert.EqualError(t, err, "unknown VStream envelope: 10")
+
+	fields := []*querypb.Field{{Name: "id", Type: sqltypes.Int64, Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG)}}
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "t0", Fields: fields}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "t0", RowChanges: []*binlogdatapb.RowChange{{
+			After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1)}),
+		}}}},
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	ch := make(chan []*binlogdatapb.VEvent)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, &vtgatepb.VStreamFlags{Envelope: vtgatepb.VStreamEnvelope_DEBEZIUM_JSON}, func(events []*binlogdatapb.VEvent) error {
+			ch <- events
+			return nil
+		})
+	}()
+	events := <-ch
+	require.Len(t, events, 4)
+	assert.Contains(t, events[0].FieldEvent.KeySchema, `"name":"TestVStream.t0.Key"`)
+	assert.Contains(t, events[0].FieldEvent.ValueSchema, `"name":"TestVStream.t0.Envelope"`)
+	re := events[1].RowEvent
+	assert.Nil(t, re.RowChanges)
+	require.Len(t, re.EncodedRowChanges, 1)
+	assert.Contains(t, string(re.EncodedRowChanges[0].Key), `"payload":{"id":1}`)
+	assert.Contains(t, string(re.EncodedRowChanges[0].Value), `"shard":"-20","table":"t0","gtid":"gtid01"},"op":"c"`)
+}
+
+func TestVStreamStartTime(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cell := "aa"
+	ks := "TestVStream"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopo(ctx, cell, ks, []string{"-20"})
+	vsm := newTestVStreamManager(hc, st, cell)
+	sbc0 := hc.AddTestTablet(cell, "1.1.1.1", 1001, ks, "-20", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	addTabletToSandboxTopo(t, st, ks, "-20", sbc0.Tablet())
+	send := func(events []*binlogdatapb.VEvent) error { return nil }
+
+	testcases := []struct {
+		vgtid *binlogdatapb.VGtid
+		flags *vtgatepb.VStreamFlags
+		err   string
+	}{{
+		vgtid: &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: ks, Shard: "-20", Gtid: "current"}}},
+		flags: &vtgatepb.VStreamFlags{StartTime: &vttimepb.Time{Seconds: -1}},
+		err:   "invalid VStream start time: seconds:-1",
+	}, {
+		vgtid: &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: ks, Shard: "-20", Gtid: "pos"}}},
+		flags: &vtgatepb.VStreamFlags{StartTime: &vttimepb.Time{Seconds: 1000}},
+		err:   "with a start time, the Gtid value must be 'current'",
+	}, {
+		vgtid: &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: ks, Shard: "-20", Gtid: "current"}}},
+		flags: &vtgatepb.VStreamFlags{StartTime: &vttimepb.Time{Seconds: 1000}, Consumer: "c1"},
+		err:   "a start time cannot be used with a VStream consumer",
+	}}
+	for _, tcase := range testcases {
+		err := vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, tcase.vgtid, nil, tcase.flags, send)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), tcase.err)
+	}
+
+	sbc0.PositionsAt = map[int64]string{1000: "pos1000"}
+	sbc0.ExpectVStreamStartPos("pos1000")
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	vgtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: ks, Shard: "-20", Gtid: "current"}}}
+	ch := make(chan []*binlogdatapb.VEvent)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, &vtgatepb.VStreamFlags{StartTime: &vttimepb.Time{Seconds: 1000}}, func(events []*binlogdatapb.VEvent) error {
+			ch <- events
+			return nil
+		})
+	}()
+	events := <-ch
+	require.Len(t, events, 2)
+	assert.Equal(t, "gtid01", events[0].Vgtid.ShardGtids[0].Gtid)
+}
+
 func verifyEvents(t *testing.T, ch <-chan *binlogdatapb.VStreamResponse, wants ...*binlogdatapb.VStreamResponse) {
 	t.Helper()
 	for i, want := range wants {
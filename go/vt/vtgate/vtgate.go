@@ -96,6 +96,9 @@ var (
 
 	enableSchemaChangeSignal = flag.Bool("schema_change_signal", false, "Enable the schema tracker; requires queryserver-config-schema-change-signal to be enabled on the underlying vttablets for this to work")
 	schemaChangeUser         = flag.String("schema_change_signal_user", "", "User to be used to send down query to vttablet to retrieve schema changes")
+
+	vstreamConsumerHistoryInterval = flag.Duration("vstream_consumer_history_interval", time.Minute, "minimum interval between two positions kept in the history of a VStream consumer, which is used to reset the consumer to a timestamp")
+	vstreamConsumerHistorySize     = flag.Int("vstream_consumer_history_size", 60, "maximum number of positions kept in the history of a VStream consumer")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -506,6 +509,11 @@ func (vtg *VTGate) VStream(ctx context.Context, tabletType topodatapb.TabletType
 	return vtg.vsm.VStream(ctx, tabletType, vgtid, filter, flags, send)
 }
 
+// VStreamAck stores the position acknowledged by a VStream consumer.
+func (vtg *VTGate) VStreamAck(ctx context.Context, consumer string, vgtid *binlogdatapb.VGtid) error {
+	return vtg.vsm.Ack(ctx, consumer, vgtid)
+}
+
 // GetGatewayCacheStatus returns a displayable version of the Gateway cache.
 func (vtg *VTGate) GetGatewayCacheStatus() TabletCacheStatusList {
 	return vtg.resolver.GetGatewayCacheStatus()
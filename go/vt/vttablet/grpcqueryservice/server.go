@@ -358,6 +358,20 @@ func (q *query) VStreamResults(request *binlogdatapb.VStreamResultsRequest, stre
 	return vterrors.ToGRPC(err)
 }
 
+// VStreamPositionAt is part of the queryservice.QueryServer interface
+func (q *query) VStreamPositionAt(ctx context.Context, request *binlogdatapb.VStreamPositionAtRequest) (response *binlogdatapb.VStreamPositionAtResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	pos, err := q.server.VStreamPositionAt(ctx, request.Target, request.Timestamp)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &binlogdatapb.VStreamPositionAtResponse{Position: pos}, nil
+}
+
 // ReserveExecute implements the QueryServer interface
 func (q *query) ReserveExecute(ctx context.Context, request *querypb.ReserveExecuteRequest) (response *querypb.ReserveExecuteResponse, err error) {
 	defer q.server.HandlePanic(&err)
@@ -745,6 +745,27 @@ func (conn *gRPCQueryClient) VStreamResults(ctx context.Context, target *querypb
 	}
 }
 
+// VStreamPositionAt returns the position of the first transaction committed at or after the timestamp.
+func (conn *gRPCQueryClient) VStreamPositionAt(ctx context.Context, target *querypb.Target, timestamp int64) (string, error) {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return "", tabletconn.ConnClosed
+	}
+
+	req := &binlogdatapb.VStreamPositionAtRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		Timestamp:         timestamp,
+	}
+	res, err := conn.c.VStreamPositionAt(ctx, req)
+	if err != nil {
+		return "", tabletconn.ErrorFromGRPC(err)
+	}
+	return res.Position, nil
+}
+
 // HandlePanic is a no-op.
 func (conn *gRPCQueryClient) HandlePanic(err *error) {
 }
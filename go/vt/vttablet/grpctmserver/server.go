@@ -246,6 +246,30 @@ func (s *server) ExecuteFetchAsApp(ctx context.Context, request *tabletmanagerda
 	return response, nil
 }
 
+func (s *server) ListQueries(ctx context.Context, request *tabletmanagerdatapb.ListQueriesRequest) (response *tabletmanagerdatapb.ListQueriesResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "ListQueries", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ListQueriesResponse{}
+	queries, err := s.tm.ListQueries(ctx, request)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	response.Queries = queries
+	return response, nil
+}
+
+func (s *server) KillQueries(ctx context.Context, request *tabletmanagerdatapb.KillQueriesRequest) (response *tabletmanagerdatapb.KillQueriesResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "KillQueries", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.KillQueriesResponse{}
+	killed, err := s.tm.KillQueries(ctx, request)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	response.Killed = killed
+	return response, nil
+}
+
 //
 // Replication related methods
 //
@@ -532,7 +556,7 @@ func (s *server) Backup(request *tabletmanagerdatapb.BackupRequest, stream table
 		})
 	})
 
-	return s.tm.Backup(ctx, int(request.Concurrency), logger, request.AllowPrimary)
+	return s.tm.Backup(ctx, logger, request)
 }
 
 func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBackupRequest, stream tabletmanagerservicepb.TabletManager_RestoreFromBackupServer) (err error) {
@@ -550,7 +574,7 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 		})
 	})
 
-	return s.tm.RestoreFromBackup(ctx, logger, logutil.ProtoToTime(request.GetBackupTime()))
+	return s.tm.RestoreFromBackup(ctx, logger, request)
 }
 
 // registration glue
@@ -634,8 +634,25 @@ func (e *Executor) terminateVReplMigration(ctx context.Context, uuid string) err
 	return nil
 }
 
-// cutOverVReplMigration stops vreplication, then removes the _vt.vreplication entry for the given migration
-func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) error {
+// isTableWriteDenied checks whether writes to the given table are denied on this shard's primary, as is the case
+// when a coordinated (atomic) cut-over is in progress
+func (e *Executor) isTableWriteDenied(shardInfo *topo.ShardInfo, tableName string) bool {
+	tc := shardInfo.GetTabletControl(topodatapb.TabletType_PRIMARY)
+	if tc == nil {
+		return false
+	}
+	for _, deniedTable := range tc.DeniedTables {
+		if deniedTable == tableName {
+			return true
+		}
+	}
+	return false
+}
+
+// cutOverVReplMigration stops vreplication, then removes the _vt.vreplication entry for the given migration.
+// When writesDeniedByCaller is true, the caller (a cross-shard cut-over coordinator) has already denied writes to
+// the table and is responsible for re-enabling them; this function then neither locks the keyspace nor toggles writes.
+func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream, writesDeniedByCaller bool) (err error) {
 	// sanity checks:
 	vreplTable, err := getVreplTable(ctx, s)
 	if err != nil {
@@ -659,6 +676,13 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 		return err
 	}
 	isVreplicationTestSuite := onlineDDL.StrategySetting().IsVreplicationTestSuite()
+	lockTimeout, err := onlineDDL.StrategySetting().CutOverLockTimeout()
+	if err != nil {
+		return err
+	}
+	if lockTimeout == 0 {
+		lockTimeout = 2 * vreplicationCutOverThreshold
+	}
 
 	// A bit early on, we create the table swap query. We do so here and not at cut-over time
 	// just because there might just be an error, and we prefer to bail out now, and not
@@ -670,8 +694,12 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 
 	// Preparation is complete. We proceed to cut-over.
 
-	// lock keyspace:
-	{
+	if writesDeniedByCaller {
+		if !e.isTableWriteDenied(shardInfo, onlineDDL.Table) {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot cut-over migration %s: writes to table %s are not denied", onlineDDL.UUID, onlineDDL.Table)
+		}
+	} else {
+		// lock keyspace:
 		lctx, unlockKeyspace, err := e.ts.LockKeyspace(ctx, e.keyspace, "OnlineDDLCutOver")
 		if err != nil {
 			return err
@@ -681,6 +709,10 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 		defer unlockKeyspace(&err)
 	}
 	toggleWrites := func(allowWrites bool) error {
+		if writesDeniedByCaller {
+			// writes are controlled by the caller
+			return nil
+		}
 		if _, err := e.ts.UpdateShardFields(ctx, e.keyspace, shardInfo.ShardName(), func(si *topo.ShardInfo) error {
 			err := si.UpdateSourceDeniedTables(ctx, topodatapb.TabletType_PRIMARY, nil, allowWrites, []string{onlineDDL.Table})
 			return err
@@ -729,7 +761,7 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 	}
 
 	waitForPos := func() error {
-		ctx, cancel := context.WithTimeout(ctx, 2*vreplicationCutOverThreshold)
+		ctx, cancel := context.WithTimeout(ctx, lockTimeout)
 		defer cancel()
 		// Wait for target to reach the up-to-date pos
 		if err := tmClient.VReplicationWaitForPos(ctx, tablet.Tablet, int(s.id), mysql.EncodePosition(postWritesPos)); err != nil {
@@ -746,6 +778,16 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 	if _, err := tmClient.VReplicationExec(ctx, tablet.Tablet, binlogplayer.StopVReplication(uint32(s.id), "stopped for online DDL cutover")); err != nil {
 		return err
 	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		// The tables were not swapped. Resume vreplication so that the migration keeps up with
+		// the source table and the cut-over can be retried.
+		if _, startErr := tmClient.VReplicationExec(ctx, tablet.Tablet, binlogplayer.StartVReplication(uint32(s.id))); startErr != nil {
+			log.Errorf("cannot resume vreplication for migration %v after failed cut-over: %v", s.workflow, startErr)
+		}
+	}()
 
 	// rename tables atomically (remember, writes on source tables are stopped)
 	{
@@ -765,6 +807,16 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 				return err
 			}
 			defer conn.Close()
+			// Do not block on metadata locks for longer than the cut-over lock timeout
+			lockWaitTimeoutQuery, err := sqlparser.ParseAndBind(sqlSetSessionLockWaitTimeout,
+				sqltypes.Int64BindVariable(int64(math.Ceil(lockTimeout.Seconds()))),
+			)
+			if err != nil {
+				return err
+			}
+			if _, err = conn.ExecuteFetch(lockWaitTimeoutQuery, 0, false); err != nil {
+				return err
+			}
 			if _, err = conn.ExecuteFetch(swapQuery, 0, false); err != nil {
 				return err
 			}
@@ -2559,14 +2611,25 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 		}
 		for _, row := range r.Named().Rows {
 			uuid := row["migration_uuid"].ToString()
-			onlineDDL, _, err := e.readMigration(ctx, uuid)
+			onlineDDL, migrationRow, err := e.readMigration(ctx, uuid)
 			if err != nil {
 				return nil, err
 			}
-			if !e.isAnyConflictingMigrationRunning(onlineDDL) {
-				// This migration seems good to go
-				return onlineDDL, err
+			if e.isAnyConflictingMigrationRunning(onlineDDL) {
+				continue
+			}
+			if onlineDDL.StrategySetting().IsWindowRestrictsCopy() {
+				// This migration may only run (copy and cut-over) within its cut-over window
+				isWithinWindow, err := e.isWithinCutOverWindow(ctx, onlineDDL, migrationRow)
+				if err != nil {
+					return nil, err
+				}
+				if !isWithinWindow {
+					continue
+				}
 			}
+			// This migration seems good to go
+			return onlineDDL, err
 		}
 		// no non-conflicting migration found...
 		// Either all ready migrations are conflicting, or there are no ready migrations...
@@ -2755,6 +2818,142 @@ func (e *Executor) isVReplMigrationReadyToCutOver(ctx context.Context, s *VReplS
 	return true, nil
 }
 
+// CutOverMigration synchronously cuts-over a running atomic migration which is ready to complete. It is
+// invoked by a cross-shard coordinator, which has already denied writes to the migrated table.
+func (e *Executor) CutOverMigration(ctx context.Context, uuid string) (result *sqltypes.Result, err error) {
+	if !e.isOpen {
+		return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "online ddl is disabled")
+	}
+	if !schema.IsOnlineDDLUUID(uuid) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "Not a valid migration ID in CUTOVER: %s", uuid)
+	}
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	onlineDDL, row, err := e.readMigration(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if !onlineDDL.StrategySetting().IsAtomicCutOver() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "migration %s is not an atomic cut-over migration", uuid)
+	}
+	if onlineDDL.Status != schema.OnlineDDLStatusRunning || !row.AsBool("ready_to_complete", false) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "migration %s is not ready to complete", uuid)
+	}
+	s, err := e.readVReplStream(ctx, uuid, false)
+	if err != nil {
+		return nil, err
+	}
+	_ = e.updateMigrationCutOverAttempt(ctx, uuid)
+	if err := e.cutOverVReplMigration(ctx, s, true); err != nil {
+		_ = e.updateMigrationMessage(ctx, uuid, fmt.Sprintf("atomic cut-over failed: %v", err))
+		return nil, err
+	}
+	return &sqltypes.Result{RowsAffected: 1}, nil
+}
+
+// RollbackCutOverMigration reverses the cut-over of an atomic migration, as part of a failed cross-shard
+// cut-over. The coordinator keeps writes to the migrated table denied throughout the cut-over, which means
+// the original table is still up to date and can simply be swapped back in. The migration resumes running.
+func (e *Executor) RollbackCutOverMigration(ctx context.Context, uuid string) (result *sqltypes.Result, err error) {
+	if !e.isOpen {
+		return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "online ddl is disabled")
+	}
+	if !schema.IsOnlineDDLUUID(uuid) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "Not a valid migration ID in ROLLBACK CUTOVER: %s", uuid)
+	}
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	onlineDDL, _, err := e.readMigration(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if !onlineDDL.StrategySetting().IsAtomicCutOver() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "migration %s is not an atomic cut-over migration", uuid)
+	}
+	if onlineDDL.Status != schema.OnlineDDLStatusComplete {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "migration %s is not complete", uuid)
+	}
+	shardInfo, err := e.ts.GetShard(ctx, e.keyspace, e.shard)
+	if err != nil {
+		return nil, err
+	}
+	if !e.isTableWriteDenied(shardInfo, onlineDDL.Table) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot roll back cut-over of migration %s: writes to table %s are not denied", uuid, onlineDDL.Table)
+	}
+	s, err := e.readVReplStream(ctx, uuid, false)
+	if err != nil {
+		return nil, err
+	}
+	vreplTable, err := getVreplTable(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return nil, err
+	}
+	// Swapping the tables again restores the original table
+	swapQuery, _, err := e.generateSwapTablesStatement(ctx, onlineDDL.Table, vreplTable)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := e.execQuery(ctx, swapQuery); err != nil {
+		return nil, err
+	}
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationRollbackCutOver,
+		sqltypes.StringBindVariable("cut-over rolled back by coordinator"),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := e.execQuery(ctx, query); err != nil {
+		return nil, err
+	}
+	tmClient := tmclient.NewTabletManagerClient()
+	if _, err := tmClient.VReplicationExec(ctx, tablet.Tablet, binlogplayer.StartVReplication(uint32(s.id))); err != nil {
+		return nil, err
+	}
+	e.ownedRunningMigrations.Store(uuid, onlineDDL)
+	if err := tmClient.ReloadSchema(ctx, tablet.Tablet, ""); err != nil {
+		log.Errorf("Error on ReloadSchema while rolling back cut-over of migration %s: %v", uuid, err)
+	}
+	defer e.triggerNextCheckInterval()
+	return &sqltypes.Result{RowsAffected: 1}, nil
+}
+
+// isWithinCutOverWindow checks whether the migration is allowed to cut-over at this time, per its
+// --cut-over-window strategy option. A migration without a window may cut-over at any time.
+// When outside the window, the start time of the next window is recorded on the migration. The migration
+// is only updated when that time differs from the one recorded in its row, as read by readMigration.
+func (e *Executor) isWithinCutOverWindow(ctx context.Context, onlineDDL *schema.OnlineDDL, row sqltypes.RowNamedValues) (bool, error) {
+	window, err := onlineDDL.StrategySetting().CutOverWindow()
+	if err != nil {
+		return false, err
+	}
+	if window == nil {
+		return true, nil
+	}
+	now := time.Now()
+	isWithinWindow := window.Contains(now)
+	var nextStart time.Time
+	if !isWithinWindow {
+		nextStart, _ = window.NextStart(now)
+	}
+	var nextStartUnix int64
+	if !nextStart.IsZero() {
+		nextStartUnix = nextStart.Unix()
+	}
+	if nextStartUnix != row.AsInt64("next_cutover_window_unix", 0) {
+		if err := e.updateMigrationNextCutOverWindow(ctx, onlineDDL.UUID, nextStart); err != nil {
+			log.Errorf("cannot record the next cut-over window of migration %v: %v", onlineDDL.UUID, err)
+		}
+	}
+	return isWithinWindow, nil
+}
+
 // isVReplMigrationRunning sees if there is a VReplication migration actively running
 func (e *Executor) isVReplMigrationRunning(ctx context.Context, uuid string) (isRunning bool, s *VReplStream, err error) {
 	s, err = e.readVReplStream(ctx, uuid, true)
@@ -2787,11 +2986,12 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 	uuidsFoundRunning := map[string]bool{}
 	for _, row := range r.Named().Rows {
 		uuid := row["migration_uuid"].ToString()
-		onlineDDL, _, err := e.readMigration(ctx, uuid)
+		onlineDDL, migrationRow, err := e.readMigration(ctx, uuid)
 		if err != nil {
 			return countRunnning, cancellable, err
 		}
 		postponeCompletion := row.AsBool("postpone_completion", false)
+		cutOverAttempts := row.AsInt64("cutover_attempts", 0)
 		elapsedSeconds := row.AsInt64("elapsed_seconds", 0)
 
 		uuidsFoundRunning[uuid] = true
@@ -2836,10 +3036,36 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 						isReady = false
 					}
 					if isReady {
-						if err := e.cutOverVReplMigration(ctx, s); err != nil {
+						// override. Even if migration is ready, we only complete it within its cut-over window, if any.
+						isReady, err = e.isWithinCutOverWindow(ctx, onlineDDL, migrationRow)
+						if err != nil {
 							return countRunnning, cancellable, err
 						}
 					}
+					if onlineDDL.StrategySetting().IsAtomicCutOver() {
+						// override. An atomic migration is cut-over by a coordinator, together with its sibling
+						// migrations on all other shards. We only report whether this shard is ready.
+						_ = e.updateMigrationReadyToComplete(ctx, uuid, isReady)
+						isReady = false
+					}
+					if isReady {
+						_ = e.updateMigrationCutOverAttempt(ctx, uuid)
+						cutOverAttempts++
+						if err := e.cutOverVReplMigration(ctx, s, false); err != nil {
+							// Cut-over failed, e.g. due to lock contention. The migration keeps running and
+							// we will re-attempt the cut-over on a later tick, unless we've exhausted the attempts.
+							maxAttempts, _ := onlineDDL.StrategySetting().CutOverAttempts()
+							if maxAttempts > 0 && cutOverAttempts >= maxAttempts {
+								log.Errorf("cut-over of migration %s failed after %d attempts: %v", uuid, cutOverAttempts, err)
+								_ = e.terminateVReplMigration(ctx, uuid)
+								_ = e.failMigration(ctx, onlineDDL, vterrors.Wrapf(err, "cut-over failed after %d attempts", cutOverAttempts))
+							} else {
+								log.Errorf("cut-over attempt %d of migration %s failed, will retry: %v", cutOverAttempts, uuid, err)
+								_ = e.updateMigrationMessage(ctx, uuid, fmt.Sprintf("cut-over attempt %d failed: %v", cutOverAttempts, err))
+								e.triggerNextCheckInterval()
+							}
+						}
+					}
 				}
 			}
 		case schema.DDLStrategyPTOSC:
@@ -3160,6 +3386,47 @@ func (e *Executor) updateMigrationTimestamp(ctx context.Context, timestampColumn
 	return err
 }
 
+func (e *Executor) updateMigrationCutOverAttempt(ctx context.Context, uuid string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationCutOverAttempt,
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+// updateMigrationNextCutOverWindow records the start time of the next cut-over window. A zero time clears the value.
+func (e *Executor) updateMigrationNextCutOverWindow(ctx context.Context, uuid string, nextStart time.Time) error {
+	var unixSeconds int64
+	if !nextStart.IsZero() {
+		unixSeconds = nextStart.Unix()
+	}
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationNextCutOverWindow,
+		sqltypes.Int64BindVariable(unixSeconds),
+		sqltypes.Int64BindVariable(unixSeconds),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+func (e *Executor) updateMigrationReadyToComplete(ctx context.Context, uuid string, isReady bool) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationReadyToComplete,
+		sqltypes.BoolBindVariable(isReady),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
 func (e *Executor) updateMigrationLogPath(ctx context.Context, uuid string, hostname, logPath string) error {
 	logFile := path.Join(logPath, migrationLogFileName)
 	hostLogPath := fmt.Sprintf("%s:%s", hostname, logPath)
@@ -3763,6 +4030,24 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 				return nil, fmt.Errorf("Not an Online DDL UUID: %s", uuid)
 			}
 			return response(e.CompleteMigration(ctx, uuid))
+		case cutOverMigrationHint:
+			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
+			if err != nil {
+				return nil, err
+			}
+			if !schema.IsOnlineDDLUUID(uuid) {
+				return nil, fmt.Errorf("Not an Online DDL UUID: %s", uuid)
+			}
+			return response(e.CutOverMigration(ctx, uuid))
+		case rollbackCutOverMigrationHint:
+			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
+			if err != nil {
+				return nil, err
+			}
+			if !schema.IsOnlineDDLUUID(uuid) {
+				return nil, fmt.Errorf("Not an Online DDL UUID: %s", uuid)
+			}
+			return response(e.RollbackCutOverMigration(ctx, uuid))
 		case cancelMigrationHint:
 			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
 			if err != nil {
@@ -19,3 +19,324 @@ Functionality of this Executor is tested in go/test/endtoend/onlineddl/...
 */
 
 package onlineddl
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeTMClient records the vreplication statements sent by the executor.
+type fakeTMClient struct {
+	tmclient.TabletManagerClient
+
+	mu      sync.Mutex
+	queries []string
+}
+
+func (tmc *fakeTMClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return nil
+}
+
+func (tmc *fakeTMClient) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
+	return nil
+}
+
+func (tmc *fakeTMClient) VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error {
+	return nil
+}
+
+func (tmc *fakeTMClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.queries = append(tmc.queries, query)
+	return &querypb.QueryResult{}, nil
+}
+
+func (tmc *fakeTMClient) vreplicationQueries() []string {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	return append([]string(nil), tmc.queries...)
+}
+
+const (
+	testMigrationUUID = "6a1e3c25_4f4a_11ec_9c9f_0a43f95f28a3"
+	testVReplTable    = "_6a1e3c25_4f4a_11ec_9c9f_0a43f95f28a3_20211125130000_vrepl"
+)
+
+// newTestExecutor returns an open executor on the primary tablet of ks/0, backed by db.
+func newTestExecutor(t *testing.T, db *fakesqldb.DB) (*Executor, *fakeTMClient) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "0"))
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}
+	require.NoError(t, ts.CreateTablet(ctx, tablet))
+
+	tmc := &fakeTMClient{}
+	tmclient.RegisterTabletManagerClientFactory(t.Name(), func() tmclient.TabletManagerClient {
+		return tmc
+	})
+	*tmclient.TabletManagerProtocol = t.Name()
+
+	params, err := db.ConnParams().MysqlParams()
+	require.NoError(t, err)
+	config := tabletenv.NewDefaultConfig()
+	config.DB = dbconfigs.NewTestDBConfigs(*params, *params, "vt_ks")
+	env := tabletenv.NewEnv(config, t.Name())
+	e := NewExecutor(env, tablet.Alias, ts, func() topodatapb.TabletType { return topodatapb.TabletType_PRIMARY })
+	e.InitDBConfig("ks", "0", "vt_ks")
+	e.pool.Open(config.DB.AppWithDB(), config.DB.DbaWithDB(), config.DB.AppDebugWithDB())
+	t.Cleanup(e.pool.Close)
+	e.isOpen = true
+	return e, tmc
+}
+
+// denyTableWrites denies or allows writes to t1, like a cut-over coordinator does.
+func denyTableWrites(t *testing.T, e *Executor, deny bool) {
+	ctx := context.Background()
+	ctx, unlock, err := e.ts.LockKeyspace(ctx, "ks", "test")
+	require.NoError(t, err)
+	defer unlock(&err)
+	_, err = e.ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		return si.UpdateSourceDeniedTables(ctx, topodatapb.TabletType_PRIMARY, nil, !deny /* remove */, []string{"t1"})
+	})
+	require.NoError(t, err)
+}
+
+// isTableWriteDenied returns whether writes to t1 are denied.
+func isTableWriteDenied(t *testing.T, e *Executor) bool {
+	si, err := e.ts.GetShard(context.Background(), "ks", "0")
+	require.NoError(t, err)
+	return e.isTableWriteDenied(si, "t1")
+}
+
+// addCutOverQueries sets up the fake database to serve the cut-over of a vreplication migration with
+// the given strategy options, status and readiness.
+func addCutOverQueries(db *fakesqldb.DB, options string, status schema.OnlineDDLStatus, readyToComplete bool) {
+	db.AddQueryPattern("use `vt_ks`", &sqltypes.Result{})
+	db.AddQueryPattern("select @@global.gtid_executed", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("@@global.gtid_executed", "varchar"),
+		"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10",
+	))
+	db.AddQueryPattern("select\\s+id,\\s+migration_uuid.*from _vt.schema_migrations.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"migration_uuid|keyspace|mysql_table|strategy|options|migration_status|ready_to_complete",
+			"varchar|varchar|varchar|varchar|varchar|varchar|int64",
+		),
+		fmt.Sprintf("%s|ks|t1|vitess|%s|%s|%v", testMigrationUUID, options, status, map[bool]int{false: 0, true: 1}[readyToComplete]),
+	))
+	db.AddQueryPattern("select\\s+id,\\s+workflow.*from _vt.vreplication.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|workflow|source|pos|state", "int64|varchar|varchar|varchar|varchar"),
+		fmt.Sprintf(`1|%s|keyspace:"ks" shard:"0" filter:{rules:{match:"%s" filter:"select * from t1"}}|MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10|Running`, testMigrationUUID, testVReplTable),
+	))
+	db.AddQueryPattern("update _vt.schema_migrations.*", &sqltypes.Result{})
+	db.AddQueryPattern("set session lock_wait_timeout=.*", &sqltypes.Result{})
+}
+
+func TestCutOverVReplMigrationResumesVReplicationOnFailure(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e, tmc := newTestExecutor(t, db)
+
+	// The first cut-over fails to swap the tables, e.g. on a lock wait timeout.
+	db.RejectQueryPattern("rename table .*", "Lock wait timeout exceeded")
+	addCutOverQueries(db, "", schema.OnlineDDLStatusRunning, true)
+
+	s, err := e.readVReplStream(ctx, testMigrationUUID, false)
+	require.NoError(t, err)
+	err = e.cutOverVReplMigration(ctx, s, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Lock wait timeout exceeded")
+	assert.Equal(t, []string{
+		binlogplayer.StopVReplication(1, "stopped for online DDL cutover"),
+		binlogplayer.StartVReplication(1),
+	}, tmc.vreplicationQueries())
+
+	// The retried cut-over swaps the tables, and vreplication stays stopped.
+	db.ClearQueryPattern()
+	db.AddQueryPattern("rename table .*", &sqltypes.Result{})
+	addCutOverQueries(db, "", schema.OnlineDDLStatusRunning, true)
+
+	err = e.cutOverVReplMigration(ctx, s, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		binlogplayer.StopVReplication(1, "stopped for online DDL cutover"),
+		binlogplayer.StartVReplication(1),
+		binlogplayer.StopVReplication(1, "stopped for online DDL cutover"),
+	}, tmc.vreplicationQueries())
+
+	// Writes to the table are allowed again after both attempts.
+	assert.False(t, isTableWriteDenied(t, e))
+}
+
+func TestCutOverMigration(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e, tmc := newTestExecutor(t, db)
+
+	// Only atomic migrations which are ready to complete are cut-over by a coordinator.
+	addCutOverQueries(db, "", schema.OnlineDDLStatusRunning, true)
+	_, err := e.CutOverMigration(ctx, testMigrationUUID)
+	assert.EqualError(t, err, fmt.Sprintf("migration %s is not an atomic cut-over migration", testMigrationUUID))
+	db.ClearQueryPattern()
+	addCutOverQueries(db, "--atomic-cut-over", schema.OnlineDDLStatusRunning, false)
+	_, err = e.CutOverMigration(ctx, testMigrationUUID)
+	assert.EqualError(t, err, fmt.Sprintf("migration %s is not ready to complete", testMigrationUUID))
+
+	// The coordinator must have denied writes to the table.
+	db.ClearQueryPattern()
+	db.AddQueryPattern("rename table .*", &sqltypes.Result{})
+	addCutOverQueries(db, "--atomic-cut-over", schema.OnlineDDLStatusRunning, true)
+	_, err = e.CutOverMigration(ctx, testMigrationUUID)
+	assert.EqualError(t, err, fmt.Sprintf("cannot cut-over migration %s: writes to table t1 are not denied", testMigrationUUID))
+	assert.Empty(t, tmc.vreplicationQueries())
+
+	// A failed cut-over resumes vreplication, so that the migration can be cut-over again.
+	denyTableWrites(t, e, true)
+	db.ClearQueryPattern()
+	db.RejectQueryPattern("rename table .*", "Lock wait timeout exceeded")
+	addCutOverQueries(db, "--atomic-cut-over", schema.OnlineDDLStatusRunning, true)
+	_, err = e.CutOverMigration(ctx, testMigrationUUID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Lock wait timeout exceeded")
+	assert.Equal(t, []string{
+		binlogplayer.StopVReplication(1, "stopped for online DDL cutover"),
+		binlogplayer.StartVReplication(1),
+	}, tmc.vreplicationQueries())
+
+	db.ClearQueryPattern()
+	db.AddQueryPattern("rename table .*", &sqltypes.Result{})
+	addCutOverQueries(db, "--atomic-cut-over", schema.OnlineDDLStatusRunning, true)
+	result, err := e.CutOverMigration(ctx, testMigrationUUID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, result.RowsAffected)
+	assert.Equal(t, []string{
+		binlogplayer.StopVReplication(1, "stopped for online DDL cutover"),
+		binlogplayer.StartVReplication(1),
+		binlogplayer.StopVReplication(1, "stopped for online DDL cutover"),
+	}, tmc.vreplicationQueries())
+
+	// Writes are left to the coordinator to allow.
+	assert.True(t, isTableWriteDenied(t, e))
+}
+
+func TestRollbackCutOverMigration(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e, tmc := newTestExecutor(t, db)
+
+	var renames []string
+	db.AddQueryPatternWithCallback("rename table .*", &sqltypes.Result{}, func(query string) {
+		renames = append(renames, query)
+	})
+	addCutOverQueries(db, "--atomic-cut-over", schema.OnlineDDLStatusRunning, true)
+	_, err := e.RollbackCutOverMigration(ctx, testMigrationUUID)
+	assert.EqualError(t, err, fmt.Sprintf("migration %s is not complete", testMigrationUUID))
+
+	// The coordinator must still deny writes to the table, for the original table to be up to date.
+	db.ClearQueryPattern()
+	db.AddQueryPatternWithCallback("rename table .*", &sqltypes.Result{}, func(query string) {
+		renames = append(renames, query)
+	})
+	addCutOverQueries(db, "--atomic-cut-over", schema.OnlineDDLStatusComplete, false)
+	_, err = e.RollbackCutOverMigration(ctx, testMigrationUUID)
+	assert.EqualError(t, err, fmt.Sprintf("cannot roll back cut-over of migration %s: writes to table t1 are not denied", testMigrationUUID))
+	assert.Empty(t, renames)
+
+	// The tables are swapped back, and the migration resumes running.
+	denyTableWrites(t, e, true)
+	result, err := e.RollbackCutOverMigration(ctx, testMigrationUUID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, result.RowsAffected)
+	require.Len(t, renames, 1)
+	assert.Contains(t, renames[0], fmt.Sprintf("`%s` TO `t1`", testVReplTable))
+	assert.Equal(t, []string{binlogplayer.StartVReplication(1)}, tmc.vreplicationQueries())
+	_, owned := e.ownedRunningMigrations.Load(testMigrationUUID)
+	assert.True(t, owned)
+}
+
+func TestIsWithinCutOverWindow(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e, _ := newTestExecutor(t, db)
+
+	options := "--cut-over-window='0 2 * * *' --cut-over-window-duration=1h"
+	var updates []string
+	addMigrationQueries := func(nextCutOverWindowUnix int64) {
+		db.ClearQueryPattern()
+		db.AddQueryPattern("use `vt_ks`", &sqltypes.Result{})
+		db.AddQueryPattern("select\\s+id,\\s+migration_uuid.*from _vt.schema_migrations.*", sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"migration_uuid|keyspace|mysql_table|strategy|options|migration_status|next_cutover_window_unix",
+				"varchar|varchar|varchar|varchar|varchar|varchar|int64",
+			),
+			fmt.Sprintf("%s|ks|t1|vitess|%s|%s|%d", testMigrationUUID, options, schema.OnlineDDLStatusRunning, nextCutOverWindowUnix),
+		))
+		db.AddQueryPatternWithCallback("update _vt.schema_migrations.*", &sqltypes.Result{}, func(query string) {
+			updates = append(updates, query)
+		})
+	}
+
+	addMigrationQueries(0)
+	onlineDDL, row, err := e.readMigration(ctx, testMigrationUUID)
+	require.NoError(t, err)
+	window, err := onlineDDL.StrategySetting().CutOverWindow()
+	require.NoError(t, err)
+	if window.Contains(time.Now()) {
+		t.Skip("the test runs within the cut-over window")
+	}
+	nextStart, ok := window.NextStart(time.Now())
+	require.True(t, ok)
+
+	// The next window is recorded on the migration.
+	isWithinWindow, err := e.isWithinCutOverWindow(ctx, onlineDDL, row)
+	require.NoError(t, err)
+	assert.False(t, isWithinWindow)
+	require.Len(t, updates, 1)
+	assert.Contains(t, updates[0], fmt.Sprintf("FROM_UNIXTIME(%d)", nextStart.Unix()))
+
+	// Once recorded, it is not written again.
+	addMigrationQueries(nextStart.Unix())
+	onlineDDL, row, err = e.readMigration(ctx, testMigrationUUID)
+	require.NoError(t, err)
+	isWithinWindow, err = e.isWithinCutOverWindow(ctx, onlineDDL, row)
+	require.NoError(t, err)
+	assert.False(t, isWithinWindow)
+	assert.Len(t, updates, 1)
+
+	// A failure to record it does not fail the review of the migration.
+	db.ClearQueryPattern()
+	db.AddQueryPattern("use `vt_ks`", &sqltypes.Result{})
+	db.RejectQueryPattern("update _vt.schema_migrations.*", "table is read only")
+	isWithinWindow, err = e.isWithinCutOverWindow(ctx, onlineDDL, sqltypes.RowNamedValues{})
+	require.NoError(t, err)
+	assert.False(t, isWithinWindow)
+}
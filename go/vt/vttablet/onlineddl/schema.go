@@ -72,6 +72,10 @@ const (
 	alterSchemaMigrationsTableRevertedUUID             = "ALTER TABLE _vt.schema_migrations add column reverted_uuid varchar(64) NOT NULL DEFAULT ''"
 	alterSchemaMigrationsTableRevertedUUIDIndex        = "ALTER TABLE _vt.schema_migrations add KEY reverted_uuid_idx (reverted_uuid(64))"
 	alterSchemaMigrationsTableIsView                   = "ALTER TABLE _vt.schema_migrations add column is_view tinyint unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableCutOverAttempts          = "ALTER TABLE _vt.schema_migrations add column cutover_attempts int unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableLastCutOverAttempt       = "ALTER TABLE _vt.schema_migrations add column last_cutover_attempt_timestamp timestamp NULL DEFAULT NULL"
+	alterSchemaMigrationsTableNextCutOverWindow        = "ALTER TABLE _vt.schema_migrations add column next_cutover_window_timestamp timestamp NULL DEFAULT NULL"
+	alterSchemaMigrationsTableReadyToComplete          = "ALTER TABLE _vt.schema_migrations add column ready_to_complete tinyint unsigned NOT NULL DEFAULT 0"
 
 	sqlInsertMigration = `INSERT IGNORE INTO _vt.schema_migrations (
 		migration_uuid,
@@ -139,6 +143,31 @@ const (
 		WHERE
 			migration_uuid=%a
 `
+	sqlUpdateMigrationCutOverAttempt = `UPDATE _vt.schema_migrations
+			SET cutover_attempts=cutover_attempts+1, last_cutover_attempt_timestamp=NOW()
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationNextCutOverWindow = `UPDATE _vt.schema_migrations
+			SET next_cutover_window_timestamp=IF(%a=0, NULL, FROM_UNIXTIME(%a))
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationReadyToComplete = `UPDATE _vt.schema_migrations
+			SET ready_to_complete=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationRollbackCutOver = `UPDATE _vt.schema_migrations
+			SET
+				migration_status='running',
+				completed_timestamp=NULL,
+				ready_to_complete=0,
+				message=%a
+		WHERE
+			migration_uuid=%a
+			AND migration_status='complete'
+	`
 	sqlUpdateMigrationStartedTimestamp = `UPDATE _vt.schema_migrations SET
 			started_timestamp =IFNULL(started_timestamp,  NOW()),
 			liveness_timestamp=IFNULL(liveness_timestamp, NOW())
@@ -235,6 +264,10 @@ const (
 			tablet=%a,
 			retries=retries + 1,
 			tablet_failure=0,
+			cutover_attempts=0,
+			last_cutover_attempt_timestamp=NULL,
+			next_cutover_window_timestamp=NULL,
+			ready_to_complete=0,
 			ready_timestamp=NULL,
 			started_timestamp=NULL,
 			liveness_timestamp=NULL,
@@ -251,6 +284,10 @@ const (
 			tablet=%a,
 			retries=retries + 1,
 			tablet_failure=0,
+			cutover_attempts=0,
+			last_cutover_attempt_timestamp=NULL,
+			next_cutover_window_timestamp=NULL,
+			ready_to_complete=0,
 			ready_timestamp=NULL,
 			started_timestamp=NULL,
 			liveness_timestamp=NULL,
@@ -268,6 +305,7 @@ const (
 	sqlSelectRunningMigrations = `SELECT
 			migration_uuid,
 			postpone_completion,
+			cutover_attempts,
 			timestampdiff(second, started_timestamp, now()) as elapsed_seconds
 		FROM _vt.schema_migrations
 		WHERE
@@ -367,7 +405,9 @@ const (
 			migration_context,
 			retain_artifacts_seconds,
 			is_view,
-			postpone_completion
+			postpone_completion,
+			ready_to_complete,
+			ifnull(unix_timestamp(next_cutover_window_timestamp), 0) as next_cutover_window_unix
 		FROM _vt.schema_migrations
 		WHERE
 			migration_uuid=%a
@@ -516,13 +556,17 @@ const (
 		`
 	sqlSwapTables  = "RENAME TABLE `%a` TO `%a`, `%a` TO `%a`, `%a` TO `%a`"
 	sqlRenameTable = "RENAME TABLE `%a` TO `%a`"
+
+	sqlSetSessionLockWaitTimeout = "SET SESSION lock_wait_timeout=%a"
 )
 
 const (
-	retryMigrationHint     = "retry"
-	cancelMigrationHint    = "cancel"
-	cancelAllMigrationHint = "cancel-all"
-	completeMigrationHint  = "complete"
+	retryMigrationHint           = "retry"
+	cancelMigrationHint          = "cancel"
+	cancelAllMigrationHint       = "cancel-all"
+	completeMigrationHint        = "complete"
+	cutOverMigrationHint         = "cutover"
+	rollbackCutOverMigrationHint = "rollback-cutover"
 )
 
 var (
@@ -571,4 +615,8 @@ var ApplyDDL = []string{
 	alterSchemaMigrationsTableRevertedUUID,
 	alterSchemaMigrationsTableRevertedUUIDIndex,
 	alterSchemaMigrationsTableIsView,
+	alterSchemaMigrationsTableCutOverAttempts,
+	alterSchemaMigrationsTableLastCutOverAttempt,
+	alterSchemaMigrationsTableNextCutOverWindow,
+	alterSchemaMigrationsTableReadyToComplete,
 }
@@ -103,6 +103,10 @@ type QueryService interface {
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(ctx context.Context, target *querypb.Target, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error
 
+	// VStreamPositionAt returns the position of the binlogs after the last
+	// transaction committed before the timestamp, in seconds.
+	VStreamPositionAt(ctx context.Context, target *querypb.Target, timestamp int64) (string, error)
+
 	// StreamHealth streams health status.
 	StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error
 
@@ -263,6 +263,15 @@ func (ws *wrappedService) VStreamResults(ctx context.Context, target *querypb.Ta
 	})
 }
 
+func (ws *wrappedService) VStreamPositionAt(ctx context.Context, target *querypb.Target, timestamp int64) (position string, err error) {
+	err = ws.wrapper(ctx, target, ws.impl, "VStreamPositionAt", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		var innerErr error
+		position, innerErr = conn.VStreamPositionAt(ctx, target, timestamp)
+		return canRetry(ctx, innerErr), innerErr
+	})
+	return position, err
+}
+
 func (ws *wrappedService) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
 	return ws.wrapper(ctx, nil, ws.impl, "StreamHealth", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
 		innerErr := conn.StreamHealth(ctx, callback)
@@ -104,6 +104,9 @@ type SandboxConn struct {
 	VStreamErrors []error
 	VStreamCh     chan *binlogdatapb.VEvent
 
+	// PositionsAt maps timestamps to the positions returned by VStreamPositionAt.
+	PositionsAt map[int64]string
+
 	// transaction id generator
 	TransactionID sync2.AtomicInt64
 
@@ -497,6 +500,15 @@ func (sbc *SandboxConn) VStreamResults(ctx context.Context, target *querypb.Targ
 	return fmt.Errorf("not implemented in test")
 }
 
+// VStreamPositionAt is part of the QueryService interface.
+func (sbc *SandboxConn) VStreamPositionAt(ctx context.Context, target *querypb.Target, timestamp int64) (string, error) {
+	pos, ok := sbc.PositionsAt[timestamp]
+	if !ok {
+		return "", fmt.Errorf("no position at %d", timestamp)
+	}
+	return pos, nil
+}
+
 // QueryServiceByAlias is part of the Gateway interface.
 func (sbc *SandboxConn) QueryServiceByAlias(_ *topodatapb.TabletAlias, _ *querypb.Target) (queryservice.QueryService, error) {
 	return sbc, nil
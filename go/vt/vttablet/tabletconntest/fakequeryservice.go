@@ -689,6 +689,11 @@ func (f *FakeQueryService) VStreamResults(ctx context.Context, target *querypb.T
 	panic("not implemented")
 }
 
+// VStreamPositionAt is part of the QueryService interface.
+func (f *FakeQueryService) VStreamPositionAt(ctx context.Context, target *querypb.Target, timestamp int64) (string, error) {
+	panic("not implemented")
+}
+
 // QueryServiceByAlias satisfies the Gateway interface
 func (f *FakeQueryService) QueryServiceByAlias(_ *topodatapb.TabletAlias, _ *querypb.Target) (queryservice.QueryService, error) {
 	panic("not implemented")
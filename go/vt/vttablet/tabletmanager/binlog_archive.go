@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file handles the continuous archiving of binary logs to the backup
+// storage, which allows point-in-time recovery with RestoreFromBackup.
+
+var (
+	binlogArchiveInterval = flag.Duration("binlog_archive_interval", 0, "if set, a PRIMARY tablet copies its rotated binary logs to the backup storage at this interval, to allow point-in-time recovery. Requires gtid_mode=ON.")
+
+	binlogArchiveCount  = stats.NewCounter("BinlogArchiveCount", "Number of binary logs archived to the backup storage")
+	binlogArchiveErrors = stats.NewCounter("BinlogArchiveErrors", "Number of failed attempts to archive binary logs")
+)
+
+// binlogArchiveLoop periodically archives the rotated binary logs of mysqld,
+// while the tablet is PRIMARY.
+func (tm *TabletManager) binlogArchiveLoop(ctx context.Context, interval time.Duration, doneChan chan<- struct{}) {
+	defer close(doneChan)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if tm.Tablet().Type != topodatapb.TabletType_PRIMARY {
+			continue
+		}
+		if err := tm.archiveBinlogs(ctx); err != nil {
+			binlogArchiveErrors.Add(1)
+			log.Errorf("Failed to archive binary logs: %v", err)
+		}
+	}
+}
+
+// archiveBinlogs archives the rotated binary logs of mysqld that are not
+// archived yet.
+func (tm *TabletManager) archiveBinlogs(ctx context.Context) error {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+
+	tablet := tm.Tablet()
+	count, err := mysqlctl.ArchiveBinlogs(ctx, bs, mysqlctl.BinlogArchiveParams{
+		Cnf:         tm.Cnf,
+		Mysqld:      tm.MysqlDaemon,
+		Logger:      logutil.NewConsoleLogger(),
+		Keyspace:    tablet.Keyspace,
+		Shard:       tablet.Shard,
+		TabletAlias: topoproto.TabletAliasString(tablet.Alias),
+	})
+	binlogArchiveCount.Add(int64(count))
+	return err
+}
+
+func (tm *TabletManager) startBinlogArchive() {
+	if *binlogArchiveInterval <= 0 || tm.Cnf == nil {
+		return
+	}
+
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	tm._binlogArchiveDone = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	tm._binlogArchiveCancel = cancel
+
+	go tm.binlogArchiveLoop(ctx, *binlogArchiveInterval, tm._binlogArchiveDone)
+}
+
+func (tm *TabletManager) stopBinlogArchive() {
+	var doneChan <-chan struct{}
+
+	tm.mutex.Lock()
+	if tm._binlogArchiveCancel != nil {
+		tm._binlogArchiveCancel()
+	}
+	doneChan = tm._binlogArchiveDone
+	tm.mutex.Unlock()
+
+	// If the archive loop was running, wait for it to fully stop.
+	if doneChan != nil {
+		<-doneChan
+	}
+}
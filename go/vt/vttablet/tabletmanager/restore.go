@@ -49,6 +49,8 @@ var (
 	restoreFromBackupTsStr = flag.String("restore_from_backup_ts", "", "(init restore parameter) if set, restore the latest backup taken at or before this timestamp. Example: '2021-04-29.133050'")
 	restoreConcurrency     = flag.Int("restore_concurrency", 4, "(init restore parameter) how many concurrent files to restore at once")
 	waitForBackupInterval  = flag.Duration("wait_for_backup_interval", 0, "(init restore parameter) if this is greater than 0, instead of starting up empty when no backups are found, keep checking at this interval for a backup to appear")
+	restoreToTimestampStr  = flag.String("restore_to_timestamp", "", "(init restore parameter) if set, run a point-in-time recovery that ends at the given timestamp, by applying archived binary logs on top of the latest backup taken before it. Example: '2021-04-29.133050'")
+	restoreToPos           = flag.String("restore_to_pos", "", "(init restore parameter) if set, run a point-in-time recovery that ends at the given replication position, by applying archived binary logs on top of the latest backup taken before it")
 
 	// Flags for PITR
 	binlogHost           = flag.String("binlog_host", "", "PITR restore parameter: hostname/IP of binlog server.")
@@ -66,7 +68,7 @@ var (
 // It will either work, fail gracefully, or return
 // an error in case of a non-recoverable error.
 // It takes the action lock so no RPC interferes.
-func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, backupTime time.Time) error {
+func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, backupTime, restoreToTimestamp time.Time, restoreToPos string) error {
 	if err := tm.lock(ctx); err != nil {
 		return err
 	}
@@ -120,7 +122,7 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 
 	startTime = time.Now()
 
-	err = tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, backupTime)
+	err = tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, backupTime, restoreToTimestamp, restoreToPos)
 	if err != nil {
 		return err
 	}
@@ -138,7 +140,7 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 	return nil
 }
 
-func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, backupTime time.Time) error {
+func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, backupTime, restoreToTimestamp time.Time, restoreToPos string) error {
 
 	tablet := tm.Tablet()
 	originalType := tablet.Type
@@ -176,6 +178,14 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		Keyspace:            keyspace,
 		Shard:               tablet.Shard,
 		StartTime:           backupTime,
+		RestoreToTimestamp:  restoreToTimestamp,
+	}
+	if restoreToPos != "" {
+		pos, err := mysql.DecodePosition(restoreToPos)
+		if err != nil {
+			return vterrors.Wrapf(err, "invalid restore_to_pos %q", restoreToPos)
+		}
+		params.RestoreToPos = pos
 	}
 
 	// Check whether we're going to restore before changing to RESTORE type,
@@ -236,7 +246,12 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
-		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
+		if params.IsPointInTimeRecovery() {
+			// Replicating from the primary would move us past the requested
+			// point in time, so we stay out of serving until told otherwise.
+			log.Infof("Point-in-time recovery done at position %v, not starting replication", mysql.EncodePosition(pos))
+			originalType = topodatapb.TabletType_DRAINED
+		} else if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
 			// Reconnect to primary only for "NORMAL" keyspaces
 			if err := tm.startReplication(context.Background(), pos, originalType); err != nil {
 				return err
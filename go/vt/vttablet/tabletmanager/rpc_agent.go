@@ -76,6 +76,10 @@ type RPCTM interface {
 
 	ExecuteFetchAsApp(ctx context.Context, query []byte, maxrows int) (*querypb.QueryResult, error)
 
+	ListQueries(ctx context.Context, req *tabletmanagerdatapb.ListQueriesRequest) ([]*tabletmanagerdatapb.LiveQuery, error)
+
+	KillQueries(ctx context.Context, req *tabletmanagerdatapb.KillQueriesRequest) (int64, error)
+
 	// Replication related methods
 	// Deprecated, use PrimaryStatus instead
 	MasterStatus(ctx context.Context) (*replicationdatapb.PrimaryStatus, error)
@@ -145,9 +149,9 @@ type RPCTM interface {
 
 	// Backup / restore related methods
 
-	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool) error
+	Backup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.BackupRequest) error
 
-	RestoreFromBackup(ctx context.Context, logger logutil.Logger, backupTime time.Time) error
+	RestoreFromBackup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.RestoreFromBackupRequest) error
 
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -36,7 +37,7 @@ const (
 )
 
 // Backup takes a db backup and sends it to the BackupStorage
-func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool) error {
+func (tm *TabletManager) Backup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.BackupRequest) error {
 	if tm.Cnf == nil {
 		return fmt.Errorf("cannot perform backup without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
@@ -45,6 +46,7 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	// During a network partition it is possible that from the topology perspective this is no longer the primary,
 	// but the process didn't find out about this.
 	// It is not safe to take backups from tablet in this state
+	allowPrimary := request.AllowPrimary
 	currentTablet := tm.Tablet()
 	if !allowPrimary && currentTablet.Type == topodatapb.TabletType_PRIMARY {
 		return fmt.Errorf("type PRIMARY cannot take backup. if you really need to do this, rerun the backup command with -allow_primary")
@@ -62,9 +64,13 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 		return fmt.Errorf("type PRIMARY cannot take backup. if you really need to do this, rerun the backup command with -allow_primary")
 	}
 
+	// An incremental backup only copies binlogs, so it never needs to drain
+	// the tablet, whatever the engine.
+	drain := engine.ShouldDrainForBackup() && !request.Incremental
+
 	// prevent concurrent backups, and record stats
 	backupMode := backupModeOnline
-	if engine.ShouldDrainForBackup() {
+	if drain {
 		backupMode = backupModeOffline
 	}
 	if err := tm.beginBackup(backupMode); err != nil {
@@ -73,7 +79,7 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	defer tm.endBackup(backupMode)
 
 	var originalType topodatapb.TabletType
-	if engine.ShouldDrainForBackup() {
+	if drain {
 		if err := tm.lock(ctx); err != nil {
 			return err
 		}
@@ -107,18 +113,19 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 		Cnf:          tm.Cnf,
 		Mysqld:       tm.MysqlDaemon,
 		Logger:       l,
-		Concurrency:  concurrency,
+		Concurrency:  int(request.Concurrency),
 		HookExtraEnv: tm.hookExtraEnv(),
 		TopoServer:   tm.TopoServer,
 		Keyspace:     tablet.Keyspace,
 		Shard:        tablet.Shard,
 		TabletAlias:  topoproto.TabletAliasString(tablet.Alias),
 		BackupTime:   time.Now(),
+		Incremental:  request.Incremental,
 	}
 
 	returnErr := mysqlctl.Backup(ctx, backupParams)
 
-	if engine.ShouldDrainForBackup() {
+	if drain {
 		bgCtx := context.Background()
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. It is also possible that the context already timed out during the
@@ -151,8 +158,9 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 }
 
 // RestoreFromBackup deletes all local data and then restores the data from the latest backup [at
-// or before the backupTime value if specified]
-func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger, backupTime time.Time) error {
+// or before the backupTime value if specified]. If a restore_to_timestamp or restore_to_pos is
+// requested, archived binlogs are then applied up to that point in time.
+func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.RestoreFromBackupRequest) error {
 	if err := tm.lock(ctx); err != nil {
 		return err
 	}
@@ -169,8 +177,14 @@ func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.L
 	// create the loggers: tee to console and source
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
+	if request.RestoreToPos != "" && request.RestoreToTimestamp != nil {
+		return fmt.Errorf("cannot restore both to a position and to a timestamp")
+	}
+	backupTime := logutil.ProtoToTime(request.BackupTime)
+	restoreToTimestamp := logutil.ProtoToTime(request.RestoreToTimestamp)
+
 	// now we can run restore
-	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, backupTime)
+	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, backupTime, restoreToTimestamp, request.RestoreToPos)
 
 	// re-run health check to be sure to capture any replication delay
 	tm.QueryServiceControl.BroadcastHealth()
@@ -19,11 +19,14 @@ package tabletmanager
 import (
 	"context"
 
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
 // ExecuteFetchAsDba will execute the given query, possibly disabling binlogs and reload schema.
@@ -110,6 +113,42 @@ func (tm *TabletManager) ExecuteFetchAsApp(ctx context.Context, query []byte, ma
 	return sqltypes.ResultToProto3(result), err
 }
 
+// ListQueries returns the queries running on the tablet that match the
+// request.
+func (tm *TabletManager) ListQueries(ctx context.Context, req *tabletmanagerdatapb.ListQueriesRequest) ([]*tabletmanagerdatapb.LiveQuery, error) {
+	rows := tm.QueryServiceControl.ListQueries(tabletserver.QueryFilter{
+		Caller:      req.Caller,
+		Table:       req.Table,
+		Fingerprint: req.Fingerprint,
+	})
+	queries := make([]*tabletmanagerdatapb.LiveQuery, 0, len(rows))
+	for _, row := range rows {
+		queries = append(queries, &tabletmanagerdatapb.LiveQuery{
+			Type:         row.Type,
+			Query:        row.Query,
+			Caller:       row.Caller,
+			Table:        row.Table,
+			Fingerprint:  row.Fingerprint,
+			ConnectionId: row.ConnID,
+			StartTime:    protoutil.TimeToProto(row.Start),
+			Duration:     protoutil.DurationToProto(row.Duration),
+			RowsStreamed: row.RowsStreamed,
+		})
+	}
+	return queries, nil
+}
+
+// KillQueries kills the queries running on the tablet that match the
+// request, and returns how many were killed.
+func (tm *TabletManager) KillQueries(ctx context.Context, req *tabletmanagerdatapb.KillQueriesRequest) (int64, error) {
+	killed, err := tm.QueryServiceControl.KillQueries(tabletserver.QueryFilter{
+		Caller:      req.Caller,
+		Table:       req.Table,
+		Fingerprint: req.Fingerprint,
+	})
+	return int64(killed), err
+}
+
 // ExecuteQuery submits a new online DDL request
 func (tm *TabletManager) ExecuteQuery(ctx context.Context, query []byte, dbName string, maxrows int) (*querypb.QueryResult, error) {
 	// get the db name from the tablet
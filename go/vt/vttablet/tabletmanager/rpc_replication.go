@@ -31,7 +31,9 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -39,10 +41,14 @@ import (
 )
 
 var (
-	enableSemiSync   = flag.Bool("enable_semi_sync", false, "Enable semi-sync when configuring replication, on primary and replica tablets only (rdonly tablets will not ack).")
+	enableSemiSync   = flag.Bool("enable_semi_sync", false, "Enable semi-sync when configuring replication, on primary and replica tablets only (rdonly tablets will not ack). Only used for keyspaces without a durability policy in the topology, whose tablets otherwise configure semi-sync according to that policy.")
 	setSuperReadOnly = flag.Bool("use_super_read_only", true, "Set super_read_only flag when performing planned failover.")
 )
 
+// keyspaceDurabilityCacheTTL is how long the durability policy of the keyspace
+// is cached before it is read from the topo again. It can be changed in tests.
+var keyspaceDurabilityCacheTTL = 10 * time.Second
+
 // ReplicationStatus returns the replication status
 func (tm *TabletManager) ReplicationStatus(ctx context.Context) (*replicationdatapb.Status, error) {
 	status, err := tm.MysqlDaemon.ReplicationStatus()
@@ -886,7 +892,64 @@ func isPrimaryEligible(tabletType topodatapb.TabletType) bool {
 	return false
 }
 
+// keyspaceDurability returns the durability policy of the keyspace of the
+// tablet, or nil if the keyspace has no durability policy in its topo record.
+// The policy is cached for keyspaceDurabilityCacheTTL. If the topo can't be
+// read, the cached policy is used, or nil if the policy was never read, so
+// that semi-sync falls back to -enable_semi_sync.
+func (tm *TabletManager) keyspaceDurability(ctx context.Context) reparentutil.Durabler {
+	tm.mutex.Lock()
+	cached, readTime := tm._durability, tm._durabilityReadTime
+	tm.mutex.Unlock()
+	if !readTime.IsZero() && time.Since(readTime) < keyspaceDurabilityCacheTTL {
+		return cached
+	}
+
+	keyspace := tm.Tablet().Keyspace
+	durability, err := tm.readKeyspaceDurability(ctx, keyspace)
+	if err != nil {
+		if readTime.IsZero() {
+			log.Errorf("can't read durability policy of keyspace %v, using -enable_semi_sync: %v", keyspace, err)
+		} else {
+			log.Errorf("can't read durability policy of keyspace %v, using the policy read at %v: %v", keyspace, readTime, err)
+		}
+		return cached
+	}
+	tm.mutex.Lock()
+	tm._durability, tm._durabilityReadTime = durability, time.Now()
+	tm.mutex.Unlock()
+	return durability
+}
+
+// readKeyspaceDurability reads the durability policy of the keyspace from
+// the topo. It returns nil if the keyspace has no durability policy.
+func (tm *TabletManager) readKeyspaceDurability(ctx context.Context, keyspace string) (reparentutil.Durabler, error) {
+	ki, err := tm.TopoServer.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't read keyspace %v", keyspace)
+	}
+	if ki.DurabilityPolicy == "" {
+		return nil, nil
+	}
+	durability, err := reparentutil.GetDurabilityPolicy(ki.DurabilityPolicy)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "invalid durability policy of keyspace %v", keyspace)
+	}
+	return durability, nil
+}
+
+// fixSemiSync configures semi-sync on MySQL for the tablet type. If the
+// keyspace has a durability policy in its topo record, the configuration
+// follows that policy. Otherwise, semi-sync is only managed with
+// -enable_semi_sync.
 func (tm *TabletManager) fixSemiSync(tabletType topodatapb.TabletType, semiSync SemiSyncAction) error {
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	if durability := tm.keyspaceDurability(ctx); durability != nil {
+		return tm.fixSemiSyncWithDurability(ctx, durability, tabletType, semiSync)
+	}
+
 	if !*enableSemiSync {
 		// Semi-sync handling is not enabled.
 		if semiSync == SemiSyncActionSet {
@@ -912,13 +975,79 @@ func (tm *TabletManager) fixSemiSync(tabletType topodatapb.TabletType, semiSync
 	return tm.MysqlDaemon.SetSemiSyncEnabled(tabletType == topodatapb.TabletType_PRIMARY, true)
 }
 
+// fixSemiSyncWithDurability configures semi-sync on MySQL for the tablet type
+// according to the durability policy of the keyspace. The semiSync action of
+// the caller, which computed it from the same policy, takes precedence for
+// replicas. Without an action, a replica acknowledges the transactions of the
+// primary of its shard if the policy says so.
+func (tm *TabletManager) fixSemiSyncWithDurability(ctx context.Context, durability reparentutil.Durabler, tabletType topodatapb.TabletType, semiSync SemiSyncAction) error {
+	tablet := tm.Tablet()
+	tablet.Type = tabletType
+
+	if tabletType == topodatapb.TabletType_PRIMARY {
+		ackers := reparentutil.SemiSyncAckers(durability, tablet)
+		if semiSync == SemiSyncActionSet && ackers == 0 {
+			log.Error("invalid configuration - semi-sync should be setup, but the durability policy of the keyspace does not use semi-sync")
+		}
+		if err := tm.MysqlDaemon.SetSemiSyncEnabled(ackers > 0, ackers > 0); err != nil {
+			return err
+		}
+		if ackers == 0 {
+			return nil
+		}
+		return tm.MysqlDaemon.ExecuteSuperQueryList(ctx, []string{
+			fmt.Sprintf("SET GLOBAL rpl_semi_sync_master_wait_for_slave_count = %d", ackers),
+		})
+	}
+
+	var replica bool
+	switch semiSync {
+	case SemiSyncActionSet:
+		replica = isPrimaryEligible(tabletType)
+		if !replica {
+			log.Error("invalid configuration - semi-sync should be setup according to durability policies, but the tablet is not primaryEligible")
+		}
+	case SemiSyncActionUnset:
+		replica = false
+	default:
+		replica = tm.isReplicaSemiSync(ctx, durability, tablet)
+	}
+	// The primary-side needs to be off for a replica, or else it will get stuck.
+	return tm.MysqlDaemon.SetSemiSyncEnabled(false, replica)
+}
+
+// isReplicaSemiSync returns whether the durability policy makes tablet
+// acknowledge the semi-sync transactions of the primary of its shard. If the
+// shard has no primary, tablet acknowledges them if it is primary eligible and
+// the policy uses semi-sync. The same applies if the shard or its primary
+// can't be read from the topo.
+func (tm *TabletManager) isReplicaSemiSync(ctx context.Context, durability reparentutil.Durabler, tablet *topodatapb.Tablet) bool {
+	noPrimary := isPrimaryEligible(tablet.Type) && reparentutil.SemiSyncAckers(durability, tablet) > 0
+	si, err := tm.TopoServer.GetShard(ctx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		log.Errorf("can't read shard %v/%v, configuring semi-sync as if it had no primary: %v", tablet.Keyspace, tablet.Shard, err)
+		return noPrimary
+	}
+	if si.PrimaryAlias == nil {
+		return noPrimary
+	}
+	primary, err := tm.TopoServer.GetTablet(ctx, si.PrimaryAlias)
+	if err != nil {
+		log.Errorf("can't read primary tablet %v, configuring semi-sync as if the shard had no primary: %v", topoproto.TabletAliasString(si.PrimaryAlias), err)
+		return noPrimary
+	}
+	return reparentutil.IsReplicaSemiSync(durability, primary.Tablet, tablet)
+}
+
 func (tm *TabletManager) isPrimarySideSemiSyncEnabled() bool {
 	semiSyncEnabled, _ := tm.MysqlDaemon.SemiSyncEnabled()
 	return semiSyncEnabled
 }
 
 func (tm *TabletManager) fixSemiSyncAndReplication(tabletType topodatapb.TabletType, semiSync SemiSyncAction) error {
-	if !*enableSemiSync {
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	if tm.keyspaceDurability(ctx) == nil && !*enableSemiSync {
 		// Semi-sync handling is not enabled.
 		return nil
 	}
@@ -947,7 +1076,7 @@ func (tm *TabletManager) fixSemiSyncAndReplication(tabletType topodatapb.TabletT
 		return nil
 	}
 
-	shouldAck := isPrimaryEligible(tabletType)
+	_, shouldAck := tm.MysqlDaemon.SemiSyncEnabled()
 	acking, err := tm.MysqlDaemon.SemiSyncReplicationStatus()
 	if err != nil {
 		return vterrors.Wrap(err, "failed to get SemiSyncReplicationStatus")
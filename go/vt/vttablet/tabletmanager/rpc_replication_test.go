@@ -19,6 +19,7 @@ package tabletmanager
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"os"
 	"testing"
@@ -27,6 +28,7 @@ import (
 
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
 	"vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 )
 
@@ -69,6 +71,18 @@ func captureStderr(f func()) (string, error) {
 	return <-outC, nil
 }
 
+// newSemiSyncTestTM returns a tablet manager for tablet, with a fake MySQL
+// daemon, which is not started.
+func newSemiSyncTestTM(ts *topo.Server, tablet *topodata.Tablet) *TabletManager {
+	tm := &TabletManager{
+		BatchCtx:    context.Background(),
+		TopoServer:  ts,
+		MysqlDaemon: fakemysqldaemon.NewFakeMysqlDaemon(nil),
+	}
+	tm.tmState = newTMState(tm, tablet)
+	return tm
+}
+
 func TestTabletManager_fixSemiSync(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -137,13 +151,18 @@ func TestTabletManager_fixSemiSync(t *testing.T) {
 	defer func() {
 		*enableSemiSync = oldEnableSemiSync
 	}()
+	// Without a durability policy in the keyspace, semi-sync is managed with -enable_semi_sync.
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.CreateKeyspace(context.Background(), keyspace, &topodata.Keyspace{}))
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			*enableSemiSync = tt.shouldEnableSemiSync
-			fakeMysql := fakemysqldaemon.NewFakeMysqlDaemon(nil)
-			tm := &TabletManager{
-				MysqlDaemon: fakeMysql,
-			}
+			tm := newSemiSyncTestTM(ts, &topodata.Tablet{
+				Alias:    &topodata.TabletAlias{Cell: "cell1", Uid: 100},
+				Keyspace: keyspace,
+				Shard:    shard,
+				Type:     tt.tabletType,
+			})
 			logOutput, err := captureStderr(func() {
 				err := tm.fixSemiSync(tt.tabletType, tt.semiSync)
 				require.NoError(t, err)
@@ -157,3 +176,192 @@ func TestTabletManager_fixSemiSync(t *testing.T) {
 		})
 	}
 }
+
+func TestTabletManager_fixSemiSyncAckers(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tm := newTestTM(t, ts, 100, keyspace, shard)
+	defer tm.Stop()
+
+	oldEnableSemiSync, oldCacheTTL := *enableSemiSync, keyspaceDurabilityCacheTTL
+	defer func() {
+		*enableSemiSync, keyspaceDurabilityCacheTTL = oldEnableSemiSync, oldCacheTTL
+	}()
+	*enableSemiSync = true
+	// Read the durability policy again after it changes.
+	keyspaceDurabilityCacheTTL = 0
+
+	fakeMysql := tm.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon)
+
+	// Without a durability policy in the keyspace, the number of ackers is not managed.
+	err := tm.fixSemiSync(topodata.TabletType_PRIMARY, SemiSyncActionSet)
+	require.NoError(t, err)
+
+	lockCtx, unlock, err := ts.LockKeyspace(ctx, keyspace, "fixSemiSyncAckers")
+	require.NoError(t, err)
+	ki, err := ts.GetKeyspace(lockCtx, keyspace)
+	require.NoError(t, err)
+	ki.DurabilityPolicy = "ackers=2"
+	err = ts.UpdateKeyspace(lockCtx, ki)
+	unlock(&err)
+	require.NoError(t, err)
+
+	fakeMysql.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL rpl_semi_sync_master_wait_for_slave_count = 2",
+	}
+	fakeMysql.ExpectedExecuteSuperQueryCurrent = 0
+	err = tm.fixSemiSync(topodata.TabletType_PRIMARY, SemiSyncActionSet)
+	require.NoError(t, err)
+	require.Equal(t, 1, fakeMysql.ExpectedExecuteSuperQueryCurrent)
+	require.True(t, fakeMysql.SemiSyncPrimaryEnabled)
+}
+
+func TestTabletManager_fixSemiSyncWithDurability(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	require.NoError(t, ts.CreateKeyspace(ctx, keyspace, &topodata.Keyspace{DurabilityPolicy: "cross_cell"}))
+	require.NoError(t, ts.CreateShard(ctx, keyspace, shard))
+	primary := &topodata.Tablet{
+		Alias:    &topodata.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: keyspace,
+		Shard:    shard,
+		Type:     topodata.TabletType_PRIMARY,
+	}
+	require.NoError(t, ts.CreateTablet(ctx, primary))
+	_, err := ts.UpdateShardFields(ctx, keyspace, shard, func(si *topo.ShardInfo) error {
+		si.PrimaryAlias = primary.Alias
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The durability policy of the keyspace is followed, whatever -enable_semi_sync.
+	oldEnableSemiSync := *enableSemiSync
+	defer func() {
+		*enableSemiSync = oldEnableSemiSync
+	}()
+	*enableSemiSync = false
+
+	tm := newSemiSyncTestTM(ts, primary)
+	fakeMysql := tm.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon)
+	fakeMysql.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL rpl_semi_sync_master_wait_for_slave_count = 1",
+	}
+	require.NoError(t, tm.fixSemiSync(topodata.TabletType_PRIMARY, SemiSyncActionNone))
+	require.Equal(t, 1, fakeMysql.ExpectedExecuteSuperQueryCurrent)
+	require.True(t, fakeMysql.SemiSyncPrimaryEnabled)
+	require.True(t, fakeMysql.SemiSyncReplicaEnabled)
+
+	tests := []struct {
+		name       string
+		cell       string
+		tabletType topodata.TabletType
+		semiSync   SemiSyncAction
+		want       bool
+	}{{
+		name:       "replica in the cell of the primary",
+		cell:       "cell1",
+		tabletType: topodata.TabletType_REPLICA,
+		semiSync:   SemiSyncActionNone,
+		want:       false,
+	}, {
+		name:       "replica in another cell",
+		cell:       "cell2",
+		tabletType: topodata.TabletType_REPLICA,
+		semiSync:   SemiSyncActionNone,
+		want:       true,
+	}, {
+		name:       "rdonly in another cell",
+		cell:       "cell2",
+		tabletType: topodata.TabletType_RDONLY,
+		semiSync:   SemiSyncActionNone,
+		want:       false,
+	}, {
+		name:       "replica unset by the caller",
+		cell:       "cell2",
+		tabletType: topodata.TabletType_REPLICA,
+		semiSync:   SemiSyncActionUnset,
+		want:       false,
+	}, {
+		name:       "replica set by the caller",
+		cell:       "cell1",
+		tabletType: topodata.TabletType_REPLICA,
+		semiSync:   SemiSyncActionSet,
+		want:       true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newSemiSyncTestTM(ts, &topodata.Tablet{
+				Alias:    &topodata.TabletAlias{Cell: tt.cell, Uid: 101},
+				Keyspace: keyspace,
+				Shard:    shard,
+				Type:     tt.tabletType,
+			})
+			fakeMysql := tm.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon)
+			fakeMysql.SemiSyncPrimaryEnabled = true
+			require.NoError(t, tm.fixSemiSync(tt.tabletType, tt.semiSync))
+			require.False(t, fakeMysql.SemiSyncPrimaryEnabled)
+			require.Equal(t, tt.want, fakeMysql.SemiSyncReplicaEnabled)
+		})
+	}
+
+	// A durability policy without semi-sync disables it.
+	lockCtx, unlock, err := ts.LockKeyspace(ctx, keyspace, "fixSemiSync")
+	require.NoError(t, err)
+	ki, err := ts.GetKeyspace(lockCtx, keyspace)
+	require.NoError(t, err)
+	ki.DurabilityPolicy = "none"
+	err = ts.UpdateKeyspace(lockCtx, ki)
+	unlock(&err)
+	require.NoError(t, err)
+
+	tm = newSemiSyncTestTM(ts, primary)
+	fakeMysql = tm.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon)
+	fakeMysql.SemiSyncPrimaryEnabled = true
+	fakeMysql.SemiSyncReplicaEnabled = true
+	require.NoError(t, tm.fixSemiSync(topodata.TabletType_PRIMARY, SemiSyncActionNone))
+	require.False(t, fakeMysql.SemiSyncPrimaryEnabled)
+	require.False(t, fakeMysql.SemiSyncReplicaEnabled)
+}
+
+func TestTabletManager_fixSemiSyncTopoError(t *testing.T) {
+	ctx := context.Background()
+	ts, factory := memorytopo.NewServerAndFactory("cell1")
+	require.NoError(t, ts.CreateKeyspace(ctx, keyspace, &topodata.Keyspace{DurabilityPolicy: "semi_sync"}))
+	primary := &topodata.Tablet{
+		Alias:    &topodata.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: keyspace,
+		Shard:    shard,
+		Type:     topodata.TabletType_PRIMARY,
+	}
+
+	oldEnableSemiSync, oldCacheTTL := *enableSemiSync, keyspaceDurabilityCacheTTL
+	defer func() {
+		*enableSemiSync, keyspaceDurabilityCacheTTL = oldEnableSemiSync, oldCacheTTL
+	}()
+	*enableSemiSync = false
+	keyspaceDurabilityCacheTTL = 0
+
+	tm := newSemiSyncTestTM(ts, primary)
+	fakeMysql := tm.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon)
+	fakeMysql.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL rpl_semi_sync_master_wait_for_slave_count = 1",
+		"SET GLOBAL rpl_semi_sync_master_wait_for_slave_count = 1",
+	}
+	require.NoError(t, tm.fixSemiSync(topodata.TabletType_PRIMARY, SemiSyncActionNone))
+	require.True(t, fakeMysql.SemiSyncPrimaryEnabled)
+
+	// When the topo can't be read, the durability policy which was read before is used.
+	factory.SetError(errors.New("topo is down"))
+	defer factory.SetError(nil)
+	fakeMysql.SemiSyncPrimaryEnabled = false
+	require.NoError(t, tm.fixSemiSync(topodata.TabletType_PRIMARY, SemiSyncActionNone))
+	require.Equal(t, 2, fakeMysql.ExpectedExecuteSuperQueryCurrent)
+	require.True(t, fakeMysql.SemiSyncPrimaryEnabled)
+
+	// Without a durability policy read before, semi-sync is managed with -enable_semi_sync.
+	tm = newSemiSyncTestTM(ts, primary)
+	fakeMysql = tm.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon)
+	require.NoError(t, tm.fixSemiSync(topodata.TabletType_PRIMARY, SemiSyncActionNone))
+	require.False(t, fakeMysql.SemiSyncPrimaryEnabled)
+	require.NoError(t, tm.fixSemiSyncAndReplication(topodata.TabletType_REPLICA, SemiSyncActionNone))
+}
@@ -62,6 +62,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
@@ -179,6 +180,14 @@ type TabletManager struct {
 	// _shardSyncCancel is the function to stop the background shard sync goroutine.
 	_shardSyncCancel context.CancelFunc
 
+	// _binlogArchiveDone is a channel for waiting until the binlog archive
+	// goroutine has really finished after _binlogArchiveCancel was called.
+	_binlogArchiveDone chan struct{}
+
+	// _binlogArchiveCancel is the function to stop the background binlog
+	// archive goroutine.
+	_binlogArchiveCancel context.CancelFunc
+
 	// _rebuildKeyspaceDone is a channel for waiting until the current keyspace
 	// has been rebuilt
 	_rebuildKeyspaceDone chan struct{}
@@ -187,6 +196,12 @@ type TabletManager struct {
 	// in progress
 	_rebuildKeyspaceCancel context.CancelFunc
 
+	// _durability is the durability policy of the keyspace, as last read
+	// from the topo at _durabilityReadTime. It is nil if the keyspace has
+	// no durability policy.
+	_durability         reparentutil.Durabler
+	_durabilityReadTime time.Time
+
 	// _lockTablesConnection is used to get and release the table read locks to pause replication
 	_lockTablesConnection *dbconnpool.DBConnection
 	_lockTablesTimer      *time.Timer
@@ -378,6 +393,7 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 	// The following initializations don't need to be done
 	// in any specific order.
 	tm.startShardSync()
+	tm.startBinlogArchive()
 	tm.exportStats()
 	orc, err := newOrcClient()
 	if err != nil {
@@ -411,6 +427,7 @@ func (tm *TabletManager) Close() {
 	// rather than registering it as an OnTerm hook so the shard sync loop keeps
 	// running during lame duck.
 	tm.stopShardSync()
+	tm.stopBinlogArchive()
 	tm.stopRebuildKeyspace()
 
 	// cleanup initialized fields in the tablet entry
@@ -442,6 +459,7 @@ func (tm *TabletManager) Stop() {
 	// Stop the shard sync loop and wait for it to exit. This needs to be done
 	// here in addition to in Close() because tests do not call Close().
 	tm.stopShardSync()
+	tm.stopBinlogArchive()
 	tm.stopRebuildKeyspace()
 
 	if tm.UpdateStream != nil {
@@ -735,9 +753,19 @@ func (tm *TabletManager) handleRestore(ctx context.Context) (bool, error) {
 				}
 			}
 
+			// A point-in-time recovery can be requested up to a timestamp or a position
+			restoreToTimestamp := time.Time{}
+			if *restoreToTimestampStr != "" {
+				var err error
+				restoreToTimestamp, err = time.Parse(mysqlctl.BackupTimestampFormat, *restoreToTimestampStr)
+				if err != nil {
+					log.Exitf(fmt.Sprintf("RestoreFromBackup failed: unable to parse the restore_to_timestamp value provided of '%s'", *restoreToTimestampStr))
+				}
+			}
+
 			// restoreFromBackup will just be a regular action
 			// (same as if it was triggered remotely)
-			if err := tm.RestoreData(ctx, logutil.NewConsoleLogger(), *waitForBackupInterval, false /* deleteBeforeRestore */, backupTime); err != nil {
+			if err := tm.RestoreData(ctx, logutil.NewConsoleLogger(), *waitForBackupInterval, false /* deleteBeforeRestore */, backupTime, restoreToTimestamp, *restoreToPos); err != nil {
 				log.Exitf("RestoreFromBackup failed: %v", err)
 			}
 		}()
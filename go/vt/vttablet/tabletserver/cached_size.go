@@ -25,7 +25,7 @@ func (cached *TabletPlan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(128)
+		size += int64(160)
 	}
 	// field Plan *vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder.Plan
 	size += cached.Plan.CachedSize(true)
@@ -47,5 +47,9 @@ func (cached *TabletPlan) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field Fingerprint string
+	size += hack.RuntimeAllocSize(int64(len(cached.Fingerprint)))
+	// field DigestText string
+	size += hack.RuntimeAllocSize(int64(len(cached.DigestText)))
 	return size
 }
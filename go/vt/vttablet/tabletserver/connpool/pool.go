@@ -64,6 +64,9 @@ type Pool struct {
 	waiterQueueFull    sync2.AtomicInt64
 	dbaPool            *dbconnpool.ConnectionPool
 	appDebugParams     dbconfigs.Connector
+	// scheduler is nil if priority scheduling is disabled.
+	scheduler       *scheduler
+	defaultPriority int
 }
 
 // NewPool creates a new Pool. The name is used
@@ -80,9 +83,19 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 		waiterCap:          int64(cfg.MaxWaiters),
 		dbaPool:            dbconnpool.NewConnectionPool("", 1, idleTimeout, 0),
 	}
+	if config := env.Config(); config != nil {
+		cp.defaultPriority = config.DefaultQueryPriority
+	}
+	if cfg.PriorityScheduling {
+		cp.scheduler = newScheduler(cfg.Size, cfg.PriorityAgingSeconds.Get())
+	}
 	if name == "" {
 		return cp
 	}
+	if cp.scheduler != nil {
+		cp.scheduler.waiters = env.Exporter().NewGaugesWithSingleLabel(name+"PriorityWaiters", "Tablet server conn pool waiters by priority", "Priority")
+		cp.scheduler.waitTimes = env.Exporter().NewTimings(name+"PriorityWaitTime", "Tablet server conn pool wait time by priority", "Priority")
+	}
 	env.Exporter().NewGaugeFunc(name+"Capacity", "Tablet server conn pool capacity", cp.Capacity)
 	env.Exporter().NewGaugeFunc(name+"Available", "Tablet server conn pool available", cp.Available)
 	env.Exporter().NewGaugeFunc(name+"Active", "Tablet server conn pool active", cp.Active)
@@ -154,9 +167,16 @@ func (cp *Pool) Close() {
 	cp.dbaPool.Close()
 }
 
-// Get returns a connection.
+// Get returns a connection with the default query priority.
 // You must call Recycle on DBConn once done.
 func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
+	return cp.GetWithPriority(ctx, cp.defaultPriority)
+}
+
+// GetWithPriority returns a connection. If priority scheduling is enabled
+// and the pool is exhausted, waiters with a lower priority value are served
+// first. You must call Recycle on DBConn once done.
+func (cp *Pool) GetWithPriority(ctx context.Context, priority int) (*DBConn, error) {
 	span, ctx := trace.NewSpan(ctx, "Pool.Get")
 	defer span.Finish()
 
@@ -186,8 +206,17 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 		ctx, cancel = context.WithTimeout(ctx, cp.timeout)
 		defer cancel()
 	}
+	if cp.scheduler != nil {
+		span.Annotate("priority", priority)
+		if err := cp.scheduler.acquire(ctx, priority); err != nil {
+			return nil, err
+		}
+	}
 	r, err := p.Get(ctx)
 	if err != nil {
+		if cp.scheduler != nil {
+			cp.scheduler.release()
+		}
 		return nil, err
 	}
 	return r.(*DBConn), nil
@@ -204,6 +233,9 @@ func (cp *Pool) Put(conn *DBConn) {
 	} else {
 		p.Put(conn)
 	}
+	if cp.scheduler != nil {
+		cp.scheduler.release()
+	}
 }
 
 // SetCapacity alters the size of the pool at runtime.
@@ -217,6 +249,9 @@ func (cp *Pool) SetCapacity(capacity int) (err error) {
 		}
 	}
 	cp.capacity = capacity
+	if cp.scheduler != nil {
+		cp.scheduler.setCapacity(capacity)
+	}
 	return nil
 }
 
@@ -110,6 +110,54 @@ func TestConnPoolMaxWaiters(t *testing.T) {
 	wg.Wait()
 }
 
+func TestConnPoolPriorityScheduling(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := NewPool(tabletenv.NewEnv(nil, "PoolTest"), "TestPool", tabletenv.ConnPoolConfig{
+		Size:                 1,
+		PriorityScheduling:   true,
+		PriorityAgingSeconds: 3600,
+	})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	dbConn, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+
+	order := make(chan int, 2)
+	var wg sync.WaitGroup
+	for i, priority := range []int{100, 0} {
+		wg.Add(1)
+		go func(priority int) {
+			defer wg.Done()
+			c, err := connPool.GetWithPriority(context.Background(), priority)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			order <- priority
+			c.Recycle()
+		}(priority)
+		// Wait for the waiter to be queued.
+		for {
+			runtime.Gosched()
+			connPool.scheduler.mu.Lock()
+			queued := connPool.scheduler.queue.Len()
+			connPool.scheduler.mu.Unlock()
+			if queued == i+1 {
+				break
+			}
+		}
+	}
+	dbConn.Recycle()
+	wg.Wait()
+	close(order)
+	var got []int
+	for priority := range order {
+		got = append(got, priority)
+	}
+	assert.Equal(t, []int{0, 100}, got)
+}
+
 func TestConnPoolGetEmptyDebugConfig(t *testing.T) {
 	db := fakesqldb.New(t)
 	debugConn := db.ConnParamsWithUname("")
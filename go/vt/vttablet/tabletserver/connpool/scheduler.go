@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"container/heap"
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/servenv"
+)
+
+// scheduler admits the callers of Pool.Get by priority once all the
+// connections of the pool are in use. Priorities go from 0 (highest) to
+// sqlparser.MaxPriorityValue (lowest). To avoid starving low priority
+// callers, a waiter is promoted by one priority level for every agingInterval
+// it spends waiting: this is implemented by ordering the waiters by their
+// enqueue time offset by priority*agingInterval, so the queue never needs
+// to be reordered.
+type scheduler struct {
+	agingInterval time.Duration
+
+	// waiters and waitTimes are nil if the pool is not named.
+	waiters   *stats.GaugesWithSingleLabel
+	waitTimes *servenv.TimingsWrapper
+
+	mu       sync.Mutex
+	capacity int
+	inUse    int
+	seq      uint64
+	queue    waiterQueue
+}
+
+// waiter is a caller blocked in scheduler.acquire.
+type waiter struct {
+	key   int64
+	seq   uint64
+	index int
+	// ready is closed when the waiter is admitted.
+	ready chan struct{}
+}
+
+func newScheduler(capacity int, agingInterval time.Duration) *scheduler {
+	return &scheduler{
+		agingInterval: agingInterval,
+		capacity:      capacity,
+	}
+}
+
+// acquire blocks until the caller is admitted or ctx is done. Every
+// successful call must be matched by a call to release.
+func (s *scheduler) acquire(ctx context.Context, priority int) error {
+	start := time.Now()
+	label := strconv.Itoa(priority)
+
+	s.mu.Lock()
+	if s.inUse < s.capacity && s.queue.Len() == 0 {
+		s.inUse++
+		s.mu.Unlock()
+		s.recordWait(label, start)
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		s.mu.Unlock()
+		return pools.ErrCtxTimeout
+	default:
+	}
+	w := &waiter{
+		key:   start.UnixNano() + int64(priority)*s.agingInterval.Nanoseconds(),
+		seq:   s.seq,
+		ready: make(chan struct{}),
+	}
+	s.seq++
+	heap.Push(&s.queue, w)
+	s.mu.Unlock()
+
+	if s.waiters != nil {
+		s.waiters.Add(label, 1)
+		defer s.waiters.Add(label, -1)
+	}
+
+	select {
+	case <-w.ready:
+		s.recordWait(label, start)
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if w.index < 0 {
+		// We were admitted concurrently with the context expiring:
+		// hand the slot over to the next waiter.
+		s.inUse--
+		s.admitLocked()
+	} else {
+		heap.Remove(&s.queue, w.index)
+	}
+	return pools.ErrTimeout
+}
+
+// release returns a slot acquired by acquire.
+func (s *scheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inUse--
+	s.admitLocked()
+}
+
+// setCapacity changes the number of callers that can be admitted at once.
+func (s *scheduler) setCapacity(capacity int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.capacity = capacity
+	s.admitLocked()
+}
+
+func (s *scheduler) admitLocked() {
+	for s.inUse < s.capacity && s.queue.Len() > 0 {
+		w := heap.Pop(&s.queue).(*waiter)
+		s.inUse++
+		close(w.ready)
+	}
+}
+
+func (s *scheduler) recordWait(label string, start time.Time) {
+	if s.waitTimes != nil {
+		s.waitTimes.Record(label, start)
+	}
+}
+
+// waiterQueue implements heap.Interface. The waiter with the lowest
+// key is served first, ties are broken by arrival order.
+type waiterQueue []*waiter
+
+func (q waiterQueue) Len() int { return len(q) }
+
+func (q waiterQueue) Less(i, j int) bool {
+	if q[i].key != q[j].key {
+		return q[i].key < q[j].key
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q waiterQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *waiterQueue) Push(x interface{}) {
+	w := x.(*waiter)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *waiterQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	w.index = -1
+	*q = old[:n-1]
+	return w
+}
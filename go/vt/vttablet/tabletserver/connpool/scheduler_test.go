@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/pools"
+)
+
+// enqueue starts a goroutine acquiring s with the given priority and
+// waits until it is queued. The returned channel receives the result.
+func enqueue(t *testing.T, ctx context.Context, s *scheduler, priority int) chan error {
+	t.Helper()
+	s.mu.Lock()
+	queued := s.queue.Len()
+	s.mu.Unlock()
+	done := make(chan error, 1)
+	go func() {
+		done <- s.acquire(ctx, priority)
+	}()
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.queue.Len() == queued+1
+	}, 5*time.Second, time.Millisecond)
+	return done
+}
+
+func TestSchedulerPriority(t *testing.T) {
+	ctx := context.Background()
+	s := newScheduler(1, time.Hour)
+	require.NoError(t, s.acquire(ctx, 50))
+
+	low := enqueue(t, ctx, s, 90)
+	high := enqueue(t, ctx, s, 10)
+	mid := enqueue(t, ctx, s, 50)
+
+	for _, want := range []chan error{high, mid, low} {
+		s.release()
+		select {
+		case err := <-want:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("waiter was not admitted in priority order")
+		}
+	}
+	s.release()
+	assert.Equal(t, 0, s.inUse)
+}
+
+func TestSchedulerAging(t *testing.T) {
+	ctx := context.Background()
+	s := newScheduler(1, time.Millisecond)
+	require.NoError(t, s.acquire(ctx, 50))
+
+	// The low priority waiter has been waiting long enough to have
+	// been promoted above the newly arrived high priority one.
+	low := enqueue(t, ctx, s, 100)
+	time.Sleep(200 * time.Millisecond)
+	high := enqueue(t, ctx, s, 0)
+
+	s.release()
+	select {
+	case err := <-low:
+		require.NoError(t, err)
+	case <-high:
+		t.Fatal("low priority waiter was starved")
+	case <-time.After(5 * time.Second):
+		t.Fatal("no waiter was admitted")
+	}
+	s.release()
+	require.NoError(t, <-high)
+	s.release()
+}
+
+func TestSchedulerTimeout(t *testing.T) {
+	s := newScheduler(1, time.Hour)
+	require.NoError(t, s.acquire(context.Background(), 50))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, pools.ErrTimeout, s.acquire(ctx, 50))
+	assert.Equal(t, pools.ErrCtxTimeout, s.acquire(ctx, 50))
+	assert.Equal(t, 0, s.queue.Len())
+
+	s.release()
+	assert.Equal(t, 0, s.inUse)
+}
+
+func TestSchedulerSetCapacity(t *testing.T) {
+	ctx := context.Background()
+	s := newScheduler(1, time.Hour)
+	require.NoError(t, s.acquire(ctx, 50))
+	waiting := enqueue(t, ctx, s, 50)
+
+	s.setCapacity(2)
+	require.NoError(t, <-waiting)
+	assert.Equal(t, 2, s.inUse)
+	s.release()
+	s.release()
+}
@@ -93,6 +93,13 @@ type Controller interface {
 
 	// TopoServer returns the topo server.
 	TopoServer() *topo.Server
+
+	// ListQueries returns the running queries that match the filter.
+	ListQueries(filter QueryFilter) []QueryDetailzRow
+
+	// KillQueries kills the running queries that match the filter,
+	// and returns how many were killed.
+	KillQueries(filter QueryFilter) (int, error)
 }
 
 // Ensure TabletServer satisfies Controller interface.
@@ -0,0 +1,421 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package digest aggregates the execution statistics of the queries
+// served by a tablet per digest: the text of the query with its literals
+// and comments stripped, identified by a fingerprint. The digests are
+// exposed in the vitess_digests virtual table, on /digestz and as
+// metrics labelled by fingerprint.
+package digest
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// numWindowBuckets is the number of buckets the window of a digest is
+// divided into.
+const numWindowBuckets = 10
+
+// Fingerprint returns the fingerprint and the text of the digest of a
+// query. All the executions of a query share the same digest, whatever
+// the values of its literals and its comments.
+func Fingerprint(query string) (fingerprint, text string) {
+	text, _ = sqlparser.SplitMarginComments(query)
+	if redacted, err := sqlparser.RedactSQLQuery(text); err == nil {
+		text = redacted
+	}
+	h := fnv.New64a()
+	h.Write([]byte(text))
+	return fmt.Sprintf("%016x", h.Sum64()), text
+}
+
+// Collector aggregates the statistics of the queries per digest. It
+// keeps at most maxDigests digests: the queries of the other digests
+// are aggregated in a single digest without fingerprint, like the
+// statements digest table of MySQL's performance_schema.
+type Collector struct {
+	enabled    bool
+	maxDigests int
+	bucketSize time.Duration
+	now        func() time.Time
+
+	mu      sync.RWMutex
+	digests map[string]*digest
+	other   *digest
+
+	lost *stats.Counter
+}
+
+// NewCollector creates a Collector. Its statistics are only collected
+// if enabled is true. The window is the period over which the recent
+// statistics of a digest are computed.
+func NewCollector(exporter *servenv.Exporter, enabled bool, maxDigests int, window time.Duration) *Collector {
+	c := &Collector{
+		enabled:    enabled,
+		maxDigests: maxDigests,
+		bucketSize: window / numWindowBuckets,
+		now:        time.Now,
+	}
+	c.reset()
+
+	labels := []string{"Fingerprint"}
+	c.lost = exporter.NewCounter("QueryDigestsLost", "Number of queries whose digest was not tracked because the maximum number of digests was reached")
+	exporter.NewCountersFuncWithMultiLabels("QueryDigestCounts", "Number of queries per digest", labels, c.metric(func(r *Row) int64 {
+		return r.Count
+	}))
+	exporter.NewCountersFuncWithMultiLabels("QueryDigestErrorCounts", "Number of failed queries per digest", labels, c.metric(func(r *Row) int64 {
+		return r.Errors
+	}))
+	exporter.NewCountersFuncWithMultiLabels("QueryDigestTimesNs", "Total execution time of the queries per digest", labels, c.metric(func(r *Row) int64 {
+		return int64(r.TotalLatency)
+	}))
+	exporter.NewCountersFuncWithMultiLabels("QueryDigestRowsAffected", "Number of rows affected by the queries per digest", labels, c.metric(func(r *Row) int64 {
+		return r.RowsAffected
+	}))
+	exporter.NewCountersFuncWithMultiLabels("QueryDigestRowsReturned", "Number of rows returned by the queries per digest", labels, c.metric(func(r *Row) int64 {
+		return r.RowsReturned
+	}))
+	exporter.NewCountersFuncWithMultiLabels("QueryDigestRowsExamined", "Number of rows examined by MySQL for the queries per digest, only known with -enable_statement_stats", labels, c.metric(func(r *Row) int64 {
+		return r.RowsExamined
+	}))
+	exporter.NewGaugesFuncWithMultiLabels("QueryDigestLatencyQuantilesNs", "Upper bound of the quantiles of the execution time of the queries per digest", []string{"Fingerprint", "Quantile"}, c.quantiles)
+	return c
+}
+
+// Enabled returns true if the collector collects statistics.
+func (c *Collector) Enabled() bool {
+	return c.enabled
+}
+
+// Window returns the period over which the recent statistics of a
+// digest are computed.
+func (c *Collector) Window() time.Duration {
+	return c.bucketSize * numWindowBuckets
+}
+
+// Record adds the execution of a query to its digest. The rows examined
+// are 0 if MySQL's statement statistics are not read.
+func (c *Collector) Record(fingerprint, text, table string, latency time.Duration, rowsAffected, rowsReturned, rowsExamined int64, failed bool) {
+	if !c.enabled || fingerprint == "" {
+		return
+	}
+	now := c.now()
+	c.get(fingerprint, text, table).record(now, latency, rowsAffected, rowsReturned, rowsExamined, failed)
+}
+
+func (c *Collector) get(fingerprint, text, table string) *digest {
+	c.mu.RLock()
+	d, ok := c.digests[fingerprint]
+	c.mu.RUnlock()
+	if ok {
+		return d
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if d, ok := c.digests[fingerprint]; ok {
+		return d
+	}
+	if len(c.digests) >= c.maxDigests {
+		c.lost.Add(1)
+		return c.other
+	}
+	d = &digest{fingerprint: fingerprint, text: text, table: table, bucketSize: c.bucketSize}
+	c.digests[fingerprint] = d
+	return d
+}
+
+// Reset forgets all the digests.
+func (c *Collector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reset()
+	c.lost.Reset()
+}
+
+func (c *Collector) reset() {
+	c.digests = make(map[string]*digest)
+	c.other = &digest{bucketSize: c.bucketSize}
+}
+
+// Rows returns the statistics of all the digests, ordered by
+// fingerprint. The digest of the queries that could not be tracked
+// comes last, if there were any.
+func (c *Collector) Rows() []*Row {
+	if !c.enabled {
+		return nil
+	}
+	now := c.now()
+	c.mu.RLock()
+	digests := make([]*digest, 0, len(c.digests)+1)
+	for _, d := range c.digests {
+		digests = append(digests, d)
+	}
+	other := c.other
+	c.mu.RUnlock()
+
+	sort.Slice(digests, func(i, j int) bool {
+		return digests[i].fingerprint < digests[j].fingerprint
+	})
+	rows := make([]*Row, 0, len(digests)+1)
+	for _, d := range digests {
+		rows = append(rows, d.row(now))
+	}
+	if r := other.row(now); r.Count > 0 {
+		rows = append(rows, r)
+	}
+	return rows
+}
+
+// SortColumns are the columns the digests can be sorted by in Top.
+var SortColumns = []string{
+	"count_star",
+	"sum_errors",
+	"sum_rows_affected",
+	"sum_rows_returned",
+	"sum_rows_examined",
+	"sum_latency_us",
+	"avg_latency_us",
+	"max_latency_us",
+	"p50_latency_us",
+	"p95_latency_us",
+	"p99_latency_us",
+	"window_count",
+	"window_errors",
+	"window_p99_latency_us",
+}
+
+var sortKeys = map[string]func(r *Row) int64{
+	"count_star":            func(r *Row) int64 { return r.Count },
+	"sum_errors":            func(r *Row) int64 { return r.Errors },
+	"sum_rows_affected":     func(r *Row) int64 { return r.RowsAffected },
+	"sum_rows_returned":     func(r *Row) int64 { return r.RowsReturned },
+	"sum_rows_examined":     func(r *Row) int64 { return r.RowsExamined },
+	"sum_latency_us":        func(r *Row) int64 { return int64(r.TotalLatency) },
+	"avg_latency_us":        func(r *Row) int64 { return int64(r.AvgLatency()) },
+	"max_latency_us":        func(r *Row) int64 { return int64(r.MaxLatency) },
+	"p50_latency_us":        func(r *Row) int64 { return int64(r.P50Latency) },
+	"p95_latency_us":        func(r *Row) int64 { return int64(r.P95Latency) },
+	"p99_latency_us":        func(r *Row) int64 { return int64(r.P99Latency) },
+	"window_count":          func(r *Row) int64 { return r.WindowCount },
+	"window_errors":         func(r *Row) int64 { return r.WindowErrors },
+	"window_p99_latency_us": func(r *Row) int64 { return int64(r.WindowP99Latency) },
+}
+
+// Top returns the n digests with the highest value of the given sort
+// column, or all of them if n is 0.
+func (c *Collector) Top(sortColumn string, n int) ([]*Row, error) {
+	key, ok := sortKeys[sortColumn]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort column %q, expected one of %v", sortColumn, SortColumns)
+	}
+	rows := c.Rows()
+	sort.SliceStable(rows, func(i, j int) bool {
+		return key(rows[i]) > key(rows[j])
+	})
+	if n > 0 && len(rows) > n {
+		rows = rows[:n]
+	}
+	return rows, nil
+}
+
+// metric returns a function exporting a value of every digest.
+func (c *Collector) metric(value func(r *Row) int64) func() map[string]int64 {
+	return func() map[string]int64 {
+		rows := c.Rows()
+		m := make(map[string]int64, len(rows))
+		for _, r := range rows {
+			m[r.metricLabel()] = value(r)
+		}
+		return m
+	}
+}
+
+func (c *Collector) quantiles() map[string]int64 {
+	rows := c.Rows()
+	m := make(map[string]int64, 3*len(rows))
+	for _, r := range rows {
+		label := r.metricLabel()
+		m[label+".p50"] = int64(r.P50Latency)
+		m[label+".p95"] = int64(r.P95Latency)
+		m[label+".p99"] = int64(r.P99Latency)
+	}
+	return m
+}
+
+// Row is a snapshot of the statistics of a digest. The statistics are
+// aggregated since the digest was first seen, except the window ones
+// which only cover the last window.
+type Row struct {
+	// Fingerprint and DigestText are empty for the digest of the
+	// queries that could not be tracked.
+	Fingerprint string
+	DigestText  string
+	Table       string
+
+	Count        int64
+	Errors       int64
+	RowsAffected int64
+	RowsReturned int64
+	RowsExamined int64
+	TotalLatency time.Duration
+	MaxLatency   time.Duration
+	P50Latency   time.Duration
+	P95Latency   time.Duration
+	P99Latency   time.Duration
+
+	WindowCount      int64
+	WindowErrors     int64
+	WindowP99Latency time.Duration
+
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// AvgLatency returns the average execution time of the queries.
+func (r *Row) AvgLatency() time.Duration {
+	if r.Count == 0 {
+		return 0
+	}
+	return r.TotalLatency / time.Duration(r.Count)
+}
+
+func (r *Row) metricLabel() string {
+	if r.Fingerprint == "" {
+		return "other"
+	}
+	return r.Fingerprint
+}
+
+// digest holds the statistics of the queries of a digest.
+type digest struct {
+	fingerprint string
+	text        string
+	table       string
+	bucketSize  time.Duration
+
+	mu        sync.Mutex
+	total     bucket
+	firstSeen time.Time
+	lastSeen  time.Time
+	// window is a ring indexed by the bucket number modulo
+	// numWindowBuckets. The bucket number of a time is the number of
+	// bucketSize intervals since the epoch.
+	window [numWindowBuckets]bucket
+	// last is the number of the most recent bucket.
+	last int64
+}
+
+func (d *digest) record(now time.Time, latency time.Duration, rowsAffected, rowsReturned, rowsExamined int64, failed bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.firstSeen.IsZero() {
+		d.firstSeen = now
+	}
+	d.lastSeen = now
+	d.total.add(latency, rowsAffected, rowsReturned, rowsExamined, failed)
+	n := d.advance(now)
+	d.window[n%numWindowBuckets].add(latency, rowsAffected, rowsReturned, rowsExamined, failed)
+}
+
+// advance expires the window buckets older than the window, and returns
+// the bucket number of now.
+func (d *digest) advance(now time.Time) int64 {
+	n := now.UnixNano() / int64(d.bucketSize)
+	if n <= d.last {
+		return d.last
+	}
+	if n-d.last >= numWindowBuckets {
+		d.window = [numWindowBuckets]bucket{}
+	} else {
+		for i := d.last + 1; i <= n; i++ {
+			d.window[i%numWindowBuckets] = bucket{}
+		}
+	}
+	d.last = n
+	return n
+}
+
+func (d *digest) row(now time.Time) *Row {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.advance(now)
+	var window bucket
+	for i := range d.window {
+		window.merge(&d.window[i])
+	}
+	return &Row{
+		Fingerprint:      d.fingerprint,
+		DigestText:       d.text,
+		Table:            d.table,
+		Count:            d.total.count,
+		Errors:           d.total.errors,
+		RowsAffected:     d.total.rowsAffected,
+		RowsReturned:     d.total.rowsReturned,
+		RowsExamined:     d.total.rowsExamined,
+		TotalLatency:     d.total.latency,
+		MaxLatency:       d.total.latencies.max,
+		P50Latency:       d.total.latencies.quantile(0.5),
+		P95Latency:       d.total.latencies.quantile(0.95),
+		P99Latency:       d.total.latencies.quantile(0.99),
+		WindowCount:      window.count,
+		WindowErrors:     window.errors,
+		WindowP99Latency: window.latencies.quantile(0.99),
+		FirstSeen:        d.firstSeen,
+		LastSeen:         d.lastSeen,
+	}
+}
+
+// bucket holds the statistics of the queries of a digest over a period.
+type bucket struct {
+	count        int64
+	errors       int64
+	rowsAffected int64
+	rowsReturned int64
+	rowsExamined int64
+	latency      time.Duration
+	latencies    histogram
+}
+
+func (b *bucket) add(latency time.Duration, rowsAffected, rowsReturned, rowsExamined int64, failed bool) {
+	b.count++
+	if failed {
+		b.errors++
+	}
+	b.rowsAffected += rowsAffected
+	b.rowsReturned += rowsReturned
+	b.rowsExamined += rowsExamined
+	b.latency += latency
+	b.latencies.add(latency)
+}
+
+func (b *bucket) merge(other *bucket) {
+	b.count += other.count
+	b.errors += other.errors
+	b.rowsAffected += other.rowsAffected
+	b.rowsReturned += other.rowsReturned
+	b.rowsExamined += other.rowsExamined
+	b.latency += other.latency
+	b.latencies.merge(&other.latencies)
+}
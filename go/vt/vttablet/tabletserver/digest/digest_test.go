@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/servenv"
+)
+
+func newTestCollector(t *testing.T, maxDigests int) (*Collector, *time.Time) {
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	c := NewCollector(servenv.NewExporter(t.Name(), "Tablet"), true, maxDigests, 10*time.Second)
+	c.now = func() time.Time { return now }
+	return c, &now
+}
+
+func TestFingerprint(t *testing.T) {
+	fp1, text := Fingerprint("select a from t where id = 1")
+	assert.Equal(t, "select a from t where id = :redacted1", text)
+	fp2, _ := Fingerprint("/* comment */ select a from t where id = 2")
+	assert.Equal(t, fp1, fp2)
+	fp3, _ := Fingerprint("select b from t where id = 1")
+	assert.NotEqual(t, fp1, fp3)
+}
+
+func TestCollectorRecord(t *testing.T) {
+	c, now := newTestCollector(t, 10)
+	first := *now
+	c.Record("fp1", "select a from t", "t", 2*time.Millisecond, 0, 3, 30, false)
+	*now = now.Add(time.Second)
+	c.Record("fp1", "select a from t", "t", 20*time.Millisecond, 0, 1, 10, true)
+	c.Record("fp2", "update t set a = :v1", "t", time.Millisecond, 5, 0, 0, false)
+
+	rows := c.Rows()
+	require.Len(t, rows, 2)
+	assert.Equal(t, &Row{
+		Fingerprint:      "fp1",
+		DigestText:       "select a from t",
+		Table:            "t",
+		Count:            2,
+		Errors:           1,
+		RowsReturned:     4,
+		RowsExamined:     40,
+		TotalLatency:     22 * time.Millisecond,
+		MaxLatency:       20 * time.Millisecond,
+		P50Latency:       2500 * time.Microsecond,
+		P95Latency:       20 * time.Millisecond,
+		P99Latency:       20 * time.Millisecond,
+		WindowCount:      2,
+		WindowErrors:     1,
+		WindowP99Latency: 20 * time.Millisecond,
+		FirstSeen:        first,
+		LastSeen:         *now,
+	}, rows[0])
+	assert.Equal(t, 11*time.Millisecond, rows[0].AvgLatency())
+	assert.Equal(t, "fp2", rows[1].Fingerprint)
+	assert.EqualValues(t, 5, rows[1].RowsAffected)
+}
+
+func TestCollectorWindow(t *testing.T) {
+	c, now := newTestCollector(t, 10)
+	c.Record("fp1", "select a from t", "t", time.Millisecond, 0, 1, 0, false)
+	*now = now.Add(5 * time.Second)
+	c.Record("fp1", "select a from t", "t", time.Millisecond, 0, 1, 0, true)
+
+	rows := c.Rows()
+	assert.EqualValues(t, 2, rows[0].WindowCount)
+	assert.EqualValues(t, 1, rows[0].WindowErrors)
+
+	// The first query leaves the window.
+	*now = now.Add(6 * time.Second)
+	rows = c.Rows()
+	assert.EqualValues(t, 2, rows[0].Count)
+	assert.EqualValues(t, 1, rows[0].WindowCount)
+	assert.EqualValues(t, 1, rows[0].WindowErrors)
+
+	*now = now.Add(time.Minute)
+	rows = c.Rows()
+	assert.EqualValues(t, 2, rows[0].Count)
+	assert.EqualValues(t, 0, rows[0].WindowCount)
+	assert.EqualValues(t, 0, rows[0].WindowP99Latency)
+}
+
+func TestCollectorOverflow(t *testing.T) {
+	c, _ := newTestCollector(t, 1)
+	c.Record("fp1", "select a from t", "t", time.Millisecond, 0, 1, 0, false)
+	c.Record("fp2", "select b from t", "t", time.Millisecond, 0, 1, 0, false)
+	c.Record("fp3", "select c from t", "t", time.Millisecond, 0, 1, 0, false)
+
+	rows := c.Rows()
+	require.Len(t, rows, 2)
+	assert.Equal(t, "fp1", rows[0].Fingerprint)
+	assert.Equal(t, "", rows[1].Fingerprint)
+	assert.Equal(t, "", rows[1].DigestText)
+	assert.EqualValues(t, 2, rows[1].Count)
+	assert.EqualValues(t, 2, c.lost.Get())
+
+	c.Reset()
+	assert.Empty(t, c.Rows())
+	assert.EqualValues(t, 0, c.lost.Get())
+	c.Record("fp2", "select b from t", "t", time.Millisecond, 0, 1, 0, false)
+	rows = c.Rows()
+	require.Len(t, rows, 1)
+	assert.Equal(t, "fp2", rows[0].Fingerprint)
+}
+
+func TestCollectorTop(t *testing.T) {
+	c, _ := newTestCollector(t, 10)
+	c.Record("fp1", "select a from t", "t", time.Millisecond, 0, 1, 0, false)
+	c.Record("fp2", "select b from t", "t", 3*time.Millisecond, 0, 1, 0, false)
+	c.Record("fp3", "select c from t", "t", 2*time.Millisecond, 0, 1, 0, false)
+	c.Record("fp3", "select c from t", "t", 2*time.Millisecond, 0, 1, 0, false)
+
+	rows, err := c.Top("sum_latency_us", 2)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "fp3", rows[0].Fingerprint)
+	assert.Equal(t, "fp2", rows[1].Fingerprint)
+
+	rows, err = c.Top("max_latency_us", 0)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, "fp2", rows[0].Fingerprint)
+
+	_, err = c.Top("unknown", 2)
+	assert.EqualError(t, err, `unknown sort column "unknown", expected one of [count_star sum_errors sum_rows_affected sum_rows_returned sum_rows_examined sum_latency_us avg_latency_us max_latency_us p50_latency_us p95_latency_us p99_latency_us window_count window_errors window_p99_latency_us]`)
+}
+
+func TestCollectorDisabled(t *testing.T) {
+	c := NewCollector(servenv.NewExporter(t.Name(), "Tablet"), false, 10, 10*time.Second)
+	c.Record("fp1", "select a from t", "t", time.Millisecond, 0, 1, 0, false)
+	assert.Empty(t, c.Rows())
+}
+
+func TestCollectorMetrics(t *testing.T) {
+	c, _ := newTestCollector(t, 1)
+	c.Record("fp1", "select a from t", "t", time.Millisecond, 0, 1, 0, false)
+	c.Record("fp2", "select b from t", "t", time.Millisecond, 0, 1, 0, true)
+
+	assert.Equal(t, map[string]int64{"fp1": 1, "other": 1}, c.metric(func(r *Row) int64 { return r.Count })())
+	assert.Equal(t, map[string]int64{
+		"fp1.p50":   int64(time.Millisecond),
+		"fp1.p95":   int64(time.Millisecond),
+		"fp1.p99":   int64(time.Millisecond),
+		"other.p50": int64(time.Millisecond),
+		"other.p95": int64(time.Millisecond),
+		"other.p99": int64(time.Millisecond),
+	}, c.quantiles())
+}
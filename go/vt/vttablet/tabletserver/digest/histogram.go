@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"math"
+	"time"
+)
+
+// latencyCutoffs are the upper bounds of the buckets of a latency
+// histogram. The last bucket holds the latencies above the last cutoff.
+var latencyCutoffs = []time.Duration{
+	100 * time.Microsecond,
+	250 * time.Microsecond,
+	500 * time.Microsecond,
+	1 * time.Millisecond,
+	2500 * time.Microsecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+const numLatencyBuckets = 17
+
+// histogram counts latencies in the buckets delimited by latencyCutoffs,
+// and keeps the maximum so that the last bucket has an upper bound too.
+type histogram struct {
+	buckets [numLatencyBuckets]int64
+	count   int64
+	max     time.Duration
+}
+
+func (h *histogram) add(d time.Duration) {
+	i := 0
+	for i < len(latencyCutoffs) && d > latencyCutoffs[i] {
+		i++
+	}
+	h.buckets[i]++
+	h.count++
+	if d > h.max {
+		h.max = d
+	}
+}
+
+func (h *histogram) merge(other *histogram) {
+	for i := range h.buckets {
+		h.buckets[i] += other.buckets[i]
+	}
+	h.count += other.count
+	if other.max > h.max {
+		h.max = other.max
+	}
+}
+
+// quantile returns an upper bound of the q-quantile of the latencies:
+// the upper bound of the bucket that holds it, or the maximum latency
+// if it is lower.
+func (h *histogram) quantile(q float64) time.Duration {
+	if h.count == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(q * float64(h.count)))
+	if rank < 1 {
+		rank = 1
+	}
+	var seen int64
+	for i, n := range h.buckets {
+		seen += n
+		if seen >= rank {
+			if i < len(latencyCutoffs) && latencyCutoffs[i] < h.max {
+				return latencyCutoffs[i]
+			}
+			return h.max
+		}
+	}
+	return h.max
+}
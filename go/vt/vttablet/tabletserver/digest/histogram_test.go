@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramQuantile(t *testing.T) {
+	var h histogram
+	assert.EqualValues(t, 0, h.quantile(0.5))
+
+	for i := 0; i < 90; i++ {
+		h.add(800 * time.Microsecond)
+	}
+	for i := 0; i < 9; i++ {
+		h.add(40 * time.Millisecond)
+	}
+	h.add(30 * time.Second)
+
+	assert.Equal(t, time.Millisecond, h.quantile(0.5))
+	assert.Equal(t, time.Millisecond, h.quantile(0.9))
+	assert.Equal(t, 50*time.Millisecond, h.quantile(0.95))
+	assert.Equal(t, 50*time.Millisecond, h.quantile(0.99))
+	assert.Equal(t, 30*time.Second, h.quantile(1))
+
+	var other histogram
+	other.add(2 * time.Minute)
+	h.merge(&other)
+	assert.EqualValues(t, 101, h.count)
+	assert.Equal(t, 2*time.Minute, h.max)
+	assert.Equal(t, 2*time.Minute, h.quantile(1))
+}
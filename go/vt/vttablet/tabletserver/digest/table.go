@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// TableName is the name of the virtual table holding the digests of a
+// tablet.
+const TableName = "vitess_digests"
+
+// Columns are the columns of the vitess_digests table. Latencies are in
+// microseconds, and times are UTC.
+var Columns = []string{
+	"shard",
+	"fingerprint",
+	"digest_text",
+	"table_name",
+	"count_star",
+	"sum_errors",
+	"sum_rows_affected",
+	"sum_rows_returned",
+	"sum_rows_examined",
+	"sum_latency_us",
+	"avg_latency_us",
+	"max_latency_us",
+	"p50_latency_us",
+	"p95_latency_us",
+	"p99_latency_us",
+	"window_count",
+	"window_errors",
+	"window_p99_latency_us",
+	"first_seen",
+	"last_seen",
+}
+
+// IsTable returns true if the table expressions only reference the
+// vitess_digests table, without qualifier.
+func IsTable(from sqlparser.TableExprs) bool {
+	if len(from) != 1 {
+		return false
+	}
+	aliased, ok := from[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return false
+	}
+	tableName, ok := aliased.Expr.(sqlparser.TableName)
+	return ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == TableName
+}
+
+// Table returns a derived table holding the rows of the digests, which
+// replaces the vitess_digests table in the queries sent to MySQL, so
+// that they can filter, sort and aggregate the digests like any table.
+func Table(rows []*Row, shard string) *sqlparser.AliasedTableExpr {
+	var union sqlparser.SelectStatement
+	for _, r := range rows {
+		sel := &sqlparser.Select{SelectExprs: rowExprs(r, shard, union == nil), From: dual()}
+		if union == nil {
+			union = sel
+			continue
+		}
+		union = &sqlparser.Union{Left: union, Right: sel}
+	}
+	if union == nil {
+		// An empty table still needs typed columns.
+		union = &sqlparser.Select{
+			SelectExprs: rowExprs(&Row{}, shard, true),
+			From:        dual(),
+			Where:       sqlparser.NewWhere(sqlparser.WhereClause, &sqlparser.ComparisonExpr{Operator: sqlparser.NotEqualOp, Left: sqlparser.NewIntLiteral("1"), Right: sqlparser.NewIntLiteral("1")}),
+		}
+	}
+	return &sqlparser.AliasedTableExpr{
+		Expr: &sqlparser.DerivedTable{Select: union},
+		As:   sqlparser.NewTableIdent(TableName),
+	}
+}
+
+// rowExprs returns the values of the columns of a row. The first row of
+// the table also names the columns.
+func rowExprs(r *Row, shard string, first bool) sqlparser.SelectExprs {
+	values := []sqlparser.Expr{
+		sqlparser.NewStrLiteral(shard),
+		nullableStr(r.Fingerprint),
+		nullableStr(r.DigestText),
+		sqlparser.NewStrLiteral(r.Table),
+		intLiteral(r.Count),
+		intLiteral(r.Errors),
+		intLiteral(r.RowsAffected),
+		intLiteral(r.RowsReturned),
+		intLiteral(r.RowsExamined),
+		intLiteral(r.TotalLatency.Microseconds()),
+		intLiteral(r.AvgLatency().Microseconds()),
+		intLiteral(r.MaxLatency.Microseconds()),
+		intLiteral(r.P50Latency.Microseconds()),
+		intLiteral(r.P95Latency.Microseconds()),
+		intLiteral(r.P99Latency.Microseconds()),
+		intLiteral(r.WindowCount),
+		intLiteral(r.WindowErrors),
+		intLiteral(r.WindowP99Latency.Microseconds()),
+		datetime(r.FirstSeen),
+		datetime(r.LastSeen),
+	}
+	exprs := make(sqlparser.SelectExprs, len(values))
+	for i, v := range values {
+		aliased := &sqlparser.AliasedExpr{Expr: v}
+		if first {
+			aliased.As = sqlparser.NewColIdent(Columns[i])
+		}
+		exprs[i] = aliased
+	}
+	return exprs
+}
+
+func dual() sqlparser.TableExprs {
+	return sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent("dual")}}}
+}
+
+func nullableStr(s string) sqlparser.Expr {
+	if s == "" {
+		return &sqlparser.NullVal{}
+	}
+	return sqlparser.NewStrLiteral(s)
+}
+
+func intLiteral(v int64) sqlparser.Expr {
+	return sqlparser.NewIntLiteral(strconv.FormatInt(v, 10))
+}
+
+func datetime(t time.Time) sqlparser.Expr {
+	if t.IsZero() {
+		return &sqlparser.NullVal{}
+	}
+	return &sqlparser.ConvertExpr{
+		Expr: sqlparser.NewStrLiteral(t.UTC().Format("2006-01-02 15:04:05.000000")),
+		Type: &sqlparser.ConvertType{Type: "datetime", Length: sqlparser.NewIntLiteral("6")},
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestIsTable(t *testing.T) {
+	testcases := []struct {
+		query string
+		want  bool
+	}{
+		{"select * from vitess_digests", true},
+		{"select count(*) from vitess_digests as d where d.count_star > 10", true},
+		{"select * from ks.vitess_digests", false},
+		{"select * from vitess_digests join t", false},
+		{"select * from t", false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, IsTable(stmt.(*sqlparser.Select).From))
+		})
+	}
+}
+
+func TestTable(t *testing.T) {
+	seen := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	rows := []*Row{{
+		Fingerprint:  "fp1",
+		DigestText:   "select 'a' from t",
+		Table:        "t",
+		Count:        2,
+		TotalLatency: 3 * time.Millisecond,
+		FirstSeen:    seen,
+		LastSeen:     seen,
+	}, {
+		Count: 1,
+	}}
+	assert.Equal(t,
+		"(select '-80' as shard, 'fp1' as fingerprint, 'select \\'a\\' from t' as digest_text, 't' as table_name, 2 as count_star, 0 as sum_errors, 0 as sum_rows_affected, 0 as sum_rows_returned, 0 as sum_rows_examined, 3000 as sum_latency_us, 1500 as avg_latency_us, 0 as max_latency_us, 0 as p50_latency_us, 0 as p95_latency_us, 0 as p99_latency_us, 0 as window_count, 0 as window_errors, 0 as window_p99_latency_us, convert('2022-03-01 10:00:00.000000', datetime(6)) as first_seen, convert('2022-03-01 10:00:00.000000', datetime(6)) as last_seen from dual "+
+			"union all select '-80', null, null, '', 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, null, null from dual) as vitess_digests",
+		sqlparser.String(Table(rows, "-80")))
+
+	assert.Equal(t,
+		"(select '-80' as shard, null as fingerprint, null as digest_text, '' as table_name, 0 as count_star, 0 as sum_errors, 0 as sum_rows_affected, 0 as sum_rows_returned, 0 as sum_rows_examined, 0 as sum_latency_us, 0 as avg_latency_us, 0 as max_latency_us, 0 as p50_latency_us, 0 as p95_latency_us, 0 as p99_latency_us, 0 as window_count, 0 as window_errors, 0 as window_p99_latency_us, null as first_seen, null as last_seen from dual where 1 != 1) as vitess_digests",
+		sqlparser.String(Table(nil, "-80")))
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logz"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/digest"
+)
+
+const defaultDigestzLimit = 100
+
+var (
+	digestzHeader = template.Must(template.New("header").Parse(`<thead>
+		<tr>
+			<th>Fingerprint</th>
+			<th>Query</th>
+			<th>Table</th>
+			<th><a href="?sort=count_star&limit={{.Limit}}">Count</a></th>
+			<th><a href="?sort=sum_errors&limit={{.Limit}}">Errors</a></th>
+			<th><a href="?sort=sum_rows_affected&limit={{.Limit}}">Rows affected</a></th>
+			<th><a href="?sort=sum_rows_returned&limit={{.Limit}}">Rows returned</a></th>
+			<th><a href="?sort=sum_rows_examined&limit={{.Limit}}">Rows examined</a></th>
+			<th><a href="?sort=sum_latency_us&limit={{.Limit}}">Time</a></th>
+			<th><a href="?sort=avg_latency_us&limit={{.Limit}}">Time per query</a></th>
+			<th><a href="?sort=p50_latency_us&limit={{.Limit}}">P50</a></th>
+			<th><a href="?sort=p95_latency_us&limit={{.Limit}}">P95</a></th>
+			<th><a href="?sort=p99_latency_us&limit={{.Limit}}">P99</a></th>
+			<th><a href="?sort=max_latency_us&limit={{.Limit}}">Max</a></th>
+			<th><a href="?sort=window_count&limit={{.Limit}}">Count ({{.Window}})</a></th>
+			<th><a href="?sort=window_errors&limit={{.Limit}}">Errors ({{.Window}})</a></th>
+			<th><a href="?sort=window_p99_latency_us&limit={{.Limit}}">P99 ({{.Window}})</a></th>
+			<th>First seen</th>
+			<th>Last seen</th>
+		</tr>
+        </thead>
+	`))
+	digestzTmpl = template.Must(template.New("example").Parse(`
+		<tr class="{{.Color}}">
+			<td>{{.Fingerprint}}</td>
+			<td>{{.Query}}</td>
+			<td>{{.Table}}</td>
+			<td>{{.Count}}</td>
+			<td>{{.Errors}}</td>
+			<td>{{.RowsAffected}}</td>
+			<td>{{.RowsReturned}}</td>
+			<td>{{.RowsExamined}}</td>
+			<td>{{.Time}}</td>
+			<td>{{.TimePQ}}</td>
+			<td>{{.P50}}</td>
+			<td>{{.P95}}</td>
+			<td>{{.P99}}</td>
+			<td>{{.Max}}</td>
+			<td>{{.WindowCount}}</td>
+			<td>{{.WindowErrors}}</td>
+			<td>{{.WindowP99}}</td>
+			<td>{{.FirstSeen.Format "2006-01-02 15:04:05"}}</td>
+			<td>{{.LastSeen.Format "2006-01-02 15:04:05"}}</td>
+		</tr>
+	`))
+)
+
+// digestzRow is used for rendering the statistics of a digest
+// using go's template.
+type digestzRow struct {
+	*digest.Row
+	Color string
+}
+
+// Query returns the text of the digest, truncated for the UI.
+func (r *digestzRow) Query() string {
+	return logz.Wrappable(sqlparser.TruncateForUI(r.DigestText))
+}
+
+// Time returns the total time as a string.
+func (r *digestzRow) Time() string {
+	return formatSeconds(r.TotalLatency)
+}
+
+// TimePQ returns the time per query as a string.
+func (r *digestzRow) TimePQ() string {
+	return formatSeconds(r.AvgLatency())
+}
+
+// P50 returns the median time as a string.
+func (r *digestzRow) P50() string {
+	return formatSeconds(r.P50Latency)
+}
+
+// P95 returns the 95th percentile of the time as a string.
+func (r *digestzRow) P95() string {
+	return formatSeconds(r.P95Latency)
+}
+
+// P99 returns the 99th percentile of the time as a string.
+func (r *digestzRow) P99() string {
+	return formatSeconds(r.P99Latency)
+}
+
+// Max returns the maximum time as a string.
+func (r *digestzRow) Max() string {
+	return formatSeconds(r.MaxLatency)
+}
+
+// WindowP99 returns the 99th percentile of the time over the window as
+// a string.
+func (r *digestzRow) WindowP99() string {
+	return formatSeconds(r.WindowP99Latency)
+}
+
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.6f", d.Seconds())
+}
+
+func digestzHandler(digests *digest.Collector, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if !digests.Enabled() {
+		http.Error(w, "query digests are disabled, start vttablet with -enable_query_digests", http.StatusNotFound)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusInternalServerError)
+		return
+	}
+	sortColumn := r.FormValue("sort")
+	if sortColumn == "" {
+		sortColumn = "sum_latency_us"
+	}
+	limit := defaultDigestzLimit
+	if v := r.FormValue("limit"); v != "" {
+		var err error
+		if limit, err = strconv.Atoi(v); err != nil {
+			http.Error(w, fmt.Sprintf("invalid limit %q: %v", v, err), http.StatusBadRequest)
+			return
+		}
+	}
+	rows, err := digests.Top(sortColumn, limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	logz.StartHTMLTable(w)
+	defer logz.EndHTMLTable(w)
+	header := struct {
+		Limit  int
+		Window time.Duration
+	}{limit, digests.Window()}
+	if err := digestzHeader.Execute(w, header); err != nil {
+		log.Errorf("digestz: couldn't execute template: %v", err)
+	}
+	for _, row := range rows {
+		value := &digestzRow{Row: row}
+		switch timepq := row.AvgLatency(); {
+		case timepq < 10*time.Millisecond:
+			value.Color = "low"
+		case timepq < 100*time.Millisecond:
+			value.Color = "medium"
+		default:
+			value.Color = "high"
+		}
+		if err := digestzTmpl.Execute(w, value); err != nil {
+			log.Errorf("digestz: couldn't execute template: %v", err)
+		}
+	}
+}
+
+func digestzResetHandler(digests *digest.Collector, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	digests.Reset()
+	log.Infof("digestz: reset the query digests")
+	digestzHandler(digests, w, r)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/digest"
+)
+
+func TestDigestzHandler(t *testing.T) {
+	digests := digest.NewCollector(servenv.NewExporter("TestDigestzHandler", "Tablet"), true, 10, time.Minute)
+	digests.Record("0000000000000001", "select a from t where id = :redacted1", "t", 2*time.Millisecond, 0, 1, 0, false)
+	digests.Record("0000000000000002", "select b from t", "t", 200*time.Millisecond, 0, 100, 0, false)
+	digests.Record("0000000000000002", "select b from t", "t", 300*time.Millisecond, 0, 100, 0, true)
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/digestz?sort=count_star&limit=1", nil)
+	digestzHandler(digests, resp, req)
+	body := resp.Body.String()
+	assert.Contains(t, body, `<a href="?sort=p99_latency_us&limit=1">P99</a>`)
+	assert.Contains(t, body, "<td>0000000000000002</td>")
+	assert.Contains(t, body, `<tr class="high">`)
+	assert.NotContains(t, body, "<td>0000000000000001</td>")
+
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/digestz?sort=unknown", nil)
+	digestzHandler(digests, resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.HasPrefix(resp.Body.String(), `unknown sort column "unknown"`))
+
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/digestz/reset", nil)
+	digestzResetHandler(digests, resp, req)
+	assert.NotContains(t, resp.Body.String(), "<td>0000000000000002</td>")
+	assert.Empty(t, digests.Rows())
+}
+
+func TestDigestzHandlerDisabled(t *testing.T) {
+	digests := digest.NewCollector(servenv.NewExporter("TestDigestzHandlerDisabled", "Tablet"), false, 10, time.Minute)
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/digestz", nil)
+	digestzHandler(digests, resp, req)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
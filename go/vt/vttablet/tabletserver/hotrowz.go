@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"html/template"
+	"net/http"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logz"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
+)
+
+var (
+	hotrowzHeader = []byte(`<thead>
+		<tr>
+			<th>Table</th>
+			<th>Primary key</th>
+			<th>Detected from</th>
+			<th>Updates</th>
+			<th>Lock waits</th>
+			<th>Detected</th>
+			<th>Last seen</th>
+			<th>Protected until</th>
+		</tr>
+        </thead>
+	`)
+	hotrowzTmpl = template.Must(template.New("example").Parse(`
+		<tr>
+			<td>{{.Table}}</td>
+			<td>{{.PK}}</td>
+			<td>{{.Source}}</td>
+			<td>{{.DMLs}}</td>
+			<td>{{.LockWaits}}</td>
+			<td>{{.Detected.Format "2006-01-02 15:04:05"}}</td>
+			<td>{{.LastSeen.Format "2006-01-02 15:04:05"}}</td>
+			<td>{{.Until.Format "2006-01-02 15:04:05"}}</td>
+		</tr>
+	`))
+)
+
+func hotrowzHandler(detector *txserializer.Detector, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if !detector.Enabled() {
+		http.Error(w, "adaptive hot row protection is disabled, start vttablet with -enable_hot_row_protection and -hot_row_protection_adaptive", http.StatusNotFound)
+		return
+	}
+
+	logz.StartHTMLTable(w)
+	defer logz.EndHTMLTable(w)
+	w.Write(hotrowzHeader)
+	for _, row := range detector.HotRows() {
+		if err := hotrowzTmpl.Execute(w, row); err != nil {
+			log.Errorf("hotrowz: couldn't execute template: %v", err)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
+)
+
+func TestHotRowzHandler(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Mode = tabletenv.Enable
+	config.HotRowProtection.Adaptive = true
+	config.HotRowProtection.DMLThreshold = 1
+	detector := txserializer.NewDetector(tabletenv.NewEnv(config, t.Name()))
+	detector.RecordDML("t1", "1, 'a'")
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/hotrowz", nil)
+	hotrowzHandler(detector, resp, req)
+	body := resp.Body.String()
+	assert.Contains(t, body, "<td>t1</td>")
+	assert.Contains(t, body, "<td>1, &#39;a&#39;</td>")
+	assert.Contains(t, body, "<td>DML</td>")
+}
+
+func TestHotRowzHandlerDisabled(t *testing.T) {
+	detector := txserializer.NewDetector(tabletenv.NewEnv(tabletenv.NewDefaultConfig(), t.Name()))
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/hotrowz", nil)
+	hotrowzHandler(detector, resp, req)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
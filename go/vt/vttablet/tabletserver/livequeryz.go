@@ -18,6 +18,7 @@ package tabletserver
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -34,6 +35,10 @@ var (
 			<th>Type</th>
 			<th>Query</th>
 			<th>Context</th>
+			<th>Caller</th>
+			<th>Table</th>
+			<th>Fingerprint</th>
+			<th>Rows Streamed</th>
 			<th>Duration</th>
 			<th>Start</th>
 			<th>ConnectionID</th>
@@ -46,6 +51,10 @@ var (
 			<td>{{.Type}}</td>
 			<td>{{.Query}}</td>
 			<td>{{.ContextHTML}}</td>
+			<td>{{.Caller}}</td>
+			<td>{{.Table}}</td>
+			<td><a href='?fingerprint={{.Fingerprint}}'>{{.Fingerprint}}</a></td>
+			<td>{{.RowsStreamed}}</td>
 			<td>{{.Duration}}</td>
 			<td>{{.Start}}</td>
 			<td>{{.ConnID}}</td>
@@ -59,14 +68,15 @@ func livequeryzHandler(queryLists []*QueryList, w http.ResponseWriter, r *http.R
 		acl.SendError(w, err)
 		return
 	}
-	var rows []QueryDetailzRow
-	for _, ql := range queryLists {
-		rows = ql.AppendQueryzRows(rows)
-	}
 	if err := r.ParseForm(); err != nil {
 		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusInternalServerError)
 		return
 	}
+	var rows []QueryDetailzRow
+	filter := queryFilterFromForm(r)
+	for _, ql := range queryLists {
+		rows = ql.AppendFilteredQueryzRows(rows, filter)
+	}
 	format := r.FormValue("format")
 	if format == "json" {
 		js, err := json.Marshal(rows)
@@ -110,3 +120,46 @@ func livequeryzTerminateHandler(queryLists []*QueryList, w http.ResponseWriter,
 	}
 	livequeryzHandler(queryLists, w, r)
 }
+
+// livequeryzKillHandler kills all the queries matching the caller, table
+// and fingerprint parameters of the request. At least one of them must
+// be set.
+func livequeryzKillHandler(queryLists []*QueryList, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusInternalServerError)
+		return
+	}
+	killed, err := killQueries(queryLists, queryFilterFromForm(r))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	log.Infof("livequeryz: killed %d queries", killed)
+	livequeryzHandler(queryLists, w, r)
+}
+
+func queryFilterFromForm(r *http.Request) QueryFilter {
+	return QueryFilter{
+		Caller:      r.FormValue("caller"),
+		Table:       r.FormValue("table"),
+		Fingerprint: r.FormValue("fingerprint"),
+	}
+}
+
+// killQueries kills the queries of all the lists that match the filter.
+// An empty filter is rejected, so that all queries cannot be killed by
+// mistake.
+func killQueries(queryLists []*QueryList, filter QueryFilter) (int, error) {
+	if filter.IsEmpty() {
+		return 0, errors.New("at least one of caller, table or fingerprint must be specified")
+	}
+	killed := 0
+	for _, ql := range queryLists {
+		killed += ql.TerminateMatching(filter)
+	}
+	return killed, nil
+}
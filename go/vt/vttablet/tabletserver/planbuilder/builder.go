@@ -24,6 +24,7 @@ import (
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/digest"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -37,6 +38,14 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 		FullQuery:  GenerateLimitQuery(sel),
 	}
 
+	if isDigestsSelect(sel, tables) {
+		plan.PlanID = PlanSelectDigests
+		plan.FieldQuery = nil
+		plan.FullQuery = nil
+		plan.FullStmt = sel
+		return plan, nil
+	}
+
 	if sel.Where != nil {
 		comp, ok := sel.Where.Expr.(*sqlparser.ComparisonExpr)
 		if ok && comp.IsImpossible() {
@@ -62,6 +71,12 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 	return plan, nil
 }
 
+// isDigestsSelect returns true if the select reads the vitess_digests
+// virtual table, unless a table of that name exists in the schema.
+func isDigestsSelect(sel *sqlparser.Select, tables map[string]*schema.Table) bool {
+	return digest.IsTable(sel.From) && tables[digest.TableName] == nil
+}
+
 // analyzeUpdate code is almost identical to analyzeDelete.
 func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
@@ -74,6 +89,7 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 		buf := sqlparser.NewTrackedBuffer(nil)
 		buf.Myprintf("%v", upd.Where)
 		plan.WhereClause = buf.ParsedQuery()
+		plan.RowPK = rowPK(upd.Where, plan.Table)
 	}
 
 	// Situations when we pass-through:
@@ -103,6 +119,7 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 		buf := sqlparser.NewTrackedBuffer(nil)
 		buf.Myprintf("%v", del.Where)
 		plan.WhereClause = buf.ParsedQuery()
+		plan.RowPK = rowPK(del.Where, plan.Table)
 	}
 
 	if PassthroughDMLs || plan.Table == nil || del.Limit != nil {
@@ -116,6 +133,47 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 	return plan, nil
 }
 
+// rowPK returns the values of the primary key columns of the table if
+// they are all compared for equality to a value in the WHERE clause.
+// It returns nil otherwise.
+func rowPK(where *sqlparser.Where, table *schema.Table) []evalengine.Expr {
+	if table == nil || !table.HasPrimary() {
+		return nil
+	}
+	values := make([]evalengine.Expr, len(table.PKColumns))
+	for _, expr := range sqlparser.SplitAndExpression(nil, where.Expr) {
+		comparison, ok := expr.(*sqlparser.ComparisonExpr)
+		if !ok || comparison.Operator != sqlparser.EqualOp {
+			continue
+		}
+		col, ok := comparison.Left.(*sqlparser.ColName)
+		if !ok {
+			continue
+		}
+		switch comparison.Right.(type) {
+		case *sqlparser.Literal, sqlparser.Argument:
+		default:
+			continue
+		}
+		for i := range table.PKColumns {
+			if values[i] != nil || !col.Name.EqualString(table.GetPKColumn(i).Name) {
+				continue
+			}
+			value, err := evalengine.Translate(comparison.Right, semantics.EmptySemTable())
+			if err != nil {
+				return nil
+			}
+			values[i] = value
+		}
+	}
+	for _, value := range values {
+		if value == nil {
+			return nil
+		}
+	}
+	return values
+}
+
 func analyzeInsert(ins *sqlparser.Insert, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
 		PlanID:    PlanInsert,
@@ -76,6 +76,8 @@ const (
 	PlanAlterMigration
 	PlanRevertMigration
 	PlanShowMigrationLogs
+	// PlanSelectDigests is for selects on the vitess_digests virtual table.
+	PlanSelectDigests
 	NumPlans
 )
 
@@ -108,6 +110,7 @@ var planName = []string{
 	"AlterMigration",
 	"RevertMigration",
 	"ShowMigrationLogs",
+	"SelectDigests",
 }
 
 func (pt PlanType) String() string {
@@ -170,6 +173,11 @@ type Plan struct {
 	// to serialize e.g. UPDATEs going to the same row.
 	WhereClause *sqlparser.ParsedQuery
 
+	// RowPK is set for the DMLs whose WHERE clause sets all the primary
+	// key columns of the table. It holds the values of these columns, and
+	// is used by the adaptive hot row protection to identify the row.
+	RowPK []evalengine.Expr
+
 	// FullStmt can be used when the query does not operate on tables
 	FullStmt sqlparser.Statement
 }
@@ -279,6 +287,11 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 			return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "select with lock not allowed for streaming")
 		}
 		plan.Table = lookupTable(stmt.From, tables)
+		if isDigestsSelect(stmt, tables) {
+			plan.PlanID = PlanSelectDigests
+			plan.FullQuery = nil
+			plan.FullStmt = stmt
+		}
 	case *sqlparser.OtherRead, *sqlparser.Show, *sqlparser.Union, *sqlparser.CallProc, sqlparser.Explain:
 		// pass
 	default:
@@ -48,6 +48,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		FullQuery   *sqlparser.ParsedQuery `json:",omitempty"`
 		NextCount   string                 `json:",omitempty"`
 		WhereClause *sqlparser.ParsedQuery `json:",omitempty"`
+		RowPK       []string               `json:",omitempty"`
 	}{
 		PlanID:      p.PlanID,
 		TableName:   p.TableName(),
@@ -59,6 +60,9 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 	if p.NextCount != nil {
 		mplan.NextCount = evalengine.FormatExpr(p.NextCount)
 	}
+	for _, value := range p.RowPK {
+		mplan.RowPK = append(mplan.RowPK, evalengine.FormatExpr(value))
+	}
 	return json.Marshal(&mplan)
 }
 
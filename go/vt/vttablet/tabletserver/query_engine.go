@@ -34,6 +34,7 @@ import (
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
@@ -41,6 +42,7 @@ import (
 	"vitess.io/vitess/go/vt/tableacl"
 	tacl "vitess.io/vitess/go/vt/tableacl/acl"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/digest"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
@@ -61,6 +63,11 @@ type TabletPlan struct {
 	Rules      *rules.Rules
 	Authorized []*tableacl.ACLResult
 
+	// Fingerprint and DigestText identify the digest of the query. They
+	// are only set if query digests are enabled.
+	Fingerprint string
+	DigestText  string
+
 	QueryCount   uint64
 	Time         uint64
 	MysqlTime    uint64
@@ -131,6 +138,8 @@ type QueryEngine struct {
 	// that we start more than one transaction per hot row (range).
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
+	// hotRows detects the hot rows for the adaptive hot row protection.
+	hotRows *txserializer.Detector
 
 	// Vars
 	maxResultSize    sync2.AtomicInt64
@@ -151,6 +160,8 @@ type QueryEngine struct {
 
 	// stats
 	queryCounts, queryTimes, queryRowCounts, queryErrorCounts, queryRowsAffected, queryRowsReturned *stats.CountersWithMultiLabels
+	// queryRuleRewrites counts the queries rewritten by each rule.
+	queryRuleRewrites *stats.CountersWithSingleLabel
 
 	// Loggers
 	accessCheckerLogger *logutil.ThrottledLogger
@@ -184,6 +195,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 		qe.streamConsolidator = NewStreamConsolidator(config.ConsolidatorStreamTotalSize, config.ConsolidatorStreamQuerySize, returnStreamResult)
 	}
 	qe.txSerializer = txserializer.New(env)
+	qe.hotRows = txserializer.NewDetector(env)
 
 	qe.strictTableACL = config.StrictTableACL
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
@@ -228,6 +240,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.queryRowsAffected = env.Exporter().NewCountersWithMultiLabels("QueryRowsAffected", "query rows affected", []string{"Table", "Plan"})
 	qe.queryRowsReturned = env.Exporter().NewCountersWithMultiLabels("QueryRowsReturned", "query rows returned", []string{"Table", "Plan"})
 	qe.queryErrorCounts = env.Exporter().NewCountersWithMultiLabels("QueryErrorCounts", "query error counts", []string{"Table", "Plan"})
+	qe.queryRuleRewrites = env.Exporter().NewCountersWithSingleLabel("QueryRuleRewrites", "queries rewritten by query rules", "Rule")
 
 	env.Exporter().HandleFunc("/debug/hotrows", qe.txSerializer.ServeHTTP)
 	env.Exporter().HandleFunc("/debug/tablet_plans", qe.handleHTTPQueryPlans)
@@ -265,6 +278,7 @@ func (qe *QueryEngine) Open() error {
 
 	qe.streamConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
 	qe.se.RegisterNotifier("qe", qe.schemaChanged)
+	qe.hotRows.Open()
 	qe.isOpen = true
 	return nil
 }
@@ -277,6 +291,7 @@ func (qe *QueryEngine) Close() {
 		return
 	}
 	// Close in reverse order of Open.
+	qe.hotRows.Close()
 	qe.se.UnregisterNotifier("qe")
 	qe.plans.Clear()
 	qe.tables = make(map[string]*schema.Table)
@@ -315,6 +330,7 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	plan := &TabletPlan{Plan: splan, Original: sql}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
+	qe.setDigest(plan)
 	if plan.PlanID.IsSelect() {
 		if !skipQueryPlanCache && qe.enableQueryPlanFieldCaching && plan.FieldQuery != nil {
 			conn, err := qe.conns.Get(ctx)
@@ -341,6 +357,26 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	return plan, nil
 }
 
+// GetRewrite returns the query that replaces the one of the plan, if
+// one of its rewrite rules matches the request. It returns an empty
+// string otherwise.
+func (qe *QueryEngine) GetRewrite(ctx context.Context, plan *TabletPlan, bindVars map[string]*querypb.BindVariable, marginComments sqlparser.MarginComments) string {
+	if plan.Rules == nil || tabletenv.IsLocalContext(ctx) {
+		return ""
+	}
+	remoteAddr := ""
+	username := ""
+	if ci, ok := callinfo.FromContext(ctx); ok {
+		remoteAddr = ci.RemoteAddr()
+		username = ci.Username()
+	}
+	query, name := plan.Rules.GetRewrite(remoteAddr, username, bindVars, marginComments)
+	if query != "" {
+		qe.queryRuleRewrites.Add(name, 1)
+	}
+	return query
+}
+
 // GetStreamPlan is similar to GetPlan, but doesn't use the cache
 // and doesn't enforce a limit. It just returns the parsed query.
 func (qe *QueryEngine) GetStreamPlan(sql string, isReservedConn bool) (*TabletPlan, error) {
@@ -353,9 +389,18 @@ func (qe *QueryEngine) GetStreamPlan(sql string, isReservedConn bool) (*TabletPl
 	plan := &TabletPlan{Plan: splan, Original: sql}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
+	qe.setDigest(plan)
 	return plan, nil
 }
 
+// setDigest computes the digest of the query of the plan, if query
+// digests are enabled.
+func (qe *QueryEngine) setDigest(plan *TabletPlan) {
+	if qe.env.Config().QueryDigests.Enable {
+		plan.Fingerprint, plan.DigestText = digest.Fingerprint(plan.Original)
+	}
+}
+
 // GetMessageStreamPlan builds a plan for Message streaming.
 func (qe *QueryEngine) GetMessageStreamPlan(name string) (*TabletPlan, error) {
 	qe.mu.RLock()
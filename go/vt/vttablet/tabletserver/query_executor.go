@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -33,11 +34,13 @@ import (
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/digest"
 	p "vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -114,10 +117,12 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		if reply == nil {
 			qre.tsv.qe.AddStats(qre.plan.PlanID, tableName, 1, duration, mysqlTime, 0, 0, 1)
 			qre.plan.AddStats(1, duration, mysqlTime, 0, 0, 1)
+			qre.recordDigest(duration, 0, 0, true)
 			return
 		}
 		qre.tsv.qe.AddStats(qre.plan.PlanID, tableName, 1, duration, mysqlTime, int64(reply.RowsAffected), int64(len(reply.Rows)), 0)
 		qre.plan.AddStats(1, duration, mysqlTime, reply.RowsAffected, uint64(len(reply.Rows)), 0)
+		qre.recordDigest(duration, int64(reply.RowsAffected), int64(len(reply.Rows)), false)
 		qre.logStats.RowsAffected = int(reply.RowsAffected)
 		qre.logStats.Rows = reply.Rows
 		qre.tsv.Stats().ResultHistogram.Add(int64(len(reply.Rows)))
@@ -130,6 +135,10 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	switch qre.plan.PlanID {
 	case p.PlanNextval:
 		return qre.execNextval()
+	case p.PlanSelectDigests:
+		// The digests are not in MySQL, the query does not need the
+		// connection of the transaction.
+		return qre.execSelectDigests()
 	case p.PlanSelectImpossible:
 		// If the fields did not get cached, we have send the query
 		// to mysql, which you can see below.
@@ -267,19 +276,30 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 }
 
 // Stream performs a streaming query execution.
-func (qre *QueryExecutor) Stream(callback StreamCallback) error {
+func (qre *QueryExecutor) Stream(callback StreamCallback) (err error) {
 	qre.logStats.PlanType = qre.plan.PlanID.String()
 
+	var rowsReturned int64
+	streamCallback := callback
+	callback = func(result *sqltypes.Result) error {
+		rowsReturned += int64(len(result.Rows))
+		return streamCallback(result)
+	}
 	defer func(start time.Time) {
 		qre.tsv.stats.QueryTimings.Record(qre.plan.PlanID.String(), start)
 		qre.recordUserQuery("Stream", int64(time.Since(start)))
+		qre.recordDigest(time.Since(start), 0, rowsReturned, err != nil)
 	}(time.Now())
 
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
 
-	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	fullQuery := qre.plan.FullQuery
+	if qre.plan.PlanID == p.PlanSelectDigests {
+		fullQuery = p.GenerateFullQuery(qre.digestsSelect())
+	}
+	sql, sqlWithoutComments, err := qre.generateFinalSQL(fullQuery, qre.bindVars)
 	if err != nil {
 		return err
 	}
@@ -624,6 +644,46 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 	return qre.execDBConn(conn, sql, true)
 }
 
+// execSelectDigests executes a select on the vitess_digests virtual
+// table. MySQL evaluates it on a derived table holding the digests.
+func (qre *QueryExecutor) execSelectDigests() (*sqltypes.Result, error) {
+	maxrows := qre.getSelectLimit()
+	qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
+	conn, err := qre.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+
+	sql, _, err := qre.generateFinalSQL(p.GenerateLimitQuery(qre.digestsSelect()), qre.bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := qre.execDBConn(conn, sql, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := qre.verifyRowCount(int64(len(qr.Rows)), maxrows); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+// digestsSelect returns the select of the plan with the vitess_digests
+// table replaced by the current digests.
+func (qre *QueryExecutor) digestsSelect() *sqlparser.Select {
+	// The statement of the plan is shared, only its copy is modified.
+	sel := *qre.plan.FullStmt.(*sqlparser.Select)
+	sel.From = sqlparser.TableExprs{digest.Table(qre.tsv.digests.Rows(), qre.tsv.sm.target.Shard)}
+	return &sel
+}
+
+// recordDigest adds the execution of the query to its digest, with the
+// rows examined read by recordStatementStats.
+func (qre *QueryExecutor) recordDigest(duration time.Duration, rowsAffected, rowsReturned int64, failed bool) {
+	qre.tsv.digests.Record(qre.plan.Fingerprint, qre.plan.DigestText, qre.plan.TableName().String(), duration, rowsAffected, rowsReturned, qre.logStats.RowsExamined, failed)
+}
+
 func (qre *QueryExecutor) execDMLLimit(conn *StatefulConnection) (*sqltypes.Result, error) {
 	maxrows := qre.tsv.qe.maxResultSize.Get()
 	qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
@@ -666,8 +726,12 @@ func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.getConn")
 	defer span.Finish()
 
+	priority, err := qre.getPriority()
+	if err != nil {
+		return nil, err
+	}
 	start := time.Now()
-	conn, err := qre.tsv.qe.conns.Get(ctx)
+	conn, err := qre.tsv.qe.conns.GetWithPriority(ctx, priority)
 
 	switch err {
 	case nil:
@@ -679,12 +743,30 @@ func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	return nil, err
 }
 
+// getPriority returns the priority requested in the execute options,
+// or the tablet default if there is none.
+func (qre *QueryExecutor) getPriority() (int, error) {
+	priority := qre.options.GetPriority()
+	if priority == "" {
+		return qre.tsv.config.DefaultQueryPriority, nil
+	}
+	intPriority, err := strconv.Atoi(priority)
+	if err != nil || intPriority < 0 || intPriority > sqlparser.MaxPriorityValue {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid priority value: %s, must be an integer between 0 and %d", priority, sqlparser.MaxPriorityValue)
+	}
+	return intPriority, nil
+}
+
 func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.getStreamConn")
 	defer span.Finish()
 
+	priority, err := qre.getPriority()
+	if err != nil {
+		return nil, err
+	}
 	start := time.Now()
-	conn, err := qre.tsv.qe.streamConns.Get(ctx)
+	conn, err := qre.tsv.qe.streamConns.GetWithPriority(ctx, priority)
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
@@ -909,11 +991,16 @@ func (qre *QueryExecutor) execDBConn(conn *connpool.DBConn, sql string, wantfiel
 
 	defer qre.logStats.AddRewrittenSQL(sql, time.Now())
 
-	qd := NewQueryDetail(qre.logStats.Ctx, conn)
+	qd := qre.newQueryDetail(conn)
 	qre.tsv.statelessql.Add(qd)
 	defer qre.tsv.statelessql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	qr, err := conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	if err != nil {
+		return nil, err
+	}
+	qre.recordStatementStats(ctx, conn)
+	return qr, nil
 }
 
 func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string, wantfields bool) (*sqltypes.Result, error) {
@@ -922,22 +1009,28 @@ func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string,
 
 	defer qre.logStats.AddRewrittenSQL(sql, time.Now())
 
-	qd := NewQueryDetail(qre.logStats.Ctx, conn)
+	qd := qre.newQueryDetail(conn)
 	qre.tsv.statefulql.Add(qd)
 	defer qre.tsv.statefulql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	qr, err := conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	if err != nil {
+		return nil, err
+	}
+	qre.recordStatementStats(ctx, conn)
+	return qr, nil
 }
 
 func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callback func(*sqltypes.Result) error) error {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.execStreamSQL")
 	trace.AnnotateSQL(span, sqlparser.Preview(sql))
+	qd := qre.newQueryDetail(conn)
 	callBackClosingSpan := func(result *sqltypes.Result) error {
 		defer span.Finish()
+		qd.rows.Add(int64(len(result.Rows)))
 		return callback(result)
 	}
 
-	qd := NewQueryDetail(qre.logStats.Ctx, conn)
 	qre.tsv.olapql.Add(qd)
 	defer qre.tsv.olapql.Remove(qd)
 
@@ -948,9 +1041,58 @@ func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callb
 		// MySQL error that isn't due to a connection issue
 		return err
 	}
+	qre.recordStatementStats(ctx, conn)
 	return nil
 }
 
+// statementStatsQuery reads the rows examined and the CPU time, in
+// picoseconds, of the last statement that completed on the connection.
+const statementStatsQuery = "select rows_examined, cpu_time from performance_schema.events_statements_history " +
+	"where thread_id = ps_current_thread_id() and nesting_event_id is null order by event_id desc limit 1"
+
+var logStatementStats = logutil.NewThrottledLogger("StatementStats", 1*time.Minute)
+
+// statementStatsConn is a connection the statement statistics are read from.
+type statementStatsConn interface {
+	Exec(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error)
+}
+
+// recordStatementStats adds the rows examined and the CPU time of the
+// query that just ran on conn to the log stats, if enabled.
+func (qre *QueryExecutor) recordStatementStats(ctx context.Context, conn statementStatsConn) {
+	if !qre.tsv.config.EnableStatementStats {
+		return
+	}
+	qr, err := conn.Exec(ctx, statementStatsQuery, 1, false)
+	if err == nil && len(qr.Rows) != 1 {
+		err = fmt.Errorf("no statement found in performance_schema.events_statements_history")
+	}
+	var rowsExamined int64
+	var cpuTime uint64
+	if err == nil {
+		rowsExamined, err = evalengine.ToInt64(qr.Rows[0][0])
+	}
+	if err == nil {
+		cpuTime, err = evalengine.ToUint64(qr.Rows[0][1])
+	}
+	if err != nil {
+		qre.tsv.stats.InternalErrors.Add("StatementStats", 1)
+		logStatementStats.Errorf("Cannot read the statement statistics of the query: %v", err)
+		return
+	}
+	qre.logStats.RowsExamined += rowsExamined
+	qre.logStats.MysqlCPUTime += time.Duration(cpuTime / 1000)
+}
+
+// newQueryDetail returns the QueryDetail under which the query is listed
+// while it runs on conn.
+func (qre *QueryExecutor) newQueryDetail(conn killable) *QueryDetail {
+	qd := NewQueryDetail(qre.logStats.Ctx, conn)
+	qd.caller = callerFromContext(qre.ctx)
+	qd.table = qre.plan.TableName().String()
+	return qd
+}
+
 func (qre *QueryExecutor) recordUserQuery(queryType string, duration int64) {
 	username := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(qre.ctx))
 	if username == "" {
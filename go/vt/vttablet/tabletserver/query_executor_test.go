@@ -484,6 +484,33 @@ func TestQueryExecutorSelectImpossible(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPriority(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from t"
+	fields := sqltypes.MakeTestFields("a|b", "int64|varchar")
+	db.AddQuery("select * from t where 1 != 1", sqltypes.MakeTestResult(fields))
+	db.AddQuery("select * from t limit 10001", sqltypes.MakeTestResult(fields))
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	for _, priority := range []string{"", "0", "100"} {
+		qre := newTestQueryExecutor(ctx, tsv, query, 0)
+		qre.options = &querypb.ExecuteOptions{Priority: priority}
+		_, err := qre.Execute()
+		require.NoError(t, err, priority)
+	}
+
+	for _, priority := range []string{"-1", "101", "high"} {
+		qre := newTestQueryExecutor(ctx, tsv, query, 0)
+		qre.options = &querypb.ExecuteOptions{Priority: priority}
+		_, err := qre.Execute()
+		require.EqualError(t, err, fmt.Sprintf("invalid priority value: %s, must be an integer between 0 and 100", priority))
+		assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	}
+}
+
 // TestDisableOnlineDDL checks whether disabling online DDLs throws the correct error or not
 func TestDisableOnlineDDL(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
@@ -773,6 +800,39 @@ func TestQueryExecutorPlanNextval(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorSelectDigests(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("select * from test_table where pk = 1 limit 10001", &sqltypes.Result{Fields: getTestTableFields()})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableQueryDigests, db)
+	defer tsv.StopService()
+
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table where pk = 1", 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	fingerprint := qre.plan.Fingerprint
+	require.NotEmpty(t, fingerprint)
+
+	want := &sqltypes.Result{
+		Fields: []*querypb.Field{{Name: "fingerprint", Type: sqltypes.VarChar}, {Name: "count_star", Type: sqltypes.Int64}},
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarChar(fingerprint), sqltypes.NewInt64(1)}},
+	}
+	db.AddQueryPattern(`select fingerprint, count_star from \(select '' as shard, '`+fingerprint+`' as fingerprint, 'select \* from test_table where pk = :redacted1' as digest_text, 'test_table' as table_name, 1 as count_star, 0 as sum_errors, 0 as sum_rows_affected, 0 as sum_rows_returned, .* from dual\) as vitess_digests order by sum_latency_us desc limit 10001`, want)
+	qre = newTestQueryExecutor(ctx, tsv, "select fingerprint, count_star from vitess_digests order by sum_latency_us desc", 0)
+	assert.Equal(t, planbuilder.PlanSelectDigests, qre.plan.PlanID)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// Both queries are now in the digests.
+	rows := tsv.digests.Rows()
+	require.Len(t, rows, 2)
+	for _, row := range rows {
+		assert.EqualValues(t, 1, row.Count)
+	}
+}
+
 func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
 	tableacl.Register(aclName, &simpleacl.Factory{})
@@ -1231,6 +1291,7 @@ const (
 	shortTwopcAge
 	smallResultSize
 	disableOnlineDDL
+	enableQueryDigests
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -1266,6 +1327,9 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 	if flags&smallResultSize > 0 {
 		config.Oltp.MaxRows = 2
 	}
+	if flags&enableQueryDigests > 0 {
+		config.QueryDigests.Enable = true
+	}
 	dbconfigs := newDBConfigs(db)
 	config.DB = dbconfigs
 	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), &topodatapb.TabletAlias{})
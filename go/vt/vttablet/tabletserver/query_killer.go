@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// queryKillerInterval is how often the query killer looks for queries
+// and connections that exceed their limits.
+var queryKillerInterval = 1 * time.Second
+
+// Workloads and limits, as exported in the QueryKills stats.
+const (
+	workloadOLAP     = "OLAP"
+	workloadReserved = "Reserved"
+
+	limitDuration = "Duration"
+	limitRows     = "Rows"
+	limitIdle     = "Idle"
+)
+
+// queryKiller enforces the OlapLimits and ReservedConnLimits of the
+// tablet config: it periodically kills the OLAP streaming queries and
+// closes the reserved connections that exceed one of their limits.
+// Reserved connections are only checked while they are not in use.
+type queryKiller struct {
+	env      tabletenv.Env
+	olapql   *QueryList
+	txPool   *TxPool
+	olap     tabletenv.QueryLimitsConfig
+	reserved tabletenv.QueryLimitsConfig
+	ticks    *timer.Timer
+
+	kills       *stats.CountersWithMultiLabels
+	killsDryRun *stats.CountersWithMultiLabels
+}
+
+func newQueryKiller(env tabletenv.Env, olapql *QueryList, txPool *TxPool) *queryKiller {
+	config := env.Config()
+	return &queryKiller{
+		env:         env,
+		olapql:      olapql,
+		txPool:      txPool,
+		olap:        config.OlapLimits,
+		reserved:    config.ReservedConnLimits,
+		ticks:       timer.NewTimer(queryKillerInterval),
+		kills:       env.Exporter().NewCountersWithMultiLabels("QueryKills", "Queries and reserved connections killed for exceeding a limit", []string{"Workload", "Limit"}),
+		killsDryRun: env.Exporter().NewCountersWithMultiLabels("QueryKillsDryRun", "Queries and reserved connections which would have been killed for exceeding a limit", []string{"Workload", "Limit"}),
+	}
+}
+
+// Open starts the query killer if any of the limits is enabled.
+func (qk *queryKiller) Open() {
+	if qk.olap.Mode == tabletenv.Disable && qk.reserved.Mode == tabletenv.Disable {
+		return
+	}
+	qk.ticks.Start(qk.check)
+}
+
+// Close stops the query killer.
+func (qk *queryKiller) Close() {
+	qk.ticks.Stop()
+}
+
+func (qk *queryKiller) check() {
+	defer qk.env.LogError()
+	if qk.olap.Mode != tabletenv.Disable {
+		qk.checkOlap()
+	}
+	if qk.reserved.Mode != tabletenv.Disable {
+		qk.checkReserved()
+	}
+}
+
+func (qk *queryKiller) checkOlap() {
+	for _, qd := range qk.olapql.snapshot() {
+		if qd.violated {
+			continue
+		}
+		elapsed := time.Since(qd.start)
+		var limit string
+		switch {
+		case qk.olap.MaxDurationSeconds > 0 && elapsed > qk.olap.MaxDurationSeconds.Get():
+			limit = limitDuration
+		case qk.olap.MaxRowsStreamed > 0 && qd.rows.Get() > qk.olap.MaxRowsStreamed:
+			limit = limitRows
+		default:
+			continue
+		}
+		qd.violated = true
+		if qk.report(qk.olap.Mode, workloadOLAP, limit, fmt.Sprintf("OLAP query on connection %d", qd.connID), qd.caller) {
+			qd.conn.Kill("exceeded OLAP "+limit+" limit", elapsed)
+		}
+	}
+}
+
+func (qk *queryKiller) checkReserved() {
+	conns := qk.txPool.scp.GetByIdleFilter("for query killer", func(sc *StatefulConnection, idle time.Duration) bool {
+		if !sc.IsTainted() || sc.limitViolated != "" {
+			return false
+		}
+		var limit string
+		switch {
+		case qk.reserved.MaxDurationSeconds > 0 && time.Since(sc.reservedProps.StartTime) > qk.reserved.MaxDurationSeconds.Get():
+			limit = limitDuration
+		case qk.reserved.MaxIdleSeconds > 0 && idle > qk.reserved.MaxIdleSeconds.Get():
+			limit = limitIdle
+		default:
+			return false
+		}
+		sc.limitViolated = limit
+		return qk.report(qk.reserved.Mode, workloadReserved, limit, fmt.Sprintf("reserved connection %d", sc.ConnID), sc.getUsername())
+	})
+	for _, conn := range conns {
+		qk.txPool.kill(conn, "exceeded reserved connection "+conn.limitViolated+" limit")
+	}
+}
+
+// report counts and logs a limit violation. It returns true if the
+// offending query or connection must be killed.
+func (qk *queryKiller) report(mode, workload, limit, what, caller string) bool {
+	if mode == tabletenv.Dryrun {
+		qk.killsDryRun.Add([]string{workload, limit}, 1)
+		log.Infof("Query killer dry run: would kill %s of caller %q for exceeding the %s limit", what, caller, limit)
+		return false
+	}
+	qk.kills.Add([]string{workload, limit}, 1)
+	log.Warningf("Query killer: killing %s of caller %q for exceeding the %s limit", what, caller, limit)
+	return true
+}
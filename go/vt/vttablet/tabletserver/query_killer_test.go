@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
+)
+
+func newTestOlapQueryList() (*QueryList, []*testConn) {
+	ql := NewQueryList("olap")
+	conns := []*testConn{
+		{id: 1, query: "select * from t1"},
+		{id: 2, query: "select * from t2"},
+		{id: 3, query: "select * from t3"},
+	}
+	for i, conn := range conns {
+		qd := NewQueryDetail(context.Background(), conn)
+		switch i {
+		case 0:
+			qd.start = qd.start.Add(-2 * time.Second)
+		case 1:
+			qd.rows.Set(11)
+		}
+		ql.Add(qd)
+	}
+	return ql, conns
+}
+
+func TestQueryKillerOlap(t *testing.T) {
+	env := newEnv("QueryKillerTest")
+	env.Config().OlapLimits = tabletenv.QueryLimitsConfig{
+		Mode:               tabletenv.Enable,
+		MaxDurationSeconds: 1,
+		MaxRowsStreamed:    10,
+	}
+	ql, conns := newTestOlapQueryList()
+	qk := newQueryKiller(env, ql, nil)
+	startDuration := qk.kills.Counts()["OLAP.Duration"]
+	startRows := qk.kills.Counts()["OLAP.Rows"]
+
+	qk.check()
+	assert.True(t, conns[0].IsKilled())
+	assert.True(t, conns[1].IsKilled())
+	assert.False(t, conns[2].IsKilled())
+	assert.EqualValues(t, 1, qk.kills.Counts()["OLAP.Duration"]-startDuration)
+	assert.EqualValues(t, 1, qk.kills.Counts()["OLAP.Rows"]-startRows)
+
+	// Queries are killed only once.
+	qk.check()
+	assert.EqualValues(t, 1, qk.kills.Counts()["OLAP.Duration"]-startDuration)
+}
+
+func TestQueryKillerOlapDryRun(t *testing.T) {
+	env := newEnv("QueryKillerTest")
+	env.Config().OlapLimits = tabletenv.QueryLimitsConfig{
+		Mode:               tabletenv.Dryrun,
+		MaxDurationSeconds: 1,
+		MaxRowsStreamed:    10,
+	}
+	ql, conns := newTestOlapQueryList()
+	qk := newQueryKiller(env, ql, nil)
+	startDuration := qk.killsDryRun.Counts()["OLAP.Duration"]
+	startRows := qk.killsDryRun.Counts()["OLAP.Rows"]
+
+	qk.check()
+	qk.check()
+	for _, conn := range conns {
+		assert.False(t, conn.IsKilled())
+	}
+	assert.EqualValues(t, 1, qk.killsDryRun.Counts()["OLAP.Duration"]-startDuration)
+	assert.EqualValues(t, 1, qk.killsDryRun.Counts()["OLAP.Rows"]-startRows)
+}
+
+func TestQueryKillerReserved(t *testing.T) {
+	env := newEnv("QueryKillerTest")
+	env.Config().ReservedConnLimits = tabletenv.QueryLimitsConfig{
+		Mode:           tabletenv.Enable,
+		MaxIdleSeconds: 0.05,
+	}
+	db, txPool, _, closer := setupWithEnv(t, env)
+	defer db.Close()
+	defer closer()
+	qk := newQueryKiller(env, NewQueryList("olap"), txPool)
+	startKills := env.Stats().KillCounters.Counts()["ReservedConnection"]
+
+	reserved, err := txPool.scp.NewConn(ctx, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	require.NoError(t, reserved.Taint(ctx, nil))
+	reserved.Unlock()
+	// Connections that are not reserved are left to the transaction killer.
+	notReserved, err := txPool.scp.NewConn(ctx, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	notReserved.Unlock()
+
+	qk.check()
+	assert.False(t, reserved.IsClosed())
+
+	time.Sleep(100 * time.Millisecond)
+	qk.check()
+	assert.True(t, reserved.IsClosed())
+	assert.False(t, notReserved.IsClosed())
+	assert.EqualValues(t, 1, env.Stats().KillCounters.Counts()["ReservedConnection"]-startKills)
+	assert.EqualValues(t, 1, txPool.scp.active.Size())
+	notReserved.Release(tx.ConnRelease)
+}
@@ -25,8 +25,11 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/digest"
 )
 
 // QueryDetail is a simple wrapper for Query, Context and a killable conn.
@@ -35,6 +38,16 @@ type QueryDetail struct {
 	conn   killable
 	connID int64
 	start  time.Time
+
+	// caller and table are used to find the query when listing or
+	// killing queries. rows counts the rows streamed so far.
+	caller string
+	table  string
+	rows   sync2.AtomicInt64
+
+	// violated is set by the query killer once it has reported the
+	// query, so that dry runs do not report it again.
+	violated bool
 }
 
 type killable interface {
@@ -48,6 +61,48 @@ func NewQueryDetail(ctx context.Context, conn killable) *QueryDetail {
 	return &QueryDetail{ctx: ctx, conn: conn, connID: conn.ID(), start: time.Now()}
 }
 
+// callerFromContext returns the principal of the effective caller, or the
+// username of the immediate caller if there is no principal.
+func callerFromContext(ctx context.Context) string {
+	if caller := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)); caller != "" {
+		return caller
+	}
+	return callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+}
+
+// queryFingerprint returns the fingerprint of the digest of the query,
+// shared by all its executions.
+func queryFingerprint(query string) string {
+	fingerprint, _ := digest.Fingerprint(query)
+	return fingerprint
+}
+
+// QueryFilter selects queries of a QueryList. Empty fields match all the
+// queries.
+type QueryFilter struct {
+	Caller      string
+	Table       string
+	Fingerprint string
+}
+
+// IsEmpty returns true if the filter matches all the queries.
+func (f QueryFilter) IsEmpty() bool {
+	return f.Caller == "" && f.Table == "" && f.Fingerprint == ""
+}
+
+func (f QueryFilter) matches(qd *QueryDetail, query string) bool {
+	if f.Caller != "" && f.Caller != qd.caller {
+		return false
+	}
+	if f.Table != "" && f.Table != qd.table {
+		return false
+	}
+	if f.Fingerprint != "" && f.Fingerprint != queryFingerprint(query) {
+		return false
+	}
+	return true
+}
+
 // QueryList holds a thread safe list of QueryDetails
 type QueryList struct {
 	name string
@@ -99,6 +154,33 @@ func (ql *QueryList) TerminateAll() {
 	}
 }
 
+// TerminateMatching kills the connections of all the queries that match
+// the filter, and returns how many were killed.
+func (ql *QueryList) TerminateMatching(filter QueryFilter) int {
+	ql.mu.Lock()
+	defer ql.mu.Unlock()
+	killed := 0
+	for _, qd := range ql.queryDetails {
+		if !filter.matches(qd, qd.conn.Current()) {
+			continue
+		}
+		qd.conn.Kill("QueryList.TerminateMatching()", time.Since(qd.start))
+		killed++
+	}
+	return killed
+}
+
+// snapshot returns the QueryDetails currently in the list.
+func (ql *QueryList) snapshot() []*QueryDetail {
+	ql.mu.Lock()
+	defer ql.mu.Unlock()
+	qds := make([]*QueryDetail, 0, len(ql.queryDetails))
+	for _, qd := range ql.queryDetails {
+		qds = append(qds, qd)
+	}
+	return qds
+}
+
 // QueryDetailzRow is used for rendering QueryDetail in a template
 type QueryDetailzRow struct {
 	Type              string
@@ -109,6 +191,10 @@ type QueryDetailzRow struct {
 	ConnID            int64
 	State             string
 	ShowTerminateLink bool
+	Caller            string
+	Table             string
+	Fingerprint       string
+	RowsStreamed      int64
 }
 
 type byStartTime []QueryDetailzRow
@@ -119,19 +205,33 @@ func (a byStartTime) Less(i, j int) bool { return a[i].Start.Before(a[j].Start)
 
 // AppendQueryzRows returns a list of QueryDetailzRow sorted by start time
 func (ql *QueryList) AppendQueryzRows(rows []QueryDetailzRow) []QueryDetailzRow {
+	return ql.AppendFilteredQueryzRows(rows, QueryFilter{})
+}
+
+// AppendFilteredQueryzRows returns a list of QueryDetailzRow of the queries
+// matching the filter, sorted by start time
+func (ql *QueryList) AppendFilteredQueryzRows(rows []QueryDetailzRow, filter QueryFilter) []QueryDetailzRow {
 	ql.mu.Lock()
 	for _, qd := range ql.queryDetails {
 		query := qd.conn.Current()
+		if !filter.matches(qd, query) {
+			continue
+		}
+		fingerprint := queryFingerprint(query)
 		if *streamlog.RedactDebugUIQueries {
 			query, _ = sqlparser.RedactSQLQuery(query)
 		}
 		row := QueryDetailzRow{
-			Type:        ql.name,
-			Query:       query,
-			ContextHTML: callinfo.HTMLFromContext(qd.ctx),
-			Start:       qd.start,
-			Duration:    time.Since(qd.start),
-			ConnID:      qd.connID,
+			Type:         ql.name,
+			Query:        query,
+			ContextHTML:  callinfo.HTMLFromContext(qd.ctx),
+			Start:        qd.start,
+			Duration:     time.Since(qd.start),
+			ConnID:       qd.connID,
+			Caller:       qd.caller,
+			Table:        qd.table,
+			Fingerprint:  fingerprint,
+			RowsStreamed: qd.rows.Get(),
 		}
 		rows = append(rows, row)
 	}
@@ -21,6 +21,9 @@ import (
 	"time"
 
 	"context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testConn struct {
@@ -66,3 +69,59 @@ func TestQueryList(t *testing.T) {
 		t.Errorf("failed to remove from QueryList")
 	}
 }
+
+func TestQueryListFilter(t *testing.T) {
+	ql := NewQueryList("test")
+	conns := []*testConn{
+		{id: 1, query: "select * from t1 where id = 1"},
+		{id: 2, query: "select * from t1 where id = 2"},
+		{id: 3, query: "select * from t2 where id = 1"},
+	}
+	for i, conn := range conns {
+		qd := NewQueryDetail(context.Background(), conn)
+		qd.caller = "user1"
+		if i == 2 {
+			qd.caller = "user2"
+		}
+		qd.table = "t1"
+		if i == 2 {
+			qd.table = "t2"
+		}
+		ql.Add(qd)
+	}
+
+	rows := ql.AppendQueryzRows(nil)
+	require.Len(t, rows, 3)
+	assert.Equal(t, rows[0].Fingerprint, rows[1].Fingerprint)
+	assert.NotEqual(t, rows[0].Fingerprint, rows[2].Fingerprint)
+
+	rows = ql.AppendFilteredQueryzRows(nil, QueryFilter{Caller: "user2"})
+	require.Len(t, rows, 1)
+	assert.EqualValues(t, 3, rows[0].ConnID)
+	assert.Equal(t, "t2", rows[0].Table)
+
+	fingerprint := queryFingerprint(conns[0].query)
+	rows = ql.AppendFilteredQueryzRows(nil, QueryFilter{Caller: "user1", Fingerprint: fingerprint})
+	assert.Len(t, rows, 2)
+
+	_, err := killQueries([]*QueryList{ql}, QueryFilter{})
+	assert.EqualError(t, err, "at least one of caller, table or fingerprint must be specified")
+
+	killed, err := killQueries([]*QueryList{ql}, QueryFilter{Table: "t1"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, killed)
+	assert.True(t, conns[0].IsKilled())
+	assert.True(t, conns[1].IsKilled())
+	assert.False(t, conns[2].IsKilled())
+}
+
+func TestQueryFingerprint(t *testing.T) {
+	assert.Equal(t,
+		queryFingerprint("select a from t where id = 1"),
+		queryFingerprint("/* comment */ select a from t where id = 2"),
+	)
+	assert.NotEqual(t,
+		queryFingerprint("select a from t where id = 1"),
+		queryFingerprint("select b from t where id = 1"),
+	)
+}
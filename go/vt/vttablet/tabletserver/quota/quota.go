@@ -0,0 +1,526 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quota enforces the resource quotas of a keyspace. The quotas
+// are stored in the global topology and watched by every tablet of the
+// keyspace, which enforces them on the queries it serves: a quota with
+// a limit of 100 QPS lets every tablet serve 100 QPS to its callers.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Resources limited by a quota, used in errors and metrics.
+const (
+	resourceConcurrentQueries = "concurrent_queries"
+	resourceQPS               = "qps"
+	resourceRowsReturned      = "rows_returned"
+	resourceResponseSeconds   = "response_seconds"
+	resourceRowsRead          = "rows_read"
+	resourceCPUSeconds        = "cpu_seconds"
+)
+
+const (
+	defaultWindow = time.Second
+	// maxUsages is the number of per caller usages a quota keeps before
+	// it starts removing the idle ones.
+	maxUsages = 1024
+)
+
+// watchRetryDelay is how long to wait before watching the quotas
+// again after an error.
+var watchRetryDelay = 10 * time.Second
+
+// Enforcer checks the queries against the quotas of the keyspace.
+type Enforcer struct {
+	env            tabletenv.Env
+	ts             *topo.Server
+	enabled        bool
+	dryRun         bool
+	statementStats bool
+	keyspace       string
+
+	mu     sync.Mutex
+	quotas []*quota
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	rejections       *stats.CountersWithMultiLabels
+	rejectionsDryRun *stats.CountersWithMultiLabels
+	waitTimes        *servenv.TimingsWrapper
+}
+
+// NewEnforcer creates a new Enforcer. It enforces no quotas until it
+// is opened, or if quotas are not enabled in the config.
+func NewEnforcer(env tabletenv.Env, ts *topo.Server) *Enforcer {
+	config := env.Config()
+	return &Enforcer{
+		env:              env,
+		ts:               ts,
+		enabled:          config.EnableQuotas || config.EnableQuotasDryRun,
+		dryRun:           config.EnableQuotasDryRun,
+		statementStats:   config.EnableStatementStats,
+		rejections:       env.Exporter().NewCountersWithMultiLabels("QuotaRejections", "Queries rejected by quotas", []string{"Quota", "Resource"}),
+		rejectionsDryRun: env.Exporter().NewCountersWithMultiLabels("QuotaRejectionsDryRun", "Queries that would have been rejected by quotas in dry run", []string{"Quota", "Resource"}),
+		waitTimes:        env.Exporter().NewTimings("QuotaWaitTime", "Time queries waited because of quotas", "Quota"),
+	}
+}
+
+// InitDBConfig sets the keyspace whose quotas are enforced.
+func (e *Enforcer) InitDBConfig(keyspace string) {
+	e.keyspace = keyspace
+}
+
+// Open starts watching the quotas of the keyspace.
+func (e *Enforcer) Open() {
+	if !e.enabled || e.ts == nil || e.keyspace == "" {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+	e.wg.Add(1)
+	go e.watch(ctx)
+}
+
+// Close stops watching the quotas, and stops enforcing them.
+func (e *Enforcer) Close() {
+	e.mu.Lock()
+	cancel := e.cancel
+	e.cancel = nil
+	e.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	e.wg.Wait()
+	e.SetQuotas(nil)
+}
+
+func (e *Enforcer) watch(ctx context.Context) {
+	defer e.wg.Done()
+	for {
+		e.watchOnce(ctx)
+		if err := timer.SleepContext(ctx, watchRetryDelay); err != nil {
+			return
+		}
+	}
+}
+
+// watchOnce applies the quotas as they change, until the watch fails.
+func (e *Enforcer) watchOnce(ctx context.Context) {
+	current, changes, cancel := e.ts.WatchQuotas(ctx, e.keyspace)
+	if current.Err != nil {
+		if topo.IsErrType(current.Err, topo.NoNode) {
+			e.SetQuotas(nil)
+		} else {
+			log.Errorf("Error watching quotas of keyspace %v: %v", e.keyspace, current.Err)
+		}
+		return
+	}
+	e.SetQuotas(current.Value)
+	for {
+		select {
+		case <-ctx.Done():
+			cancel()
+			for range changes {
+			}
+			return
+		case wd, ok := <-changes:
+			if !ok {
+				return
+			}
+			if wd.Err != nil {
+				if topo.IsErrType(wd.Err, topo.NoNode) {
+					e.SetQuotas(nil)
+				} else if !topo.IsErrType(wd.Err, topo.Interrupted) {
+					log.Errorf("Error watching quotas of keyspace %v: %v", e.keyspace, wd.Err)
+				}
+				return
+			}
+			e.SetQuotas(wd.Value)
+		}
+	}
+}
+
+// SetQuotas replaces the enforced quotas. The usage of a quota is kept
+// if the new one has the same name and window.
+func (e *Enforcer) SetQuotas(quotas *quotapb.Quotas) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	old := make(map[string]*quota, len(e.quotas))
+	for _, q := range e.quotas {
+		old[q.config.Name] = q
+	}
+	newQuotas := make([]*quota, 0, len(quotas.GetQuotas()))
+	for _, config := range quotas.GetQuotas() {
+		if !e.statementStats && (config.MaxRowsRead > 0 || config.MaxCpuSeconds > 0) {
+			log.Warningf("Quota %v limits the rows read or the CPU time, which are not enforced without -enable_statement_stats", config.Name)
+		}
+		q := newQuota(config)
+		if prev, ok := old[config.Name]; ok && prev.window == q.window {
+			prev.mu.Lock()
+			for _, u := range prev.usages {
+				u.q = q
+			}
+			q.usages = prev.usages
+			q.mu = prev.mu
+			prev.mu.Unlock()
+		}
+		newQuotas = append(newQuotas, q)
+	}
+	e.quotas = newQuotas
+}
+
+// Ticket holds the resources a query acquired from the quotas it
+// matches. It must be released once the query is done.
+type Ticket struct {
+	e      *Enforcer
+	usages []*usage
+}
+
+// Acquire checks a query against the quotas matching its caller and
+// workload. Depending on the action of the exceeded quotas, it waits
+// or returns a RESOURCE_EXHAUSTED error. If it succeeds, the returned
+// ticket must be released when the query is done.
+func (e *Enforcer) Acquire(ctx context.Context, options *querypb.ExecuteOptions) (*Ticket, error) {
+	if e == nil || !e.enabled {
+		return nil, nil
+	}
+	e.mu.Lock()
+	quotas := e.quotas
+	e.mu.Unlock()
+	if len(quotas) == 0 {
+		return nil, nil
+	}
+
+	immediate := callerid.ImmediateCallerIDFromContext(ctx)
+	effective := callerid.EffectiveCallerIDFromContext(ctx)
+	workload := options.GetWorkload()
+	if workload == querypb.ExecuteOptions_UNSPECIFIED {
+		workload = querypb.ExecuteOptions_OLTP
+	}
+
+	ticket := &Ticket{e: e}
+	for _, q := range quotas {
+		if !q.matches(immediate, effective, workload) {
+			continue
+		}
+		u := q.usage(immediate, effective)
+		if err := e.admit(ctx, q, u); err != nil {
+			ticket.Release(Usage{})
+			return nil, err
+		}
+		ticket.usages = append(ticket.usages, u)
+	}
+	return ticket, nil
+}
+
+// Usage is the resources used by a query, recorded when its ticket is
+// released.
+type Usage struct {
+	// RowsReturned is the number of rows returned by the query.
+	RowsReturned int64
+	// ResponseTime is the time MySQL took to respond to the query.
+	ResponseTime time.Duration
+	// RowsRead is the number of rows examined by MySQL, and CPUTime the
+	// CPU time MySQL spent, for the query. They are only known with
+	// -enable_statement_stats.
+	RowsRead int64
+	CPUTime  time.Duration
+}
+
+// Release returns the resources held by the ticket, and records the
+// resources used by the query.
+func (t *Ticket) Release(used Usage) {
+	if t == nil {
+		return
+	}
+	now := time.Now()
+	for _, u := range t.usages {
+		u.q.mu.Lock()
+		u.running--
+		u.rows.add(now, float64(used.RowsReturned))
+		u.responseTime.add(now, used.ResponseTime.Seconds())
+		u.rowsRead.add(now, float64(used.RowsRead))
+		u.cpuTime.add(now, used.CPUTime.Seconds())
+		close(u.released)
+		u.released = make(chan struct{})
+		u.q.mu.Unlock()
+	}
+	t.usages = nil
+}
+
+// admit waits until the query is allowed by the quota, according to its
+// action, and marks it as running.
+func (e *Enforcer) admit(ctx context.Context, q *quota, u *usage) error {
+	start := time.Now()
+	action := q.config.Action
+	deadline, hasDeadline := ctx.Deadline()
+	if q.maxWait > 0 && (!hasDeadline || start.Add(q.maxWait).Before(deadline)) {
+		deadline, hasDeadline = start.Add(q.maxWait), true
+	}
+
+	var timeout <-chan time.Time
+	if hasDeadline {
+		t := time.NewTimer(time.Until(deadline))
+		defer t.Stop()
+		timeout = t.C
+	}
+
+	if action == quotapb.Action_QUEUE && !e.dryRun {
+		// Only the query at the head of the queue checks the quota,
+		// so that they are admitted in order.
+		select {
+		case <-u.turn:
+			defer func() { u.turn <- struct{}{} }()
+		case <-ctx.Done():
+			return e.reject(q, resourceConcurrentQueries, ctx.Err().Error())
+		case <-timeout:
+			return e.reject(q, resourceConcurrentQueries, "timed out waiting in queue")
+		}
+	}
+
+	waited, delayed := false, false
+	for {
+		now := time.Now()
+		q.mu.Lock()
+		resource, wait := u.check(now)
+		// DELAY admits the query once it waited for the rate limits,
+		// even if they are still exceeded.
+		if resource == "" || e.dryRun || (delayed && resource != resourceConcurrentQueries) {
+			if resource != "" && e.dryRun {
+				e.rejectionsDryRun.Add([]string{q.config.Name, resource}, 1)
+			}
+			u.running++
+			u.queries.add(now, 1)
+			q.mu.Unlock()
+			if waited {
+				e.waitTimes.Record(q.config.Name, start)
+			}
+			return nil
+		}
+		released := u.released
+		q.mu.Unlock()
+
+		if action == quotapb.Action_REJECT {
+			return e.reject(q, resource, "")
+		}
+		if wait > 0 && hasDeadline && now.Add(wait).After(deadline) {
+			return e.reject(q, resource, fmt.Sprintf("would wait %v", wait))
+		}
+
+		// Wait for a running query to be released, or for the
+		// usage to expire, whichever comes first.
+		var expired <-chan time.Time
+		var t *time.Timer
+		if wait > 0 {
+			t = time.NewTimer(wait)
+			expired = t.C
+		}
+		var err error
+		select {
+		case <-released:
+		case <-expired:
+			delayed = action == quotapb.Action_DELAY
+		case <-ctx.Done():
+			err = e.reject(q, resource, ctx.Err().Error())
+		case <-timeout:
+			err = e.reject(q, resource, "timed out waiting")
+		}
+		if t != nil {
+			t.Stop()
+		}
+		if err != nil {
+			return err
+		}
+		waited = true
+	}
+}
+
+func (e *Enforcer) reject(q *quota, resource, detail string) error {
+	e.rejections.Add([]string{q.config.Name, resource}, 1)
+	if detail != "" {
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "quota %s exceeded: %s (%s)", q.config.Name, resource, detail)
+	}
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "quota %s exceeded: %s", q.config.Name, resource)
+}
+
+// quota is the state of a quota on this tablet.
+type quota struct {
+	config  *quotapb.Quota
+	window  time.Duration
+	maxWait time.Duration
+
+	// mu protects usages and their content. It is a pointer so that
+	// it can be shared by successive versions of the same quota.
+	mu     *sync.Mutex
+	usages map[string]*usage
+}
+
+func newQuota(config *quotapb.Quota) *quota {
+	window := defaultWindow
+	if config.WindowSeconds > 0 {
+		window = time.Duration(config.WindowSeconds) * time.Second
+	}
+	return &quota{
+		config:  config,
+		window:  window,
+		maxWait: time.Duration(config.MaxWaitMs) * time.Millisecond,
+		mu:      &sync.Mutex{},
+		usages:  make(map[string]*usage),
+	}
+}
+
+func (q *quota) matches(immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID, workload querypb.ExecuteOptions_Workload) bool {
+	return matchField(q.config.Username, immediate.GetUsername()) &&
+		matchField(q.config.Principal, effective.GetPrincipal()) &&
+		matchField(q.config.Component, effective.GetComponent()) &&
+		matchField(q.config.Subcomponent, effective.GetSubcomponent()) &&
+		matchField(q.config.Workload, workload.String())
+}
+
+func matchField(want, got string) bool {
+	return want == "" || strings.EqualFold(want, got)
+}
+
+// usage returns the usage of the caller, which is shared by all the
+// callers unless the quota is per caller.
+func (q *quota) usage(immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID) *usage {
+	key := ""
+	if q.config.PerCaller {
+		key = strings.Join([]string{
+			immediate.GetUsername(),
+			effective.GetPrincipal(),
+			effective.GetComponent(),
+			effective.GetSubcomponent(),
+		}, "/")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if u, ok := q.usages[key]; ok {
+		return u
+	}
+	if len(q.usages) >= maxUsages {
+		q.removeIdleUsages(time.Now())
+	}
+	u := &usage{
+		q:            q,
+		queries:      newSlidingWindow(q.window),
+		rows:         newSlidingWindow(q.window),
+		responseTime: newSlidingWindow(q.window),
+		rowsRead:     newSlidingWindow(q.window),
+		cpuTime:      newSlidingWindow(q.window),
+		turn:         make(chan struct{}, 1),
+		released:     make(chan struct{}),
+	}
+	u.turn <- struct{}{}
+	q.usages[key] = u
+	return u
+}
+
+// removeIdleUsages removes the usages with no running or waiting query,
+// and no recent activity. It must be called with mu held.
+func (q *quota) removeIdleUsages(now time.Time) {
+	for key, u := range q.usages {
+		if u.running == 0 && len(u.turn) == 1 && u.queries.sum(now) == 0 && u.rows.sum(now) == 0 && u.responseTime.sum(now) == 0 &&
+			u.rowsRead.sum(now) == 0 && u.cpuTime.sum(now) == 0 {
+			delete(q.usages, key)
+		}
+	}
+}
+
+// usage is the resources used by the callers sharing a quota.
+type usage struct {
+	// q is the latest version of the quota, whose limits are enforced.
+	q *quota
+
+	running      int64
+	queries      *slidingWindow
+	rows         *slidingWindow
+	responseTime *slidingWindow
+	rowsRead     *slidingWindow
+	cpuTime      *slidingWindow
+
+	// turn holds a token taken by the query at the head of the queue.
+	turn chan struct{}
+	// released is closed, and replaced, when a query is released.
+	released chan struct{}
+}
+
+// check returns the first resource over its limit, and how long it will
+// take for the rate limits to be met again. The wait is 0 if only the
+// concurrent queries are over the limit. It must be called with the
+// quota mutex held.
+func (u *usage) check(now time.Time) (string, time.Duration) {
+	config := u.q.config
+	windowSeconds := u.q.window.Seconds()
+	resource := ""
+	var wait time.Duration
+	exceeded := func(name string, w *slidingWindow, limit float64) {
+		if d := w.timeUntilBelow(now, limit); d > 0 {
+			if resource == "" {
+				resource = name
+			}
+			if d > wait {
+				wait = d
+			}
+		}
+	}
+	if config.MaxConcurrentQueries > 0 && u.running >= config.MaxConcurrentQueries {
+		resource = resourceConcurrentQueries
+	}
+	if config.MaxQps > 0 {
+		exceeded(resourceQPS, u.queries, config.MaxQps*windowSeconds)
+	}
+	if config.MaxRowsReturned > 0 {
+		exceeded(resourceRowsReturned, u.rows, float64(config.MaxRowsReturned))
+	}
+	if config.MaxResponseSeconds > 0 {
+		exceeded(resourceResponseSeconds, u.responseTime, config.MaxResponseSeconds)
+	}
+	if config.MaxRowsRead > 0 {
+		exceeded(resourceRowsRead, u.rowsRead, float64(config.MaxRowsRead))
+	}
+	if config.MaxCpuSeconds > 0 {
+		exceeded(resourceCPUSeconds, u.cpuTime, config.MaxCpuSeconds)
+	}
+	return resource, wait
+}
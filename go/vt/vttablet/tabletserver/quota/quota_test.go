@@ -0,0 +1,305 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func newTestEnforcer(dryRun bool, quotas ...*quotapb.Quota) *Enforcer {
+	config := tabletenv.NewDefaultConfig()
+	config.EnableQuotas = !dryRun
+	config.EnableQuotasDryRun = dryRun
+	e := NewEnforcer(tabletenv.NewEnv(config, "QuotaTest"), nil)
+	e.SetQuotas(&quotapb.Quotas{Quotas: quotas})
+	return e
+}
+
+func callerContext(username, principal string) context.Context {
+	return callerid.NewContext(context.Background(),
+		callerid.NewEffectiveCallerID(principal, "", ""),
+		callerid.NewImmediateCallerID(username))
+}
+
+func TestEnforcerDisabled(t *testing.T) {
+	e := NewEnforcer(tabletenv.NewEnv(tabletenv.NewDefaultConfig(), "QuotaTest"), nil)
+	e.SetQuotas(&quotapb.Quotas{Quotas: []*quotapb.Quota{{Name: "q1", MaxConcurrentQueries: 1}}})
+	for i := 0; i < 3; i++ {
+		ticket, err := e.Acquire(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Nil(t, ticket)
+	}
+}
+
+func TestEnforcerReject(t *testing.T) {
+	e := newTestEnforcer(false, &quotapb.Quota{Name: "q1", MaxConcurrentQueries: 1})
+	ctx := callerContext("user1", "")
+	rejections := e.rejections.Counts()["q1.concurrent_queries"]
+
+	ticket, err := e.Acquire(ctx, nil)
+	require.NoError(t, err)
+
+	_, err = e.Acquire(ctx, nil)
+	require.EqualError(t, err, "quota q1 exceeded: concurrent_queries")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.EqualValues(t, rejections+1, e.rejections.Counts()["q1.concurrent_queries"])
+
+	ticket.Release(Usage{})
+	ticket, err = e.Acquire(ctx, nil)
+	require.NoError(t, err)
+	ticket.Release(Usage{})
+}
+
+func TestEnforcerRowsAndResponseTime(t *testing.T) {
+	e := newTestEnforcer(false,
+		&quotapb.Quota{Name: "rows", MaxRowsReturned: 10, WindowSeconds: 60},
+		&quotapb.Quota{Name: "time", MaxResponseSeconds: 1, WindowSeconds: 60},
+	)
+	ctx := callerContext("user1", "")
+
+	ticket, err := e.Acquire(ctx, nil)
+	require.NoError(t, err)
+	ticket.Release(Usage{RowsReturned: 9, ResponseTime: 500 * time.Millisecond})
+	ticket, err = e.Acquire(ctx, nil)
+	require.NoError(t, err)
+	ticket.Release(Usage{RowsReturned: 1})
+
+	_, err = e.Acquire(ctx, nil)
+	require.EqualError(t, err, "quota rows exceeded: rows_returned")
+
+	e.SetQuotas(&quotapb.Quotas{Quotas: []*quotapb.Quota{{Name: "time", MaxResponseSeconds: 0.5, WindowSeconds: 60}}})
+	_, err = e.Acquire(ctx, nil)
+	require.EqualError(t, err, "quota time exceeded: response_seconds")
+}
+
+func TestEnforcerRowsReadAndCPUTime(t *testing.T) {
+	e := newTestEnforcer(false,
+		&quotapb.Quota{Name: "rows", MaxRowsRead: 1000, WindowSeconds: 60},
+		&quotapb.Quota{Name: "cpu", MaxCpuSeconds: 1, WindowSeconds: 60},
+	)
+	ctx := callerContext("user1", "")
+
+	// The rows read are counted, not the rows returned.
+	ticket, err := e.Acquire(ctx, nil)
+	require.NoError(t, err)
+	ticket.Release(Usage{RowsReturned: 1, RowsRead: 999, CPUTime: 500 * time.Millisecond})
+	ticket, err = e.Acquire(ctx, nil)
+	require.NoError(t, err)
+	ticket.Release(Usage{RowsReturned: 1, RowsRead: 1})
+
+	_, err = e.Acquire(ctx, nil)
+	require.EqualError(t, err, "quota rows exceeded: rows_read")
+
+	// The CPU time is counted, not the response time.
+	e.SetQuotas(&quotapb.Quotas{Quotas: []*quotapb.Quota{{Name: "cpu", MaxCpuSeconds: 1, WindowSeconds: 60}}})
+	ticket, err = e.Acquire(ctx, nil)
+	require.NoError(t, err)
+	ticket.Release(Usage{ResponseTime: 10 * time.Second, CPUTime: 499 * time.Millisecond})
+	ticket, err = e.Acquire(ctx, nil)
+	require.NoError(t, err)
+	ticket.Release(Usage{CPUTime: 100 * time.Millisecond})
+	_, err = e.Acquire(ctx, nil)
+	require.EqualError(t, err, "quota cpu exceeded: cpu_seconds")
+}
+
+func TestEnforcerMatching(t *testing.T) {
+	e := newTestEnforcer(false, &quotapb.Quota{
+		Name:                 "q1",
+		Username:             "user1",
+		Principal:            "batch",
+		Workload:             "olap",
+		MaxConcurrentQueries: 1,
+	})
+	olap := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+
+	ticket, err := e.Acquire(callerContext("user1", "batch"), olap)
+	require.NoError(t, err)
+	defer ticket.Release(Usage{})
+
+	_, err = e.Acquire(callerContext("user1", "batch"), olap)
+	require.Error(t, err)
+
+	// The other callers and workloads do not match.
+	for _, tcase := range []struct {
+		ctx     context.Context
+		options *querypb.ExecuteOptions
+	}{
+		{callerContext("user2", "batch"), olap},
+		{callerContext("user1", "web"), olap},
+		{callerContext("user1", "batch"), nil},
+	} {
+		ticket, err := e.Acquire(tcase.ctx, tcase.options)
+		require.NoError(t, err)
+		assert.Empty(t, ticket.usages)
+	}
+}
+
+func TestEnforcerPerCaller(t *testing.T) {
+	e := newTestEnforcer(false, &quotapb.Quota{Name: "q1", PerCaller: true, MaxConcurrentQueries: 1})
+
+	ticket1, err := e.Acquire(callerContext("user1", ""), nil)
+	require.NoError(t, err)
+	defer ticket1.Release(Usage{})
+	ticket2, err := e.Acquire(callerContext("user2", ""), nil)
+	require.NoError(t, err)
+	defer ticket2.Release(Usage{})
+
+	_, err = e.Acquire(callerContext("user1", ""), nil)
+	require.Error(t, err)
+}
+
+func TestEnforcerQueue(t *testing.T) {
+	e := newTestEnforcer(false, &quotapb.Quota{Name: "q1", MaxConcurrentQueries: 1, Action: quotapb.Action_QUEUE})
+	ctx := callerContext("user1", "")
+	waits := e.waitTimes.Counts()["QuotaTest.q1"]
+
+	ticket, err := e.Acquire(ctx, nil)
+	require.NoError(t, err)
+
+	admitted := make(chan *Ticket)
+	go func() {
+		ticket, err := e.Acquire(ctx, nil)
+		assert.NoError(t, err)
+		admitted <- ticket
+	}()
+
+	select {
+	case <-admitted:
+		t.Fatal("query admitted while the quota is exceeded")
+	case <-time.After(50 * time.Millisecond):
+	}
+	ticket.Release(Usage{})
+	select {
+	case ticket := <-admitted:
+		ticket.Release(Usage{})
+	case <-time.After(5 * time.Second):
+		t.Fatal("query not admitted after release")
+	}
+	assert.EqualValues(t, waits+1, e.waitTimes.Counts()["QuotaTest.q1"])
+}
+
+func TestEnforcerQueueTimeout(t *testing.T) {
+	e := newTestEnforcer(false, &quotapb.Quota{Name: "q1", MaxConcurrentQueries: 1, Action: quotapb.Action_QUEUE, MaxWaitMs: 10})
+	ctx := callerContext("user1", "")
+
+	ticket, err := e.Acquire(ctx, nil)
+	require.NoError(t, err)
+	defer ticket.Release(Usage{})
+
+	_, err = e.Acquire(ctx, nil)
+	require.EqualError(t, err, "quota q1 exceeded: concurrent_queries (timed out waiting)")
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = e.Acquire(cancelled, nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+}
+
+func TestEnforcerDelay(t *testing.T) {
+	e := newTestEnforcer(false, &quotapb.Quota{Name: "q1", MaxQps: 2, Action: quotapb.Action_DELAY})
+	ctx := callerContext("user1", "")
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		ticket, err := e.Acquire(ctx, nil)
+		require.NoError(t, err)
+		ticket.Release(Usage{})
+	}
+	// The third query waited for the first one to leave the window.
+	assert.Greater(t, int64(time.Since(start)), int64(100*time.Millisecond))
+
+	// A delay longer than the max wait is rejected right away.
+	e.SetQuotas(&quotapb.Quotas{Quotas: []*quotapb.Quota{{Name: "q1", MaxQps: 2, Action: quotapb.Action_DELAY, MaxWaitMs: 1}}})
+	for i := 0; i < 2; i++ {
+		ticket, err := e.Acquire(ctx, nil)
+		if err != nil {
+			break
+		}
+		ticket.Release(Usage{})
+	}
+	_, err := e.Acquire(ctx, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "quota q1 exceeded: qps (would wait")
+}
+
+func TestEnforcerDryRun(t *testing.T) {
+	e := newTestEnforcer(true, &quotapb.Quota{Name: "q1", MaxConcurrentQueries: 1})
+	ctx := callerContext("user1", "")
+	rejections := e.rejections.Counts()["q1.concurrent_queries"]
+	rejectionsDryRun := e.rejectionsDryRun.Counts()["q1.concurrent_queries"]
+
+	ticket1, err := e.Acquire(ctx, nil)
+	require.NoError(t, err)
+	ticket2, err := e.Acquire(ctx, nil)
+	require.NoError(t, err)
+	ticket1.Release(Usage{})
+	ticket2.Release(Usage{})
+
+	assert.EqualValues(t, rejectionsDryRun+1, e.rejectionsDryRun.Counts()["q1.concurrent_queries"])
+	assert.EqualValues(t, rejections, e.rejections.Counts()["q1.concurrent_queries"])
+}
+
+func TestEnforcerWatch(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.SaveQuotas(ctx, "ks", &quotapb.Quotas{Quotas: []*quotapb.Quota{{Name: "q1", MaxConcurrentQueries: 1}}}))
+
+	config := tabletenv.NewDefaultConfig()
+	config.EnableQuotas = true
+	e := NewEnforcer(tabletenv.NewEnv(config, "QuotaTest"), ts)
+	e.InitDBConfig("ks")
+	e.Open()
+	defer e.Close()
+
+	waitForQuotas := func(want int) {
+		t.Helper()
+		for i := 0; ; i++ {
+			e.mu.Lock()
+			got := len(e.quotas)
+			e.mu.Unlock()
+			if got == want {
+				return
+			}
+			if i == 500 {
+				t.Fatalf("got %d quotas, want %d", got, want)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	waitForQuotas(1)
+
+	require.NoError(t, ts.SaveQuotas(ctx, "ks", &quotapb.Quotas{Quotas: []*quotapb.Quota{{Name: "q1"}, {Name: "q2"}}}))
+	waitForQuotas(2)
+
+	require.NoError(t, ts.SaveQuotas(ctx, "ks", &quotapb.Quotas{}))
+	waitForQuotas(0)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import "time"
+
+// numBuckets is the number of buckets a sliding window is divided into.
+const numBuckets = 10
+
+// slidingWindow sums the values recorded during the last window, with
+// a resolution of window/numBuckets. It is not thread safe.
+type slidingWindow struct {
+	bucketSize time.Duration
+	// buckets is a ring indexed by the bucket number modulo numBuckets.
+	// The bucket number of a time is the number of bucketSize intervals
+	// since the epoch.
+	buckets [numBuckets]float64
+	// last is the number of the most recent bucket.
+	last int64
+}
+
+func newSlidingWindow(window time.Duration) *slidingWindow {
+	return &slidingWindow{bucketSize: window / numBuckets}
+}
+
+// advance expires the buckets older than the window, and returns the
+// bucket number of now.
+func (w *slidingWindow) advance(now time.Time) int64 {
+	n := now.UnixNano() / int64(w.bucketSize)
+	if n <= w.last {
+		return w.last
+	}
+	if n-w.last >= numBuckets {
+		w.buckets = [numBuckets]float64{}
+	} else {
+		for i := w.last + 1; i <= n; i++ {
+			w.buckets[i%numBuckets] = 0
+		}
+	}
+	w.last = n
+	return n
+}
+
+func (w *slidingWindow) add(now time.Time, value float64) {
+	n := w.advance(now)
+	w.buckets[n%numBuckets] += value
+}
+
+func (w *slidingWindow) sum(now time.Time) float64 {
+	w.advance(now)
+	total := 0.0
+	for _, v := range w.buckets {
+		total += v
+	}
+	return total
+}
+
+// timeUntilBelow returns how long it takes for the sum to go below limit,
+// as the values recorded so far expire.
+func (w *slidingWindow) timeUntilBelow(now time.Time, limit float64) time.Duration {
+	total := w.sum(now)
+	if total < limit {
+		return 0
+	}
+	// Buckets expire from the oldest one, which is numBuckets-1 buckets
+	// before the current one.
+	for i := w.last - numBuckets + 1; i <= w.last; i++ {
+		total -= w.buckets[i%numBuckets]
+		if total < limit {
+			expiry := time.Unix(0, (i+numBuckets)*int64(w.bucketSize))
+			return expiry.Sub(now)
+		}
+	}
+	return time.Unix(0, (w.last+numBuckets)*int64(w.bucketSize)).Sub(now)
+}
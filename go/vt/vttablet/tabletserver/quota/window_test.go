@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlidingWindow(t *testing.T) {
+	w := newSlidingWindow(time.Second)
+	start := time.Unix(1000, 0)
+
+	w.add(start, 3)
+	w.add(start.Add(250*time.Millisecond), 2)
+	assert.EqualValues(t, 5, w.sum(start.Add(500*time.Millisecond)))
+
+	// The first bucket expires after the window.
+	assert.EqualValues(t, 2, w.sum(start.Add(time.Second)))
+	assert.EqualValues(t, 0, w.sum(start.Add(1300*time.Millisecond)))
+
+	// Everything expires after a long time.
+	w.add(start.Add(2*time.Second), 4)
+	assert.EqualValues(t, 0, w.sum(start.Add(time.Hour)))
+}
+
+func TestSlidingWindowTimeUntilBelow(t *testing.T) {
+	w := newSlidingWindow(time.Second)
+	start := time.Unix(1000, 0)
+
+	w.add(start, 3)
+	w.add(start.Add(250*time.Millisecond), 2)
+	now := start.Add(500 * time.Millisecond)
+
+	assert.Equal(t, time.Duration(0), w.timeUntilBelow(now, 6))
+	// The first bucket expires in 500ms.
+	assert.Equal(t, 500*time.Millisecond, w.timeUntilBelow(now, 5))
+	assert.Equal(t, 500*time.Millisecond, w.timeUntilBelow(now, 3))
+	// The second bucket expires in 700ms.
+	assert.Equal(t, 700*time.Millisecond, w.timeUntilBelow(now, 2))
+}
@@ -167,6 +167,7 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 }
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
+// Rewrite rules are skipped: they are applied by GetRewrite before the query is executed.
 func (qrs *Rules) GetAction(
 	ip,
 	user string,
@@ -174,13 +175,34 @@ func (qrs *Rules) GetAction(
 	marginComments sqlparser.MarginComments,
 ) (action Action, desc string) {
 	for _, qr := range qrs.rules {
-		if act := qr.GetAction(ip, user, bindVars, marginComments); act != QRContinue {
+		if act := qr.GetAction(ip, user, bindVars, marginComments); act != QRContinue && act != QRRewrite {
 			return act, qr.Description
 		}
 	}
 	return QRContinue, ""
 }
 
+// GetRewrite runs the input against the rewrite rules, and returns the
+// rewritten query of the first one that matches, along with its name.
+// It returns an empty query if no rewrite rule matches. The rules must
+// have been filtered by FilterByPlan, which expands the rewrite templates.
+func (qrs *Rules) GetRewrite(
+	ip,
+	user string,
+	bindVars map[string]*querypb.BindVariable,
+	marginComments sqlparser.MarginComments,
+) (query, name string) {
+	for _, qr := range qrs.rules {
+		if qr.act != QRRewrite {
+			continue
+		}
+		if qr.GetAction(ip, user, bindVars, marginComments) == QRRewrite {
+			return qr.rewrite, qr.Name
+		}
+	}
+	return "", ""
+}
+
 //-----------------------------------------------
 
 // Rule represents one rule (conditions-action).
@@ -211,6 +233,10 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// Template of the query that replaces the matched query, for the
+	// QRRewrite action. After FilterByPlan, it is the rewritten query.
+	rewrite string
 }
 
 type namedRegexp struct {
@@ -252,7 +278,8 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.rewrite == other.rewrite)
 }
 
 // Copy performs a deep copy of a Rule.
@@ -266,6 +293,7 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		leadingComment:  qr.leadingComment,
 		trailingComment: qr.trailingComment,
 		act:             qr.act,
+		rewrite:         qr.rewrite,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -314,6 +342,9 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.rewrite != "" {
+		safeEncode(b, `,"Rewrite":`, qr.rewrite)
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -369,6 +400,28 @@ func (qr *Rule) SetTrailingCommentCond(pattern string) (err error) {
 	return
 }
 
+// SetRewrite sets the template of the query that replaces the matched
+// query when the rule fires. The rule action must be QRRewrite.
+// The template can refer to the submatches of the query condition with
+// the syntax of regexp.Expand: $0 is the whole query, $1 or ${name} are
+// the groups of the pattern.
+func (qr *Rule) SetRewrite(template string) {
+	qr.rewrite = template
+}
+
+// matchAll is used to expand the rewrite templates of the rules without
+// a query condition.
+var matchAll = regexp.MustCompile(`^(?s:.*)$`)
+
+// expandRewrite returns the rewrite template expanded for the query.
+func (qr *Rule) expandRewrite(query string) string {
+	re := qr.query.Regexp
+	if re == nil {
+		re = matchAll
+	}
+	return string(re.ExpandString(nil, qr.rewrite, query, re.FindStringSubmatchIndex(query)))
+}
+
 // makeExact forces a full string match for the regex instead of substring
 func makeExact(pattern string) string {
 	return fmt.Sprintf("^%s$", pattern)
@@ -447,6 +500,9 @@ func (qr *Rule) FilterByPlan(query string, planid planbuilder.PlanType, tableNam
 		return nil
 	}
 	newqr = qr.Copy()
+	if qr.act == QRRewrite {
+		newqr.rewrite = qr.expandRewrite(query)
+	}
 	newqr.query = namedRegexp{}
 	// Note we explicitly don't remove the leading/trailing comments as they
 	// must be evaluated at execution time.
@@ -533,6 +589,7 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	QRRewrite
 )
 
 // MarshalJSON marshals to JSON.
@@ -544,6 +601,8 @@ func (act Action) MarshalJSON() ([]byte, error) {
 		str = "FAIL"
 	case QRFailRetry:
 		str = "FAIL_RETRY"
+	case QRRewrite:
+		str = "REWRITE"
 	default:
 		str = "INVALID"
 	}
@@ -834,7 +893,7 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 		var lv []interface{}
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment", "Rewrite":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
@@ -914,11 +973,18 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "REWRITE":
+				qr.act = QRRewrite
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "Rewrite":
+			qr.SetRewrite(sv)
 		}
 	}
+	if (qr.act == QRRewrite) != (qr.rewrite != "") {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Rewrite must be set if and only if Action is REWRITE")
+	}
 	return qr, nil
 }
 
@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -692,6 +693,9 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"Rewrite": 1 }]`, "want string for Rewrite"},
+	{`[{"Action": "REWRITE" }]`, "Rewrite must be set if and only if Action is REWRITE"},
+	{`[{"Action": "FAIL", "Rewrite": "select 1" }]`, "Rewrite must be set if and only if Action is REWRITE"},
 }
 
 func TestInvalidJSON(t *testing.T) {
@@ -729,6 +733,61 @@ func TestBuildQueryRuleActionFail(t *testing.T) {
 	}
 }
 
+func TestBuildQueryRuleActionRewrite(t *testing.T) {
+	var ruleInfo map[string]interface{}
+	err := json.Unmarshal([]byte(`{"Name": "r1", "Query": "select (.*) from a", "Action": "REWRITE", "Rewrite": "select $1 from a force index (b)"}`), &ruleInfo)
+	require.NoError(t, err)
+	qr, err := BuildQueryRule(ruleInfo)
+	require.NoError(t, err)
+	assert.Equal(t, QRRewrite, qr.act)
+
+	b, err := json.Marshal(qr)
+	require.NoError(t, err)
+	assert.Equal(t, `{"Description":"","Name":"r1","Query":"select (.*) from a","Action":"REWRITE","Rewrite":"select $1 from a force index (b)"}`, string(b))
+}
+
+func TestRewrite(t *testing.T) {
+	qrs := New()
+
+	qr1 := NewQueryRule("add limit", "r1", QRRewrite)
+	qr1.SetUserCond("batch")
+	qr1.SetRewrite("$0 limit 1000")
+
+	qr2 := NewQueryRule("force index", "r2", QRRewrite)
+	require.NoError(t, qr2.SetQueryCond("select (?P<exprs>.*) from a where (.*)"))
+	qr2.SetRewrite("select ${exprs} from a force index (b) where $2")
+
+	qr3 := NewQueryRule("deny", "r3", QRFail)
+	qr3.SetUserCond("denied")
+
+	qrs.Add(qr1)
+	qrs.Add(qr2)
+	qrs.Add(qr3)
+
+	mc := sqlparser.MarginComments{}
+	filtered := qrs.FilterByPlan("select c from a where d = 1", planbuilder.PlanSelect, "a")
+
+	query, name := filtered.GetRewrite("", "batch", nil, mc)
+	assert.Equal(t, "select c from a where d = 1 limit 1000", query)
+	assert.Equal(t, "r1", name)
+
+	query, name = filtered.GetRewrite("", "web", nil, mc)
+	assert.Equal(t, "select c from a force index (b) where d = 1", query)
+	assert.Equal(t, "r2", name)
+
+	// Rewrite rules do not affect the action.
+	action, _ := filtered.GetAction("", "web", nil, mc)
+	assert.Equal(t, QRContinue, action)
+	action, desc := filtered.GetAction("", "denied", nil, mc)
+	assert.Equal(t, QRFail, action)
+	assert.Equal(t, "deny", desc)
+
+	// The query condition of r2 does not match.
+	filtered = qrs.FilterByPlan("select c from a", planbuilder.PlanSelect, "a")
+	query, _ = filtered.GetRewrite("", "web", nil, mc)
+	assert.Equal(t, "", query)
+}
+
 func TestBadAddBindVarCond(t *testing.T) {
 	qr1 := NewQueryRule("rule 1", "r1", QRFail)
 	err := qr1.AddBindVarCond("a", true, false, QRMatch, uint64(1))
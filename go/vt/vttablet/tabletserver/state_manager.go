@@ -110,6 +110,8 @@ type stateManager struct {
 	watcher     subComponent
 	qe          queryEngine
 	txThrottler txThrottler
+	quotas      subComponent
+	killer      subComponent
 	te          txEngine
 	messager    subComponent
 	ddle        onlineDDLExecutor
@@ -489,7 +491,12 @@ func (sm *stateManager) connect(tabletType topodatapb.TabletType) error {
 	if err := sm.qe.Open(); err != nil {
 		return err
 	}
-	return sm.txThrottler.Open()
+	if err := sm.txThrottler.Open(); err != nil {
+		return err
+	}
+	sm.quotas.Open()
+	sm.killer.Open()
+	return nil
 }
 
 func (sm *stateManager) unserveCommon() {
@@ -527,6 +534,8 @@ func (sm *stateManager) closeAll() {
 	defer close(sm.setTimeBomb())
 
 	sm.unserveCommon()
+	sm.killer.Close()
+	sm.quotas.Close()
 	sm.txThrottler.Close()
 	sm.qe.Close()
 	sm.watcher.Close()
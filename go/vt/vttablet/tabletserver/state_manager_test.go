@@ -82,13 +82,15 @@ func TestStateManagerServePrimary(t *testing.T) {
 	verifySubcomponent(t, 3, sm.vstreamer, testStateOpen)
 	verifySubcomponent(t, 4, sm.qe, testStateOpen)
 	verifySubcomponent(t, 5, sm.txThrottler, testStateOpen)
-	verifySubcomponent(t, 6, sm.rt, testStatePrimary)
-	verifySubcomponent(t, 7, sm.tracker, testStateOpen)
-	verifySubcomponent(t, 8, sm.te, testStatePrimary)
-	verifySubcomponent(t, 9, sm.messager, testStateOpen)
-	verifySubcomponent(t, 10, sm.throttler, testStateOpen)
-	verifySubcomponent(t, 11, sm.tableGC, testStateOpen)
-	verifySubcomponent(t, 12, sm.ddle, testStateOpen)
+	verifySubcomponent(t, 6, sm.quotas, testStateOpen)
+	verifySubcomponent(t, 7, sm.killer, testStateOpen)
+	verifySubcomponent(t, 8, sm.rt, testStatePrimary)
+	verifySubcomponent(t, 9, sm.tracker, testStateOpen)
+	verifySubcomponent(t, 10, sm.te, testStatePrimary)
+	verifySubcomponent(t, 11, sm.messager, testStateOpen)
+	verifySubcomponent(t, 12, sm.throttler, testStateOpen)
+	verifySubcomponent(t, 13, sm.tableGC, testStateOpen)
+	verifySubcomponent(t, 14, sm.ddle, testStateOpen)
 
 	assert.False(t, sm.se.(*testSchemaEngine).nonPrimary)
 	assert.True(t, sm.se.(*testSchemaEngine).ensureCalled)
@@ -113,10 +115,12 @@ func TestStateManagerServeNonPrimary(t *testing.T) {
 	verifySubcomponent(t, 6, sm.vstreamer, testStateOpen)
 	verifySubcomponent(t, 7, sm.qe, testStateOpen)
 	verifySubcomponent(t, 8, sm.txThrottler, testStateOpen)
-	verifySubcomponent(t, 9, sm.te, testStateNonPrimary)
-	verifySubcomponent(t, 10, sm.rt, testStateNonPrimary)
-	verifySubcomponent(t, 11, sm.watcher, testStateOpen)
-	verifySubcomponent(t, 12, sm.throttler, testStateOpen)
+	verifySubcomponent(t, 9, sm.quotas, testStateOpen)
+	verifySubcomponent(t, 10, sm.killer, testStateOpen)
+	verifySubcomponent(t, 11, sm.te, testStateNonPrimary)
+	verifySubcomponent(t, 12, sm.rt, testStateNonPrimary)
+	verifySubcomponent(t, 13, sm.watcher, testStateOpen)
+	verifySubcomponent(t, 14, sm.throttler, testStateOpen)
 
 	assert.Equal(t, topodatapb.TabletType_REPLICA, sm.target.TabletType)
 	assert.Equal(t, StateServing, sm.state)
@@ -140,8 +144,10 @@ func TestStateManagerUnservePrimary(t *testing.T) {
 	verifySubcomponent(t, 9, sm.vstreamer, testStateOpen)
 	verifySubcomponent(t, 10, sm.qe, testStateOpen)
 	verifySubcomponent(t, 11, sm.txThrottler, testStateOpen)
+	verifySubcomponent(t, 12, sm.quotas, testStateOpen)
+	verifySubcomponent(t, 13, sm.killer, testStateOpen)
 
-	verifySubcomponent(t, 12, sm.rt, testStatePrimary)
+	verifySubcomponent(t, 14, sm.rt, testStatePrimary)
 
 	assert.Equal(t, topodatapb.TabletType_PRIMARY, sm.target.TabletType)
 	assert.Equal(t, StateNotServing, sm.state)
@@ -166,9 +172,11 @@ func TestStateManagerUnserveNonPrimary(t *testing.T) {
 	verifySubcomponent(t, 8, sm.vstreamer, testStateOpen)
 	verifySubcomponent(t, 9, sm.qe, testStateOpen)
 	verifySubcomponent(t, 10, sm.txThrottler, testStateOpen)
+	verifySubcomponent(t, 11, sm.quotas, testStateOpen)
+	verifySubcomponent(t, 12, sm.killer, testStateOpen)
 
-	verifySubcomponent(t, 11, sm.rt, testStateNonPrimary)
-	verifySubcomponent(t, 12, sm.watcher, testStateOpen)
+	verifySubcomponent(t, 13, sm.rt, testStateNonPrimary)
+	verifySubcomponent(t, 14, sm.watcher, testStateOpen)
 
 	assert.Equal(t, topodatapb.TabletType_RDONLY, sm.target.TabletType)
 	assert.Equal(t, StateNotServing, sm.state)
@@ -187,12 +195,14 @@ func TestStateManagerClose(t *testing.T) {
 	verifySubcomponent(t, 5, sm.te, testStateClosed)
 	verifySubcomponent(t, 6, sm.tracker, testStateClosed)
 
-	verifySubcomponent(t, 7, sm.txThrottler, testStateClosed)
-	verifySubcomponent(t, 8, sm.qe, testStateClosed)
-	verifySubcomponent(t, 9, sm.watcher, testStateClosed)
-	verifySubcomponent(t, 10, sm.vstreamer, testStateClosed)
-	verifySubcomponent(t, 11, sm.rt, testStateClosed)
-	verifySubcomponent(t, 12, sm.se, testStateClosed)
+	verifySubcomponent(t, 7, sm.killer, testStateClosed)
+	verifySubcomponent(t, 8, sm.quotas, testStateClosed)
+	verifySubcomponent(t, 9, sm.txThrottler, testStateClosed)
+	verifySubcomponent(t, 10, sm.qe, testStateClosed)
+	verifySubcomponent(t, 11, sm.watcher, testStateClosed)
+	verifySubcomponent(t, 12, sm.vstreamer, testStateClosed)
+	verifySubcomponent(t, 13, sm.rt, testStateClosed)
+	verifySubcomponent(t, 14, sm.se, testStateClosed)
 
 	assert.Equal(t, topodatapb.TabletType_RDONLY, sm.target.TabletType)
 	assert.Equal(t, StateNotConnected, sm.state)
@@ -304,10 +314,12 @@ func TestStateManagerSetServingTypeNoChange(t *testing.T) {
 	verifySubcomponent(t, 6, sm.vstreamer, testStateOpen)
 	verifySubcomponent(t, 7, sm.qe, testStateOpen)
 	verifySubcomponent(t, 8, sm.txThrottler, testStateOpen)
-	verifySubcomponent(t, 9, sm.te, testStateNonPrimary)
-	verifySubcomponent(t, 10, sm.rt, testStateNonPrimary)
-	verifySubcomponent(t, 11, sm.watcher, testStateOpen)
-	verifySubcomponent(t, 12, sm.throttler, testStateOpen)
+	verifySubcomponent(t, 9, sm.quotas, testStateOpen)
+	verifySubcomponent(t, 10, sm.killer, testStateOpen)
+	verifySubcomponent(t, 11, sm.te, testStateNonPrimary)
+	verifySubcomponent(t, 12, sm.rt, testStateNonPrimary)
+	verifySubcomponent(t, 13, sm.watcher, testStateOpen)
+	verifySubcomponent(t, 14, sm.throttler, testStateOpen)
 
 	assert.Equal(t, topodatapb.TabletType_REPLICA, sm.target.TabletType)
 	assert.Equal(t, StateServing, sm.state)
@@ -693,6 +705,8 @@ func newTestStateManager(t *testing.T) *stateManager {
 		watcher:     &testSubcomponent{},
 		qe:          &testQueryEngine{},
 		txThrottler: &testTxThrottler{},
+		quotas:      &testSubcomponent{},
+		killer:      &testSubcomponent{},
 		te:          &testTxEngine{},
 		messager:    &testSubcomponent{},
 		ddle:        &testOnlineDDLExecutor{},
@@ -51,6 +51,10 @@ type StatefulConnection struct {
 	reservedProps  *Properties
 	tainted        bool
 	enforceTimeout bool
+
+	// limitViolated is the reserved connection limit this connection
+	// was reported for by the query killer.
+	limitViolated string
 }
 
 // Properties contains meta information about the connection
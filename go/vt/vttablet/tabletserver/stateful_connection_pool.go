@@ -142,6 +142,15 @@ func (sf *StatefulConnectionPool) GetOutdated(age time.Duration, purpose string)
 	return mapToTxConn(sf.active.GetOutdated(age, purpose))
 }
 
+// GetByIdleFilter returns a list of connections that match the filter.
+// The filter is also given how long the connection has been idle.
+// It does not return any connections that are in use.
+func (sf *StatefulConnectionPool) GetByIdleFilter(purpose string, match func(sc *StatefulConnection, idle time.Duration) bool) []*StatefulConnection {
+	return mapToTxConn(sf.active.GetByIdleFilter(purpose, func(val interface{}, idle time.Duration) bool {
+		return match(val.(*StatefulConnection), idle)
+	}))
+}
+
 func mapToTxConn(outdated []interface{}) []*StatefulConnection {
 	result := make([]*StatefulConnection, len(outdated))
 	for i, el := range outdated {
@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/throttler"
 )
 
@@ -117,6 +118,9 @@ func init() {
 	flag.IntVar(&currentConfig.OltpReadPool.MaxWaiters, "queryserver-config-query-pool-waiter-cap", defaultConfig.OltpReadPool.MaxWaiters, "query server query pool waiter limit, this is the maximum number of queries that can be queued waiting to get a connection")
 	flag.IntVar(&currentConfig.OlapReadPool.MaxWaiters, "queryserver-config-stream-pool-waiter-cap", defaultConfig.OlapReadPool.MaxWaiters, "query server stream pool waiter limit, this is the maximum number of streaming queries that can be queued waiting to get a connection")
 	flag.IntVar(&currentConfig.TxPool.MaxWaiters, "queryserver-config-txpool-waiter-cap", defaultConfig.TxPool.MaxWaiters, "query server transaction pool waiter limit, this is the maximum number of transactions that can be queued waiting to get a connection")
+	flag.BoolVar(&currentConfig.OltpReadPool.PriorityScheduling, "queryserver-config-query-pool-priority-scheduling", defaultConfig.OltpReadPool.PriorityScheduling, "query server query pool priority scheduling, when enabled queries waiting for a connection from the query pool are served by priority instead of in arrival order")
+	SecondsVar(&currentConfig.OltpReadPool.PriorityAgingSeconds, "queryserver-config-query-pool-priority-aging", defaultConfig.OltpReadPool.PriorityAgingSeconds, "query server query pool priority aging (in seconds), a query waiting for a connection from the query pool is promoted by one priority level every time this interval elapses, so that low priority queries are not starved")
+	flag.IntVar(&currentConfig.DefaultQueryPriority, "queryserver-config-default-query-priority", defaultConfig.DefaultQueryPriority, "query server default query priority, from 0 (highest) to 100 (lowest), used for queries that do not specify a priority")
 	// tableacl related configurations.
 	flag.BoolVar(&currentConfig.StrictTableACL, "queryserver-config-strict-table-acl", defaultConfig.StrictTableACL, "only allow queries that pass table acl checks")
 	flag.BoolVar(&currentConfig.EnableTableACLDryRun, "queryserver-config-enable-table-acl-dry-run", defaultConfig.EnableTableACLDryRun, "If this flag is enabled, tabletserver will emit monitoring metrics and let the request pass regardless of table acl check results")
@@ -139,6 +143,24 @@ func init() {
 	flag.IntVar(&currentConfig.HotRowProtection.MaxQueueSize, "hot_row_protection_max_queue_size", defaultConfig.HotRowProtection.MaxQueueSize, "Maximum number of BeginExecute RPCs which will be queued for the same row (range).")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxGlobalQueueSize, "hot_row_protection_max_global_queue_size", defaultConfig.HotRowProtection.MaxGlobalQueueSize, "Global queue limit across all row (ranges). Useful to prevent that the queue can grow unbounded.")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxConcurrency, "hot_row_protection_concurrent_transactions", defaultConfig.HotRowProtection.MaxConcurrency, "Number of concurrent transactions let through to the txpool/MySQL for the same hot row. Should be > 1 to have enough 'ready' transactions in MySQL and benefit from a pipelining effect.")
+	flag.BoolVar(&currentConfig.HotRowProtection.Adaptive, "hot_row_protection_adaptive", defaultConfig.HotRowProtection.Adaptive, "If true, hot row protection only serializes the transactions updating a row detected as hot, from its MySQL lock waits or the number of its updates. Requires -enable_hot_row_protection.")
+	SecondsVar(&currentConfig.HotRowProtection.DetectionWindowSeconds, "hot_row_protection_detection_window", defaultConfig.HotRowProtection.DetectionWindowSeconds, "Period (in seconds) over which the lock waits and the updates of a row are counted to detect hot rows.")
+	flag.IntVar(&currentConfig.HotRowProtection.DMLThreshold, "hot_row_protection_dml_threshold", defaultConfig.HotRowProtection.DMLThreshold, "Number of updates or deletes of the same row within the detection window at which the row becomes hot. 0 disables the detection from updates.")
+	flag.IntVar(&currentConfig.HotRowProtection.LockWaitThreshold, "hot_row_protection_lock_wait_threshold", defaultConfig.HotRowProtection.LockWaitThreshold, "Number of MySQL lock waits on the same row within the detection window at which the row becomes hot. 0 disables the detection from lock waits.")
+	SecondsVar(&currentConfig.HotRowProtection.LockWaitPollIntervalSeconds, "hot_row_protection_lock_wait_poll_interval", defaultConfig.HotRowProtection.LockWaitPollIntervalSeconds, "How often (in seconds) the MySQL lock waits are polled to detect hot rows.")
+	SecondsVar(&currentConfig.HotRowProtection.CooldownSeconds, "hot_row_protection_cooldown", defaultConfig.HotRowProtection.CooldownSeconds, "Time (in seconds) a hot row stays protected after it was last detected as hot.")
+	flag.IntVar(&currentConfig.HotRowProtection.MaxTrackedRows, "hot_row_protection_max_tracked_rows", defaultConfig.HotRowProtection.MaxTrackedRows, "Maximum number of rows tracked to detect hot rows.")
+
+	flag.StringVar(&currentConfig.OlapLimits.Mode, "queryserver-config-olap-limits-mode", defaultConfig.OlapLimits.Mode, "Enforcement of the OLAP query limits: disable, dryRun (log and count violations only) or enable (kill the offending queries).")
+	SecondsVar(&currentConfig.OlapLimits.MaxDurationSeconds, "queryserver-config-olap-max-duration", defaultConfig.OlapLimits.MaxDurationSeconds, "Maximum time (in seconds) an OLAP streaming query is allowed to run. 0 means no limit.")
+	flag.Int64Var(&currentConfig.OlapLimits.MaxRowsStreamed, "queryserver-config-olap-max-rows-streamed", defaultConfig.OlapLimits.MaxRowsStreamed, "Maximum number of rows an OLAP streaming query is allowed to return. 0 means no limit.")
+	flag.StringVar(&currentConfig.ReservedConnLimits.Mode, "queryserver-config-reserved-conn-limits-mode", defaultConfig.ReservedConnLimits.Mode, "Enforcement of the reserved connection limits: disable, dryRun (log and count violations only) or enable (close the offending connections).")
+	SecondsVar(&currentConfig.ReservedConnLimits.MaxDurationSeconds, "queryserver-config-reserved-conn-max-duration", defaultConfig.ReservedConnLimits.MaxDurationSeconds, "Maximum time (in seconds) a reserved connection is allowed to live. 0 means no limit.")
+	SecondsVar(&currentConfig.ReservedConnLimits.MaxIdleSeconds, "queryserver-config-reserved-conn-max-idle", defaultConfig.ReservedConnLimits.MaxIdleSeconds, "Maximum time (in seconds) a reserved connection is allowed to stay idle between two queries. 0 means no limit.")
+
+	flag.BoolVar(&currentConfig.QueryDigests.Enable, "enable_query_digests", defaultConfig.QueryDigests.Enable, "If true, vttablet aggregates the statistics of its queries per digest, and serves them in the vitess_digests table and on /digestz.")
+	flag.IntVar(&currentConfig.QueryDigests.MaxDigests, "query_digests_max", defaultConfig.QueryDigests.MaxDigests, "Maximum number of digests tracked. The queries of the other digests are aggregated in a single digest without fingerprint.")
+	SecondsVar(&currentConfig.QueryDigests.WindowSeconds, "query_digests_window", defaultConfig.QueryDigests.WindowSeconds, "Period (in seconds) over which the recent statistics of a digest are computed.")
 
 	flag.BoolVar(&currentConfig.EnableTransactionLimit, "enable_transaction_limit", defaultConfig.EnableTransactionLimit, "If true, limit on number of transactions open at the same time will be enforced for all users. User trying to open a new transaction after exhausting their limit will receive an error immediately, regardless of whether there are available slots or not.")
 	flag.BoolVar(&currentConfig.EnableTransactionLimitDryRun, "enable_transaction_limit_dry_run", defaultConfig.EnableTransactionLimitDryRun, "If true, limit on number of transactions open at the same time will be tracked for all users, but not enforced.")
@@ -148,6 +170,10 @@ func init() {
 	flag.BoolVar(&currentConfig.TransactionLimitByComponent, "transaction_limit_by_component", defaultConfig.TransactionLimitByComponent, "Include CallerID.component when considering who the user is for the purpose of transaction limit.")
 	flag.BoolVar(&currentConfig.TransactionLimitBySubcomponent, "transaction_limit_by_subcomponent", defaultConfig.TransactionLimitBySubcomponent, "Include CallerID.subcomponent when considering who the user is for the purpose of transaction limit.")
 
+	flag.BoolVar(&currentConfig.EnableQuotas, "enable_quotas", defaultConfig.EnableQuotas, "If true, the quotas of the keyspace stored in the topology will be enforced on the queries served by this tablet.")
+	flag.BoolVar(&currentConfig.EnableQuotasDryRun, "enable_quotas_dry_run", defaultConfig.EnableQuotasDryRun, "If true, the quotas of the keyspace stored in the topology will be tracked, but not enforced.")
+	flag.BoolVar(&currentConfig.EnableStatementStats, "enable_statement_stats", defaultConfig.EnableStatementStats, "If true, vttablet reads the rows examined and the CPU time of every query it runs from performance_schema, on the same connection, right after the query. They are counted by the quotas and the query digests. Requires MySQL 8.0.28 or later, with the events_statements_history consumer enabled.")
+
 	flag.BoolVar(&enableHeartbeat, "heartbeat_enable", false, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
 	flag.DurationVar(&heartbeatInterval, "heartbeat_interval", 1*time.Second, "How frequently to read and write replication heartbeat.")
 	flagutil.DualFormatBoolVar(&currentConfig.EnableLagThrottler, "enable_lag_throttler", defaultConfig.EnableLagThrottler, "If true, vttablet will run a throttler service, and will implicitly enable heartbeats")
@@ -242,6 +268,11 @@ type TabletConfig struct {
 	Oltp             OltpConfig             `json:"oltp,omitempty"`
 	HotRowProtection HotRowProtectionConfig `json:"hotRowProtection,omitempty"`
 
+	OlapLimits         QueryLimitsConfig `json:"olapLimits,omitempty"`
+	ReservedConnLimits QueryLimitsConfig `json:"reservedConnLimits,omitempty"`
+
+	QueryDigests QueryDigestsConfig `json:"queryDigests,omitempty"`
+
 	Healthcheck  HealthcheckConfig  `json:"healthcheck,omitempty"`
 	GracePeriods GracePeriodsConfig `json:"gracePeriods,omitempty"`
 
@@ -266,6 +297,7 @@ type TabletConfig struct {
 	MessagePostponeParallelism              int     `json:"messagePostponeParallelism,omitempty"`
 	CacheResultFields                       bool    `json:"cacheResultFields,omitempty"`
 	SignalWhenSchemaChange                  bool    `json:"signalWhenSchemaChange,omitempty"`
+	DefaultQueryPriority                    int     `json:"defaultQueryPriority,omitempty"`
 
 	ExternalConnections map[string]*dbconfigs.DBConfigs `json:"externalConnections,omitempty"`
 
@@ -285,6 +317,10 @@ type TabletConfig struct {
 
 	TransactionLimitConfig `json:"-"`
 
+	EnableQuotas         bool `json:"-"`
+	EnableQuotasDryRun   bool `json:"-"`
+	EnableStatementStats bool `json:"-"`
+
 	EnforceStrictTransTables bool `json:"-"`
 	EnableOnlineDDL          bool `json:"-"`
 }
@@ -296,6 +332,11 @@ type ConnPoolConfig struct {
 	IdleTimeoutSeconds Seconds `json:"idleTimeoutSeconds,omitempty"`
 	PrefillParallelism int     `json:"prefillParallelism,omitempty"`
 	MaxWaiters         int     `json:"maxWaiters,omitempty"`
+	// PriorityScheduling makes waiters be served by priority instead of
+	// in arrival order. PriorityAgingSeconds is the time after which a
+	// waiter is promoted by one priority level.
+	PriorityScheduling   bool    `json:"priorityScheduling,omitempty"`
+	PriorityAgingSeconds Seconds `json:"priorityAgingSeconds,omitempty"`
 }
 
 // OltpConfig contains the config for oltp settings.
@@ -313,6 +354,53 @@ type HotRowProtectionConfig struct {
 	MaxQueueSize       int    `json:"maxQueueSize,omitempty"`
 	MaxGlobalQueueSize int    `json:"maxGlobalQueueSize,omitempty"`
 	MaxConcurrency     int    `json:"maxConcurrency,omitempty"`
+
+	// Adaptive restricts the protection to the rows detected as hot,
+	// from their lock waits in MySQL or the number of their updates.
+	Adaptive                    bool    `json:"adaptive,omitempty"`
+	DetectionWindowSeconds      Seconds `json:"detectionWindowSeconds,omitempty"`
+	DMLThreshold                int     `json:"dmlThreshold,omitempty"`
+	LockWaitThreshold           int     `json:"lockWaitThreshold,omitempty"`
+	LockWaitPollIntervalSeconds Seconds `json:"lockWaitPollIntervalSeconds,omitempty"`
+	CooldownSeconds             Seconds `json:"cooldownSeconds,omitempty"`
+	MaxTrackedRows              int     `json:"maxTrackedRows,omitempty"`
+}
+
+func (c *HotRowProtectionConfig) verifyAdaptive() error {
+	if v := c.DetectionWindowSeconds; v <= 0 {
+		return fmt.Errorf("-hot_row_protection_detection_window must be > 0 (specified value: %v)", v)
+	}
+	if c.DMLThreshold < 0 || c.LockWaitThreshold < 0 || c.DMLThreshold+c.LockWaitThreshold == 0 {
+		return fmt.Errorf("-hot_row_protection_dml_threshold and -hot_row_protection_lock_wait_threshold must be >= 0, and one of them > 0 (specified values: %v, %v)", c.DMLThreshold, c.LockWaitThreshold)
+	}
+	if v := c.LockWaitPollIntervalSeconds; v <= 0 {
+		return fmt.Errorf("-hot_row_protection_lock_wait_poll_interval must be > 0 (specified value: %v)", v)
+	}
+	if v := c.CooldownSeconds; v <= 0 {
+		return fmt.Errorf("-hot_row_protection_cooldown must be > 0 (specified value: %v)", v)
+	}
+	if v := c.MaxTrackedRows; v <= 0 {
+		return fmt.Errorf("-hot_row_protection_max_tracked_rows must be > 0 (specified value: %v)", v)
+	}
+	return nil
+}
+
+// QueryLimitsConfig contains the limits enforced on long-running
+// OLAP queries or reserved connections. A zero value disables a limit.
+type QueryLimitsConfig struct {
+	// Mode can be disable, dryRun or enable. Default is disable.
+	Mode               string  `json:"mode,omitempty"`
+	MaxDurationSeconds Seconds `json:"maxDurationSeconds,omitempty"`
+	MaxRowsStreamed    int64   `json:"maxRowsStreamed,omitempty"`
+	MaxIdleSeconds     Seconds `json:"maxIdleSeconds,omitempty"`
+}
+
+// QueryDigestsConfig contains the config for the statistics of the
+// queries per digest.
+type QueryDigestsConfig struct {
+	Enable        bool    `json:"enable,omitempty"`
+	MaxDigests    int     `json:"maxDigests,omitempty"`
+	WindowSeconds Seconds `json:"windowSeconds,omitempty"`
 }
 
 // HealthcheckConfig contains the config for healthcheck.
@@ -372,6 +460,21 @@ func (c *TabletConfig) Verify() error {
 	if err := c.verifyTransactionLimitConfig(); err != nil {
 		return err
 	}
+	if c.EnableQuotas && c.EnableQuotasDryRun {
+		return errors.New("only one of two flags allowed: -enable_quotas or -enable_quotas_dry_run")
+	}
+	if err := c.OlapLimits.verify("-queryserver-config-olap-limits-mode"); err != nil {
+		return err
+	}
+	if err := c.ReservedConnLimits.verify("-queryserver-config-reserved-conn-limits-mode"); err != nil {
+		return err
+	}
+	if v := c.QueryDigests.MaxDigests; v <= 0 {
+		return fmt.Errorf("-query_digests_max must be > 0 (specified value: %v)", v)
+	}
+	if v := c.QueryDigests.WindowSeconds; v <= 0 {
+		return fmt.Errorf("-query_digests_window must be > 0 (specified value: %v)", v)
+	}
 	if v := c.HotRowProtection.MaxQueueSize; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_max_queue_size must be > 0 (specified value: %v)", v)
 	}
@@ -384,6 +487,14 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if c.HotRowProtection.Adaptive {
+		if err := c.HotRowProtection.verifyAdaptive(); err != nil {
+			return err
+		}
+	}
+	if v := c.DefaultQueryPriority; v < 0 || v > sqlparser.MaxPriorityValue {
+		return fmt.Errorf("-queryserver-config-default-query-priority must be between 0 and %v (specified value: %v)", sqlparser.MaxPriorityValue, v)
+	}
 	return nil
 }
 
@@ -417,13 +528,28 @@ func (c *TabletConfig) verifyTransactionLimitConfig() error {
 	return nil
 }
 
+// verify checks QueryLimitsConfig for sanity. flagName is the name of
+// the flag that sets the mode, for the error message.
+func (c *QueryLimitsConfig) verify(flagName string) error {
+	switch c.Mode {
+	case Disable, Dryrun, Enable:
+	default:
+		return fmt.Errorf("%s must be one of %s, %s or %s (specified value: %v)", flagName, Disable, Dryrun, Enable, c.Mode)
+	}
+	if c.MaxRowsStreamed < 0 {
+		return fmt.Errorf("max rows streamed must be >= 0 (specified value: %v)", c.MaxRowsStreamed)
+	}
+	return nil
+}
+
 // Some of these values are for documentation purposes.
 // They actually get overwritten during Init.
 var defaultConfig = TabletConfig{
 	OltpReadPool: ConnPoolConfig{
-		Size:               16,
-		IdleTimeoutSeconds: 30 * 60,
-		MaxWaiters:         5000,
+		Size:                 16,
+		IdleTimeoutSeconds:   30 * 60,
+		MaxWaiters:           5000,
+		PriorityAgingSeconds: 0.05,
 	},
 	OlapReadPool: ConnPoolConfig{
 		Size:               200,
@@ -457,6 +583,23 @@ var defaultConfig = TabletConfig{
 		// Allow more than 1 transaction for the same hot row through to have enough
 		// of them ready in MySQL and profit from a pipelining effect.
 		MaxConcurrency: 5,
+
+		DetectionWindowSeconds:      10,
+		DMLThreshold:                100,
+		LockWaitThreshold:           10,
+		LockWaitPollIntervalSeconds: 1,
+		CooldownSeconds:             60,
+		MaxTrackedRows:              10000,
+	},
+	OlapLimits: QueryLimitsConfig{
+		Mode: Disable,
+	},
+	ReservedConnLimits: QueryLimitsConfig{
+		Mode: Disable,
+	},
+	QueryDigests: QueryDigestsConfig{
+		MaxDigests:    1000,
+		WindowSeconds: 60,
 	},
 	Consolidator:                Enable,
 	ConsolidatorStreamTotalSize: 128 * 1024 * 1024,
@@ -476,6 +619,7 @@ var defaultConfig = TabletConfig{
 	MessagePostponeParallelism:              4,
 	CacheResultFields:                       true,
 	SignalWhenSchemaChange:                  false, // while this feature is experimental, the safe default is off
+	DefaultQueryPriority:                    50,
 
 	EnableTxThrottler:           false,
 	TxThrottlerConfig:           defaultTxThrottlerConfig(),
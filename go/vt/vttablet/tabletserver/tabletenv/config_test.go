@@ -70,6 +70,7 @@ func TestConfigParse(t *testing.T) {
 gracePeriods: {}
 healthcheck: {}
 hotRowProtection: {}
+olapLimits: {}
 olapReadPool: {}
 oltp: {}
 oltpReadPool:
@@ -78,7 +79,9 @@ oltpReadPool:
   prefillParallelism: 30
   size: 16
   timeoutSeconds: 10
+queryDigests: {}
 replicationTracker: {}
+reservedConnLimits: {}
 txPool: {}
 `
 	assert.Equal(t, wantBytes, string(gotBytes))
@@ -113,17 +116,26 @@ func TestDefaultConfig(t *testing.T) {
 consolidator: enable
 consolidatorStreamQuerySize: 2097152
 consolidatorStreamTotalSize: 134217728
+defaultQueryPriority: 50
 gracePeriods: {}
 healthcheck:
   degradedThresholdSeconds: 30
   intervalSeconds: 20
   unhealthyThresholdSeconds: 7200
 hotRowProtection:
+  cooldownSeconds: 60
+  detectionWindowSeconds: 10
+  dmlThreshold: 100
+  lockWaitPollIntervalSeconds: 1
+  lockWaitThreshold: 10
   maxConcurrency: 5
   maxGlobalQueueSize: 1000
   maxQueueSize: 20
+  maxTrackedRows: 10000
   mode: disable
 messagePostponeParallelism: 4
+olapLimits:
+  mode: disable
 olapReadPool:
   idleTimeoutSeconds: 1800
   size: 200
@@ -134,13 +146,19 @@ oltp:
 oltpReadPool:
   idleTimeoutSeconds: 1800
   maxWaiters: 5000
+  priorityAgingSeconds: 0.05
   size: 16
 queryCacheLFU: true
 queryCacheMemory: 33554432
 queryCacheSize: 5000
+queryDigests:
+  maxDigests: 1000
+  windowSeconds: 60
 replicationTracker:
   heartbeatIntervalSeconds: 0.25
   mode: disable
+reservedConnLimits:
+  mode: disable
 schemaReloadIntervalSeconds: 1800
 signalSchemaChangeReloadIntervalSeconds: 5
 streamBufferSize: 32768
@@ -175,9 +193,10 @@ func TestClone(t *testing.T) {
 func TestFlags(t *testing.T) {
 	want := TabletConfig{
 		OltpReadPool: ConnPoolConfig{
-			Size:               16,
-			IdleTimeoutSeconds: 1800,
-			MaxWaiters:         5000,
+			Size:                 16,
+			IdleTimeoutSeconds:   1800,
+			MaxWaiters:           5000,
+			PriorityAgingSeconds: 0.05,
 		},
 		OlapReadPool: ConnPoolConfig{
 			Size: 200,
@@ -196,6 +215,23 @@ func TestFlags(t *testing.T) {
 			MaxQueueSize:       20,
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
+
+			DetectionWindowSeconds:      10,
+			DMLThreshold:                100,
+			LockWaitThreshold:           10,
+			LockWaitPollIntervalSeconds: 1,
+			CooldownSeconds:             60,
+			MaxTrackedRows:              10000,
+		},
+		OlapLimits: QueryLimitsConfig{
+			Mode: Disable,
+		},
+		ReservedConnLimits: QueryLimitsConfig{
+			Mode: Disable,
+		},
+		QueryDigests: QueryDigestsConfig{
+			MaxDigests:    1000,
+			WindowSeconds: 60,
 		},
 		StreamBufferSize:                        32768,
 		QueryCacheSize:                          int(cache.DefaultConfig.MaxEntries),
@@ -206,6 +242,7 @@ func TestFlags(t *testing.T) {
 		TrackSchemaVersions:                     false,
 		MessagePostponeParallelism:              4,
 		CacheResultFields:                       true,
+		DefaultQueryPriority:                    50,
 		TxThrottlerConfig:                       "target_replication_lag_sec: 2\nmax_replication_lag_sec: 10\ninitial_rate: 100\nmax_increase: 1\nemergency_decrease: 0.5\nmin_duration_between_increases_sec: 40\nmax_duration_between_increases_sec: 62\nmin_duration_between_decreases_sec: 20\nspread_backlog_across_sec: 20\nage_bad_rate_after_sec: 180\nbad_rate_increase: 0.1\nmax_rate_approach_threshold: 0.9\n",
 		TxThrottlerHealthCheckCells:             []string{},
 		TransactionLimitConfig: TransactionLimitConfig{
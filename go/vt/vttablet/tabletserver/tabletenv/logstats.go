@@ -55,6 +55,8 @@ type LogStats struct {
 	StartTime            time.Time
 	EndTime              time.Time
 	MysqlResponseTime    time.Duration
+	MysqlCPUTime         time.Duration
+	RowsExamined         int64
 	WaitingForConnection time.Duration
 	QuerySources         byte
 	Rows                 [][]sqltypes.Value
@@ -34,6 +34,7 @@ import (
 
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
@@ -55,11 +56,14 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/digest"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/gc"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/messager"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/quota"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/repltracker"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
@@ -111,6 +115,9 @@ type TabletServer struct {
 	watcher      *BinlogWatcher
 	qe           *QueryEngine
 	txThrottler  *txthrottler.TxThrottler
+	quotas       *quota.Enforcer
+	killer       *queryKiller
+	digests      *digest.Collector
 	te           *TxEngine
 	messager     *messager.Engine
 	hs           *healthStreamer
@@ -179,7 +186,10 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.watcher = NewBinlogWatcher(tsv, tsv.vstreamer, tsv.config)
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
 	tsv.txThrottler = txthrottler.NewTxThrottler(tsv.config, topoServer)
+	tsv.quotas = quota.NewEnforcer(tsv, topoServer)
 	tsv.te = NewTxEngine(tsv)
+	tsv.killer = newQueryKiller(tsv, tsv.olapql, tsv.te.txPool)
+	tsv.digests = digest.NewCollector(tsv.exporter, config.QueryDigests.Enable, config.QueryDigests.MaxDigests, config.QueryDigests.WindowSeconds.Get())
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
 	tsv.onlineDDLExecutor = onlineddl.NewExecutor(tsv, alias, topoServer, tabletTypeFunc)
@@ -197,6 +207,8 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 		watcher:     tsv.watcher,
 		qe:          tsv.qe,
 		txThrottler: tsv.txThrottler,
+		quotas:      tsv.quotas,
+		killer:      tsv.killer,
 		te:          tsv.te,
 		messager:    tsv.messager,
 		ddle:        tsv.onlineDDLExecutor,
@@ -217,6 +229,8 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.registerHealthzHealthHandler()
 	tsv.registerDebugHealthHandler()
 	tsv.registerQueryzHandler()
+	tsv.registerDigestzHandlers()
+	tsv.registerHotRowzHandler()
 	tsv.registerQueryListHandlers([]*QueryList{tsv.statelessql, tsv.statefulql, tsv.olapql})
 	tsv.registerTwopczHandler()
 	tsv.registerMigrationStatusHandler()
@@ -239,6 +253,7 @@ func (tsv *TabletServer) InitDBConfig(target *querypb.Target, dbcfgs *dbconfigs.
 	tsv.se.InitDBConfig(tsv.config.DB.DbaWithDB())
 	tsv.rt.InitDBConfig(target, mysqld)
 	tsv.txThrottler.InitDBConfig(target)
+	tsv.quotas.InitDBConfig(target.Keyspace)
 	tsv.vstreamer.InitDBConfig(target.Keyspace, target.Shard)
 	tsv.hs.InitDBConfig(target, tsv.config.DB.DbaWithDB())
 	tsv.onlineDDLExecutor.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
@@ -713,6 +728,13 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			if err != nil {
 				return err
 			}
+			if rewrite := tsv.qe.GetRewrite(ctx, plan, bindVariables, comments); rewrite != "" {
+				query = rewrite
+				plan, err = tsv.qe.GetPlan(ctx, logStats, query, skipQueryPlanCache(options), reservedID != 0)
+				if err != nil {
+					return err
+				}
+			}
 			// If both the values are non-zero then by design they are same value. So, it is safe to overwrite.
 			connID := reservedID
 			if transactionID != 0 {
@@ -732,10 +754,16 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 				tsv:            tsv,
 				tabletType:     target.GetTabletType(),
 			}
+			ticket, err := tsv.quotas.Acquire(ctx, options)
+			if err != nil {
+				return err
+			}
 			result, err = qre.Execute()
 			if err != nil {
+				ticket.Release(quotaUsage(logStats, 0))
 				return err
 			}
+			ticket.Release(quotaUsage(logStats, int64(len(result.Rows))))
 			result = result.StripMetadata(sqltypes.IncludeFieldsOrDefault(options))
 
 			// Change database name in mysql output to the keyspace name
@@ -802,6 +830,13 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 			if err != nil {
 				return err
 			}
+			if rewrite := tsv.qe.GetRewrite(ctx, plan, bindVariables, comments); rewrite != "" {
+				query = rewrite
+				plan, err = tsv.qe.GetStreamPlan(query, false /* isReservedConn */)
+				if err != nil {
+					return err
+				}
+			}
 			// If both the values are non-zero then by design they are same value. So, it is safe to overwrite.
 			connID := reservedID
 			if transactionID != 0 {
@@ -818,11 +853,31 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 				logStats:       logStats,
 				tsv:            tsv,
 			}
-			return qre.Stream(callback)
+			ticket, err := tsv.quotas.Acquire(ctx, options)
+			if err != nil {
+				return err
+			}
+			var rows int64
+			defer func() { ticket.Release(quotaUsage(logStats, rows)) }()
+			return qre.Stream(func(result *sqltypes.Result) error {
+				rows += int64(len(result.Rows))
+				return callback(result)
+			})
 		},
 	)
 }
 
+// quotaUsage returns the resources used by a query, as recorded in its
+// log stats, to be charged to its quotas.
+func quotaUsage(logStats *tabletenv.LogStats, rowsReturned int64) quota.Usage {
+	return quota.Usage{
+		RowsReturned: rowsReturned,
+		ResponseTime: logStats.MysqlResponseTime,
+		RowsRead:     logStats.RowsExamined,
+		CPUTime:      logStats.MysqlCPUTime,
+	}
+}
+
 // BeginExecute combines Begin and Execute.
 func (tsv *TabletServer) BeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 
@@ -933,6 +988,20 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 		return "", ""
 	}
 
+	if tsv.qe.hotRows.Enabled() {
+		// In adaptive mode, only the updates of the hot rows are serialized.
+		pk, err := rowPK(plan, bindVariables)
+		if err != nil {
+			logComputeRowSerializerKey.Errorf("failed to evaluate the primary key: %v query: %v bind vars: %v", err, sql, bindVariables)
+			return "", ""
+		}
+		if pk == "" || !tsv.qe.hotRows.RecordDML(tableName.String(), pk) {
+			return "", ""
+		}
+		// Example: table1 pk (1, 2)
+		return fmt.Sprintf("%s pk (%s)", tableName, pk), tableName.String()
+	}
+
 	where, err := plan.WhereClause.GenerateQuery(bindVariables, nil)
 	if err != nil {
 		logComputeRowSerializerKey.Errorf("failed to substitute bind vars in where clause: %v query: %v bind vars: %v", err, sql, bindVariables)
@@ -944,6 +1013,25 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 	return key, tableName.String()
 }
 
+// rowPK returns the primary key of the row updated by the plan, with
+// its values separated by ", ". It returns an empty string if the plan
+// does not update a single row.
+func rowPK(plan *TabletPlan, bindVariables map[string]*querypb.BindVariable) (string, error) {
+	if plan.RowPK == nil {
+		return "", nil
+	}
+	env := evalengine.EnvWithBindVars(bindVariables, collations.Unknown)
+	values := make([]string, 0, len(plan.RowPK))
+	for _, expr := range plan.RowPK {
+		result, err := env.Evaluate(expr)
+		if err != nil {
+			return "", err
+		}
+		values = append(values, result.Value().ToString())
+	}
+	return strings.Join(values, ", "), nil
+}
+
 // MessageStream streams messages from the requested table.
 func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
 	return tsv.execRequest(
@@ -1074,6 +1162,14 @@ func (tsv *TabletServer) VStreamResults(ctx context.Context, target *querypb.Tar
 	return tsv.vstreamer.StreamResults(ctx, query, send)
 }
 
+// VStreamPositionAt returns the position of the first transaction committed at or after the timestamp.
+func (tsv *TabletServer) VStreamPositionAt(ctx context.Context, target *querypb.Target, timestamp int64) (string, error) {
+	if err := tsv.sm.VerifyTarget(ctx, target); err != nil {
+		return "", err
+	}
+	return tsv.vstreamer.PositionAt(ctx, timestamp)
+}
+
 // ReserveBeginExecute implements the QueryService interface
 func (tsv *TabletServer) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, postBeginQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, int64, *topodatapb.TabletAlias, error) {
 
@@ -1594,6 +1690,21 @@ func (tsv *TabletServer) registerQueryzHandler() {
 	})
 }
 
+func (tsv *TabletServer) registerDigestzHandlers() {
+	tsv.exporter.HandleFunc("/digestz", func(w http.ResponseWriter, r *http.Request) {
+		digestzHandler(tsv.digests, w, r)
+	})
+	tsv.exporter.HandleFunc("/digestz/reset", func(w http.ResponseWriter, r *http.Request) {
+		digestzResetHandler(tsv.digests, w, r)
+	})
+}
+
+func (tsv *TabletServer) registerHotRowzHandler() {
+	tsv.exporter.HandleFunc("/hotrowz", func(w http.ResponseWriter, r *http.Request) {
+		hotrowzHandler(tsv.qe.hotRows, w, r)
+	})
+}
+
 func (tsv *TabletServer) registerQueryListHandlers(queryLists []*QueryList) {
 	tsv.exporter.HandleFunc("/livequeryz/", func(w http.ResponseWriter, r *http.Request) {
 		livequeryzHandler(queryLists, w, r)
@@ -1601,6 +1712,25 @@ func (tsv *TabletServer) registerQueryListHandlers(queryLists []*QueryList) {
 	tsv.exporter.HandleFunc("/livequeryz/terminate", func(w http.ResponseWriter, r *http.Request) {
 		livequeryzTerminateHandler(queryLists, w, r)
 	})
+	tsv.exporter.HandleFunc("/livequeryz/kill", func(w http.ResponseWriter, r *http.Request) {
+		livequeryzKillHandler(queryLists, w, r)
+	})
+}
+
+// ListQueries returns the running queries that match the filter,
+// sorted by start time.
+func (tsv *TabletServer) ListQueries(filter QueryFilter) []QueryDetailzRow {
+	var rows []QueryDetailzRow
+	for _, ql := range []*QueryList{tsv.statelessql, tsv.statefulql, tsv.olapql} {
+		rows = ql.AppendFilteredQueryzRows(rows, filter)
+	}
+	return rows
+}
+
+// KillQueries kills the running queries that match the filter, and
+// returns how many were killed. The filter must not be empty.
+func (tsv *TabletServer) KillQueries(filter QueryFilter) (int, error) {
+	return killQueries([]*QueryList{tsv.statelessql, tsv.statefulql, tsv.olapql}, filter)
 }
 
 func (tsv *TabletServer) registerTwopczHandler() {
@@ -49,9 +49,11 @@ import (
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -761,6 +763,134 @@ func TestTabletServerStreamExecuteComments(t *testing.T) {
 	}
 }
 
+func TestTabletServerQueryRuleRewrite(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	rewrittenSQL := "select * from test_table force index (idx) limit 1000"
+	db.AddQuery(rewrittenSQL, &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarBinary("rewritten")}},
+	})
+	db.AddQuery("select * from test_table force index (idx) where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+	})
+
+	qr := rules.NewQueryRule("force index", "force_index", rules.QRRewrite)
+	require.NoError(t, qr.SetQueryCond(`select \* from test_table (limit .*)`))
+	qr.SetRewrite("select * from test_table force index (idx) $1")
+	qrs := rules.New()
+	qrs.Add(qr)
+	tsv.qe.queryRuleSources.RegisterSource("rewrite")
+	defer tsv.qe.queryRuleSources.UnRegisterSource("rewrite")
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules("rewrite", qrs))
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+	result, err := tsv.Execute(ctx, &target, "select * from test_table limit 1000", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "rewritten", result.Rows[0][0].ToString())
+
+	var rows [][]sqltypes.Value
+	err = tsv.StreamExecute(ctx, &target, "select * from test_table limit 1000", nil, 0, 0, nil, func(result *sqltypes.Result) error {
+		rows = append(rows, result.Rows...)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "rewritten", rows[0][0].ToString())
+	assert.EqualValues(t, 2, tsv.qe.queryRuleRewrites.Counts()["force_index"])
+}
+
+func TestTabletServerQuotas(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.EnableQuotas = true
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarBinary("row01")},
+			{sqltypes.NewVarBinary("row02")},
+		},
+	})
+	tsv.quotas.SetQuotas(&quotapb.Quotas{Quotas: []*quotapb.Quota{{
+		Name:            "rows",
+		MaxRowsReturned: 2,
+		Workload:        "OLTP",
+	}}})
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+	_, err := tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, nil)
+	require.NoError(t, err)
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, nil)
+	require.EqualError(t, err, "quota rows exceeded: rows_returned")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	// OLAP queries do not match the quota.
+	olap := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+	err = tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, 0, olap, func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+}
+
+func TestTabletServerQuotasStatementStats(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.EnableQuotas = true
+	config.EnableStatementStats = true
+	config.QueryDigests.Enable = true
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarBinary("row01")}},
+	})
+	// Each query examines 600 rows, and uses 2ms of CPU.
+	db.AddQuery(statementStatsQuery, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("rows_examined|cpu_time", "int64|uint64"),
+		"600|2000000000",
+	))
+	tsv.quotas.SetQuotas(&quotapb.Quotas{Quotas: []*quotapb.Quota{{
+		Name:          "rows",
+		MaxRowsRead:   1000,
+		WindowSeconds: 60,
+	}, {
+		Name:          "cpu",
+		MaxCpuSeconds: 0.005,
+		WindowSeconds: 60,
+	}}})
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+	for i := 0; i < 2; i++ {
+		_, err := tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, nil)
+		require.NoError(t, err)
+	}
+	_, err := tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, nil)
+	require.EqualError(t, err, "quota rows exceeded: rows_read")
+
+	// Streaming queries are counted too.
+	tsv.quotas.SetQuotas(&quotapb.Quotas{Quotas: []*quotapb.Quota{{
+		Name:          "stream_cpu",
+		MaxCpuSeconds: 0.005,
+		WindowSeconds: 60,
+	}}})
+	for i := 0; i < 3; i++ {
+		err = tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, 0, nil, func(*sqltypes.Result) error { return nil })
+		require.NoError(t, err)
+	}
+	err = tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, 0, nil, func(*sqltypes.Result) error { return nil })
+	require.EqualError(t, err, "quota stream_cpu exceeded: cpu_seconds")
+
+	// The rows examined by the queries which ran are in their digest.
+	rows := tsv.digests.Rows()
+	require.Len(t, rows, 1)
+	assert.EqualValues(t, 5*600, rows[0].RowsExamined)
+}
+
 func TestSerializeTransactionsSameRow(t *testing.T) {
 	// This test runs three transaction in parallel:
 	// tx1 | tx2 | tx3
@@ -894,6 +1024,47 @@ func TestDMLQueryWithoutWhereClause(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAdaptiveHotRowProtection(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Mode = tabletenv.Enable
+	config.HotRowProtection.Adaptive = true
+	config.HotRowProtection.DMLThreshold = 2
+	config.HotRowProtection.LockWaitThreshold = 0
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	logStats := tabletenv.NewLogStats(ctx, "TestAdaptiveHotRowProtection")
+	q := "update test_table set name_string = 'tx' where pk = :pk and `name` = :name"
+	bv := func(pk, name int64) map[string]*querypb.BindVariable {
+		return map[string]*querypb.BindVariable{
+			"pk":   sqltypes.Int64BindVariable(pk),
+			"name": sqltypes.Int64BindVariable(name),
+		}
+	}
+
+	key, _ := tsv.computeTxSerializerKey(ctx, logStats, q, bv(1, 1))
+	assert.Empty(t, key, "the row is not hot yet")
+	// The updates of the hot row are serialized, whatever their WHERE clause.
+	key, table := tsv.computeTxSerializerKey(ctx, logStats, q, bv(1, 2))
+	assert.Equal(t, "test_table pk (1)", key)
+	assert.Equal(t, "test_table", table)
+	key, _ = tsv.computeTxSerializerKey(ctx, logStats, "delete from test_table where pk = 1", nil)
+	assert.Equal(t, "test_table pk (1)", key)
+
+	key, _ = tsv.computeTxSerializerKey(ctx, logStats, q, bv(2, 1))
+	assert.Empty(t, key)
+	// Only the updates of a single row are serialized.
+	key, _ = tsv.computeTxSerializerKey(ctx, logStats, "update test_table set name_string = 'tx' where pk in (1, 2)", nil)
+	assert.Empty(t, key)
+
+	rows := tsv.qe.hotRows.HotRows()
+	require.Len(t, rows, 1)
+	assert.Equal(t, "test_table", rows[0].Table)
+	assert.Equal(t, "1", rows[0].PK)
+	assert.Equal(t, int64(3), rows[0].DMLs)
+}
+
 func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 	// This test runs three transaction in parallel:
 	// tx1 | tx2 | tx3
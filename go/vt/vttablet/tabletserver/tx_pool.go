@@ -17,6 +17,7 @@ limitations under the License.
 package tabletserver
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -124,26 +125,32 @@ func (tp *TxPool) transactionKiller() {
 	defer tp.env.LogError()
 	for _, conn := range tp.scp.GetOutdated(tp.Timeout(), "for tx killer rollback") {
 		log.Warningf("killing transaction (exceeded timeout: %v): %s", tp.Timeout(), conn.String(tp.env.Config().SanitizeLogMessages))
-		switch {
-		case conn.IsTainted():
+		tp.kill(conn, fmt.Sprintf("exceeded timeout: %v", tp.Timeout()))
+	}
+}
+
+// kill rolls back the transaction of a locked connection and closes it
+// if it is reserved, then releases it with the given reason.
+func (tp *TxPool) kill(conn *StatefulConnection, reason string) {
+	switch {
+	case conn.IsTainted():
+		conn.Close()
+		tp.env.Stats().KillCounters.Add("ReservedConnection", 1)
+	case conn.IsInTransaction():
+		_, err := conn.Exec(context.Background(), "rollback", 1, false)
+		if err != nil {
 			conn.Close()
-			tp.env.Stats().KillCounters.Add("ReservedConnection", 1)
-		case conn.IsInTransaction():
-			_, err := conn.Exec(context.Background(), "rollback", 1, false)
-			if err != nil {
-				conn.Close()
-			}
-			tp.env.Stats().KillCounters.Add("Transactions", 1)
-		}
-		// For logging, as transaction is killed as the connection is closed.
-		if conn.IsTainted() && conn.IsInTransaction() {
-			tp.env.Stats().KillCounters.Add("Transactions", 1)
-		}
-		if conn.IsInTransaction() {
-			tp.txComplete(conn, tx.TxKill)
 		}
-		conn.Releasef("exceeded timeout: %v", tp.Timeout())
+		tp.env.Stats().KillCounters.Add("Transactions", 1)
+	}
+	// For logging, as transaction is killed as the connection is closed.
+	if conn.IsTainted() && conn.IsInTransaction() {
+		tp.env.Stats().KillCounters.Add("Transactions", 1)
+	}
+	if conn.IsInTransaction() {
+		tp.txComplete(conn, tx.TxKill)
 	}
+	conn.Releasef("%s", reason)
 }
 
 // WaitForEmpty waits until all active transactions are completed.
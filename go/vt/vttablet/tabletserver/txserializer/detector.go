@@ -0,0 +1,391 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txserializer
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// Sources of the hot row detections, as exported in the HotRowDetections
+// stats.
+const (
+	SourceDML      = "DML"
+	SourceLockWait = "LockWait"
+)
+
+const (
+	// lockWaitsQuery returns the number of transactions waiting for a
+	// lock on each row of the primary keys of the database.
+	lockWaitsQuery = "select l.object_name, l.lock_data, count(*) from performance_schema.data_lock_waits as w join performance_schema.data_locks as l on l.engine_lock_id = w.blocking_engine_lock_id where l.object_schema = database() and l.index_name = 'PRIMARY' group by l.object_name, l.lock_data"
+	// innodbLockWaitsQuery is the lockWaitsQuery of MySQL 5.7, which
+	// has no performance_schema.data_lock_waits table.
+	innodbLockWaitsQuery = "select l.lock_table, l.lock_data, count(*) from information_schema.innodb_lock_waits as w join information_schema.innodb_locks as l on l.lock_id = w.blocking_lock_id where l.lock_index = 'PRIMARY' and l.lock_table like concat('`', database(), '`.%') group by l.lock_table, l.lock_data"
+
+	maxLockWaitRows = 10000
+)
+
+// Detector detects the hot rows for the adaptive hot row protection.
+// A row is hot when the number of transactions waiting for its lock in
+// MySQL, or the number of its updates and deletes, reaches a threshold
+// within the detection window. It then stays hot until it was not
+// detected as hot for the cooldown period.
+//
+// Rows are identified by their table and the values of their primary
+// key columns, separated by ", " as in the lock_data column of the
+// InnoDB lock tables.
+type Detector struct {
+	env tabletenv.Env
+
+	// Immutable fields.
+	enabled           bool
+	window            time.Duration
+	cooldown          time.Duration
+	interval          time.Duration
+	dmlThreshold      int64
+	lockWaitThreshold int64
+	maxRows           int
+	now               func() time.Time
+
+	runMu  sync.Mutex
+	isOpen bool
+	pool   *connpool.Pool
+	ticks  *timer.Timer
+	// query is only used by the poller.
+	query string
+
+	detections *stats.CountersWithMultiLabels
+	lockWaits  *stats.CountersWithSingleLabel
+	dropped    *stats.Counter
+
+	log      *logutil.ThrottledLogger
+	errorLog *logutil.ThrottledLogger
+
+	mu   sync.Mutex
+	rows map[rowKey]*trackedRow
+}
+
+type rowKey struct {
+	table, pk string
+}
+
+type trackedRow struct {
+	// windowStart is the start of the detection window of the counts.
+	windowStart time.Time
+	dmls        int64
+	lockWaits   int64
+	lastSeen    time.Time
+	// detected and source are set when the row becomes hot, until
+	// the end of the cooldown.
+	detected time.Time
+	source   string
+	until    time.Time
+}
+
+func (r *trackedRow) hot(now time.Time) bool {
+	return now.Before(r.until)
+}
+
+// HotRow describes a hot row.
+type HotRow struct {
+	Table string
+	PK    string
+	// Source is what made the row hot, SourceDML or SourceLockWait.
+	Source string
+	// DMLs and LockWaits are the counts of the current detection window.
+	DMLs      int64
+	LockWaits int64
+	Detected  time.Time
+	LastSeen  time.Time
+	// Until is the end of the cooldown of the row.
+	Until time.Time
+}
+
+// NewDetector returns a Detector, which is enabled if the hot row
+// protection is enabled in adaptive mode.
+func NewDetector(env tabletenv.Env) *Detector {
+	config := env.Config().HotRowProtection
+	if config.Mode == tabletenv.Disable || !config.Adaptive {
+		return &Detector{}
+	}
+	interval := config.LockWaitPollIntervalSeconds.Get()
+	return &Detector{
+		env:               env,
+		enabled:           true,
+		window:            config.DetectionWindowSeconds.Get(),
+		cooldown:          config.CooldownSeconds.Get(),
+		interval:          interval,
+		dmlThreshold:      int64(config.DMLThreshold),
+		lockWaitThreshold: int64(config.LockWaitThreshold),
+		maxRows:           config.MaxTrackedRows,
+		now:               time.Now,
+		pool: connpool.NewPool(env, "HotRowDetectorPool", tabletenv.ConnPoolConfig{
+			Size:               1,
+			IdleTimeoutSeconds: env.Config().OltpReadPool.IdleTimeoutSeconds,
+		}),
+		ticks: timer.NewTimer(interval),
+		query: lockWaitsQuery,
+		detections: env.Exporter().NewCountersWithMultiLabels(
+			"HotRowDetections",
+			"Number of times a row was detected as hot",
+			[]string{"Table", "Source"}),
+		lockWaits: env.Exporter().NewCountersWithSingleLabel(
+			"HotRowLockWaits",
+			"Number of transactions seen waiting for a row lock in MySQL",
+			"Table"),
+		dropped: env.Exporter().NewCounter(
+			"HotRowDetectorDropped",
+			"Number of row updates or lock waits ignored because the maximum number of tracked rows was reached"),
+		log:      logutil.NewThrottledLogger("HotRowDetector", 5*time.Second),
+		errorLog: logutil.NewThrottledLogger("HotRowDetector Errors", 60*time.Second),
+		rows:     make(map[rowKey]*trackedRow),
+	}
+}
+
+// Enabled returns true if the adaptive hot row protection is enabled.
+func (d *Detector) Enabled() bool {
+	return d.enabled
+}
+
+// Open starts polling the lock waits of MySQL.
+func (d *Detector) Open() {
+	if !d.enabled {
+		return
+	}
+	d.runMu.Lock()
+	defer d.runMu.Unlock()
+	if d.isOpen {
+		return
+	}
+	log.Info("Hot Row Detector: opening")
+
+	// The app user may not be allowed to read the lock tables.
+	dbConfig := d.env.Config().DB
+	d.pool.Open(dbConfig.DbaWithDB(), dbConfig.DbaWithDB(), dbConfig.AppDebugWithDB())
+	d.ticks.Start(d.poll)
+	d.isOpen = true
+}
+
+// Close stops polling the lock waits, and forgets the detected rows.
+func (d *Detector) Close() {
+	if !d.enabled {
+		return
+	}
+	d.runMu.Lock()
+	defer d.runMu.Unlock()
+	if !d.isOpen {
+		return
+	}
+	d.ticks.Stop()
+	d.pool.Close()
+
+	d.mu.Lock()
+	d.rows = make(map[rowKey]*trackedRow)
+	d.mu.Unlock()
+	d.isOpen = false
+	log.Info("Hot Row Detector: closed")
+}
+
+// RecordDML records an update or delete of a row. It returns true if
+// the row is hot.
+func (d *Detector) RecordDML(table, pk string) bool {
+	if !d.enabled {
+		return false
+	}
+	return d.record(table, pk, 1, 0)
+}
+
+// HotRows returns the rows currently hot, sorted by table and primary
+// key.
+func (d *Detector) HotRows() []HotRow {
+	if !d.enabled {
+		return nil
+	}
+	now := d.now()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var rows []HotRow
+	for key, r := range d.rows {
+		if !r.hot(now) {
+			continue
+		}
+		rows = append(rows, HotRow{
+			Table:     key.table,
+			PK:        key.pk,
+			Source:    r.source,
+			DMLs:      r.dmls,
+			LockWaits: r.lockWaits,
+			Detected:  r.detected,
+			LastSeen:  r.lastSeen,
+			Until:     r.until,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Table != rows[j].Table {
+			return rows[i].Table < rows[j].Table
+		}
+		return rows[i].PK < rows[j].PK
+	})
+	return rows
+}
+
+// record adds the updates and lock waits of a row to its counts, and
+// returns true if the row is hot.
+func (d *Detector) record(table, pk string, dmls, lockWaits int64) bool {
+	now := d.now()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := rowKey{table: table, pk: pk}
+	r, ok := d.rows[key]
+	if !ok {
+		if len(d.rows) >= d.maxRows {
+			d.evictLocked(now)
+		}
+		if len(d.rows) >= d.maxRows {
+			d.dropped.Add(1)
+			return false
+		}
+		r = &trackedRow{windowStart: now}
+		d.rows[key] = r
+	}
+	if now.Sub(r.windowStart) >= d.window {
+		r.windowStart = now
+		r.dmls = 0
+		r.lockWaits = 0
+	}
+	r.dmls += dmls
+	r.lockWaits += lockWaits
+	r.lastSeen = now
+
+	var source string
+	switch {
+	case d.lockWaitThreshold > 0 && r.lockWaits >= d.lockWaitThreshold:
+		source = SourceLockWait
+	case d.dmlThreshold > 0 && r.dmls >= d.dmlThreshold:
+		source = SourceDML
+	default:
+		return r.hot(now)
+	}
+	if !r.hot(now) {
+		r.detected = now
+		r.source = source
+		d.detections.Add([]string{table, source}, 1)
+		d.log.Infof("Detected hot row of table %v with primary key (%v): %v lock waits and %v updates in %v", table, pk, r.lockWaits, r.dmls, now.Sub(r.windowStart))
+	}
+	r.until = now.Add(d.cooldown)
+	return true
+}
+
+// evictLocked forgets the rows which are not hot and were not seen
+// during the last detection window.
+// The method has the suffix "Locked" to clarify that "d.mu" must be locked.
+func (d *Detector) evictLocked(now time.Time) {
+	for key, r := range d.rows {
+		if !r.hot(now) && now.Sub(r.lastSeen) >= d.window {
+			delete(d.rows, key)
+		}
+	}
+}
+
+func (d *Detector) poll() {
+	defer d.env.LogError()
+	if d.lockWaitThreshold > 0 {
+		if err := d.pollLockWaits(); err != nil {
+			d.errorLog.Errorf("failed to read the lock waits: %v", err)
+		}
+	}
+
+	now := d.now()
+	d.mu.Lock()
+	d.evictLocked(now)
+	d.mu.Unlock()
+}
+
+func (d *Detector) pollLockWaits() error {
+	ctx, cancel := context.WithTimeout(context.Background(), d.interval)
+	defer cancel()
+	conn, err := d.pool.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	qr, err := conn.Exec(ctx, d.query, maxLockWaitRows, false)
+	if sqlErr, ok := mysql.NewSQLErrorFromError(err).(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERNoSuchTable && d.query == lockWaitsQuery {
+		d.query = innodbLockWaitsQuery
+		qr, err = conn.Exec(ctx, d.query, maxLockWaitRows, false)
+	}
+	if err != nil {
+		return err
+	}
+	for _, row := range qr.Rows {
+		if row[1].IsNull() {
+			continue
+		}
+		pk, ok := parseLockData(row[1].ToString())
+		if !ok {
+			continue
+		}
+		waits, err := row[2].ToInt64()
+		if err != nil {
+			return err
+		}
+		table := parseLockTable(row[0].ToString())
+		d.lockWaits.Add(table, waits)
+		d.record(table, pk, 0, waits)
+	}
+	return nil
+}
+
+// parseLockTable returns the table name of the object_name column of
+// performance_schema.data_locks, or of the lock_table column of
+// information_schema.innodb_locks, which is qualified with the database.
+func parseLockTable(lockTable string) string {
+	if i := strings.Index(lockTable, "`.`"); i != -1 {
+		lockTable = lockTable[i+2:]
+	}
+	return strings.Trim(lockTable, "`")
+}
+
+// parseLockData returns the primary key of the row of a lock_data
+// column, stripped of the quotes of its string values. It returns false
+// for the locks which are not on a single row.
+func parseLockData(lockData string) (string, bool) {
+	if lockData == "" || strings.HasSuffix(lockData, "pseudo-record") {
+		return "", false
+	}
+	values := strings.Split(lockData, ", ")
+	for i, value := range values {
+		if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			values[i] = strings.ReplaceAll(value[1:len(value)-1], `\'`, "'")
+		}
+	}
+	return strings.Join(values, ", "), true
+}
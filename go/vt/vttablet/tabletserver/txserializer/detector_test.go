@@ -0,0 +1,191 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txserializer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func newTestDetector(t *testing.T, now *time.Time) *Detector {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Mode = tabletenv.Enable
+	config.HotRowProtection.Adaptive = true
+	config.HotRowProtection.DetectionWindowSeconds = 10
+	config.HotRowProtection.DMLThreshold = 3
+	config.HotRowProtection.LockWaitThreshold = 5
+	config.HotRowProtection.CooldownSeconds = 60
+	config.HotRowProtection.MaxTrackedRows = 10
+	d := NewDetector(tabletenv.NewEnv(config, t.Name()))
+	d.now = func() time.Time { return *now }
+	return d
+}
+
+func TestDetectorDisabled(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Adaptive = true
+	d := NewDetector(tabletenv.NewEnv(config, t.Name()))
+	assert.False(t, d.Enabled())
+	d.Open()
+	defer d.Close()
+	assert.False(t, d.RecordDML("t1", "1"))
+	assert.Nil(t, d.HotRows())
+}
+
+func TestDetectorDML(t *testing.T) {
+	now := time.Now()
+	d := newTestDetector(t, &now)
+
+	assert.False(t, d.RecordDML("t1", "1"))
+	assert.False(t, d.RecordDML("t1", "1"))
+	assert.False(t, d.RecordDML("t1", "2"))
+	now = now.Add(time.Second)
+	assert.True(t, d.RecordDML("t1", "1"), "the third update within the window makes the row hot")
+	assert.False(t, d.RecordDML("t1", "2"))
+	assert.Equal(t, map[string]int64{"t1.DML": 1}, d.detections.Counts())
+
+	rows := d.HotRows()
+	require.Len(t, rows, 1)
+	assert.Equal(t, HotRow{
+		Table:    "t1",
+		PK:       "1",
+		Source:   SourceDML,
+		DMLs:     3,
+		Detected: now,
+		LastSeen: now,
+		Until:    now.Add(60 * time.Second),
+	}, rows[0])
+
+	// The row stays hot during the cooldown, even if it is rarely updated.
+	now = now.Add(30 * time.Second)
+	assert.True(t, d.RecordDML("t1", "1"))
+	now = now.Add(30 * time.Second)
+	assert.False(t, d.RecordDML("t1", "1"))
+	assert.Empty(t, d.HotRows())
+	assert.Equal(t, map[string]int64{"t1.DML": 1}, d.detections.Counts())
+
+	// The updates outside of the detection window are not counted.
+	now = now.Add(10 * time.Second)
+	assert.False(t, d.RecordDML("t1", "2"))
+	now = now.Add(10 * time.Second)
+	assert.False(t, d.RecordDML("t1", "2"))
+	assert.False(t, d.RecordDML("t1", "2"))
+}
+
+func TestDetectorMaxTrackedRows(t *testing.T) {
+	now := time.Now()
+	d := newTestDetector(t, &now)
+	d.maxRows = 2
+
+	d.RecordDML("t1", "1")
+	d.RecordDML("t1", "2")
+	d.RecordDML("t1", "3")
+	assert.Equal(t, int64(1), d.dropped.Get())
+	assert.Len(t, d.rows, 2)
+
+	// Rows not seen during the window are evicted to make room.
+	now = now.Add(10 * time.Second)
+	d.RecordDML("t1", "3")
+	assert.Equal(t, int64(1), d.dropped.Get())
+	assert.Len(t, d.rows, 1)
+}
+
+func TestDetectorLockWaits(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	now := time.Now()
+	d := newTestDetector(t, &now)
+	params, _ := db.ConnParams().MysqlParams()
+	dbc := dbconfigs.NewTestDBConfigs(*params, *params, "")
+	d.pool.Open(dbc.AppWithDB(), dbc.DbaWithDB(), dbc.AppDebugWithDB())
+	defer d.pool.Close()
+
+	db.AddQuery(lockWaitsQuery, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("object_name|lock_data|count(*)", "varchar|varchar|int64"),
+		"t1|1|5",
+		"t1|2|1",
+		"t2|'a', 3|6",
+		"t2|supremum pseudo-record|8",
+	))
+	d.poll()
+
+	assert.Equal(t, map[string]int64{"t1": 6, "t2": 6}, d.lockWaits.Counts())
+	assert.Equal(t, map[string]int64{"t1.LockWait": 1, "t2.LockWait": 1}, d.detections.Counts())
+	rows := d.HotRows()
+	require.Len(t, rows, 2)
+	assert.Equal(t, "t1", rows[0].Table)
+	assert.Equal(t, "1", rows[0].PK)
+	assert.Equal(t, SourceLockWait, rows[0].Source)
+	assert.Equal(t, int64(5), rows[0].LockWaits)
+	assert.Equal(t, "t2", rows[1].Table)
+	assert.Equal(t, "a, 3", rows[1].PK)
+
+	// The updates of the rows detected from their lock waits are
+	// protected.
+	assert.True(t, d.RecordDML("t2", "a, 3"))
+}
+
+func TestDetectorInnoDBLockWaits(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	now := time.Now()
+	d := newTestDetector(t, &now)
+	params, _ := db.ConnParams().MysqlParams()
+	dbc := dbconfigs.NewTestDBConfigs(*params, *params, "")
+	d.pool.Open(dbc.AppWithDB(), dbc.DbaWithDB(), dbc.AppDebugWithDB())
+	defer d.pool.Close()
+
+	db.AddRejectedQuery(lockWaitsQuery, mysql.NewSQLError(mysql.ERNoSuchTable, mysql.SSUnknownTable, "Table 'performance_schema.data_lock_waits' doesn't exist"))
+	db.AddQuery(innodbLockWaitsQuery, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("lock_table|lock_data|count(*)", "varchar|varchar|int64"),
+		"`vt_ks`.`t1`|1|7",
+	))
+	d.poll()
+
+	assert.Equal(t, innodbLockWaitsQuery, d.query)
+	assert.Equal(t, map[string]int64{"t1.LockWait": 1}, d.detections.Counts())
+}
+
+func TestParseLockData(t *testing.T) {
+	testcases := []struct {
+		in   string
+		out  string
+		isPK bool
+	}{
+		{in: "1", out: "1", isPK: true},
+		{in: "1, 2", out: "1, 2", isPK: true},
+		{in: "'a', 'it\\'s'", out: "a, it's", isPK: true},
+		{in: "supremum pseudo-record"},
+		{in: ""},
+	}
+	for _, tc := range testcases {
+		out, isPK := parseLockData(tc.in)
+		assert.Equal(t, tc.out, out, tc.in)
+		assert.Equal(t, tc.isPK, isPK, tc.in)
+	}
+	assert.Equal(t, "t1", parseLockTable("t1"))
+	assert.Equal(t, "t1", parseLockTable("`vt_ks`.`t1`"))
+}
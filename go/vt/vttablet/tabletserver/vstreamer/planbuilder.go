@@ -56,6 +56,10 @@ type Plan struct {
 	// Filters is the list of filters to be applied to the columns
 	// of the table.
 	Filters []Filter
+
+	// hasExprs is set if a filter or a column expression
+	// needs to be evaluated by the evalengine.
+	hasExprs bool
 }
 
 // Opcode enumerates the operators supported in a where clause
@@ -76,8 +80,14 @@ const (
 	GreaterThanEqual
 	// NotEqual is used to filter a comparable column if != specific value
 	NotEqual
+	// ExprMatch is used to filter on any other boolean expression,
+	// which is evaluated by the evalengine
+	ExprMatch
 )
 
+// excludeFilter is the filter value of a rule that excludes the tables it matches.
+const excludeFilter = "exclude"
+
 // Filter contains opcodes for filtering.
 type Filter struct {
 	Opcode Opcode
@@ -92,6 +102,9 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the expression for ExprMatch.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -109,6 +122,10 @@ type ColExpr struct {
 	Field *querypb.Field
 
 	FixedValue sqltypes.Value
+
+	// Expr, if set, is evaluated against the row to compute
+	// the value. If so, ColNum is ignored.
+	Expr evalengine.Expr
 }
 
 // Table contains the metadata for a table.
@@ -211,8 +228,24 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 	if len(result) != len(plan.ColExprs) {
 		return false, fmt.Errorf("expected %d values in result slice", len(plan.ColExprs))
 	}
+	var env *evalengine.ExpressionEnv
+	if plan.hasExprs {
+		env = &evalengine.ExpressionEnv{Row: values, DefaultCollation: collations.Default()}
+	}
 	for _, filter := range plan.Filters {
 		switch filter.Opcode {
+		case ExprMatch:
+			er, err := env.Evaluate(filter.Expr)
+			if err != nil {
+				return false, err
+			}
+			match, err := isTrue(er.Value())
+			if err != nil {
+				return false, err
+			}
+			if !match {
+				return false, nil
+			}
 		case VindexMatch:
 			ksid, err := getKeyspaceID(values, filter.Vindex, filter.VindexColumns, plan.Table.Fields)
 			if err != nil {
@@ -232,6 +265,14 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 		}
 	}
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			er, err := env.Evaluate(colExpr.Expr)
+			if err != nil {
+				return false, err
+			}
+			result[i] = er.Value()
+			continue
+		}
 		if colExpr.ColNum == -1 {
 			result[i] = colExpr.FixedValue
 			continue
@@ -252,6 +293,19 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 	return true, nil
 }
 
+// isTrue returns true if the value of a boolean expression is true,
+// which is the case for any value other than NULL and zero.
+func isTrue(value sqltypes.Value) (bool, error) {
+	if value.IsNull() {
+		return false, nil
+	}
+	f, err := evalengine.ToFloat64(value)
+	if err != nil {
+		return false, err
+	}
+	return f != 0, nil
+}
+
 func getKeyspaceID(values []sqltypes.Value, vindex vindexes.Vindex, vindexColumns []int, fields []*querypb.Field) (key.DestinationKeyspaceID, error) {
 	vindexValues := make([]sqltypes.Value, 0, len(vindexColumns))
 	for _, col := range vindexColumns {
@@ -322,9 +376,9 @@ func ruleMatches(tableName string, filter *binlogdatapb.Filter) bool {
 			if !result {
 				continue
 			}
-			return true
+			return rule.Filter != excludeFilter
 		case tableName == rule.Match:
-			return true
+			return rule.Filter != excludeFilter
 		}
 	}
 	return false
@@ -350,8 +404,14 @@ func buildPlan(ti *Table, vschema *localVSchema, filter *binlogdatapb.Filter) (*
 			if !result {
 				continue
 			}
+			if rule.Filter == excludeFilter {
+				return nil, nil
+			}
 			return buildREPlan(ti, vschema, rule.Filter)
 		case rule.Match == ti.Name:
+			if rule.Filter == excludeFilter {
+				return nil, nil
+			}
 			return buildTablePlan(ti, vschema, rule.Filter)
 		}
 	}
@@ -482,57 +542,124 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
-			opcode, err := getOpcode(expr)
-			if err != nil {
-				return err
-			}
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
+			filter, ok, err := plan.analyzeComparison(expr)
 			if err != nil {
 				return err
 			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			pv, err := evalengine.Translate(val, semantics.EmptySemTable())
-			if err != nil {
-				return err
-			}
-			env := evalengine.EmptyExpressionEnv()
-			resolved, err := env.Evaluate(pv)
-			if err != nil {
-				return err
+			if ok {
+				plan.Filters = append(plan.Filters, filter)
+				continue
 			}
-			plan.Filters = append(plan.Filters, Filter{
-				Opcode: opcode,
-				ColNum: colnum,
-				Value:  resolved.Value(),
-			})
 		case *sqlparser.FuncExpr:
-			if !expr.Name.EqualString("in_keyrange") {
-				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
-			}
-			if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
-				return err
+			if expr.Name.EqualString("in_keyrange") {
+				if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
+					return err
+				}
+				continue
 			}
-		default:
+		}
+		// Any other constraint is evaluated by the evalengine.
+		pv, err := plan.translateExpr(expr)
+		if vterrors.Code(err) == vtrpcpb.Code_UNIMPLEMENTED {
 			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
 		}
+		if err != nil {
+			return err
+		}
+		plan.Filters = append(plan.Filters, Filter{
+			Opcode: ExprMatch,
+			Expr:   pv,
+		})
 	}
 	return nil
 }
 
+// analyzeComparison returns the filter for a comparison of a column
+// with an integer or string literal. It returns false if the comparison
+// is of any other form, in which case it has to be evaluated as an expression.
+func (plan *Plan) analyzeComparison(expr *sqlparser.ComparisonExpr) (Filter, bool, error) {
+	opcode, err := getOpcode(expr)
+	if err != nil {
+		return Filter{}, false, nil
+	}
+	qualifiedName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return Filter{}, false, nil
+	}
+	if !qualifiedName.Qualifier.IsEmpty() {
+		return Filter{}, false, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+	}
+	val, ok := expr.Right.(*sqlparser.Literal)
+	if !ok {
+		return Filter{}, false, nil
+	}
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
+		return Filter{}, false, nil
+	}
+	colnum, err := findColumn(plan.Table, qualifiedName.Name)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	pv, err := evalengine.Translate(val, semantics.EmptySemTable())
+	if err != nil {
+		return Filter{}, false, err
+	}
+	env := evalengine.EmptyExpressionEnv()
+	resolved, err := env.Evaluate(pv)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	return Filter{
+		Opcode: opcode,
+		ColNum: colnum,
+		Value:  resolved.Value(),
+	}, true, nil
+}
+
+// translateExpr translates an expression on the columns of the table
+// for the evalengine.
+func (plan *Plan) translateExpr(expr sqlparser.Expr) (evalengine.Expr, error) {
+	pv, err := evalengine.Translate(expr, &tableLookup{table: plan.Table})
+	if err != nil {
+		return nil, err
+	}
+	plan.hasExprs = true
+	return pv, nil
+}
+
+// tableLookup resolves the columns of an expression to the
+// columns of a table, for the evalengine.
+type tableLookup struct {
+	table *Table
+}
+
+// ColumnLookup implements the evalengine.TranslationLookup interface.
+func (tl *tableLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	if !col.Qualifier.IsEmpty() {
+		return 0, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+	}
+	return findColumn(tl.table, col.Name)
+}
+
+// CollationForExpr implements the evalengine.TranslationLookup interface.
+func (tl *tableLookup) CollationForExpr(expr sqlparser.Expr) collations.ID {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return collations.Unknown
+	}
+	colnum := tl.table.FindColumn(col.Name)
+	if colnum == -1 {
+		return collations.Unknown
+	}
+	return collations.ID(tl.table.Fields[colnum].Charset)
+}
+
+// DefaultCollation implements the evalengine.TranslationLookup interface.
+func (tl *tableLookup) DefaultCollation() collations.ID {
+	return collations.Default()
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -594,7 +721,11 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
-			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			cExpr, err := plan.analyzeComputedExpr(aliased)
+			if vterrors.Code(err) == vtrpcpb.Code_UNIMPLEMENTED {
+				return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			}
+			return cExpr, err
 		}
 		if len(inner.Exprs) != 0 {
 			return ColExpr{}, fmt.Errorf("unexpected: %v", sqlparser.String(inner))
@@ -647,11 +778,51 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			Field:  field,
 		}, nil
 	default:
-		log.Infof("Unsupported expression: %v", inner)
-		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+		cExpr, err := plan.analyzeComputedExpr(aliased)
+		if vterrors.Code(err) == vtrpcpb.Code_UNIMPLEMENTED {
+			log.Infof("Unsupported expression: %v", inner)
+			return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+		}
+		return cExpr, err
 	}
 }
 
+// analyzeComputedExpr returns the column expression for an expression
+// on the columns of the table, which is evaluated by the evalengine.
+func (plan *Plan) analyzeComputedExpr(aliased *sqlparser.AliasedExpr) (ColExpr, error) {
+	pv, err := plan.translateExpr(aliased.Expr)
+	if err != nil {
+		return ColExpr{}, err
+	}
+	// The type of the expression is inferred from the types of the columns.
+	env := &evalengine.ExpressionEnv{
+		Row:              make([]sqltypes.Value, len(plan.Table.Fields)),
+		DefaultCollation: collations.Default(),
+	}
+	for i, field := range plan.Table.Fields {
+		env.Row[i] = sqltypes.MakeTrusted(field.Type, nil)
+	}
+	typ, err := env.TypeOf(pv)
+	if err != nil {
+		return ColExpr{}, err
+	}
+	if typ == sqltypes.Null {
+		typ = sqltypes.VarBinary
+	}
+	as := aliased.As
+	if as.IsEmpty() {
+		as = sqlparser.NewColIdent(sqlparser.String(aliased.Expr))
+	}
+	return ColExpr{
+		Field: &querypb.Field{
+			Name: as.String(),
+			Type: typ,
+		},
+		ColNum: -1,
+		Expr:   pv,
+	}, nil
+}
+
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
 // "in_keyrange(col, 'hash', '-80')", "in_keyrange(col, 'local_vindex', '-80')", or
 // "in_keyrange(col, 'ks.external_vindex', '-80')".
@@ -96,6 +96,9 @@ func init() {
 func TestMustSendDDL(t *testing.T) {
 	filter := &binlogdatapb.Filter{
 		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1x",
+			Filter: "exclude",
+		}, {
 			Match: "/t1.*/",
 		}, {
 			Match: "t2",
@@ -156,6 +159,9 @@ func TestMustSendDDL(t *testing.T) {
 	}, {
 		sql:    "drop table t2",
 		output: true,
+	}, {
+		sql:    "drop table t1x",
+		output: false,
 	}, {
 		sql:    "create table t1a(id int)",
 		db:     "db",
@@ -446,6 +452,14 @@ func TestPlanBuilder(t *testing.T) {
 				VindexColumns: []int{0, 1},
 			}},
 		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "exclude"},
+		outPlan: nil,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "/.*/", Filter: "exclude"},
+		outPlan: nil,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "/*/"},
@@ -537,8 +551,24 @@ func TestPlanBuilder(t *testing.T) {
 		outErr:  `unsupported function: max(val)`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id + none > 1"},
+		outErr:  "column `none` not found in table t1",
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where t1.id + 1 > 1"},
+		outErr:  `unsupported qualifier for column: t1.id`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id in (select id from t2)"},
+		outErr:  `unsupported constraint: id in (select id from t2)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id + none, val from t1"},
+		outErr:  "column `none` not found in table t1",
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select (select 1), val from t1"},
+		outErr:  `unsupported: (select 1 from dual)`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
@@ -663,6 +693,108 @@ func TestPlanBuilderFilterComparison(t *testing.T) {
 	}
 }
 
+func TestPlanFilterExpressions(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name:    "val",
+			Type:    sqltypes.VarBinary,
+			Charset: collations.CollationBinaryID,
+		}, {
+			Name: "price",
+			Type: sqltypes.Int64,
+		}},
+	}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarBinary("aaa"), sqltypes.NewInt64(10)},
+		{sqltypes.NewInt64(2), sqltypes.NewVarBinary("abc"), sqltypes.NULL},
+		{sqltypes.NewInt64(3), sqltypes.NewVarBinary("xyz"), sqltypes.NewInt64(30)},
+	}
+	charsets := []collations.ID{collations.Unknown, collations.CollationBinaryID, collations.Unknown}
+
+	testcases := []struct {
+		name      string
+		inFilter  string
+		outFields []*querypb.Field
+		outRows   [][]sqltypes.Value
+	}{{
+		name:     "or",
+		inFilter: "select id from t1 where id = 1 or val = 'xyz'",
+		outFields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+		},
+		outRows: [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(3)}},
+	}, {
+		name:     "in-and-like",
+		inFilter: "select id from t1 where id in (2, 3) and val like 'a%'",
+		outFields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+		},
+		outRows: [][]sqltypes.Value{{sqltypes.NewInt64(2)}},
+	}, {
+		name:     "null-is-false",
+		inFilter: "select id from t1 where price > 5",
+		outFields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+		},
+		outRows: [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(3)}},
+	}, {
+		name:     "is-null",
+		inFilter: "select id from t1 where price is null and in_keyrange(id, 'hash', '-80')",
+		outFields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+		},
+		outRows: [][]sqltypes.Value{{sqltypes.NewInt64(2)}},
+	}, {
+		name:     "projection",
+		inFilter: "select id, price * 2 as double_price, id + 1 from t1 where id < 3",
+		outFields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "double_price", Type: sqltypes.Int64},
+			{Name: "id + 1", Type: sqltypes.Int64},
+		},
+		outRows: [][]sqltypes.Value{
+			{sqltypes.NewInt64(1), sqltypes.NewInt64(20), sqltypes.NewInt64(2)},
+			{sqltypes.NewInt64(2), sqltypes.NULL, sqltypes.NewInt64(3)},
+		},
+	}, {
+		name:     "function",
+		inFilter: "select id, coalesce(price, 0) as price from t1 where id != 1",
+		outFields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "price", Type: sqltypes.Int64},
+		},
+		outRows: [][]sqltypes.Value{
+			{sqltypes.NewInt64(2), sqltypes.NewInt64(0)},
+			{sqltypes.NewInt64(3), sqltypes.NewInt64(30)},
+		},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.inFilter}},
+			})
+			require.NoError(t, err)
+			require.NotNil(t, plan)
+			utils.MustMatch(t, tcase.outFields, plan.fields())
+
+			var got [][]sqltypes.Value
+			for _, row := range rows {
+				result := make([]sqltypes.Value, len(plan.ColExprs))
+				ok, err := plan.filter(row, result, charsets)
+				require.NoError(t, err)
+				if ok {
+					got = append(got, result)
+				}
+			}
+			assert.Equal(t, tcase.outRows, got)
+		})
+	}
+}
+
 func TestCompare(t *testing.T) {
 	type testcase struct {
 		opcode                   Opcode
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/binlog"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// PositionAt returns the position of the first transaction committed at or
+// after timestamp (in seconds since the epoch). If no such transaction exists
+// yet, the current position is returned.
+func (vse *Engine) PositionAt(ctx context.Context, timestamp int64) (string, error) {
+	vse.mu.Lock()
+	isOpen := vse.isOpen
+	vse.mu.Unlock()
+	if !isOpen {
+		return "", errors.New("VStreamer is not open")
+	}
+
+	cp := vse.env.Config().DB.AppWithDB()
+	conn, err := cp.Connect(ctx)
+	if err != nil {
+		return "", err
+	}
+	current, err := conn.PrimaryPosition()
+	conn.Close()
+	if err != nil {
+		return "", vterrors.Wrap(err, "could not obtain current position")
+	}
+	if timestamp >= time.Now().Unix() {
+		return mysql.EncodePosition(current), nil
+	}
+
+	bc, err := binlog.NewBinlogConnection(cp)
+	if err != nil {
+		return "", err
+	}
+	defer bc.Close()
+
+	events, err := bc.StartBinlogDumpFromBinlogBeforeTimestamp(ctx, timestamp)
+	if err == binlog.ErrBinlogUnavailable {
+		return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "no binlogs are available for timestamp %d", timestamp)
+	}
+	if err != nil {
+		return "", err
+	}
+	pos, err := positionAt(ctx, events, timestamp, current)
+	if err != nil {
+		return "", err
+	}
+	return mysql.EncodePosition(pos), nil
+}
+
+// positionAt scans events, which must start at the beginning of a binlog file,
+// and returns the position just before the first GTID whose timestamp is at or
+// after timestamp. The scan stops at current if no such GTID is found by then.
+func positionAt(ctx context.Context, events <-chan mysql.BinlogEvent, timestamp int64, current mysql.Position) (mysql.Position, error) {
+	var (
+		format mysql.BinlogFormat
+		pos    mysql.Position
+	)
+	for {
+		var ev mysql.BinlogEvent
+		var ok bool
+		select {
+		case ev, ok = <-events:
+			if !ok {
+				return mysql.Position{}, fmt.Errorf("binlog stream ended before reaching timestamp %d", timestamp)
+			}
+		case <-ctx.Done():
+			return mysql.Position{}, ctx.Err()
+		}
+		if !ev.IsValid() {
+			return mysql.Position{}, fmt.Errorf("can't parse binlog event: invalid data: %#v", ev)
+		}
+		if ev.IsFormatDescription() {
+			var err error
+			format, err = ev.Format()
+			if err != nil {
+				return mysql.Position{}, fmt.Errorf("can't parse FORMAT_DESCRIPTION_EVENT: %v, event data: %#v", err, ev)
+			}
+			continue
+		}
+		if format.IsZero() {
+			if ev.IsRotate() {
+				continue
+			}
+			return mysql.Position{}, fmt.Errorf("got a real event before FORMAT_DESCRIPTION_EVENT: %#v", ev)
+		}
+		ev, _, err := ev.StripChecksum(format)
+		if err != nil {
+			return mysql.Position{}, fmt.Errorf("can't strip checksum from binlog event: %v, event data: %#v", err, ev)
+		}
+
+		switch {
+		case ev.IsPreviousGTIDs():
+			pos, err = ev.PreviousGTIDs(format)
+			if err != nil {
+				return mysql.Position{}, fmt.Errorf("can't get previous GTIDs from binlog event: %v, event data: %#v", err, ev)
+			}
+		case ev.IsGTID():
+			if int64(ev.Timestamp()) >= timestamp {
+				return pos, nil
+			}
+			gtid, _, err := ev.GTID(format)
+			if err != nil {
+				return mysql.Position{}, fmt.Errorf("can't get GTID from binlog event: %v, event data: %#v", err, ev)
+			}
+			pos = mysql.AppendGTID(pos, gtid)
+		default:
+			continue
+		}
+		if pos.AtLeast(current) {
+			return pos, nil
+		}
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+)
+
+func TestPositionAt(t *testing.T) {
+	sid, err := mysql.ParseSID("00010203-0405-0607-0809-0a0b0c0d0e0f")
+	require.NoError(t, err)
+	previous, err := mysql.DecodePosition("MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5")
+	require.NoError(t, err)
+	f := mysql.NewMySQL56BinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+
+	events := func(current string) <-chan mysql.BinlogEvent {
+		s.Timestamp = 100
+		evs := []mysql.BinlogEvent{
+			mysql.NewRotateEvent(f, s, 4, "vt-bin.000001"),
+			mysql.NewFormatDescriptionEvent(f, s),
+			mysql.NewMySQL56PreviousGTIDsEvent(f, s, previous.GTIDSet.(mysql.Mysql56GTIDSet)),
+		}
+		for i, ts := range []uint32{110, 120, 130} {
+			s.Timestamp = ts
+			evs = append(evs,
+				mysql.NewMySQL56GTIDEvent(f, s, mysql.Mysql56GTID{Server: sid, Sequence: int64(6 + i)}),
+				mysql.NewXIDEvent(f, s),
+			)
+		}
+		ch := make(chan mysql.BinlogEvent, len(evs))
+		for _, ev := range evs {
+			ch <- ev
+		}
+		if current == "" {
+			close(ch)
+		}
+		return ch
+	}
+	current, err := mysql.DecodePosition("MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-8")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		timestamp int64
+		want      string
+	}{{
+		timestamp: 105,
+		want:      "00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+	}, {
+		timestamp: 110,
+		want:      "00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+	}, {
+		timestamp: 115,
+		want:      "00010203-0405-0607-0809-0a0b0c0d0e0f:1-6",
+	}, {
+		timestamp: 130,
+		want:      "00010203-0405-0607-0809-0a0b0c0d0e0f:1-7",
+	}, {
+		timestamp: 200,
+		want:      "00010203-0405-0607-0809-0a0b0c0d0e0f:1-8",
+	}}
+	for _, tcase := range testcases {
+		pos, err := positionAt(context.Background(), events("current"), tcase.timestamp, current)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, pos.GTIDSet.String(), "timestamp %d", tcase.timestamp)
+	}
+
+	// The stream must not end before the timestamp or the current position is reached.
+	ahead, err := mysql.DecodePosition("MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-9")
+	require.NoError(t, err)
+	_, err = positionAt(context.Background(), events(""), 200, ahead)
+	assert.EqualError(t, err, "binlog stream ended before reaching timestamp 200")
+}
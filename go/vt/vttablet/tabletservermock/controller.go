@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -226,6 +227,16 @@ func (tqsc *Controller) TopoServer() *topo.Server {
 	return tqsc.TS
 }
 
+// ListQueries is part of the tabletserver.Controller interface.
+func (tqsc *Controller) ListQueries(filter tabletserver.QueryFilter) []tabletserver.QueryDetailzRow {
+	return nil
+}
+
+// KillQueries is part of the tabletserver.Controller interface.
+func (tqsc *Controller) KillQueries(filter tabletserver.QueryFilter) (int, error) {
+	return 0, nil
+}
+
 // EnterLameduck implements tabletserver.Controller.
 func (tqsc *Controller) EnterLameduck() {
 	tqsc.mu.Lock()
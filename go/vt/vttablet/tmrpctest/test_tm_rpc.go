@@ -40,6 +40,7 @@ import (
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // fakeRPCTM implements tabletmanager.RPCTM and fills in all
@@ -678,6 +679,69 @@ func tmRPCTestExecuteFetchPanic(ctx context.Context, t *testing.T, client tmclie
 	expectHandleRPCPanic(t, "ExecuteFetchAsAllPrivs", false /*verbose*/, err)
 }
 
+var testListQueriesRequest = &tabletmanagerdatapb.ListQueriesRequest{
+	Caller: "test_caller",
+	Table:  "test_table",
+}
+
+var testLiveQuery = &tabletmanagerdatapb.LiveQuery{
+	Type:         "olap",
+	Query:        "select * from test_table",
+	Caller:       "test_caller",
+	Table:        "test_table",
+	Fingerprint:  "0123456789abcdef",
+	ConnectionId: 12,
+	StartTime:    &vttimepb.Time{Seconds: 1234},
+	Duration:     &vttimepb.Duration{Seconds: 56},
+	RowsStreamed: 789,
+}
+
+var testKillQueriesRequest = &tabletmanagerdatapb.KillQueriesRequest{
+	Fingerprint: "0123456789abcdef",
+}
+
+func (fra *fakeRPCTM) ListQueries(ctx context.Context, req *tabletmanagerdatapb.ListQueriesRequest) ([]*tabletmanagerdatapb.LiveQuery, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ListQueries request", req, testListQueriesRequest)
+	return []*tabletmanagerdatapb.LiveQuery{testLiveQuery}, nil
+}
+
+func (fra *fakeRPCTM) KillQueries(ctx context.Context, req *tabletmanagerdatapb.KillQueriesRequest) (int64, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "KillQueries request", req, testKillQueriesRequest)
+	return 3, nil
+}
+
+func tmRPCTestListQueries(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	queries, err := client.ListQueries(ctx, tablet, testListQueriesRequest)
+	if err != nil {
+		t.Fatalf("ListQueries failed: %v", err)
+	}
+	if len(queries) != 1 {
+		t.Fatalf("ListQueries returned %d queries, want 1", len(queries))
+	}
+	compare(t, "ListQueries queries", queries[0], testLiveQuery)
+}
+
+func tmRPCTestListQueriesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ListQueries(ctx, tablet, testListQueriesRequest)
+	expectHandleRPCPanic(t, "ListQueries", false /*verbose*/, err)
+}
+
+func tmRPCTestKillQueries(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	killed, err := client.KillQueries(ctx, tablet, testKillQueriesRequest)
+	compareError(t, "KillQueries", err, killed, int64(3))
+}
+
+func tmRPCTestKillQueriesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.KillQueries(ctx, tablet, testKillQueriesRequest)
+	expectHandleRPCPanic(t, "KillQueries", true /*verbose*/, err)
+}
+
 //
 // Replication related methods
 //
@@ -1193,22 +1257,25 @@ func tmRPCTestPromoteReplicaPanic(ctx context.Context, t *testing.T, client tmcl
 
 var testBackupConcurrency = 24
 var testBackupAllowPrimary = false
+var testBackupIncremental = true
 var testBackupCalled = false
 var testRestoreFromBackupCalled = false
+var testRestoreToPos = "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
 
-func (fra *fakeRPCTM) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool) error {
+func (fra *fakeRPCTM) Backup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.BackupRequest) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
-	compare(fra.t, "Backup args", concurrency, testBackupConcurrency)
-	compare(fra.t, "Backup args", allowPrimary, testBackupAllowPrimary)
+	compare(fra.t, "Backup args", request.Concurrency, int64(testBackupConcurrency))
+	compare(fra.t, "Backup args", request.AllowPrimary, testBackupAllowPrimary)
+	compare(fra.t, "Backup args", request.Incremental, testBackupIncremental)
 	logStuff(logger, 10)
 	testBackupCalled = true
 	return nil
 }
 
-func tmRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowPrimary)
+func tmRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.BackupRequest) {
+	stream, err := client.Backup(ctx, tablet, req)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1216,8 +1283,8 @@ func tmRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletMa
 	compareError(t, "Backup", err, true, testBackupCalled)
 }
 
-func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowPrimary)
+func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.BackupRequest) {
+	stream, err := client.Backup(ctx, tablet, req)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1228,17 +1295,18 @@ func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.Tab
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger, backupTime time.Time) error {
+func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.RestoreFromBackupRequest) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "RestoreFromBackup restoreToPos", request.RestoreToPos, testRestoreToPos)
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
 }
 
-func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet, backupTime time.Time) {
-	stream, err := client.RestoreFromBackup(ctx, tablet, backupTime)
+func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) {
+	stream, err := client.RestoreFromBackup(ctx, tablet, req)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1246,8 +1314,8 @@ func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclie
 	compareError(t, "RestoreFromBackup", err, true, testRestoreFromBackupCalled)
 }
 
-func tmRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet, backupTime time.Time) {
-	stream, err := client.RestoreFromBackup(ctx, tablet, backupTime)
+func tmRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) {
+	stream, err := client.RestoreFromBackup(ctx, tablet, req)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1279,7 +1347,14 @@ func (fra *fakeRPCTM) HandleRPCPanic(ctx context.Context, name string, args, rep
 func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet, fakeTM tabletmanager.RPCTM) {
 	ctx := context.Background()
 
-	backupTime := time.Time{}
+	backupRequest := &tabletmanagerdatapb.BackupRequest{
+		Concurrency:  int64(testBackupConcurrency),
+		AllowPrimary: testBackupAllowPrimary,
+		Incremental:  testBackupIncremental,
+	}
+	restoreFromBackupRequest := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: testRestoreToPos,
+	}
 
 	// Test RPC specific methods of the interface.
 	tmRPCTestDialExpiredContext(ctx, t, client, tablet)
@@ -1301,6 +1376,8 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestPreflightSchema(ctx, t, client, tablet)
 	tmRPCTestApplySchema(ctx, t, client, tablet)
 	tmRPCTestExecuteFetch(ctx, t, client, tablet)
+	tmRPCTestListQueries(ctx, t, client, tablet)
+	tmRPCTestKillQueries(ctx, t, client, tablet)
 
 	// Replication related methods
 	tmRPCTestMasterPosition(ctx, t, client, tablet)
@@ -1332,8 +1409,8 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestReplicaWasRestarted(ctx, t, client, tablet)
 
 	// Backup / restore related methods
-	tmRPCTestBackup(ctx, t, client, tablet)
-	tmRPCTestRestoreFromBackup(ctx, t, client, tablet, backupTime)
+	tmRPCTestBackup(ctx, t, client, tablet, backupRequest)
+	tmRPCTestRestoreFromBackup(ctx, t, client, tablet, restoreFromBackupRequest)
 
 	//
 	// Tests panic handling everywhere now
@@ -1356,6 +1433,8 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestPreflightSchemaPanic(ctx, t, client, tablet)
 	tmRPCTestApplySchemaPanic(ctx, t, client, tablet)
 	tmRPCTestExecuteFetchPanic(ctx, t, client, tablet)
+	tmRPCTestListQueriesPanic(ctx, t, client, tablet)
+	tmRPCTestKillQueriesPanic(ctx, t, client, tablet)
 
 	// Replication related methods
 	tmRPCTestMasterPositionPanic(ctx, t, client, tablet)
@@ -1382,8 +1461,8 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestReplicaWasPromotedPanic(ctx, t, client, tablet)
 	tmRPCTestReplicaWasRestartedPanic(ctx, t, client, tablet)
 	// Backup / restore related methods
-	tmRPCTestBackupPanic(ctx, t, client, tablet)
-	tmRPCTestRestoreFromBackupPanic(ctx, t, client, tablet, backupTime)
+	tmRPCTestBackupPanic(ctx, t, client, tablet, backupRequest)
+	tmRPCTestRestoreFromBackupPanic(ctx, t, client, tablet, restoreFromBackupRequest)
 
 	client.Close()
 }
@@ -0,0 +1,272 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// atomicCutOverReadyCheckInterval is the interval between checks for all shards being ready to cut-over
+	atomicCutOverReadyCheckInterval = time.Second
+	// atomicCutOverTimeout bounds the time each shard may take to cut-over or roll back
+	atomicCutOverTimeout = time.Minute
+)
+
+// atomicMigrationState is the state of an atomic migration on a single shard
+type atomicMigrationState struct {
+	primary         *topo.TabletInfo
+	table           string
+	status          schema.OnlineDDLStatus
+	readyToComplete bool
+}
+
+// newOnlineDDLVExec plans a VExec query on _vt.schema_migrations for the given migration, on all primaries of the keyspace
+func (wr *Wrangler) newOnlineDDLVExec(ctx context.Context, keyspace, uuid, query string) (*vexec, error) {
+	vx := newVExec(ctx, uuid, keyspace, query, wr)
+	if err := vx.getPrimaries(); err != nil {
+		return nil, err
+	}
+	plan, err := vx.parseAndPlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vx.plannedQuery = plan.parsedQuery.Query
+	return vx, nil
+}
+
+// readAtomicMigrationStates reads the state of the given migration on all shards of the keyspace
+func (wr *Wrangler) readAtomicMigrationStates(ctx context.Context, keyspace, uuid string) ([]*atomicMigrationState, error) {
+	query, err := sqlparser.ParseAndBind(`select migration_status, mysql_table, strategy, options, ready_to_complete from _vt.schema_migrations where migration_uuid=%a`,
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return nil, err
+	}
+	vx, err := wr.newOnlineDDLVExec(ctx, keyspace, uuid, query)
+	if err != nil {
+		return nil, err
+	}
+	results, err := vx.exec()
+	if err != nil {
+		return nil, err
+	}
+	var states []*atomicMigrationState
+	for primary, qr := range results {
+		row := sqltypes.Proto3ToResult(qr).Named().Row()
+		if row == nil {
+			return nil, fmt.Errorf("migration %s not found on shard %s", uuid, primary.Shard)
+		}
+		setting := schema.NewDDLStrategySetting(schema.DDLStrategy(row["strategy"].ToString()), row["options"].ToString())
+		if !setting.IsAtomicCutOver() {
+			return nil, fmt.Errorf("migration %s is not an atomic cut-over migration", uuid)
+		}
+		states = append(states, &atomicMigrationState{
+			primary:         primary,
+			table:           row["mysql_table"].ToString(),
+			status:          schema.OnlineDDLStatus(row["migration_status"].ToString()),
+			readyToComplete: row.AsBool("ready_to_complete", false),
+		})
+	}
+	return states, nil
+}
+
+// waitForAtomicMigrationReady waits until the migration is running and ready to complete on all shards, and
+// returns its per-shard states. With a zero timeout, the states are only checked once.
+func (wr *Wrangler) waitForAtomicMigrationReady(ctx context.Context, keyspace, uuid string, timeout time.Duration) ([]*atomicMigrationState, error) {
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(atomicCutOverReadyCheckInterval)
+	defer ticker.Stop()
+	for {
+		states, err := wr.readAtomicMigrationStates(ctx, keyspace, uuid)
+		if err != nil {
+			return nil, err
+		}
+		var notReady []string
+		for _, state := range states {
+			if state.status != schema.OnlineDDLStatusRunning {
+				return nil, fmt.Errorf("migration %s is %s on shard %s", uuid, state.status, state.primary.Shard)
+			}
+			if !state.readyToComplete {
+				notReady = append(notReady, state.primary.Shard)
+			}
+		}
+		if len(notReady) == 0 {
+			return states, nil
+		}
+		if !time.Now().Before(deadline) {
+			return nil, fmt.Errorf("migration %s is not ready to complete on shards %v", uuid, notReady)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// setAtomicMigrationTableWrites denies or allows writes to the migrated table on all primaries, and refreshes their state.
+// The keyspace must be locked.
+func (wr *Wrangler) setAtomicMigrationTableWrites(ctx context.Context, states []*atomicMigrationState, allowWrites bool) error {
+	var shards []*topo.ShardInfo
+	for _, state := range states {
+		si, err := wr.ts.UpdateShardFields(ctx, state.primary.Keyspace, state.primary.Shard, func(si *topo.ShardInfo) error {
+			return si.UpdateSourceDeniedTables(ctx, topodatapb.TabletType_PRIMARY, nil, allowWrites /* remove */, []string{state.table})
+		})
+		if err != nil {
+			return err
+		}
+		shards = append(shards, si)
+	}
+	return wr.refreshPrimaryTablets(ctx, shards)
+}
+
+// execAtomicMigrationHint runs an online DDL hint (e.g. 'cutover') on the given primaries, concurrently. It returns
+// the per-shard errors, for those shards where the hint failed.
+func (wr *Wrangler) execAtomicMigrationHint(ctx context.Context, keyspace, uuid, hint string, states []*atomicMigrationState) (map[string]error, error) {
+	query, err := sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status=%a where migration_uuid=%a`,
+		sqltypes.StringBindVariable(hint),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return nil, err
+	}
+	vx, err := wr.newOnlineDDLVExec(ctx, keyspace, uuid, query)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, atomicCutOverTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	shardErrors := make(map[string]error)
+	for _, state := range states {
+		wg.Add(1)
+		go func(primary *topo.TabletInfo) {
+			defer wg.Done()
+			if _, err := vx.planner.exec(ctx, primary.Alias, vx.plannedQuery); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				shardErrors[primary.Shard] = err
+			}
+		}(state.primary)
+	}
+	wg.Wait()
+	return shardErrors, nil
+}
+
+// readCutOverMigrationStates re-reads the migration after a failed atomic cut-over, and returns the states of the
+// shards on which it is complete. If the migration cannot be read, the states of all shards are returned along
+// with the error: the tablets refuse to roll back a migration which did not cut-over.
+func (wr *Wrangler) readCutOverMigrationStates(ctx context.Context, keyspace, uuid string, states []*atomicMigrationState) ([]*atomicMigrationState, error) {
+	currentStates, err := wr.readAtomicMigrationStates(ctx, keyspace, uuid)
+	if err != nil {
+		return states, vterrors.Wrapf(err, "cannot read migration %s after failed cut-over", uuid)
+	}
+	var completeStates []*atomicMigrationState
+	for _, state := range currentStates {
+		if state.status == schema.OnlineDDLStatusComplete {
+			completeStates = append(completeStates, state)
+		}
+	}
+	return completeStates, nil
+}
+
+// OnlineDDLAtomicCutOver coordinates a near-simultaneous cut-over of an atomic (--atomic-cut-over) migration on all
+// shards of a keyspace. It waits (up to readyTimeout) for all shards to be ready to complete, then denies writes to
+// the migrated table on all shards, and cuts over all shards concurrently. If any shard fails to cut-over, the
+// shards which report the migration as complete are rolled back, including those whose cut-over returned an
+// error, and all shards resume running the migration. Writes are then allowed again.
+// This is only run on request (vtctl OnlineDDL cutover): atomic migrations which are ready to complete keep
+// running until then.
+func (wr *Wrangler) OnlineDDLAtomicCutOver(ctx context.Context, keyspace, uuid string, readyTimeout time.Duration) (err error) {
+	if !schema.IsOnlineDDLUUID(uuid) {
+		return fmt.Errorf("Not an Online DDL UUID: %s", uuid)
+	}
+	states, err := wr.waitForAtomicMigrationReady(ctx, keyspace, uuid, readyTimeout)
+	if err != nil {
+		return err
+	}
+
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, "OnlineDDLAtomicCutOver")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	// Deny writes to the migrated table on all shards. From this point on, the original tables
+	// on all shards are frozen, which makes it possible to roll back any cut-over.
+	defer func() {
+		if allowErr := wr.setAtomicMigrationTableWrites(ctx, states, true); allowErr != nil {
+			wr.Logger().Errorf("failed to allow writes after atomic cut-over of migration %s: %v", uuid, allowErr)
+			if err == nil {
+				err = allowErr
+			}
+		}
+	}()
+	if err := wr.setAtomicMigrationTableWrites(ctx, states, false); err != nil {
+		return err
+	}
+
+	wr.Logger().Infof("cutting over migration %s on %d shards", uuid, len(states))
+	cutOverErrors, err := wr.execAtomicMigrationHint(ctx, keyspace, uuid, "cutover", states)
+	if err != nil {
+		return err
+	}
+	if len(cutOverErrors) == 0 {
+		wr.Logger().Infof("migration %s cut-over on all shards", uuid)
+		return nil
+	}
+
+	// Some shards failed to cut-over.
+	rec := &concurrency.AllErrorRecorder{}
+	for _, state := range states {
+		if shardErr, ok := cutOverErrors[state.primary.Shard]; ok {
+			rec.RecordError(vterrors.Wrapf(shardErr, "cut-over failed on shard %s", state.primary.Shard))
+		}
+	}
+	// A shard whose cut-over returned an error, e.g. because it timed out, may still have cut-over. Roll back
+	// the cut-over on all shards which report the migration as complete.
+	rollbackStates, err := wr.readCutOverMigrationStates(ctx, keyspace, uuid, states)
+	if err != nil {
+		rec.RecordError(err)
+	}
+	if len(rollbackStates) > 0 {
+		wr.Logger().Warningf("rolling back cut-over of migration %s on %d shards", uuid, len(rollbackStates))
+		rollbackErrors, err := wr.execAtomicMigrationHint(ctx, keyspace, uuid, "rollback-cutover", rollbackStates)
+		if err != nil {
+			rec.RecordError(err)
+		}
+		for shard, shardErr := range rollbackErrors {
+			rec.RecordError(vterrors.Wrapf(shardErr, "rollback of cut-over failed on shard %s", shard))
+		}
+	}
+	return rec.AggrError(vterrors.Aggregate)
+}
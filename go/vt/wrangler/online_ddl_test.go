@@ -0,0 +1,249 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const testAtomicMigrationUUID = "6a1e3c25_4f4a_11ec_9c9f_0a43f95f28a3"
+
+// atomicCutOverTMClient fakes the primaries of a keyspace running an atomic migration of table t1, which is
+// ready to complete on all shards.
+type atomicCutOverTMClient struct {
+	tmclient.TabletManagerClient
+	ts *topo.Server
+
+	mu sync.Mutex
+	// hints has the migration_status hints received by each shard
+	hints map[string][]string
+	// failCutOver has the shards on which the cut-over fails
+	failCutOver map[string]bool
+	// timeOutAfterCutOver has the shards on which the cut-over times out after the tables are swapped
+	timeOutAfterCutOver map[string]bool
+	// notReady has the shards on which the migration is not ready to complete
+	notReady map[string]bool
+	// complete has the shards on which the migration is cut-over
+	complete map[string]bool
+}
+
+func (tmc *atomicCutOverTMClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return nil
+}
+
+func (tmc *atomicCutOverTMClient) VExec(ctx context.Context, tablet *topodatapb.Tablet, query, workflow, keyspace string) (*querypb.QueryResult, error) {
+	if strings.HasPrefix(query, "select ") {
+		tmc.mu.Lock()
+		defer tmc.mu.Unlock()
+		status, readyToComplete := "running", 1
+		if tmc.complete[tablet.Shard] {
+			status = "complete"
+		}
+		if tmc.notReady[tablet.Shard] {
+			readyToComplete = 0
+		}
+		return sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"migration_status|mysql_table|strategy|options|ready_to_complete",
+			"varchar|varchar|varchar|varchar|int64"),
+			fmt.Sprintf("%s|t1|vitess|--atomic-cut-over|%d", status, readyToComplete),
+		)), nil
+	}
+
+	var hint string
+	switch {
+	case strings.Contains(query, "'rollback-cutover'"):
+		hint = "rollback-cutover"
+	case strings.Contains(query, "'cutover'"):
+		hint = "cutover"
+	default:
+		return nil, fmt.Errorf("unexpected query %q", query)
+	}
+	tmc.mu.Lock()
+	tmc.hints[tablet.Shard] = append(tmc.hints[tablet.Shard], hint)
+	tmc.mu.Unlock()
+
+	// Like the tablets, only cut-over or roll back while writes are denied.
+	si, err := tmc.ts.GetShard(ctx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		return nil, err
+	}
+	if tc := si.GetTabletControl(topodatapb.TabletType_PRIMARY); tc == nil || len(tc.DeniedTables) != 1 || tc.DeniedTables[0] != "t1" {
+		return nil, fmt.Errorf("writes to t1 are not denied on shard %s", tablet.Shard)
+	}
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	switch hint {
+	case "cutover":
+		if tmc.failCutOver[tablet.Shard] {
+			return nil, fmt.Errorf("lock wait timeout exceeded")
+		}
+		tmc.complete[tablet.Shard] = true
+		if tmc.timeOutAfterCutOver[tablet.Shard] {
+			return nil, context.DeadlineExceeded
+		}
+	case "rollback-cutover":
+		if !tmc.complete[tablet.Shard] {
+			return nil, fmt.Errorf("migration %s is not complete", testAtomicMigrationUUID)
+		}
+		tmc.complete[tablet.Shard] = false
+	}
+	return &querypb.QueryResult{RowsAffected: 1}, nil
+}
+
+func newAtomicCutOverTestEnv(t *testing.T, shards ...string) (*Wrangler, *atomicCutOverTMClient) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	for i, shard := range shards {
+		tablet := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: uint32(100 + i)},
+			Keyspace: "ks",
+			Shard:    shard,
+			Type:     topodatapb.TabletType_PRIMARY,
+		}
+		require.NoError(t, ts.InitTablet(ctx, tablet, false /* allowPrimaryOverride */, true /* createShardAndKeyspace */, false /* allowUpdate */))
+		_, err := ts.UpdateShardFields(ctx, "ks", shard, func(si *topo.ShardInfo) error {
+			si.PrimaryAlias = tablet.Alias
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	tmc := &atomicCutOverTMClient{
+		ts:                  ts,
+		hints:               make(map[string][]string),
+		failCutOver:         make(map[string]bool),
+		timeOutAfterCutOver: make(map[string]bool),
+		notReady:            make(map[string]bool),
+		complete:            make(map[string]bool),
+	}
+	return New(logutil.NewConsoleLogger(), ts, tmc), tmc
+}
+
+// requireWritesAllowed checks that writes to the migrated table are allowed again on all shards.
+func requireWritesAllowed(t *testing.T, wr *Wrangler, shards ...string) {
+	t.Helper()
+	for _, shard := range shards {
+		si, err := wr.ts.GetShard(context.Background(), "ks", shard)
+		require.NoError(t, err)
+		if tc := si.GetTabletControl(topodatapb.TabletType_PRIMARY); tc != nil {
+			assert.Empty(t, tc.DeniedTables, "shard %s", shard)
+		}
+	}
+}
+
+func TestOnlineDDLAtomicCutOver(t *testing.T) {
+	ctx := context.Background()
+	shards := []string{"-40", "40-80", "80-"}
+
+	t.Run("all shards cut-over", func(t *testing.T) {
+		wr, tmc := newAtomicCutOverTestEnv(t, shards...)
+		err := wr.OnlineDDLAtomicCutOver(ctx, "ks", testAtomicMigrationUUID, 0)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{
+			"-40":   {"cutover"},
+			"40-80": {"cutover"},
+			"80-":   {"cutover"},
+		}, tmc.hints)
+		requireWritesAllowed(t, wr, shards...)
+	})
+
+	t.Run("one shard fails to cut-over", func(t *testing.T) {
+		wr, tmc := newAtomicCutOverTestEnv(t, shards...)
+		tmc.failCutOver["40-80"] = true
+		err := wr.OnlineDDLAtomicCutOver(ctx, "ks", testAtomicMigrationUUID, 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cut-over failed on shard 40-80")
+		assert.Contains(t, err.Error(), "lock wait timeout exceeded")
+		// The shards which did cut-over are rolled back, the failed one resumes the migration by itself.
+		assert.Equal(t, map[string][]string{
+			"-40":   {"cutover", "rollback-cutover"},
+			"40-80": {"cutover"},
+			"80-":   {"cutover", "rollback-cutover"},
+		}, tmc.hints)
+		assert.False(t, tmc.complete["-40"])
+		assert.False(t, tmc.complete["80-"])
+		requireWritesAllowed(t, wr, shards...)
+	})
+
+	t.Run("one shard times out after cutting over", func(t *testing.T) {
+		wr, tmc := newAtomicCutOverTestEnv(t, shards...)
+		tmc.failCutOver["-40"] = true
+		tmc.timeOutAfterCutOver["80-"] = true
+		err := wr.OnlineDDLAtomicCutOver(ctx, "ks", testAtomicMigrationUUID, 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cut-over failed on shard -40")
+		assert.Contains(t, err.Error(), "cut-over failed on shard 80-")
+		assert.NotContains(t, err.Error(), "rollback of cut-over failed")
+		// The shard which timed out did cut-over, and is rolled back along with the shard which succeeded.
+		assert.Equal(t, map[string][]string{
+			"-40":   {"cutover"},
+			"40-80": {"cutover", "rollback-cutover"},
+			"80-":   {"cutover", "rollback-cutover"},
+		}, tmc.hints)
+		for _, shard := range shards {
+			assert.False(t, tmc.complete[shard], "shard %s", shard)
+		}
+		requireWritesAllowed(t, wr, shards...)
+	})
+
+	t.Run("all shards fail to cut-over", func(t *testing.T) {
+		wr, tmc := newAtomicCutOverTestEnv(t, shards...)
+		for _, shard := range shards {
+			tmc.failCutOver[shard] = true
+		}
+		err := wr.OnlineDDLAtomicCutOver(ctx, "ks", testAtomicMigrationUUID, 0)
+		require.Error(t, err)
+		// There's nothing to roll back.
+		assert.Equal(t, map[string][]string{
+			"-40":   {"cutover"},
+			"40-80": {"cutover"},
+			"80-":   {"cutover"},
+		}, tmc.hints)
+		requireWritesAllowed(t, wr, shards...)
+	})
+
+	t.Run("one shard is not ready", func(t *testing.T) {
+		wr, tmc := newAtomicCutOverTestEnv(t, shards...)
+		tmc.notReady["80-"] = true
+		err := wr.OnlineDDLAtomicCutOver(ctx, "ks", testAtomicMigrationUUID, 0)
+		require.EqualError(t, err, fmt.Sprintf("migration %s is not ready to complete on shards [80-]", testAtomicMigrationUUID))
+		assert.Empty(t, tmc.hints)
+		requireWritesAllowed(t, wr, shards...)
+	})
+
+	t.Run("invalid uuid", func(t *testing.T) {
+		wr, tmc := newAtomicCutOverTestEnv(t, shards...)
+		err := wr.OnlineDDLAtomicCutOver(ctx, "ks", "not-a-uuid", 0)
+		require.EqualError(t, err, "Not an Online DDL UUID: not-a-uuid")
+		assert.Empty(t, tmc.hints)
+	})
+}
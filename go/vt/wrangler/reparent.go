@@ -184,6 +184,11 @@ func (wr *Wrangler) TabletExternallyReparented(ctx context.Context, newPrimaryAl
 	if tablet.Type != topodatapb.TabletType_PRIMARY {
 		log.Infof("TabletExternallyReparented: executing tablet type change to PRIMARY")
 
+		durability, err := reparentutil.GetKeyspaceDurability(ctx, wr.ts, tablet.Keyspace)
+		if err != nil {
+			return err
+		}
+
 		// Create a reusable Reparent event with available info.
 		ev := &events.Reparent{
 			ShardInfo:  *si,
@@ -200,7 +205,7 @@ func (wr *Wrangler) TabletExternallyReparented(ctx context.Context, newPrimaryAl
 		}()
 		event.DispatchUpdate(ev, "starting external reparent")
 
-		if err := wr.tmc.ChangeType(ctx, tablet, topodatapb.TabletType_PRIMARY, reparentutil.SemiSyncAckers(tablet) > 0); err != nil {
+		if err := wr.tmc.ChangeType(ctx, tablet, topodatapb.TabletType_PRIMARY, reparentutil.SemiSyncAckers(durability, tablet) > 0); err != nil {
 			log.Warningf("Error calling ChangeType on new primary %v: %v", topoproto.TabletAliasString(newPrimaryAlias), err)
 			return err
 		}
@@ -150,7 +150,12 @@ func (wr *Wrangler) shouldSendSemiSyncAck(ctx context.Context, tablet *topodatap
 		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "primary %v and potential replica %v not in same keypace shard (%v/%v)", topoproto.TabletAliasString(shard.PrimaryAlias), topoproto.TabletAliasString(tablet.Alias), tablet.Keyspace, tablet.Shard)
 	}
 
-	return reparentutil.IsReplicaSemiSync(shardPrimary.Tablet, tablet), nil
+	durability, err := reparentutil.GetKeyspaceDurability(ctx, wr.ts, tablet.Keyspace)
+	if err != nil {
+		return false, err
+	}
+
+	return reparentutil.IsReplicaSemiSync(durability, shardPrimary.Tablet, tablet), nil
 }
 
 // RefreshTabletState refreshes tablet state
@@ -22,6 +22,76 @@ export namespace vtadmin {
          */
         public static create(rpcImpl: $protobuf.RPCImpl, requestDelimited?: boolean, responseDelimited?: boolean): VTAdmin;
 
+        /**
+         * Calls ApplySchema.
+         * @param request ApplySchemaRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and ApplySchemaResponse
+         */
+        public applySchema(request: vtadmin.IApplySchemaRequest, callback: vtadmin.VTAdmin.ApplySchemaCallback): void;
+
+        /**
+         * Calls ApplySchema.
+         * @param request ApplySchemaRequest message or plain object
+         * @returns Promise
+         */
+        public applySchema(request: vtadmin.IApplySchemaRequest): Promise<vtctldata.ApplySchemaResponse>;
+
+        /**
+         * Calls CancelSchemaMigration.
+         * @param request CancelSchemaMigrationRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and CancelSchemaMigrationResponse
+         */
+        public cancelSchemaMigration(request: vtadmin.ICancelSchemaMigrationRequest, callback: vtadmin.VTAdmin.CancelSchemaMigrationCallback): void;
+
+        /**
+         * Calls CancelSchemaMigration.
+         * @param request CancelSchemaMigrationRequest message or plain object
+         * @returns Promise
+         */
+        public cancelSchemaMigration(request: vtadmin.ICancelSchemaMigrationRequest): Promise<vtadmin.CancelSchemaMigrationResponse>;
+
+        /**
+         * Calls CancelWorkflow.
+         * @param request CancelWorkflowRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and CancelWorkflowResponse
+         */
+        public cancelWorkflow(request: vtadmin.ICancelWorkflowRequest, callback: vtadmin.VTAdmin.CancelWorkflowCallback): void;
+
+        /**
+         * Calls CancelWorkflow.
+         * @param request CancelWorkflowRequest message or plain object
+         * @returns Promise
+         */
+        public cancelWorkflow(request: vtadmin.ICancelWorkflowRequest): Promise<vtadmin.CancelWorkflowResponse>;
+
+        /**
+         * Calls CompleteSchemaMigration.
+         * @param request CompleteSchemaMigrationRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and CompleteSchemaMigrationResponse
+         */
+        public completeSchemaMigration(request: vtadmin.ICompleteSchemaMigrationRequest, callback: vtadmin.VTAdmin.CompleteSchemaMigrationCallback): void;
+
+        /**
+         * Calls CompleteSchemaMigration.
+         * @param request CompleteSchemaMigrationRequest message or plain object
+         * @returns Promise
+         */
+        public completeSchemaMigration(request: vtadmin.ICompleteSchemaMigrationRequest): Promise<vtadmin.CompleteSchemaMigrationResponse>;
+
+        /**
+         * Calls CompleteWorkflow.
+         * @param request CompleteWorkflowRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and CompleteWorkflowResponse
+         */
+        public completeWorkflow(request: vtadmin.ICompleteWorkflowRequest, callback: vtadmin.VTAdmin.CompleteWorkflowCallback): void;
+
+        /**
+         * Calls CompleteWorkflow.
+         * @param request CompleteWorkflowRequest message or plain object
+         * @returns Promise
+         */
+        public completeWorkflow(request: vtadmin.ICompleteWorkflowRequest): Promise<vtadmin.CompleteWorkflowResponse>;
+
         /**
          * Calls CreateKeyspace.
          * @param request CreateKeyspaceRequest message or plain object
@@ -50,6 +120,20 @@ export namespace vtadmin {
          */
         public createShard(request: vtadmin.ICreateShardRequest): Promise<vtctldata.CreateShardResponse>;
 
+        /**
+         * Calls CreateWorkflow.
+         * @param request CreateWorkflowRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and CreateWorkflowResponse
+         */
+        public createWorkflow(request: vtadmin.ICreateWorkflowRequest, callback: vtadmin.VTAdmin.CreateWorkflowCallback): void;
+
+        /**
+         * Calls CreateWorkflow.
+         * @param request CreateWorkflowRequest message or plain object
+         * @returns Promise
+         */
+        public createWorkflow(request: vtadmin.ICreateWorkflowRequest): Promise<vtadmin.CreateWorkflowResponse>;
+
         /**
          * Calls DeleteKeyspace.
          * @param request DeleteKeyspaceRequest message or plain object
@@ -106,6 +190,20 @@ export namespace vtadmin {
          */
         public findSchema(request: vtadmin.IFindSchemaRequest): Promise<vtadmin.Schema>;
 
+        /**
+         * Calls GetAuditEntries.
+         * @param request GetAuditEntriesRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and GetAuditEntriesResponse
+         */
+        public getAuditEntries(request: vtadmin.IGetAuditEntriesRequest, callback: vtadmin.VTAdmin.GetAuditEntriesCallback): void;
+
+        /**
+         * Calls GetAuditEntries.
+         * @param request GetAuditEntriesRequest message or plain object
+         * @returns Promise
+         */
+        public getAuditEntries(request: vtadmin.IGetAuditEntriesRequest): Promise<vtadmin.GetAuditEntriesResponse>;
+
         /**
          * Calls GetBackups.
          * @param request GetBackupsRequest message or plain object
@@ -190,6 +288,20 @@ export namespace vtadmin {
          */
         public getSchema(request: vtadmin.IGetSchemaRequest): Promise<vtadmin.Schema>;
 
+        /**
+         * Calls GetSchemaMigrations.
+         * @param request GetSchemaMigrationsRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and GetSchemaMigrationsResponse
+         */
+        public getSchemaMigrations(request: vtadmin.IGetSchemaMigrationsRequest, callback: vtadmin.VTAdmin.GetSchemaMigrationsCallback): void;
+
+        /**
+         * Calls GetSchemaMigrations.
+         * @param request GetSchemaMigrationsRequest message or plain object
+         * @returns Promise
+         */
+        public getSchemaMigrations(request: vtadmin.IGetSchemaMigrationsRequest): Promise<vtadmin.GetSchemaMigrationsResponse>;
+
         /**
          * Calls GetSchemas.
          * @param request GetSchemasRequest message or plain object
@@ -386,6 +498,34 @@ export namespace vtadmin {
          */
         public reparentTablet(request: vtadmin.IReparentTabletRequest): Promise<vtadmin.ReparentTabletResponse>;
 
+        /**
+         * Calls RetrySchemaMigration.
+         * @param request RetrySchemaMigrationRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and RetrySchemaMigrationResponse
+         */
+        public retrySchemaMigration(request: vtadmin.IRetrySchemaMigrationRequest, callback: vtadmin.VTAdmin.RetrySchemaMigrationCallback): void;
+
+        /**
+         * Calls RetrySchemaMigration.
+         * @param request RetrySchemaMigrationRequest message or plain object
+         * @returns Promise
+         */
+        public retrySchemaMigration(request: vtadmin.IRetrySchemaMigrationRequest): Promise<vtadmin.RetrySchemaMigrationResponse>;
+
+        /**
+         * Calls RevertSchemaMigration.
+         * @param request RevertSchemaMigrationRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and RevertSchemaMigrationResponse
+         */
+        public revertSchemaMigration(request: vtadmin.IRevertSchemaMigrationRequest, callback: vtadmin.VTAdmin.RevertSchemaMigrationCallback): void;
+
+        /**
+         * Calls RevertSchemaMigration.
+         * @param request RevertSchemaMigrationRequest message or plain object
+         * @returns Promise
+         */
+        public revertSchemaMigration(request: vtadmin.IRevertSchemaMigrationRequest): Promise<vtadmin.RevertSchemaMigrationResponse>;
+
         /**
          * Calls RunHealthCheck.
          * @param request RunHealthCheckRequest message or plain object
@@ -456,6 +596,20 @@ export namespace vtadmin {
          */
         public stopReplication(request: vtadmin.IStopReplicationRequest): Promise<vtadmin.StopReplicationResponse>;
 
+        /**
+         * Calls SwitchWorkflowTraffic.
+         * @param request SwitchWorkflowTrafficRequest message or plain object
+         * @param callback Node-style callback called with the error, if any, and SwitchWorkflowTrafficResponse
+         */
+        public switchWorkflowTraffic(request: vtadmin.ISwitchWorkflowTrafficRequest, callback: vtadmin.VTAdmin.SwitchWorkflowTrafficCallback): void;
+
+        /**
+         * Calls SwitchWorkflowTraffic.
+         * @param request SwitchWorkflowTrafficRequest message or plain object
+         * @returns Promise
+         */
+        public switchWorkflowTraffic(request: vtadmin.ISwitchWorkflowTrafficRequest): Promise<vtadmin.SwitchWorkflowTrafficResponse>;
+
         /**
          * Calls VTExplain.
          * @param request VTExplainRequest message or plain object
@@ -473,6 +627,41 @@ export namespace vtadmin {
 
     namespace VTAdmin {
 
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#applySchema}.
+         * @param error Error, if any
+         * @param [response] ApplySchemaResponse
+         */
+        type ApplySchemaCallback = (error: (Error|null), response?: vtctldata.ApplySchemaResponse) => void;
+
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#cancelSchemaMigration}.
+         * @param error Error, if any
+         * @param [response] CancelSchemaMigrationResponse
+         */
+        type CancelSchemaMigrationCallback = (error: (Error|null), response?: vtadmin.CancelSchemaMigrationResponse) => void;
+
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#cancelWorkflow}.
+         * @param error Error, if any
+         * @param [response] CancelWorkflowResponse
+         */
+        type CancelWorkflowCallback = (error: (Error|null), response?: vtadmin.CancelWorkflowResponse) => void;
+
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#completeSchemaMigration}.
+         * @param error Error, if any
+         * @param [response] CompleteSchemaMigrationResponse
+         */
+        type CompleteSchemaMigrationCallback = (error: (Error|null), response?: vtadmin.CompleteSchemaMigrationResponse) => void;
+
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#completeWorkflow}.
+         * @param error Error, if any
+         * @param [response] CompleteWorkflowResponse
+         */
+        type CompleteWorkflowCallback = (error: (Error|null), response?: vtadmin.CompleteWorkflowResponse) => void;
+
         /**
          * Callback as used by {@link vtadmin.VTAdmin#createKeyspace}.
          * @param error Error, if any
@@ -487,6 +676,13 @@ export namespace vtadmin {
          */
         type CreateShardCallback = (error: (Error|null), response?: vtctldata.CreateShardResponse) => void;
 
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#createWorkflow}.
+         * @param error Error, if any
+         * @param [response] CreateWorkflowResponse
+         */
+        type CreateWorkflowCallback = (error: (Error|null), response?: vtadmin.CreateWorkflowResponse) => void;
+
         /**
          * Callback as used by {@link vtadmin.VTAdmin#deleteKeyspace}.
          * @param error Error, if any
@@ -515,6 +711,13 @@ export namespace vtadmin {
          */
         type FindSchemaCallback = (error: (Error|null), response?: vtadmin.Schema) => void;
 
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#getAuditEntries}.
+         * @param error Error, if any
+         * @param [response] GetAuditEntriesResponse
+         */
+        type GetAuditEntriesCallback = (error: (Error|null), response?: vtadmin.GetAuditEntriesResponse) => void;
+
         /**
          * Callback as used by {@link vtadmin.VTAdmin#getBackups}.
          * @param error Error, if any
@@ -557,6 +760,13 @@ export namespace vtadmin {
          */
         type GetSchemaCallback = (error: (Error|null), response?: vtadmin.Schema) => void;
 
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#getSchemaMigrations}.
+         * @param error Error, if any
+         * @param [response] GetSchemaMigrationsResponse
+         */
+        type GetSchemaMigrationsCallback = (error: (Error|null), response?: vtadmin.GetSchemaMigrationsResponse) => void;
+
         /**
          * Callback as used by {@link vtadmin.VTAdmin#getSchemas}.
          * @param error Error, if any
@@ -655,6 +865,20 @@ export namespace vtadmin {
          */
         type ReparentTabletCallback = (error: (Error|null), response?: vtadmin.ReparentTabletResponse) => void;
 
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#retrySchemaMigration}.
+         * @param error Error, if any
+         * @param [response] RetrySchemaMigrationResponse
+         */
+        type RetrySchemaMigrationCallback = (error: (Error|null), response?: vtadmin.RetrySchemaMigrationResponse) => void;
+
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#revertSchemaMigration}.
+         * @param error Error, if any
+         * @param [response] RevertSchemaMigrationResponse
+         */
+        type RevertSchemaMigrationCallback = (error: (Error|null), response?: vtadmin.RevertSchemaMigrationResponse) => void;
+
         /**
          * Callback as used by {@link vtadmin.VTAdmin#runHealthCheck}.
          * @param error Error, if any
@@ -690,6 +914,13 @@ export namespace vtadmin {
          */
         type StopReplicationCallback = (error: (Error|null), response?: vtadmin.StopReplicationResponse) => void;
 
+        /**
+         * Callback as used by {@link vtadmin.VTAdmin#switchWorkflowTraffic}.
+         * @param error Error, if any
+         * @param [response] SwitchWorkflowTrafficResponse
+         */
+        type SwitchWorkflowTrafficCallback = (error: (Error|null), response?: vtadmin.SwitchWorkflowTrafficResponse) => void;
+
         /**
          * Callback as used by {@link vtadmin.VTAdmin#vTExplain}.
          * @param error Error, if any
@@ -698,6 +929,156 @@ export namespace vtadmin {
         type VTExplainCallback = (error: (Error|null), response?: vtadmin.VTExplainResponse) => void;
     }
 
+    /** Properties of an AuditEntry. */
+    interface IAuditEntry {
+
+        /** AuditEntry time */
+        time?: (vttime.ITime|null);
+
+        /** AuditEntry actor */
+        actor?: (string|null);
+
+        /** AuditEntry actor_roles */
+        actor_roles?: (string[]|null);
+
+        /** AuditEntry cluster_id */
+        cluster_id?: (string|null);
+
+        /** AuditEntry method */
+        method?: (string|null);
+
+        /** AuditEntry resource */
+        resource?: (string|null);
+
+        /** AuditEntry action */
+        action?: (string|null);
+
+        /** AuditEntry request */
+        request?: (string|null);
+
+        /** AuditEntry response */
+        response?: (string|null);
+
+        /** AuditEntry error */
+        error?: (string|null);
+
+        /** AuditEntry duration */
+        duration?: (vttime.IDuration|null);
+    }
+
+    /** Represents an AuditEntry. */
+    class AuditEntry implements IAuditEntry {
+
+        /**
+         * Constructs a new AuditEntry.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.IAuditEntry);
+
+        /** AuditEntry time. */
+        public time?: (vttime.ITime|null);
+
+        /** AuditEntry actor. */
+        public actor: string;
+
+        /** AuditEntry actor_roles. */
+        public actor_roles: string[];
+
+        /** AuditEntry cluster_id. */
+        public cluster_id: string;
+
+        /** AuditEntry method. */
+        public method: string;
+
+        /** AuditEntry resource. */
+        public resource: string;
+
+        /** AuditEntry action. */
+        public action: string;
+
+        /** AuditEntry request. */
+        public request: string;
+
+        /** AuditEntry response. */
+        public response: string;
+
+        /** AuditEntry error. */
+        public error: string;
+
+        /** AuditEntry duration. */
+        public duration?: (vttime.IDuration|null);
+
+        /**
+         * Creates a new AuditEntry instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns AuditEntry instance
+         */
+        public static create(properties?: vtadmin.IAuditEntry): vtadmin.AuditEntry;
+
+        /**
+         * Encodes the specified AuditEntry message. Does not implicitly {@link vtadmin.AuditEntry.verify|verify} messages.
+         * @param message AuditEntry message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.IAuditEntry, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified AuditEntry message, length delimited. Does not implicitly {@link vtadmin.AuditEntry.verify|verify} messages.
+         * @param message AuditEntry message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.IAuditEntry, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes an AuditEntry message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns AuditEntry
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.AuditEntry;
+
+        /**
+         * Decodes an AuditEntry message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns AuditEntry
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.AuditEntry;
+
+        /**
+         * Verifies an AuditEntry message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates an AuditEntry message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns AuditEntry
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.AuditEntry;
+
+        /**
+         * Creates a plain object from an AuditEntry message. Also converts values to other types if specified.
+         * @param message AuditEntry
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.AuditEntry, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this AuditEntry to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a Cluster. */
     interface ICluster {
 
@@ -1505,6 +1886,240 @@ export namespace vtadmin {
         }
     }
 
+    /** Properties of a SchemaMigration. */
+    interface ISchemaMigration {
+
+        /** SchemaMigration cluster */
+        cluster?: (vtadmin.ICluster|null);
+
+        /** SchemaMigration keyspace */
+        keyspace?: (string|null);
+
+        /** SchemaMigration shard */
+        shard?: (string|null);
+
+        /** SchemaMigration tablet */
+        tablet?: (topodata.ITabletAlias|null);
+
+        /** SchemaMigration uuid */
+        uuid?: (string|null);
+
+        /** SchemaMigration table */
+        table?: (string|null);
+
+        /** SchemaMigration statement */
+        statement?: (string|null);
+
+        /** SchemaMigration strategy */
+        strategy?: (string|null);
+
+        /** SchemaMigration options */
+        options?: (string|null);
+
+        /** SchemaMigration ddl_action */
+        ddl_action?: (string|null);
+
+        /** SchemaMigration migration_context */
+        migration_context?: (string|null);
+
+        /** SchemaMigration status */
+        status?: (string|null);
+
+        /** SchemaMigration message */
+        message?: (string|null);
+
+        /** SchemaMigration progress */
+        progress?: (number|null);
+
+        /** SchemaMigration eta_seconds */
+        eta_seconds?: (number|Long|null);
+
+        /** SchemaMigration rows_copied */
+        rows_copied?: (number|Long|null);
+
+        /** SchemaMigration table_rows */
+        table_rows?: (number|Long|null);
+
+        /** SchemaMigration retries */
+        retries?: (number|Long|null);
+
+        /** SchemaMigration postpone_completion */
+        postpone_completion?: (boolean|null);
+
+        /** SchemaMigration ready_to_complete */
+        ready_to_complete?: (boolean|null);
+
+        /** SchemaMigration reverted_uuid */
+        reverted_uuid?: (string|null);
+
+        /** SchemaMigration added_at */
+        added_at?: (vttime.ITime|null);
+
+        /** SchemaMigration requested_at */
+        requested_at?: (vttime.ITime|null);
+
+        /** SchemaMigration started_at */
+        started_at?: (vttime.ITime|null);
+
+        /** SchemaMigration completed_at */
+        completed_at?: (vttime.ITime|null);
+    }
+
+    /** Represents a SchemaMigration. */
+    class SchemaMigration implements ISchemaMigration {
+
+        /**
+         * Constructs a new SchemaMigration.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ISchemaMigration);
+
+        /** SchemaMigration cluster. */
+        public cluster?: (vtadmin.ICluster|null);
+
+        /** SchemaMigration keyspace. */
+        public keyspace: string;
+
+        /** SchemaMigration shard. */
+        public shard: string;
+
+        /** SchemaMigration tablet. */
+        public tablet?: (topodata.ITabletAlias|null);
+
+        /** SchemaMigration uuid. */
+        public uuid: string;
+
+        /** SchemaMigration table. */
+        public table: string;
+
+        /** SchemaMigration statement. */
+        public statement: string;
+
+        /** SchemaMigration strategy. */
+        public strategy: string;
+
+        /** SchemaMigration options. */
+        public options: string;
+
+        /** SchemaMigration ddl_action. */
+        public ddl_action: string;
+
+        /** SchemaMigration migration_context. */
+        public migration_context: string;
+
+        /** SchemaMigration status. */
+        public status: string;
+
+        /** SchemaMigration message. */
+        public message: string;
+
+        /** SchemaMigration progress. */
+        public progress: number;
+
+        /** SchemaMigration eta_seconds. */
+        public eta_seconds: (number|Long);
+
+        /** SchemaMigration rows_copied. */
+        public rows_copied: (number|Long);
+
+        /** SchemaMigration table_rows. */
+        public table_rows: (number|Long);
+
+        /** SchemaMigration retries. */
+        public retries: (number|Long);
+
+        /** SchemaMigration postpone_completion. */
+        public postpone_completion: boolean;
+
+        /** SchemaMigration ready_to_complete. */
+        public ready_to_complete: boolean;
+
+        /** SchemaMigration reverted_uuid. */
+        public reverted_uuid: string;
+
+        /** SchemaMigration added_at. */
+        public added_at?: (vttime.ITime|null);
+
+        /** SchemaMigration requested_at. */
+        public requested_at?: (vttime.ITime|null);
+
+        /** SchemaMigration started_at. */
+        public started_at?: (vttime.ITime|null);
+
+        /** SchemaMigration completed_at. */
+        public completed_at?: (vttime.ITime|null);
+
+        /**
+         * Creates a new SchemaMigration instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns SchemaMigration instance
+         */
+        public static create(properties?: vtadmin.ISchemaMigration): vtadmin.SchemaMigration;
+
+        /**
+         * Encodes the specified SchemaMigration message. Does not implicitly {@link vtadmin.SchemaMigration.verify|verify} messages.
+         * @param message SchemaMigration message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ISchemaMigration, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified SchemaMigration message, length delimited. Does not implicitly {@link vtadmin.SchemaMigration.verify|verify} messages.
+         * @param message SchemaMigration message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ISchemaMigration, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a SchemaMigration message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns SchemaMigration
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.SchemaMigration;
+
+        /**
+         * Decodes a SchemaMigration message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns SchemaMigration
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.SchemaMigration;
+
+        /**
+         * Verifies a SchemaMigration message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a SchemaMigration message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns SchemaMigration
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.SchemaMigration;
+
+        /**
+         * Creates a plain object from a SchemaMigration message. Also converts values to other types if specified.
+         * @param message SchemaMigration
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.SchemaMigration, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this SchemaMigration to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a SrvVSchema. */
     interface ISrvVSchema {
 
@@ -2151,6 +2766,894 @@ export namespace vtadmin {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of an ApplySchemaRequest. */
+    interface IApplySchemaRequest {
+
+        /** ApplySchemaRequest cluster_id */
+        cluster_id?: (string|null);
+
+        /** ApplySchemaRequest options */
+        options?: (vtctldata.IApplySchemaRequest|null);
+    }
+
+    /** Represents an ApplySchemaRequest. */
+    class ApplySchemaRequest implements IApplySchemaRequest {
+
+        /**
+         * Constructs a new ApplySchemaRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.IApplySchemaRequest);
+
+        /** ApplySchemaRequest cluster_id. */
+        public cluster_id: string;
+
+        /** ApplySchemaRequest options. */
+        public options?: (vtctldata.IApplySchemaRequest|null);
+
+        /**
+         * Creates a new ApplySchemaRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns ApplySchemaRequest instance
+         */
+        public static create(properties?: vtadmin.IApplySchemaRequest): vtadmin.ApplySchemaRequest;
+
+        /**
+         * Encodes the specified ApplySchemaRequest message. Does not implicitly {@link vtadmin.ApplySchemaRequest.verify|verify} messages.
+         * @param message ApplySchemaRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.IApplySchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified ApplySchemaRequest message, length delimited. Does not implicitly {@link vtadmin.ApplySchemaRequest.verify|verify} messages.
+         * @param message ApplySchemaRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.IApplySchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes an ApplySchemaRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns ApplySchemaRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.ApplySchemaRequest;
+
+        /**
+         * Decodes an ApplySchemaRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns ApplySchemaRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.ApplySchemaRequest;
+
+        /**
+         * Verifies an ApplySchemaRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates an ApplySchemaRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns ApplySchemaRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.ApplySchemaRequest;
+
+        /**
+         * Creates a plain object from an ApplySchemaRequest message. Also converts values to other types if specified.
+         * @param message ApplySchemaRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.ApplySchemaRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this ApplySchemaRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a CancelSchemaMigrationRequest. */
+    interface ICancelSchemaMigrationRequest {
+
+        /** CancelSchemaMigrationRequest cluster_id */
+        cluster_id?: (string|null);
+
+        /** CancelSchemaMigrationRequest keyspace */
+        keyspace?: (string|null);
+
+        /** CancelSchemaMigrationRequest uuid */
+        uuid?: (string|null);
+    }
+
+    /** Represents a CancelSchemaMigrationRequest. */
+    class CancelSchemaMigrationRequest implements ICancelSchemaMigrationRequest {
+
+        /**
+         * Constructs a new CancelSchemaMigrationRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ICancelSchemaMigrationRequest);
+
+        /** CancelSchemaMigrationRequest cluster_id. */
+        public cluster_id: string;
+
+        /** CancelSchemaMigrationRequest keyspace. */
+        public keyspace: string;
+
+        /** CancelSchemaMigrationRequest uuid. */
+        public uuid: string;
+
+        /**
+         * Creates a new CancelSchemaMigrationRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns CancelSchemaMigrationRequest instance
+         */
+        public static create(properties?: vtadmin.ICancelSchemaMigrationRequest): vtadmin.CancelSchemaMigrationRequest;
+
+        /**
+         * Encodes the specified CancelSchemaMigrationRequest message. Does not implicitly {@link vtadmin.CancelSchemaMigrationRequest.verify|verify} messages.
+         * @param message CancelSchemaMigrationRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ICancelSchemaMigrationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified CancelSchemaMigrationRequest message, length delimited. Does not implicitly {@link vtadmin.CancelSchemaMigrationRequest.verify|verify} messages.
+         * @param message CancelSchemaMigrationRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ICancelSchemaMigrationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a CancelSchemaMigrationRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns CancelSchemaMigrationRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.CancelSchemaMigrationRequest;
+
+        /**
+         * Decodes a CancelSchemaMigrationRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns CancelSchemaMigrationRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.CancelSchemaMigrationRequest;
+
+        /**
+         * Verifies a CancelSchemaMigrationRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a CancelSchemaMigrationRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns CancelSchemaMigrationRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.CancelSchemaMigrationRequest;
+
+        /**
+         * Creates a plain object from a CancelSchemaMigrationRequest message. Also converts values to other types if specified.
+         * @param message CancelSchemaMigrationRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.CancelSchemaMigrationRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this CancelSchemaMigrationRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a CancelSchemaMigrationResponse. */
+    interface ICancelSchemaMigrationResponse {
+    }
+
+    /** Represents a CancelSchemaMigrationResponse. */
+    class CancelSchemaMigrationResponse implements ICancelSchemaMigrationResponse {
+
+        /**
+         * Constructs a new CancelSchemaMigrationResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ICancelSchemaMigrationResponse);
+
+        /**
+         * Creates a new CancelSchemaMigrationResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns CancelSchemaMigrationResponse instance
+         */
+        public static create(properties?: vtadmin.ICancelSchemaMigrationResponse): vtadmin.CancelSchemaMigrationResponse;
+
+        /**
+         * Encodes the specified CancelSchemaMigrationResponse message. Does not implicitly {@link vtadmin.CancelSchemaMigrationResponse.verify|verify} messages.
+         * @param message CancelSchemaMigrationResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ICancelSchemaMigrationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified CancelSchemaMigrationResponse message, length delimited. Does not implicitly {@link vtadmin.CancelSchemaMigrationResponse.verify|verify} messages.
+         * @param message CancelSchemaMigrationResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ICancelSchemaMigrationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a CancelSchemaMigrationResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns CancelSchemaMigrationResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.CancelSchemaMigrationResponse;
+
+        /**
+         * Decodes a CancelSchemaMigrationResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns CancelSchemaMigrationResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.CancelSchemaMigrationResponse;
+
+        /**
+         * Verifies a CancelSchemaMigrationResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a CancelSchemaMigrationResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns CancelSchemaMigrationResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.CancelSchemaMigrationResponse;
+
+        /**
+         * Creates a plain object from a CancelSchemaMigrationResponse message. Also converts values to other types if specified.
+         * @param message CancelSchemaMigrationResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.CancelSchemaMigrationResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this CancelSchemaMigrationResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a CancelWorkflowRequest. */
+    interface ICancelWorkflowRequest {
+
+        /** CancelWorkflowRequest cluster_id */
+        cluster_id?: (string|null);
+
+        /** CancelWorkflowRequest keyspace */
+        keyspace?: (string|null);
+
+        /** CancelWorkflowRequest name */
+        name?: (string|null);
+
+        /** CancelWorkflowRequest keep_data */
+        keep_data?: (boolean|null);
+
+        /** CancelWorkflowRequest keep_routing_rules */
+        keep_routing_rules?: (boolean|null);
+    }
+
+    /** Represents a CancelWorkflowRequest. */
+    class CancelWorkflowRequest implements ICancelWorkflowRequest {
+
+        /**
+         * Constructs a new CancelWorkflowRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ICancelWorkflowRequest);
+
+        /** CancelWorkflowRequest cluster_id. */
+        public cluster_id: string;
+
+        /** CancelWorkflowRequest keyspace. */
+        public keyspace: string;
+
+        /** CancelWorkflowRequest name. */
+        public name: string;
+
+        /** CancelWorkflowRequest keep_data. */
+        public keep_data: boolean;
+
+        /** CancelWorkflowRequest keep_routing_rules. */
+        public keep_routing_rules: boolean;
+
+        /**
+         * Creates a new CancelWorkflowRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns CancelWorkflowRequest instance
+         */
+        public static create(properties?: vtadmin.ICancelWorkflowRequest): vtadmin.CancelWorkflowRequest;
+
+        /**
+         * Encodes the specified CancelWorkflowRequest message. Does not implicitly {@link vtadmin.CancelWorkflowRequest.verify|verify} messages.
+         * @param message CancelWorkflowRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ICancelWorkflowRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified CancelWorkflowRequest message, length delimited. Does not implicitly {@link vtadmin.CancelWorkflowRequest.verify|verify} messages.
+         * @param message CancelWorkflowRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ICancelWorkflowRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a CancelWorkflowRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns CancelWorkflowRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.CancelWorkflowRequest;
+
+        /**
+         * Decodes a CancelWorkflowRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns CancelWorkflowRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.CancelWorkflowRequest;
+
+        /**
+         * Verifies a CancelWorkflowRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a CancelWorkflowRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns CancelWorkflowRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.CancelWorkflowRequest;
+
+        /**
+         * Creates a plain object from a CancelWorkflowRequest message. Also converts values to other types if specified.
+         * @param message CancelWorkflowRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.CancelWorkflowRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this CancelWorkflowRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a CancelWorkflowResponse. */
+    interface ICancelWorkflowResponse {
+
+        /** CancelWorkflowResponse output */
+        output?: (string[]|null);
+    }
+
+    /** Represents a CancelWorkflowResponse. */
+    class CancelWorkflowResponse implements ICancelWorkflowResponse {
+
+        /**
+         * Constructs a new CancelWorkflowResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ICancelWorkflowResponse);
+
+        /** CancelWorkflowResponse output. */
+        public output: string[];
+
+        /**
+         * Creates a new CancelWorkflowResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns CancelWorkflowResponse instance
+         */
+        public static create(properties?: vtadmin.ICancelWorkflowResponse): vtadmin.CancelWorkflowResponse;
+
+        /**
+         * Encodes the specified CancelWorkflowResponse message. Does not implicitly {@link vtadmin.CancelWorkflowResponse.verify|verify} messages.
+         * @param message CancelWorkflowResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ICancelWorkflowResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified CancelWorkflowResponse message, length delimited. Does not implicitly {@link vtadmin.CancelWorkflowResponse.verify|verify} messages.
+         * @param message CancelWorkflowResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ICancelWorkflowResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a CancelWorkflowResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns CancelWorkflowResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.CancelWorkflowResponse;
+
+        /**
+         * Decodes a CancelWorkflowResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns CancelWorkflowResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.CancelWorkflowResponse;
+
+        /**
+         * Verifies a CancelWorkflowResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a CancelWorkflowResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns CancelWorkflowResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.CancelWorkflowResponse;
+
+        /**
+         * Creates a plain object from a CancelWorkflowResponse message. Also converts values to other types if specified.
+         * @param message CancelWorkflowResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.CancelWorkflowResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this CancelWorkflowResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a CompleteSchemaMigrationRequest. */
+    interface ICompleteSchemaMigrationRequest {
+
+        /** CompleteSchemaMigrationRequest cluster_id */
+        cluster_id?: (string|null);
+
+        /** CompleteSchemaMigrationRequest keyspace */
+        keyspace?: (string|null);
+
+        /** CompleteSchemaMigrationRequest uuid */
+        uuid?: (string|null);
+    }
+
+    /** Represents a CompleteSchemaMigrationRequest. */
+    class CompleteSchemaMigrationRequest implements ICompleteSchemaMigrationRequest {
+
+        /**
+         * Constructs a new CompleteSchemaMigrationRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ICompleteSchemaMigrationRequest);
+
+        /** CompleteSchemaMigrationRequest cluster_id. */
+        public cluster_id: string;
+
+        /** CompleteSchemaMigrationRequest keyspace. */
+        public keyspace: string;
+
+        /** CompleteSchemaMigrationRequest uuid. */
+        public uuid: string;
+
+        /**
+         * Creates a new CompleteSchemaMigrationRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns CompleteSchemaMigrationRequest instance
+         */
+        public static create(properties?: vtadmin.ICompleteSchemaMigrationRequest): vtadmin.CompleteSchemaMigrationRequest;
+
+        /**
+         * Encodes the specified CompleteSchemaMigrationRequest message. Does not implicitly {@link vtadmin.CompleteSchemaMigrationRequest.verify|verify} messages.
+         * @param message CompleteSchemaMigrationRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ICompleteSchemaMigrationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified CompleteSchemaMigrationRequest message, length delimited. Does not implicitly {@link vtadmin.CompleteSchemaMigrationRequest.verify|verify} messages.
+         * @param message CompleteSchemaMigrationRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ICompleteSchemaMigrationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a CompleteSchemaMigrationRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns CompleteSchemaMigrationRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.CompleteSchemaMigrationRequest;
+
+        /**
+         * Decodes a CompleteSchemaMigrationRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns CompleteSchemaMigrationRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.CompleteSchemaMigrationRequest;
+
+        /**
+         * Verifies a CompleteSchemaMigrationRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a CompleteSchemaMigrationRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns CompleteSchemaMigrationRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.CompleteSchemaMigrationRequest;
+
+        /**
+         * Creates a plain object from a CompleteSchemaMigrationRequest message. Also converts values to other types if specified.
+         * @param message CompleteSchemaMigrationRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.CompleteSchemaMigrationRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this CompleteSchemaMigrationRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a CompleteSchemaMigrationResponse. */
+    interface ICompleteSchemaMigrationResponse {
+    }
+
+    /** Represents a CompleteSchemaMigrationResponse. */
+    class CompleteSchemaMigrationResponse implements ICompleteSchemaMigrationResponse {
+
+        /**
+         * Constructs a new CompleteSchemaMigrationResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ICompleteSchemaMigrationResponse);
+
+        /**
+         * Creates a new CompleteSchemaMigrationResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns CompleteSchemaMigrationResponse instance
+         */
+        public static create(properties?: vtadmin.ICompleteSchemaMigrationResponse): vtadmin.CompleteSchemaMigrationResponse;
+
+        /**
+         * Encodes the specified CompleteSchemaMigrationResponse message. Does not implicitly {@link vtadmin.CompleteSchemaMigrationResponse.verify|verify} messages.
+         * @param message CompleteSchemaMigrationResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ICompleteSchemaMigrationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified CompleteSchemaMigrationResponse message, length delimited. Does not implicitly {@link vtadmin.CompleteSchemaMigrationResponse.verify|verify} messages.
+         * @param message CompleteSchemaMigrationResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ICompleteSchemaMigrationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a CompleteSchemaMigrationResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns CompleteSchemaMigrationResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.CompleteSchemaMigrationResponse;
+
+        /**
+         * Decodes a CompleteSchemaMigrationResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns CompleteSchemaMigrationResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.CompleteSchemaMigrationResponse;
+
+        /**
+         * Verifies a CompleteSchemaMigrationResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a CompleteSchemaMigrationResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns CompleteSchemaMigrationResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.CompleteSchemaMigrationResponse;
+
+        /**
+         * Creates a plain object from a CompleteSchemaMigrationResponse message. Also converts values to other types if specified.
+         * @param message CompleteSchemaMigrationResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.CompleteSchemaMigrationResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this CompleteSchemaMigrationResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a CompleteWorkflowRequest. */
+    interface ICompleteWorkflowRequest {
+
+        /** CompleteWorkflowRequest cluster_id */
+        cluster_id?: (string|null);
+
+        /** CompleteWorkflowRequest keyspace */
+        keyspace?: (string|null);
+
+        /** CompleteWorkflowRequest name */
+        name?: (string|null);
+
+        /** CompleteWorkflowRequest keep_data */
+        keep_data?: (boolean|null);
+
+        /** CompleteWorkflowRequest keep_routing_rules */
+        keep_routing_rules?: (boolean|null);
+
+        /** CompleteWorkflowRequest rename_tables */
+        rename_tables?: (boolean|null);
+
+        /** CompleteWorkflowRequest dry_run */
+        dry_run?: (boolean|null);
+    }
+
+    /** Represents a CompleteWorkflowRequest. */
+    class CompleteWorkflowRequest implements ICompleteWorkflowRequest {
+
+        /**
+         * Constructs a new CompleteWorkflowRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ICompleteWorkflowRequest);
+
+        /** CompleteWorkflowRequest cluster_id. */
+        public cluster_id: string;
+
+        /** CompleteWorkflowRequest keyspace. */
+        public keyspace: string;
+
+        /** CompleteWorkflowRequest name. */
+        public name: string;
+
+        /** CompleteWorkflowRequest keep_data. */
+        public keep_data: boolean;
+
+        /** CompleteWorkflowRequest keep_routing_rules. */
+        public keep_routing_rules: boolean;
+
+        /** CompleteWorkflowRequest rename_tables. */
+        public rename_tables: boolean;
+
+        /** CompleteWorkflowRequest dry_run. */
+        public dry_run: boolean;
+
+        /**
+         * Creates a new CompleteWorkflowRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns CompleteWorkflowRequest instance
+         */
+        public static create(properties?: vtadmin.ICompleteWorkflowRequest): vtadmin.CompleteWorkflowRequest;
+
+        /**
+         * Encodes the specified CompleteWorkflowRequest message. Does not implicitly {@link vtadmin.CompleteWorkflowRequest.verify|verify} messages.
+         * @param message CompleteWorkflowRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ICompleteWorkflowRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified CompleteWorkflowRequest message, length delimited. Does not implicitly {@link vtadmin.CompleteWorkflowRequest.verify|verify} messages.
+         * @param message CompleteWorkflowRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ICompleteWorkflowRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a CompleteWorkflowRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns CompleteWorkflowRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.CompleteWorkflowRequest;
+
+        /**
+         * Decodes a CompleteWorkflowRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns CompleteWorkflowRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.CompleteWorkflowRequest;
+
+        /**
+         * Verifies a CompleteWorkflowRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a CompleteWorkflowRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns CompleteWorkflowRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.CompleteWorkflowRequest;
+
+        /**
+         * Creates a plain object from a CompleteWorkflowRequest message. Also converts values to other types if specified.
+         * @param message CompleteWorkflowRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.CompleteWorkflowRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this CompleteWorkflowRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a CompleteWorkflowResponse. */
+    interface ICompleteWorkflowResponse {
+
+        /** CompleteWorkflowResponse output */
+        output?: (string[]|null);
+    }
+
+    /** Represents a CompleteWorkflowResponse. */
+    class CompleteWorkflowResponse implements ICompleteWorkflowResponse {
+
+        /**
+         * Constructs a new CompleteWorkflowResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ICompleteWorkflowResponse);
+
+        /** CompleteWorkflowResponse output. */
+        public output: string[];
+
+        /**
+         * Creates a new CompleteWorkflowResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns CompleteWorkflowResponse instance
+         */
+        public static create(properties?: vtadmin.ICompleteWorkflowResponse): vtadmin.CompleteWorkflowResponse;
+
+        /**
+         * Encodes the specified CompleteWorkflowResponse message. Does not implicitly {@link vtadmin.CompleteWorkflowResponse.verify|verify} messages.
+         * @param message CompleteWorkflowResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ICompleteWorkflowResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified CompleteWorkflowResponse message, length delimited. Does not implicitly {@link vtadmin.CompleteWorkflowResponse.verify|verify} messages.
+         * @param message CompleteWorkflowResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ICompleteWorkflowResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a CompleteWorkflowResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns CompleteWorkflowResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.CompleteWorkflowResponse;
+
+        /**
+         * Decodes a CompleteWorkflowResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns CompleteWorkflowResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.CompleteWorkflowResponse;
+
+        /**
+         * Verifies a CompleteWorkflowResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a CompleteWorkflowResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns CompleteWorkflowResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.CompleteWorkflowResponse;
+
+        /**
+         * Creates a plain object from a CompleteWorkflowResponse message. Also converts values to other types if specified.
+         * @param message CompleteWorkflowResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.CompleteWorkflowResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this CompleteWorkflowResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a CreateKeyspaceRequest. */
     interface ICreateKeyspaceRequest {
 
@@ -2433,6 +3936,270 @@ export namespace vtadmin {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of a CreateWorkflowRequest. */
+    interface ICreateWorkflowRequest {
+
+        /** CreateWorkflowRequest cluster_id */
+        cluster_id?: (string|null);
+
+        /** CreateWorkflowRequest keyspace */
+        keyspace?: (string|null);
+
+        /** CreateWorkflowRequest name */
+        name?: (string|null);
+
+        /** CreateWorkflowRequest workflow_type */
+        workflow_type?: (string|null);
+
+        /** CreateWorkflowRequest source_keyspace */
+        source_keyspace?: (string|null);
+
+        /** CreateWorkflowRequest tables */
+        tables?: (string[]|null);
+
+        /** CreateWorkflowRequest all_tables */
+        all_tables?: (boolean|null);
+
+        /** CreateWorkflowRequest exclude_tables */
+        exclude_tables?: (string[]|null);
+
+        /** CreateWorkflowRequest source_shards */
+        source_shards?: (string[]|null);
+
+        /** CreateWorkflowRequest target_shards */
+        target_shards?: (string[]|null);
+
+        /** CreateWorkflowRequest skip_schema_copy */
+        skip_schema_copy?: (boolean|null);
+
+        /** CreateWorkflowRequest cells */
+        cells?: (string[]|null);
+
+        /** CreateWorkflowRequest tablet_types */
+        tablet_types?: (topodata.TabletType[]|null);
+
+        /** CreateWorkflowRequest skip_start */
+        skip_start?: (boolean|null);
+
+        /** CreateWorkflowRequest stop_after_copy */
+        stop_after_copy?: (boolean|null);
+    }
+
+    /** Represents a CreateWorkflowRequest. */
+    class CreateWorkflowRequest implements ICreateWorkflowRequest {
+
+        /**
+         * Constructs a new CreateWorkflowRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ICreateWorkflowRequest);
+
+        /** CreateWorkflowRequest cluster_id. */
+        public cluster_id: string;
+
+        /** CreateWorkflowRequest keyspace. */
+        public keyspace: string;
+
+        /** CreateWorkflowRequest name. */
+        public name: string;
+
+        /** CreateWorkflowRequest workflow_type. */
+        public workflow_type: string;
+
+        /** CreateWorkflowRequest source_keyspace. */
+        public source_keyspace: string;
+
+        /** CreateWorkflowRequest tables. */
+        public tables: string[];
+
+        /** CreateWorkflowRequest all_tables. */
+        public all_tables: boolean;
+
+        /** CreateWorkflowRequest exclude_tables. */
+        public exclude_tables: string[];
+
+        /** CreateWorkflowRequest source_shards. */
+        public source_shards: string[];
+
+        /** CreateWorkflowRequest target_shards. */
+        public target_shards: string[];
+
+        /** CreateWorkflowRequest skip_schema_copy. */
+        public skip_schema_copy: boolean;
+
+        /** CreateWorkflowRequest cells. */
+        public cells: string[];
+
+        /** CreateWorkflowRequest tablet_types. */
+        public tablet_types: topodata.TabletType[];
+
+        /** CreateWorkflowRequest skip_start. */
+        public skip_start: boolean;
+
+        /** CreateWorkflowRequest stop_after_copy. */
+        public stop_after_copy: boolean;
+
+        /**
+         * Creates a new CreateWorkflowRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns CreateWorkflowRequest instance
+         */
+        public static create(properties?: vtadmin.ICreateWorkflowRequest): vtadmin.CreateWorkflowRequest;
+
+        /**
+         * Encodes the specified CreateWorkflowRequest message. Does not implicitly {@link vtadmin.CreateWorkflowRequest.verify|verify} messages.
+         * @param message CreateWorkflowRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ICreateWorkflowRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified CreateWorkflowRequest message, length delimited. Does not implicitly {@link vtadmin.CreateWorkflowRequest.verify|verify} messages.
+         * @param message CreateWorkflowRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ICreateWorkflowRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a CreateWorkflowRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns CreateWorkflowRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.CreateWorkflowRequest;
+
+        /**
+         * Decodes a CreateWorkflowRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns CreateWorkflowRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.CreateWorkflowRequest;
+
+        /**
+         * Verifies a CreateWorkflowRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a CreateWorkflowRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns CreateWorkflowRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.CreateWorkflowRequest;
+
+        /**
+         * Creates a plain object from a CreateWorkflowRequest message. Also converts values to other types if specified.
+         * @param message CreateWorkflowRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.CreateWorkflowRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this CreateWorkflowRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a CreateWorkflowResponse. */
+    interface ICreateWorkflowResponse {
+
+        /** CreateWorkflowResponse output */
+        output?: (string[]|null);
+    }
+
+    /** Represents a CreateWorkflowResponse. */
+    class CreateWorkflowResponse implements ICreateWorkflowResponse {
+
+        /**
+         * Constructs a new CreateWorkflowResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ICreateWorkflowResponse);
+
+        /** CreateWorkflowResponse output. */
+        public output: string[];
+
+        /**
+         * Creates a new CreateWorkflowResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns CreateWorkflowResponse instance
+         */
+        public static create(properties?: vtadmin.ICreateWorkflowResponse): vtadmin.CreateWorkflowResponse;
+
+        /**
+         * Encodes the specified CreateWorkflowResponse message. Does not implicitly {@link vtadmin.CreateWorkflowResponse.verify|verify} messages.
+         * @param message CreateWorkflowResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ICreateWorkflowResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified CreateWorkflowResponse message, length delimited. Does not implicitly {@link vtadmin.CreateWorkflowResponse.verify|verify} messages.
+         * @param message CreateWorkflowResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ICreateWorkflowResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a CreateWorkflowResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns CreateWorkflowResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.CreateWorkflowResponse;
+
+        /**
+         * Decodes a CreateWorkflowResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns CreateWorkflowResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.CreateWorkflowResponse;
+
+        /**
+         * Verifies a CreateWorkflowResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a CreateWorkflowResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns CreateWorkflowResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.CreateWorkflowResponse;
+
+        /**
+         * Creates a plain object from a CreateWorkflowResponse message. Also converts values to other types if specified.
+         * @param message CreateWorkflowResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.CreateWorkflowResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this CreateWorkflowResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a DeleteKeyspaceRequest. */
     interface IDeleteKeyspaceRequest {
 
@@ -2913,6 +4680,204 @@ export namespace vtadmin {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of a GetAuditEntriesRequest. */
+    interface IGetAuditEntriesRequest {
+
+        /** GetAuditEntriesRequest cluster_ids */
+        cluster_ids?: (string[]|null);
+
+        /** GetAuditEntriesRequest actor */
+        actor?: (string|null);
+
+        /** GetAuditEntriesRequest method */
+        method?: (string|null);
+
+        /** GetAuditEntriesRequest limit */
+        limit?: (number|null);
+    }
+
+    /** Represents a GetAuditEntriesRequest. */
+    class GetAuditEntriesRequest implements IGetAuditEntriesRequest {
+
+        /**
+         * Constructs a new GetAuditEntriesRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.IGetAuditEntriesRequest);
+
+        /** GetAuditEntriesRequest cluster_ids. */
+        public cluster_ids: string[];
+
+        /** GetAuditEntriesRequest actor. */
+        public actor: string;
+
+        /** GetAuditEntriesRequest method. */
+        public method: string;
+
+        /** GetAuditEntriesRequest limit. */
+        public limit: number;
+
+        /**
+         * Creates a new GetAuditEntriesRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetAuditEntriesRequest instance
+         */
+        public static create(properties?: vtadmin.IGetAuditEntriesRequest): vtadmin.GetAuditEntriesRequest;
+
+        /**
+         * Encodes the specified GetAuditEntriesRequest message. Does not implicitly {@link vtadmin.GetAuditEntriesRequest.verify|verify} messages.
+         * @param message GetAuditEntriesRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.IGetAuditEntriesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetAuditEntriesRequest message, length delimited. Does not implicitly {@link vtadmin.GetAuditEntriesRequest.verify|verify} messages.
+         * @param message GetAuditEntriesRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.IGetAuditEntriesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetAuditEntriesRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetAuditEntriesRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.GetAuditEntriesRequest;
+
+        /**
+         * Decodes a GetAuditEntriesRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetAuditEntriesRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.GetAuditEntriesRequest;
+
+        /**
+         * Verifies a GetAuditEntriesRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetAuditEntriesRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetAuditEntriesRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.GetAuditEntriesRequest;
+
+        /**
+         * Creates a plain object from a GetAuditEntriesRequest message. Also converts values to other types if specified.
+         * @param message GetAuditEntriesRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.GetAuditEntriesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetAuditEntriesRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a GetAuditEntriesResponse. */
+    interface IGetAuditEntriesResponse {
+
+        /** GetAuditEntriesResponse entries */
+        entries?: (vtadmin.IAuditEntry[]|null);
+    }
+
+    /** Represents a GetAuditEntriesResponse. */
+    class GetAuditEntriesResponse implements IGetAuditEntriesResponse {
+
+        /**
+         * Constructs a new GetAuditEntriesResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.IGetAuditEntriesResponse);
+
+        /** GetAuditEntriesResponse entries. */
+        public entries: vtadmin.IAuditEntry[];
+
+        /**
+         * Creates a new GetAuditEntriesResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetAuditEntriesResponse instance
+         */
+        public static create(properties?: vtadmin.IGetAuditEntriesResponse): vtadmin.GetAuditEntriesResponse;
+
+        /**
+         * Encodes the specified GetAuditEntriesResponse message. Does not implicitly {@link vtadmin.GetAuditEntriesResponse.verify|verify} messages.
+         * @param message GetAuditEntriesResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.IGetAuditEntriesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetAuditEntriesResponse message, length delimited. Does not implicitly {@link vtadmin.GetAuditEntriesResponse.verify|verify} messages.
+         * @param message GetAuditEntriesResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.IGetAuditEntriesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetAuditEntriesResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetAuditEntriesResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.GetAuditEntriesResponse;
+
+        /**
+         * Decodes a GetAuditEntriesResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetAuditEntriesResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.GetAuditEntriesResponse;
+
+        /**
+         * Verifies a GetAuditEntriesResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetAuditEntriesResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetAuditEntriesResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.GetAuditEntriesResponse;
+
+        /**
+         * Creates a plain object from a GetAuditEntriesResponse message. Also converts values to other types if specified.
+         * @param message GetAuditEntriesResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.GetAuditEntriesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetAuditEntriesResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a GetBackupsRequest. */
     interface IGetBackupsRequest {
 
@@ -3741,6 +5706,198 @@ export namespace vtadmin {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of a GetSchemaMigrationsRequest. */
+    interface IGetSchemaMigrationsRequest {
+
+        /** GetSchemaMigrationsRequest cluster_ids */
+        cluster_ids?: (string[]|null);
+
+        /** GetSchemaMigrationsRequest keyspaces */
+        keyspaces?: (string[]|null);
+
+        /** GetSchemaMigrationsRequest filter */
+        filter?: (string|null);
+    }
+
+    /** Represents a GetSchemaMigrationsRequest. */
+    class GetSchemaMigrationsRequest implements IGetSchemaMigrationsRequest {
+
+        /**
+         * Constructs a new GetSchemaMigrationsRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.IGetSchemaMigrationsRequest);
+
+        /** GetSchemaMigrationsRequest cluster_ids. */
+        public cluster_ids: string[];
+
+        /** GetSchemaMigrationsRequest keyspaces. */
+        public keyspaces: string[];
+
+        /** GetSchemaMigrationsRequest filter. */
+        public filter: string;
+
+        /**
+         * Creates a new GetSchemaMigrationsRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetSchemaMigrationsRequest instance
+         */
+        public static create(properties?: vtadmin.IGetSchemaMigrationsRequest): vtadmin.GetSchemaMigrationsRequest;
+
+        /**
+         * Encodes the specified GetSchemaMigrationsRequest message. Does not implicitly {@link vtadmin.GetSchemaMigrationsRequest.verify|verify} messages.
+         * @param message GetSchemaMigrationsRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.IGetSchemaMigrationsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetSchemaMigrationsRequest message, length delimited. Does not implicitly {@link vtadmin.GetSchemaMigrationsRequest.verify|verify} messages.
+         * @param message GetSchemaMigrationsRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.IGetSchemaMigrationsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetSchemaMigrationsRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetSchemaMigrationsRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.GetSchemaMigrationsRequest;
+
+        /**
+         * Decodes a GetSchemaMigrationsRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetSchemaMigrationsRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.GetSchemaMigrationsRequest;
+
+        /**
+         * Verifies a GetSchemaMigrationsRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetSchemaMigrationsRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetSchemaMigrationsRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.GetSchemaMigrationsRequest;
+
+        /**
+         * Creates a plain object from a GetSchemaMigrationsRequest message. Also converts values to other types if specified.
+         * @param message GetSchemaMigrationsRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.GetSchemaMigrationsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetSchemaMigrationsRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a GetSchemaMigrationsResponse. */
+    interface IGetSchemaMigrationsResponse {
+
+        /** GetSchemaMigrationsResponse schema_migrations */
+        schema_migrations?: (vtadmin.ISchemaMigration[]|null);
+    }
+
+    /** Represents a GetSchemaMigrationsResponse. */
+    class GetSchemaMigrationsResponse implements IGetSchemaMigrationsResponse {
+
+        /**
+         * Constructs a new GetSchemaMigrationsResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.IGetSchemaMigrationsResponse);
+
+        /** GetSchemaMigrationsResponse schema_migrations. */
+        public schema_migrations: vtadmin.ISchemaMigration[];
+
+        /**
+         * Creates a new GetSchemaMigrationsResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetSchemaMigrationsResponse instance
+         */
+        public static create(properties?: vtadmin.IGetSchemaMigrationsResponse): vtadmin.GetSchemaMigrationsResponse;
+
+        /**
+         * Encodes the specified GetSchemaMigrationsResponse message. Does not implicitly {@link vtadmin.GetSchemaMigrationsResponse.verify|verify} messages.
+         * @param message GetSchemaMigrationsResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.IGetSchemaMigrationsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetSchemaMigrationsResponse message, length delimited. Does not implicitly {@link vtadmin.GetSchemaMigrationsResponse.verify|verify} messages.
+         * @param message GetSchemaMigrationsResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.IGetSchemaMigrationsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetSchemaMigrationsResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetSchemaMigrationsResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.GetSchemaMigrationsResponse;
+
+        /**
+         * Decodes a GetSchemaMigrationsResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetSchemaMigrationsResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.GetSchemaMigrationsResponse;
+
+        /**
+         * Verifies a GetSchemaMigrationsResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetSchemaMigrationsResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetSchemaMigrationsResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.GetSchemaMigrationsResponse;
+
+        /**
+         * Creates a plain object from a GetSchemaMigrationsResponse message. Also converts values to other types if specified.
+         * @param message GetSchemaMigrationsResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.GetSchemaMigrationsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetSchemaMigrationsResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a GetSchemaRequest. */
     interface IGetSchemaRequest {
 
@@ -6213,6 +8370,384 @@ export namespace vtadmin {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of a RetrySchemaMigrationRequest. */
+    interface IRetrySchemaMigrationRequest {
+
+        /** RetrySchemaMigrationRequest cluster_id */
+        cluster_id?: (string|null);
+
+        /** RetrySchemaMigrationRequest keyspace */
+        keyspace?: (string|null);
+
+        /** RetrySchemaMigrationRequest uuid */
+        uuid?: (string|null);
+    }
+
+    /** Represents a RetrySchemaMigrationRequest. */
+    class RetrySchemaMigrationRequest implements IRetrySchemaMigrationRequest {
+
+        /**
+         * Constructs a new RetrySchemaMigrationRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.IRetrySchemaMigrationRequest);
+
+        /** RetrySchemaMigrationRequest cluster_id. */
+        public cluster_id: string;
+
+        /** RetrySchemaMigrationRequest keyspace. */
+        public keyspace: string;
+
+        /** RetrySchemaMigrationRequest uuid. */
+        public uuid: string;
+
+        /**
+         * Creates a new RetrySchemaMigrationRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns RetrySchemaMigrationRequest instance
+         */
+        public static create(properties?: vtadmin.IRetrySchemaMigrationRequest): vtadmin.RetrySchemaMigrationRequest;
+
+        /**
+         * Encodes the specified RetrySchemaMigrationRequest message. Does not implicitly {@link vtadmin.RetrySchemaMigrationRequest.verify|verify} messages.
+         * @param message RetrySchemaMigrationRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.IRetrySchemaMigrationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified RetrySchemaMigrationRequest message, length delimited. Does not implicitly {@link vtadmin.RetrySchemaMigrationRequest.verify|verify} messages.
+         * @param message RetrySchemaMigrationRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.IRetrySchemaMigrationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a RetrySchemaMigrationRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns RetrySchemaMigrationRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.RetrySchemaMigrationRequest;
+
+        /**
+         * Decodes a RetrySchemaMigrationRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns RetrySchemaMigrationRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.RetrySchemaMigrationRequest;
+
+        /**
+         * Verifies a RetrySchemaMigrationRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a RetrySchemaMigrationRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns RetrySchemaMigrationRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.RetrySchemaMigrationRequest;
+
+        /**
+         * Creates a plain object from a RetrySchemaMigrationRequest message. Also converts values to other types if specified.
+         * @param message RetrySchemaMigrationRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.RetrySchemaMigrationRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this RetrySchemaMigrationRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a RetrySchemaMigrationResponse. */
+    interface IRetrySchemaMigrationResponse {
+    }
+
+    /** Represents a RetrySchemaMigrationResponse. */
+    class RetrySchemaMigrationResponse implements IRetrySchemaMigrationResponse {
+
+        /**
+         * Constructs a new RetrySchemaMigrationResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.IRetrySchemaMigrationResponse);
+
+        /**
+         * Creates a new RetrySchemaMigrationResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns RetrySchemaMigrationResponse instance
+         */
+        public static create(properties?: vtadmin.IRetrySchemaMigrationResponse): vtadmin.RetrySchemaMigrationResponse;
+
+        /**
+         * Encodes the specified RetrySchemaMigrationResponse message. Does not implicitly {@link vtadmin.RetrySchemaMigrationResponse.verify|verify} messages.
+         * @param message RetrySchemaMigrationResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.IRetrySchemaMigrationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified RetrySchemaMigrationResponse message, length delimited. Does not implicitly {@link vtadmin.RetrySchemaMigrationResponse.verify|verify} messages.
+         * @param message RetrySchemaMigrationResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.IRetrySchemaMigrationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a RetrySchemaMigrationResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns RetrySchemaMigrationResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.RetrySchemaMigrationResponse;
+
+        /**
+         * Decodes a RetrySchemaMigrationResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns RetrySchemaMigrationResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.RetrySchemaMigrationResponse;
+
+        /**
+         * Verifies a RetrySchemaMigrationResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a RetrySchemaMigrationResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns RetrySchemaMigrationResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.RetrySchemaMigrationResponse;
+
+        /**
+         * Creates a plain object from a RetrySchemaMigrationResponse message. Also converts values to other types if specified.
+         * @param message RetrySchemaMigrationResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.RetrySchemaMigrationResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this RetrySchemaMigrationResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a RevertSchemaMigrationRequest. */
+    interface IRevertSchemaMigrationRequest {
+
+        /** RevertSchemaMigrationRequest cluster_id */
+        cluster_id?: (string|null);
+
+        /** RevertSchemaMigrationRequest keyspace */
+        keyspace?: (string|null);
+
+        /** RevertSchemaMigrationRequest uuid */
+        uuid?: (string|null);
+    }
+
+    /** Represents a RevertSchemaMigrationRequest. */
+    class RevertSchemaMigrationRequest implements IRevertSchemaMigrationRequest {
+
+        /**
+         * Constructs a new RevertSchemaMigrationRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.IRevertSchemaMigrationRequest);
+
+        /** RevertSchemaMigrationRequest cluster_id. */
+        public cluster_id: string;
+
+        /** RevertSchemaMigrationRequest keyspace. */
+        public keyspace: string;
+
+        /** RevertSchemaMigrationRequest uuid. */
+        public uuid: string;
+
+        /**
+         * Creates a new RevertSchemaMigrationRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns RevertSchemaMigrationRequest instance
+         */
+        public static create(properties?: vtadmin.IRevertSchemaMigrationRequest): vtadmin.RevertSchemaMigrationRequest;
+
+        /**
+         * Encodes the specified RevertSchemaMigrationRequest message. Does not implicitly {@link vtadmin.RevertSchemaMigrationRequest.verify|verify} messages.
+         * @param message RevertSchemaMigrationRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.IRevertSchemaMigrationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified RevertSchemaMigrationRequest message, length delimited. Does not implicitly {@link vtadmin.RevertSchemaMigrationRequest.verify|verify} messages.
+         * @param message RevertSchemaMigrationRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.IRevertSchemaMigrationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a RevertSchemaMigrationRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns RevertSchemaMigrationRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.RevertSchemaMigrationRequest;
+
+        /**
+         * Decodes a RevertSchemaMigrationRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns RevertSchemaMigrationRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.RevertSchemaMigrationRequest;
+
+        /**
+         * Verifies a RevertSchemaMigrationRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a RevertSchemaMigrationRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns RevertSchemaMigrationRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.RevertSchemaMigrationRequest;
+
+        /**
+         * Creates a plain object from a RevertSchemaMigrationRequest message. Also converts values to other types if specified.
+         * @param message RevertSchemaMigrationRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.RevertSchemaMigrationRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this RevertSchemaMigrationRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a RevertSchemaMigrationResponse. */
+    interface IRevertSchemaMigrationResponse {
+
+        /** RevertSchemaMigrationResponse uuid */
+        uuid?: (string|null);
+    }
+
+    /** Represents a RevertSchemaMigrationResponse. */
+    class RevertSchemaMigrationResponse implements IRevertSchemaMigrationResponse {
+
+        /**
+         * Constructs a new RevertSchemaMigrationResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.IRevertSchemaMigrationResponse);
+
+        /** RevertSchemaMigrationResponse uuid. */
+        public uuid: string;
+
+        /**
+         * Creates a new RevertSchemaMigrationResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns RevertSchemaMigrationResponse instance
+         */
+        public static create(properties?: vtadmin.IRevertSchemaMigrationResponse): vtadmin.RevertSchemaMigrationResponse;
+
+        /**
+         * Encodes the specified RevertSchemaMigrationResponse message. Does not implicitly {@link vtadmin.RevertSchemaMigrationResponse.verify|verify} messages.
+         * @param message RevertSchemaMigrationResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.IRevertSchemaMigrationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified RevertSchemaMigrationResponse message, length delimited. Does not implicitly {@link vtadmin.RevertSchemaMigrationResponse.verify|verify} messages.
+         * @param message RevertSchemaMigrationResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.IRevertSchemaMigrationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a RevertSchemaMigrationResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns RevertSchemaMigrationResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.RevertSchemaMigrationResponse;
+
+        /**
+         * Decodes a RevertSchemaMigrationResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns RevertSchemaMigrationResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.RevertSchemaMigrationResponse;
+
+        /**
+         * Verifies a RevertSchemaMigrationResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a RevertSchemaMigrationResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns RevertSchemaMigrationResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.RevertSchemaMigrationResponse;
+
+        /**
+         * Creates a plain object from a RevertSchemaMigrationResponse message. Also converts values to other types if specified.
+         * @param message RevertSchemaMigrationResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.RevertSchemaMigrationResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this RevertSchemaMigrationResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a RunHealthCheckRequest. */
     interface IRunHealthCheckRequest {
 
@@ -7131,6 +9666,240 @@ export namespace vtadmin {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of a SwitchWorkflowTrafficRequest. */
+    interface ISwitchWorkflowTrafficRequest {
+
+        /** SwitchWorkflowTrafficRequest cluster_id */
+        cluster_id?: (string|null);
+
+        /** SwitchWorkflowTrafficRequest keyspace */
+        keyspace?: (string|null);
+
+        /** SwitchWorkflowTrafficRequest name */
+        name?: (string|null);
+
+        /** SwitchWorkflowTrafficRequest tablet_types */
+        tablet_types?: (topodata.TabletType[]|null);
+
+        /** SwitchWorkflowTrafficRequest cells */
+        cells?: (string[]|null);
+
+        /** SwitchWorkflowTrafficRequest reverse */
+        reverse?: (boolean|null);
+
+        /** SwitchWorkflowTrafficRequest dry_run */
+        dry_run?: (boolean|null);
+
+        /** SwitchWorkflowTrafficRequest timeout */
+        timeout?: (vttime.IDuration|null);
+
+        /** SwitchWorkflowTrafficRequest max_replication_lag_allowed */
+        max_replication_lag_allowed?: (vttime.IDuration|null);
+
+        /** SwitchWorkflowTrafficRequest skip_reverse_replication */
+        skip_reverse_replication?: (boolean|null);
+    }
+
+    /** Represents a SwitchWorkflowTrafficRequest. */
+    class SwitchWorkflowTrafficRequest implements ISwitchWorkflowTrafficRequest {
+
+        /**
+         * Constructs a new SwitchWorkflowTrafficRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ISwitchWorkflowTrafficRequest);
+
+        /** SwitchWorkflowTrafficRequest cluster_id. */
+        public cluster_id: string;
+
+        /** SwitchWorkflowTrafficRequest keyspace. */
+        public keyspace: string;
+
+        /** SwitchWorkflowTrafficRequest name. */
+        public name: string;
+
+        /** SwitchWorkflowTrafficRequest tablet_types. */
+        public tablet_types: topodata.TabletType[];
+
+        /** SwitchWorkflowTrafficRequest cells. */
+        public cells: string[];
+
+        /** SwitchWorkflowTrafficRequest reverse. */
+        public reverse: boolean;
+
+        /** SwitchWorkflowTrafficRequest dry_run. */
+        public dry_run: boolean;
+
+        /** SwitchWorkflowTrafficRequest timeout. */
+        public timeout?: (vttime.IDuration|null);
+
+        /** SwitchWorkflowTrafficRequest max_replication_lag_allowed. */
+        public max_replication_lag_allowed?: (vttime.IDuration|null);
+
+        /** SwitchWorkflowTrafficRequest skip_reverse_replication. */
+        public skip_reverse_replication: boolean;
+
+        /**
+         * Creates a new SwitchWorkflowTrafficRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns SwitchWorkflowTrafficRequest instance
+         */
+        public static create(properties?: vtadmin.ISwitchWorkflowTrafficRequest): vtadmin.SwitchWorkflowTrafficRequest;
+
+        /**
+         * Encodes the specified SwitchWorkflowTrafficRequest message. Does not implicitly {@link vtadmin.SwitchWorkflowTrafficRequest.verify|verify} messages.
+         * @param message SwitchWorkflowTrafficRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ISwitchWorkflowTrafficRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified SwitchWorkflowTrafficRequest message, length delimited. Does not implicitly {@link vtadmin.SwitchWorkflowTrafficRequest.verify|verify} messages.
+         * @param message SwitchWorkflowTrafficRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ISwitchWorkflowTrafficRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a SwitchWorkflowTrafficRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns SwitchWorkflowTrafficRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.SwitchWorkflowTrafficRequest;
+
+        /**
+         * Decodes a SwitchWorkflowTrafficRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns SwitchWorkflowTrafficRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.SwitchWorkflowTrafficRequest;
+
+        /**
+         * Verifies a SwitchWorkflowTrafficRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a SwitchWorkflowTrafficRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns SwitchWorkflowTrafficRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.SwitchWorkflowTrafficRequest;
+
+        /**
+         * Creates a plain object from a SwitchWorkflowTrafficRequest message. Also converts values to other types if specified.
+         * @param message SwitchWorkflowTrafficRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.SwitchWorkflowTrafficRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this SwitchWorkflowTrafficRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a SwitchWorkflowTrafficResponse. */
+    interface ISwitchWorkflowTrafficResponse {
+
+        /** SwitchWorkflowTrafficResponse output */
+        output?: (string[]|null);
+    }
+
+    /** Represents a SwitchWorkflowTrafficResponse. */
+    class SwitchWorkflowTrafficResponse implements ISwitchWorkflowTrafficResponse {
+
+        /**
+         * Constructs a new SwitchWorkflowTrafficResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtadmin.ISwitchWorkflowTrafficResponse);
+
+        /** SwitchWorkflowTrafficResponse output. */
+        public output: string[];
+
+        /**
+         * Creates a new SwitchWorkflowTrafficResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns SwitchWorkflowTrafficResponse instance
+         */
+        public static create(properties?: vtadmin.ISwitchWorkflowTrafficResponse): vtadmin.SwitchWorkflowTrafficResponse;
+
+        /**
+         * Encodes the specified SwitchWorkflowTrafficResponse message. Does not implicitly {@link vtadmin.SwitchWorkflowTrafficResponse.verify|verify} messages.
+         * @param message SwitchWorkflowTrafficResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtadmin.ISwitchWorkflowTrafficResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified SwitchWorkflowTrafficResponse message, length delimited. Does not implicitly {@link vtadmin.SwitchWorkflowTrafficResponse.verify|verify} messages.
+         * @param message SwitchWorkflowTrafficResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtadmin.ISwitchWorkflowTrafficResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a SwitchWorkflowTrafficResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns SwitchWorkflowTrafficResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtadmin.SwitchWorkflowTrafficResponse;
+
+        /**
+         * Decodes a SwitchWorkflowTrafficResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns SwitchWorkflowTrafficResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtadmin.SwitchWorkflowTrafficResponse;
+
+        /**
+         * Verifies a SwitchWorkflowTrafficResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a SwitchWorkflowTrafficResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns SwitchWorkflowTrafficResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtadmin.SwitchWorkflowTrafficResponse;
+
+        /**
+         * Creates a plain object from a SwitchWorkflowTrafficResponse message. Also converts values to other types if specified.
+         * @param message SwitchWorkflowTrafficResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtadmin.SwitchWorkflowTrafficResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this SwitchWorkflowTrafficResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a VTExplainRequest. */
     interface IVTExplainRequest {
 
@@ -9209,6 +11978,9 @@ export namespace topodata {
 
         /** Keyspace snapshot_time */
         snapshot_time?: (vttime.ITime|null);
+
+        /** Keyspace durability_policy */
+        durability_policy?: (string|null);
     }
 
     /** Represents a Keyspace. */
@@ -9238,6 +12010,9 @@ export namespace topodata {
         /** Keyspace snapshot_time. */
         public snapshot_time?: (vttime.ITime|null);
 
+        /** Keyspace durability_policy. */
+        public durability_policy: string;
+
         /**
          * Creates a new Keyspace instance using the specified properties.
          * @param [properties] Properties to set
@@ -14882,6 +17657,528 @@ export namespace tabletmanagerdata {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of a LiveQuery. */
+    interface ILiveQuery {
+
+        /** LiveQuery type */
+        type?: (string|null);
+
+        /** LiveQuery query */
+        query?: (string|null);
+
+        /** LiveQuery caller */
+        caller?: (string|null);
+
+        /** LiveQuery table */
+        table?: (string|null);
+
+        /** LiveQuery fingerprint */
+        fingerprint?: (string|null);
+
+        /** LiveQuery connection_id */
+        connection_id?: (number|Long|null);
+
+        /** LiveQuery start_time */
+        start_time?: (vttime.ITime|null);
+
+        /** LiveQuery duration */
+        duration?: (vttime.IDuration|null);
+
+        /** LiveQuery rows_streamed */
+        rows_streamed?: (number|Long|null);
+    }
+
+    /** Represents a LiveQuery. */
+    class LiveQuery implements ILiveQuery {
+
+        /**
+         * Constructs a new LiveQuery.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.ILiveQuery);
+
+        /** LiveQuery type. */
+        public type: string;
+
+        /** LiveQuery query. */
+        public query: string;
+
+        /** LiveQuery caller. */
+        public caller: string;
+
+        /** LiveQuery table. */
+        public table: string;
+
+        /** LiveQuery fingerprint. */
+        public fingerprint: string;
+
+        /** LiveQuery connection_id. */
+        public connection_id: (number|Long);
+
+        /** LiveQuery start_time. */
+        public start_time?: (vttime.ITime|null);
+
+        /** LiveQuery duration. */
+        public duration?: (vttime.IDuration|null);
+
+        /** LiveQuery rows_streamed. */
+        public rows_streamed: (number|Long);
+
+        /**
+         * Creates a new LiveQuery instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns LiveQuery instance
+         */
+        public static create(properties?: tabletmanagerdata.ILiveQuery): tabletmanagerdata.LiveQuery;
+
+        /**
+         * Encodes the specified LiveQuery message. Does not implicitly {@link tabletmanagerdata.LiveQuery.verify|verify} messages.
+         * @param message LiveQuery message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.ILiveQuery, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified LiveQuery message, length delimited. Does not implicitly {@link tabletmanagerdata.LiveQuery.verify|verify} messages.
+         * @param message LiveQuery message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.ILiveQuery, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a LiveQuery message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns LiveQuery
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.LiveQuery;
+
+        /**
+         * Decodes a LiveQuery message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns LiveQuery
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.LiveQuery;
+
+        /**
+         * Verifies a LiveQuery message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a LiveQuery message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns LiveQuery
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.LiveQuery;
+
+        /**
+         * Creates a plain object from a LiveQuery message. Also converts values to other types if specified.
+         * @param message LiveQuery
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.LiveQuery, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this LiveQuery to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a ListQueriesRequest. */
+    interface IListQueriesRequest {
+
+        /** ListQueriesRequest caller */
+        caller?: (string|null);
+
+        /** ListQueriesRequest table */
+        table?: (string|null);
+
+        /** ListQueriesRequest fingerprint */
+        fingerprint?: (string|null);
+    }
+
+    /** Represents a ListQueriesRequest. */
+    class ListQueriesRequest implements IListQueriesRequest {
+
+        /**
+         * Constructs a new ListQueriesRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.IListQueriesRequest);
+
+        /** ListQueriesRequest caller. */
+        public caller: string;
+
+        /** ListQueriesRequest table. */
+        public table: string;
+
+        /** ListQueriesRequest fingerprint. */
+        public fingerprint: string;
+
+        /**
+         * Creates a new ListQueriesRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns ListQueriesRequest instance
+         */
+        public static create(properties?: tabletmanagerdata.IListQueriesRequest): tabletmanagerdata.ListQueriesRequest;
+
+        /**
+         * Encodes the specified ListQueriesRequest message. Does not implicitly {@link tabletmanagerdata.ListQueriesRequest.verify|verify} messages.
+         * @param message ListQueriesRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.IListQueriesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified ListQueriesRequest message, length delimited. Does not implicitly {@link tabletmanagerdata.ListQueriesRequest.verify|verify} messages.
+         * @param message ListQueriesRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.IListQueriesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a ListQueriesRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns ListQueriesRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.ListQueriesRequest;
+
+        /**
+         * Decodes a ListQueriesRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns ListQueriesRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.ListQueriesRequest;
+
+        /**
+         * Verifies a ListQueriesRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a ListQueriesRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns ListQueriesRequest
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.ListQueriesRequest;
+
+        /**
+         * Creates a plain object from a ListQueriesRequest message. Also converts values to other types if specified.
+         * @param message ListQueriesRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.ListQueriesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this ListQueriesRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a ListQueriesResponse. */
+    interface IListQueriesResponse {
+
+        /** ListQueriesResponse queries */
+        queries?: (tabletmanagerdata.ILiveQuery[]|null);
+    }
+
+    /** Represents a ListQueriesResponse. */
+    class ListQueriesResponse implements IListQueriesResponse {
+
+        /**
+         * Constructs a new ListQueriesResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.IListQueriesResponse);
+
+        /** ListQueriesResponse queries. */
+        public queries: tabletmanagerdata.ILiveQuery[];
+
+        /**
+         * Creates a new ListQueriesResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns ListQueriesResponse instance
+         */
+        public static create(properties?: tabletmanagerdata.IListQueriesResponse): tabletmanagerdata.ListQueriesResponse;
+
+        /**
+         * Encodes the specified ListQueriesResponse message. Does not implicitly {@link tabletmanagerdata.ListQueriesResponse.verify|verify} messages.
+         * @param message ListQueriesResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.IListQueriesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified ListQueriesResponse message, length delimited. Does not implicitly {@link tabletmanagerdata.ListQueriesResponse.verify|verify} messages.
+         * @param message ListQueriesResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.IListQueriesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a ListQueriesResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns ListQueriesResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.ListQueriesResponse;
+
+        /**
+         * Decodes a ListQueriesResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns ListQueriesResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.ListQueriesResponse;
+
+        /**
+         * Verifies a ListQueriesResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a ListQueriesResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns ListQueriesResponse
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.ListQueriesResponse;
+
+        /**
+         * Creates a plain object from a ListQueriesResponse message. Also converts values to other types if specified.
+         * @param message ListQueriesResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.ListQueriesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this ListQueriesResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a KillQueriesRequest. */
+    interface IKillQueriesRequest {
+
+        /** KillQueriesRequest caller */
+        caller?: (string|null);
+
+        /** KillQueriesRequest table */
+        table?: (string|null);
+
+        /** KillQueriesRequest fingerprint */
+        fingerprint?: (string|null);
+    }
+
+    /** Represents a KillQueriesRequest. */
+    class KillQueriesRequest implements IKillQueriesRequest {
+
+        /**
+         * Constructs a new KillQueriesRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.IKillQueriesRequest);
+
+        /** KillQueriesRequest caller. */
+        public caller: string;
+
+        /** KillQueriesRequest table. */
+        public table: string;
+
+        /** KillQueriesRequest fingerprint. */
+        public fingerprint: string;
+
+        /**
+         * Creates a new KillQueriesRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns KillQueriesRequest instance
+         */
+        public static create(properties?: tabletmanagerdata.IKillQueriesRequest): tabletmanagerdata.KillQueriesRequest;
+
+        /**
+         * Encodes the specified KillQueriesRequest message. Does not implicitly {@link tabletmanagerdata.KillQueriesRequest.verify|verify} messages.
+         * @param message KillQueriesRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.IKillQueriesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified KillQueriesRequest message, length delimited. Does not implicitly {@link tabletmanagerdata.KillQueriesRequest.verify|verify} messages.
+         * @param message KillQueriesRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.IKillQueriesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a KillQueriesRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns KillQueriesRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.KillQueriesRequest;
+
+        /**
+         * Decodes a KillQueriesRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns KillQueriesRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.KillQueriesRequest;
+
+        /**
+         * Verifies a KillQueriesRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a KillQueriesRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns KillQueriesRequest
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.KillQueriesRequest;
+
+        /**
+         * Creates a plain object from a KillQueriesRequest message. Also converts values to other types if specified.
+         * @param message KillQueriesRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.KillQueriesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this KillQueriesRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a KillQueriesResponse. */
+    interface IKillQueriesResponse {
+
+        /** KillQueriesResponse killed */
+        killed?: (number|Long|null);
+    }
+
+    /** Represents a KillQueriesResponse. */
+    class KillQueriesResponse implements IKillQueriesResponse {
+
+        /**
+         * Constructs a new KillQueriesResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.IKillQueriesResponse);
+
+        /** KillQueriesResponse killed. */
+        public killed: (number|Long);
+
+        /**
+         * Creates a new KillQueriesResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns KillQueriesResponse instance
+         */
+        public static create(properties?: tabletmanagerdata.IKillQueriesResponse): tabletmanagerdata.KillQueriesResponse;
+
+        /**
+         * Encodes the specified KillQueriesResponse message. Does not implicitly {@link tabletmanagerdata.KillQueriesResponse.verify|verify} messages.
+         * @param message KillQueriesResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.IKillQueriesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified KillQueriesResponse message, length delimited. Does not implicitly {@link tabletmanagerdata.KillQueriesResponse.verify|verify} messages.
+         * @param message KillQueriesResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.IKillQueriesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a KillQueriesResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns KillQueriesResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.KillQueriesResponse;
+
+        /**
+         * Decodes a KillQueriesResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns KillQueriesResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.KillQueriesResponse;
+
+        /**
+         * Verifies a KillQueriesResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a KillQueriesResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns KillQueriesResponse
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.KillQueriesResponse;
+
+        /**
+         * Creates a plain object from a KillQueriesResponse message. Also converts values to other types if specified.
+         * @param message KillQueriesResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.KillQueriesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this KillQueriesResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a ReplicationStatusRequest. */
     interface IReplicationStatusRequest {
     }
@@ -18820,6 +22117,9 @@ export namespace tabletmanagerdata {
 
         /** BackupRequest allow_primary */
         allow_primary?: (boolean|null);
+
+        /** BackupRequest incremental */
+        incremental?: (boolean|null);
     }
 
     /** Represents a BackupRequest. */
@@ -18837,6 +22137,9 @@ export namespace tabletmanagerdata {
         /** BackupRequest allow_primary. */
         public allow_primary: boolean;
 
+        /** BackupRequest incremental. */
+        public incremental: boolean;
+
         /**
          * Creates a new BackupRequest instance using the specified properties.
          * @param [properties] Properties to set
@@ -19003,6 +22306,12 @@ export namespace tabletmanagerdata {
 
         /** RestoreFromBackupRequest backup_time */
         backup_time?: (vttime.ITime|null);
+
+        /** RestoreFromBackupRequest restore_to_pos */
+        restore_to_pos?: (string|null);
+
+        /** RestoreFromBackupRequest restore_to_timestamp */
+        restore_to_timestamp?: (vttime.ITime|null);
     }
 
     /** Represents a RestoreFromBackupRequest. */
@@ -19017,6 +22326,12 @@ export namespace tabletmanagerdata {
         /** RestoreFromBackupRequest backup_time. */
         public backup_time?: (vttime.ITime|null);
 
+        /** RestoreFromBackupRequest restore_to_pos. */
+        public restore_to_pos: string;
+
+        /** RestoreFromBackupRequest restore_to_timestamp. */
+        public restore_to_timestamp?: (vttime.ITime|null);
+
         /**
          * Creates a new RestoreFromBackupRequest instance using the specified properties.
          * @param [properties] Properties to set
@@ -20073,6 +23388,9 @@ export namespace query {
 
         /** ExecuteOptions has_created_temp_tables */
         has_created_temp_tables?: (boolean|null);
+
+        /** ExecuteOptions priority */
+        priority?: (string|null);
     }
 
     /** Represents an ExecuteOptions. */
@@ -20108,6 +23426,9 @@ export namespace query {
         /** ExecuteOptions has_created_temp_tables. */
         public has_created_temp_tables: boolean;
 
+        /** ExecuteOptions priority. */
+        public priority: string;
+
         /**
          * Creates a new ExecuteOptions instance using the specified properties.
          * @param [properties] Properties to set
@@ -30791,6 +34112,9 @@ export namespace vtctldata {
 
         /** CreateKeyspaceRequest snapshot_time */
         snapshot_time?: (vttime.ITime|null);
+
+        /** CreateKeyspaceRequest durability_policy */
+        durability_policy?: (string|null);
     }
 
     /** Represents a CreateKeyspaceRequest. */
@@ -30829,6 +34153,9 @@ export namespace vtctldata {
         /** CreateKeyspaceRequest snapshot_time. */
         public snapshot_time?: (vttime.ITime|null);
 
+        /** CreateKeyspaceRequest durability_policy. */
+        public durability_policy: string;
+
         /**
          * Creates a new CreateKeyspaceRequest instance using the specified properties.
          * @param [properties] Properties to set
@@ -38583,6 +41910,222 @@ export namespace vtctldata {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of a RestoreFromBackupRequest. */
+    interface IRestoreFromBackupRequest {
+
+        /** RestoreFromBackupRequest tablet_alias */
+        tablet_alias?: (topodata.ITabletAlias|null);
+
+        /** RestoreFromBackupRequest backup_time */
+        backup_time?: (vttime.ITime|null);
+
+        /** RestoreFromBackupRequest restore_to_pos */
+        restore_to_pos?: (string|null);
+
+        /** RestoreFromBackupRequest restore_to_timestamp */
+        restore_to_timestamp?: (vttime.ITime|null);
+    }
+
+    /** Represents a RestoreFromBackupRequest. */
+    class RestoreFromBackupRequest implements IRestoreFromBackupRequest {
+
+        /**
+         * Constructs a new RestoreFromBackupRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IRestoreFromBackupRequest);
+
+        /** RestoreFromBackupRequest tablet_alias. */
+        public tablet_alias?: (topodata.ITabletAlias|null);
+
+        /** RestoreFromBackupRequest backup_time. */
+        public backup_time?: (vttime.ITime|null);
+
+        /** RestoreFromBackupRequest restore_to_pos. */
+        public restore_to_pos: string;
+
+        /** RestoreFromBackupRequest restore_to_timestamp. */
+        public restore_to_timestamp?: (vttime.ITime|null);
+
+        /**
+         * Creates a new RestoreFromBackupRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns RestoreFromBackupRequest instance
+         */
+        public static create(properties?: vtctldata.IRestoreFromBackupRequest): vtctldata.RestoreFromBackupRequest;
+
+        /**
+         * Encodes the specified RestoreFromBackupRequest message. Does not implicitly {@link vtctldata.RestoreFromBackupRequest.verify|verify} messages.
+         * @param message RestoreFromBackupRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IRestoreFromBackupRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified RestoreFromBackupRequest message, length delimited. Does not implicitly {@link vtctldata.RestoreFromBackupRequest.verify|verify} messages.
+         * @param message RestoreFromBackupRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IRestoreFromBackupRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a RestoreFromBackupRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns RestoreFromBackupRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.RestoreFromBackupRequest;
+
+        /**
+         * Decodes a RestoreFromBackupRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns RestoreFromBackupRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.RestoreFromBackupRequest;
+
+        /**
+         * Verifies a RestoreFromBackupRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a RestoreFromBackupRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns RestoreFromBackupRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.RestoreFromBackupRequest;
+
+        /**
+         * Creates a plain object from a RestoreFromBackupRequest message. Also converts values to other types if specified.
+         * @param message RestoreFromBackupRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.RestoreFromBackupRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this RestoreFromBackupRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a RestoreFromBackupResponse. */
+    interface IRestoreFromBackupResponse {
+
+        /** RestoreFromBackupResponse tablet_alias */
+        tablet_alias?: (topodata.ITabletAlias|null);
+
+        /** RestoreFromBackupResponse keyspace */
+        keyspace?: (string|null);
+
+        /** RestoreFromBackupResponse shard */
+        shard?: (string|null);
+
+        /** RestoreFromBackupResponse event */
+        event?: (logutil.IEvent|null);
+    }
+
+    /** Represents a RestoreFromBackupResponse. */
+    class RestoreFromBackupResponse implements IRestoreFromBackupResponse {
+
+        /**
+         * Constructs a new RestoreFromBackupResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IRestoreFromBackupResponse);
+
+        /** RestoreFromBackupResponse tablet_alias. */
+        public tablet_alias?: (topodata.ITabletAlias|null);
+
+        /** RestoreFromBackupResponse keyspace. */
+        public keyspace: string;
+
+        /** RestoreFromBackupResponse shard. */
+        public shard: string;
+
+        /** RestoreFromBackupResponse event. */
+        public event?: (logutil.IEvent|null);
+
+        /**
+         * Creates a new RestoreFromBackupResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns RestoreFromBackupResponse instance
+         */
+        public static create(properties?: vtctldata.IRestoreFromBackupResponse): vtctldata.RestoreFromBackupResponse;
+
+        /**
+         * Encodes the specified RestoreFromBackupResponse message. Does not implicitly {@link vtctldata.RestoreFromBackupResponse.verify|verify} messages.
+         * @param message RestoreFromBackupResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IRestoreFromBackupResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified RestoreFromBackupResponse message, length delimited. Does not implicitly {@link vtctldata.RestoreFromBackupResponse.verify|verify} messages.
+         * @param message RestoreFromBackupResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IRestoreFromBackupResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a RestoreFromBackupResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns RestoreFromBackupResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.RestoreFromBackupResponse;
+
+        /**
+         * Decodes a RestoreFromBackupResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns RestoreFromBackupResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.RestoreFromBackupResponse;
+
+        /**
+         * Verifies a RestoreFromBackupResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a RestoreFromBackupResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns RestoreFromBackupResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.RestoreFromBackupResponse;
+
+        /**
+         * Creates a plain object from a RestoreFromBackupResponse message. Also converts values to other types if specified.
+         * @param message RestoreFromBackupResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.RestoreFromBackupResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this RestoreFromBackupResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a RunHealthCheckRequest. */
     interface IRunHealthCheckRequest {
 
@@ -38757,6 +42300,192 @@ export namespace vtctldata {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of a SetKeyspaceDurabilityPolicyRequest. */
+    interface ISetKeyspaceDurabilityPolicyRequest {
+
+        /** SetKeyspaceDurabilityPolicyRequest keyspace */
+        keyspace?: (string|null);
+
+        /** SetKeyspaceDurabilityPolicyRequest durability_policy */
+        durability_policy?: (string|null);
+    }
+
+    /** Represents a SetKeyspaceDurabilityPolicyRequest. */
+    class SetKeyspaceDurabilityPolicyRequest implements ISetKeyspaceDurabilityPolicyRequest {
+
+        /**
+         * Constructs a new SetKeyspaceDurabilityPolicyRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.ISetKeyspaceDurabilityPolicyRequest);
+
+        /** SetKeyspaceDurabilityPolicyRequest keyspace. */
+        public keyspace: string;
+
+        /** SetKeyspaceDurabilityPolicyRequest durability_policy. */
+        public durability_policy: string;
+
+        /**
+         * Creates a new SetKeyspaceDurabilityPolicyRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns SetKeyspaceDurabilityPolicyRequest instance
+         */
+        public static create(properties?: vtctldata.ISetKeyspaceDurabilityPolicyRequest): vtctldata.SetKeyspaceDurabilityPolicyRequest;
+
+        /**
+         * Encodes the specified SetKeyspaceDurabilityPolicyRequest message. Does not implicitly {@link vtctldata.SetKeyspaceDurabilityPolicyRequest.verify|verify} messages.
+         * @param message SetKeyspaceDurabilityPolicyRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.ISetKeyspaceDurabilityPolicyRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified SetKeyspaceDurabilityPolicyRequest message, length delimited. Does not implicitly {@link vtctldata.SetKeyspaceDurabilityPolicyRequest.verify|verify} messages.
+         * @param message SetKeyspaceDurabilityPolicyRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.ISetKeyspaceDurabilityPolicyRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a SetKeyspaceDurabilityPolicyRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns SetKeyspaceDurabilityPolicyRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.SetKeyspaceDurabilityPolicyRequest;
+
+        /**
+         * Decodes a SetKeyspaceDurabilityPolicyRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns SetKeyspaceDurabilityPolicyRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.SetKeyspaceDurabilityPolicyRequest;
+
+        /**
+         * Verifies a SetKeyspaceDurabilityPolicyRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a SetKeyspaceDurabilityPolicyRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns SetKeyspaceDurabilityPolicyRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.SetKeyspaceDurabilityPolicyRequest;
+
+        /**
+         * Creates a plain object from a SetKeyspaceDurabilityPolicyRequest message. Also converts values to other types if specified.
+         * @param message SetKeyspaceDurabilityPolicyRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.SetKeyspaceDurabilityPolicyRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this SetKeyspaceDurabilityPolicyRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a SetKeyspaceDurabilityPolicyResponse. */
+    interface ISetKeyspaceDurabilityPolicyResponse {
+
+        /** SetKeyspaceDurabilityPolicyResponse keyspace */
+        keyspace?: (topodata.IKeyspace|null);
+    }
+
+    /** Represents a SetKeyspaceDurabilityPolicyResponse. */
+    class SetKeyspaceDurabilityPolicyResponse implements ISetKeyspaceDurabilityPolicyResponse {
+
+        /**
+         * Constructs a new SetKeyspaceDurabilityPolicyResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.ISetKeyspaceDurabilityPolicyResponse);
+
+        /** SetKeyspaceDurabilityPolicyResponse keyspace. */
+        public keyspace?: (topodata.IKeyspace|null);
+
+        /**
+         * Creates a new SetKeyspaceDurabilityPolicyResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns SetKeyspaceDurabilityPolicyResponse instance
+         */
+        public static create(properties?: vtctldata.ISetKeyspaceDurabilityPolicyResponse): vtctldata.SetKeyspaceDurabilityPolicyResponse;
+
+        /**
+         * Encodes the specified SetKeyspaceDurabilityPolicyResponse message. Does not implicitly {@link vtctldata.SetKeyspaceDurabilityPolicyResponse.verify|verify} messages.
+         * @param message SetKeyspaceDurabilityPolicyResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.ISetKeyspaceDurabilityPolicyResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified SetKeyspaceDurabilityPolicyResponse message, length delimited. Does not implicitly {@link vtctldata.SetKeyspaceDurabilityPolicyResponse.verify|verify} messages.
+         * @param message SetKeyspaceDurabilityPolicyResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.ISetKeyspaceDurabilityPolicyResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a SetKeyspaceDurabilityPolicyResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns SetKeyspaceDurabilityPolicyResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.SetKeyspaceDurabilityPolicyResponse;
+
+        /**
+         * Decodes a SetKeyspaceDurabilityPolicyResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns SetKeyspaceDurabilityPolicyResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.SetKeyspaceDurabilityPolicyResponse;
+
+        /**
+         * Verifies a SetKeyspaceDurabilityPolicyResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a SetKeyspaceDurabilityPolicyResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns SetKeyspaceDurabilityPolicyResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.SetKeyspaceDurabilityPolicyResponse;
+
+        /**
+         * Creates a plain object from a SetKeyspaceDurabilityPolicyResponse message. Also converts values to other types if specified.
+         * @param message SetKeyspaceDurabilityPolicyResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.SetKeyspaceDurabilityPolicyResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this SetKeyspaceDurabilityPolicyResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a SetKeyspaceServedFromRequest. */
     interface ISetKeyspaceServedFromRequest {
 
@@ -42926,6 +46655,102 @@ export namespace binlogdata {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of an EncodedRowChange. */
+    interface IEncodedRowChange {
+
+        /** EncodedRowChange key */
+        key?: (Uint8Array|null);
+
+        /** EncodedRowChange value */
+        value?: (Uint8Array|null);
+    }
+
+    /** Represents an EncodedRowChange. */
+    class EncodedRowChange implements IEncodedRowChange {
+
+        /**
+         * Constructs a new EncodedRowChange.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: binlogdata.IEncodedRowChange);
+
+        /** EncodedRowChange key. */
+        public key: Uint8Array;
+
+        /** EncodedRowChange value. */
+        public value: Uint8Array;
+
+        /**
+         * Creates a new EncodedRowChange instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns EncodedRowChange instance
+         */
+        public static create(properties?: binlogdata.IEncodedRowChange): binlogdata.EncodedRowChange;
+
+        /**
+         * Encodes the specified EncodedRowChange message. Does not implicitly {@link binlogdata.EncodedRowChange.verify|verify} messages.
+         * @param message EncodedRowChange message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: binlogdata.IEncodedRowChange, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified EncodedRowChange message, length delimited. Does not implicitly {@link binlogdata.EncodedRowChange.verify|verify} messages.
+         * @param message EncodedRowChange message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: binlogdata.IEncodedRowChange, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes an EncodedRowChange message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns EncodedRowChange
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): binlogdata.EncodedRowChange;
+
+        /**
+         * Decodes an EncodedRowChange message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns EncodedRowChange
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): binlogdata.EncodedRowChange;
+
+        /**
+         * Verifies an EncodedRowChange message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates an EncodedRowChange message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns EncodedRowChange
+         */
+        public static fromObject(object: { [k: string]: any }): binlogdata.EncodedRowChange;
+
+        /**
+         * Creates a plain object from an EncodedRowChange message. Also converts values to other types if specified.
+         * @param message EncodedRowChange
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: binlogdata.EncodedRowChange, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this EncodedRowChange to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of a RowEvent. */
     interface IRowEvent {
 
@@ -42940,6 +46765,9 @@ export namespace binlogdata {
 
         /** RowEvent shard */
         shard?: (string|null);
+
+        /** RowEvent encoded_row_changes */
+        encoded_row_changes?: (binlogdata.IEncodedRowChange[]|null);
     }
 
     /** Represents a RowEvent. */
@@ -42963,6 +46791,9 @@ export namespace binlogdata {
         /** RowEvent shard. */
         public shard: string;
 
+        /** RowEvent encoded_row_changes. */
+        public encoded_row_changes: binlogdata.IEncodedRowChange[];
+
         /**
          * Creates a new RowEvent instance using the specified properties.
          * @param [properties] Properties to set
@@ -43048,6 +46879,12 @@ export namespace binlogdata {
 
         /** FieldEvent shard */
         shard?: (string|null);
+
+        /** FieldEvent key_schema */
+        key_schema?: (string|null);
+
+        /** FieldEvent value_schema */
+        value_schema?: (string|null);
     }
 
     /** Represents a FieldEvent. */
@@ -43071,6 +46908,12 @@ export namespace binlogdata {
         /** FieldEvent shard. */
         public shard: string;
 
+        /** FieldEvent key_schema. */
+        public key_schema: string;
+
+        /** FieldEvent value_schema. */
+        public value_schema: string;
+
         /**
          * Creates a new FieldEvent instance using the specified properties.
          * @param [properties] Properties to set
@@ -44761,4 +48604,202 @@ export namespace binlogdata {
          */
         public toJSON(): { [k: string]: any };
     }
+
+    /** Properties of a VStreamPositionAtRequest. */
+    interface IVStreamPositionAtRequest {
+
+        /** VStreamPositionAtRequest effective_caller_id */
+        effective_caller_id?: (vtrpc.ICallerID|null);
+
+        /** VStreamPositionAtRequest immediate_caller_id */
+        immediate_caller_id?: (query.IVTGateCallerID|null);
+
+        /** VStreamPositionAtRequest target */
+        target?: (query.ITarget|null);
+
+        /** VStreamPositionAtRequest timestamp */
+        timestamp?: (number|Long|null);
+    }
+
+    /** Represents a VStreamPositionAtRequest. */
+    class VStreamPositionAtRequest implements IVStreamPositionAtRequest {
+
+        /**
+         * Constructs a new VStreamPositionAtRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: binlogdata.IVStreamPositionAtRequest);
+
+        /** VStreamPositionAtRequest effective_caller_id. */
+        public effective_caller_id?: (vtrpc.ICallerID|null);
+
+        /** VStreamPositionAtRequest immediate_caller_id. */
+        public immediate_caller_id?: (query.IVTGateCallerID|null);
+
+        /** VStreamPositionAtRequest target. */
+        public target?: (query.ITarget|null);
+
+        /** VStreamPositionAtRequest timestamp. */
+        public timestamp: (number|Long);
+
+        /**
+         * Creates a new VStreamPositionAtRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns VStreamPositionAtRequest instance
+         */
+        public static create(properties?: binlogdata.IVStreamPositionAtRequest): binlogdata.VStreamPositionAtRequest;
+
+        /**
+         * Encodes the specified VStreamPositionAtRequest message. Does not implicitly {@link binlogdata.VStreamPositionAtRequest.verify|verify} messages.
+         * @param message VStreamPositionAtRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: binlogdata.IVStreamPositionAtRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified VStreamPositionAtRequest message, length delimited. Does not implicitly {@link binlogdata.VStreamPositionAtRequest.verify|verify} messages.
+         * @param message VStreamPositionAtRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: binlogdata.IVStreamPositionAtRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a VStreamPositionAtRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns VStreamPositionAtRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): binlogdata.VStreamPositionAtRequest;
+
+        /**
+         * Decodes a VStreamPositionAtRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns VStreamPositionAtRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): binlogdata.VStreamPositionAtRequest;
+
+        /**
+         * Verifies a VStreamPositionAtRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a VStreamPositionAtRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns VStreamPositionAtRequest
+         */
+        public static fromObject(object: { [k: string]: any }): binlogdata.VStreamPositionAtRequest;
+
+        /**
+         * Creates a plain object from a VStreamPositionAtRequest message. Also converts values to other types if specified.
+         * @param message VStreamPositionAtRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: binlogdata.VStreamPositionAtRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this VStreamPositionAtRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a VStreamPositionAtResponse. */
+    interface IVStreamPositionAtResponse {
+
+        /** VStreamPositionAtResponse position */
+        position?: (string|null);
+    }
+
+    /** Represents a VStreamPositionAtResponse. */
+    class VStreamPositionAtResponse implements IVStreamPositionAtResponse {
+
+        /**
+         * Constructs a new VStreamPositionAtResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: binlogdata.IVStreamPositionAtResponse);
+
+        /** VStreamPositionAtResponse position. */
+        public position: string;
+
+        /**
+         * Creates a new VStreamPositionAtResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns VStreamPositionAtResponse instance
+         */
+        public static create(properties?: binlogdata.IVStreamPositionAtResponse): binlogdata.VStreamPositionAtResponse;
+
+        /**
+         * Encodes the specified VStreamPositionAtResponse message. Does not implicitly {@link binlogdata.VStreamPositionAtResponse.verify|verify} messages.
+         * @param message VStreamPositionAtResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: binlogdata.IVStreamPositionAtResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified VStreamPositionAtResponse message, length delimited. Does not implicitly {@link binlogdata.VStreamPositionAtResponse.verify|verify} messages.
+         * @param message VStreamPositionAtResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: binlogdata.IVStreamPositionAtResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a VStreamPositionAtResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns VStreamPositionAtResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): binlogdata.VStreamPositionAtResponse;
+
+        /**
+         * Decodes a VStreamPositionAtResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns VStreamPositionAtResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): binlogdata.VStreamPositionAtResponse;
+
+        /**
+         * Verifies a VStreamPositionAtResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a VStreamPositionAtResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns VStreamPositionAtResponse
+         */
+        public static fromObject(object: { [k: string]: any }): binlogdata.VStreamPositionAtResponse;
+
+        /**
+         * Creates a plain object from a VStreamPositionAtResponse message. Also converts values to other types if specified.
+         * @param message VStreamPositionAtResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: binlogdata.VStreamPositionAtResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this VStreamPositionAtResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
 }